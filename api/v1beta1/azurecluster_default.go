@@ -17,7 +17,10 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/binary"
 	"fmt"
+	"net"
+	"strconv"
 
 	"k8s.io/utils/ptr"
 )
@@ -31,12 +34,23 @@ const (
 	DefaultNodeSubnetCIDR = "10.1.0.0/16"
 	// DefaultNodeSubnetCIDRPattern is the pattern that will be used to generate the default subnets CIDRs.
 	DefaultNodeSubnetCIDRPattern = "10.%d.0.0/16"
+	// DefaultSubnetPrefixLength is the default prefix length of subnet CIDR blocks carved out of a custom vnet address space.
+	DefaultSubnetPrefixLength = 24
 	// DefaultAzureBastionSubnetCIDR is the default Subnet CIDR for AzureBastion.
-	DefaultAzureBastionSubnetCIDR = "10.255.255.224/27"
+	DefaultAzureBastionSubnetCIDR = "10.255.255.192/26"
+	// AzureBastionSubnetPrefixLength is the prefix length of the AzureBastion Subnet CIDR block carved out of a custom
+	// vnet address space. Azure requires the AzureBastion Subnet to be at least this large.
+	AzureBastionSubnetPrefixLength = 26
 	// DefaultAzureBastionSubnetName is the default Subnet Name for AzureBastion.
 	DefaultAzureBastionSubnetName = "AzureBastionSubnet"
 	// DefaultAzureBastionSubnetRole is the default Subnet role for AzureBastion.
 	DefaultAzureBastionSubnetRole = SubnetBastion
+	// DefaultAzureFirewallSubnetCIDR is the default Subnet CIDR for AzureFirewall.
+	DefaultAzureFirewallSubnetCIDR = "10.255.255.128/26"
+	// DefaultAzureFirewallSubnetName is the Subnet Name required by Azure for AzureFirewall.
+	DefaultAzureFirewallSubnetName = "AzureFirewallSubnet"
+	// DefaultAzureFirewallSubnetRole is the default Subnet role for AzureFirewall.
+	DefaultAzureFirewallSubnetRole = SubnetFirewall
 	// DefaultInternalLBIPAddress is the default internal load balancer ip address.
 	DefaultInternalLBIPAddress = "10.0.0.100"
 	// DefaultOutboundRuleIdleTimeoutInMinutes is the default for IdleTimeoutInMinutes for the load balancer.
@@ -53,8 +67,11 @@ func (c *AzureCluster) setDefaults() {
 
 func (c *AzureCluster) setNetworkSpecDefaults() {
 	c.setVnetDefaults()
-	c.setBastionDefaults()
+	c.setAzureFirewallDefaults()
 	c.setSubnetDefaults()
+	// The AzureBastion Subnet is defaulted after the cluster's subnets so that it is carved out of the vnet address
+	// space after them.
+	c.setBastionDefaults()
 	c.setVnetPeeringDefaults()
 	c.setAPIServerLBDefaults()
 	c.SetNodeOutboundLBDefaults()
@@ -94,7 +111,10 @@ func (c *AzureCluster) setSubnetDefaults() {
 		cpSubnet.Name = generateControlPlaneSubnetName(c.ObjectMeta.Name)
 	}
 
-	cpSubnet.SubnetClassSpec.setDefaults(DefaultControlPlaneSubnetCIDR)
+	cidrs := c.newSubnetCIDRAllocator(c.subnetPrefixLength())
+	if len(cpSubnet.CIDRBlocks) == 0 {
+		cpSubnet.SubnetClassSpec.setDefaults(cidrs.nextOr(DefaultControlPlaneSubnetCIDR))
+	}
 
 	if cpSubnet.SecurityGroup.Name == "" {
 		cpSubnet.SecurityGroup.Name = generateControlPlaneSecurityGroupName(c.ObjectMeta.Name)
@@ -114,7 +134,9 @@ func (c *AzureCluster) setSubnetDefaults() {
 		if subnet.Name == "" {
 			subnet.Name = withIndex(generateNodeSubnetName(c.ObjectMeta.Name), nodeSubnetCounter)
 		}
-		subnet.SubnetClassSpec.setDefaults(fmt.Sprintf(DefaultNodeSubnetCIDRPattern, nodeSubnetCounter))
+		if len(subnet.CIDRBlocks) == 0 {
+			subnet.SubnetClassSpec.setDefaults(cidrs.nextOr(fmt.Sprintf(DefaultNodeSubnetCIDRPattern, nodeSubnetCounter)))
+		}
 
 		if subnet.SecurityGroup.Name == "" {
 			subnet.SecurityGroup.Name = generateNodeSecurityGroupName(c.ObjectMeta.Name)
@@ -144,7 +166,7 @@ func (c *AzureCluster) setSubnetDefaults() {
 		nodeSubnet := SubnetSpec{
 			SubnetClassSpec: SubnetClassSpec{
 				Role:       SubnetNode,
-				CIDRBlocks: []string{DefaultNodeSubnetCIDR},
+				CIDRBlocks: []string{cidrs.nextOr(DefaultNodeSubnetCIDR)},
 				Name:       generateNodeSubnetName(c.ObjectMeta.Name),
 			},
 			SecurityGroup: SecurityGroup{
@@ -163,6 +185,86 @@ func (c *AzureCluster) setSubnetDefaults() {
 	}
 }
 
+// subnetCIDRAllocator carves non-overlapping subnet CIDR blocks out of an IPv4 vnet address space.
+type subnetCIDRAllocator struct {
+	vnet      *net.IPNet
+	prefixLen int
+	allocated []*net.IPNet
+}
+
+// subnetPrefixLength returns the prefix length of the subnet CIDR blocks carved out of a custom vnet address space.
+func (c *AzureCluster) subnetPrefixLength() int {
+	if l, err := strconv.Atoi(c.GetAnnotations()[SubnetPrefixLengthAnnotation]); err == nil && l > 0 && l <= net.IPv4len*8 {
+		return l
+	}
+	return DefaultSubnetPrefixLength
+}
+
+// newSubnetCIDRAllocator returns an allocator of CIDR blocks of the given prefix length for the first IPv4 CIDR block
+// of a custom vnet address space, which avoids the CIDR blocks of all subnets which already have them. It returns nil
+// if the vnet uses the default address space, so that subnets keep the default CIDR blocks.
+func (c *AzureCluster) newSubnetCIDRAllocator(prefixLen int) *subnetCIDRAllocator {
+	vnetCIDRs := c.Spec.NetworkSpec.Vnet.CIDRBlocks
+	if len(vnetCIDRs) == 0 || (len(vnetCIDRs) == 1 && vnetCIDRs[0] == DefaultVnetCIDR) {
+		return nil
+	}
+
+	for _, cidr := range vnetCIDRs {
+		_, vnet, err := net.ParseCIDR(cidr)
+		if err != nil || vnet.IP.To4() == nil {
+			continue
+		}
+		a := &subnetCIDRAllocator{vnet: vnet, prefixLen: prefixLen}
+		subnets := c.Spec.NetworkSpec.Subnets
+		if c.Spec.BastionSpec.AzureBastion != nil {
+			subnets = append(subnets[:len(subnets):len(subnets)], c.Spec.BastionSpec.AzureBastion.Subnet)
+		}
+		for _, subnet := range subnets {
+			for _, subnetCIDR := range subnet.CIDRBlocks {
+				if _, n, err := net.ParseCIDR(subnetCIDR); err == nil {
+					a.allocated = append(a.allocated, n)
+				}
+			}
+		}
+		return a
+	}
+	return nil
+}
+
+// nextOr returns the next free CIDR block in the vnet address space, or fallback if there is none.
+func (a *subnetCIDRAllocator) nextOr(fallback string) string {
+	if a == nil {
+		return fallback
+	}
+	vnetOnes, _ := a.vnet.Mask.Size()
+	if a.prefixLen < vnetOnes {
+		return fallback
+	}
+
+	mask := net.CIDRMask(a.prefixLen, net.IPv4len*8)
+	start := uint64(binary.BigEndian.Uint32(a.vnet.IP.To4()))
+	end := start + 1<<(net.IPv4len*8-vnetOnes)
+	step := uint64(1) << (net.IPv4len*8 - a.prefixLen)
+	for ip := start; ip < end; ip += step {
+		candidate := &net.IPNet{IP: make(net.IP, net.IPv4len), Mask: mask}
+		binary.BigEndian.PutUint32(candidate.IP, uint32(ip))
+		if !a.overlaps(candidate) {
+			a.allocated = append(a.allocated, candidate)
+			return candidate.String()
+		}
+	}
+	return fallback
+}
+
+func (a *subnetCIDRAllocator) overlaps(candidate *net.IPNet) bool {
+	for _, n := range a.allocated {
+		if n.Contains(candidate.IP) || candidate.Contains(n.IP) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *AzureCluster) setVnetPeeringDefaults() {
 	for i, peering := range c.Spec.NetworkSpec.Vnet.Peerings {
 		if peering.ResourceGroup == "" {
@@ -336,7 +438,13 @@ func (c *AzureCluster) setBastionDefaults() {
 			c.Spec.BastionSpec.AzureBastion.Subnet.Name = DefaultAzureBastionSubnetName
 		}
 		if len(c.Spec.BastionSpec.AzureBastion.Subnet.CIDRBlocks) == 0 {
-			c.Spec.BastionSpec.AzureBastion.Subnet.CIDRBlocks = []string{DefaultAzureBastionSubnetCIDR}
+			// The Subnet is carved out of a custom vnet address space. It is left without CIDR blocks, which
+			// validation rejects, if the vnet has no room for it.
+			if cidrs := c.newSubnetCIDRAllocator(AzureBastionSubnetPrefixLength); cidrs == nil {
+				c.Spec.BastionSpec.AzureBastion.Subnet.CIDRBlocks = []string{DefaultAzureBastionSubnetCIDR}
+			} else if cidr := cidrs.nextOr(""); cidr != "" {
+				c.Spec.BastionSpec.AzureBastion.Subnet.CIDRBlocks = []string{cidr}
+			}
 		}
 		if c.Spec.BastionSpec.AzureBastion.Subnet.Role == "" {
 			c.Spec.BastionSpec.AzureBastion.Subnet.Role = DefaultAzureBastionSubnetRole
@@ -348,6 +456,39 @@ func (c *AzureCluster) setBastionDefaults() {
 	}
 }
 
+func (c *AzureCluster) setAzureFirewallDefaults() {
+	firewall := c.Spec.NetworkSpec.AzureFirewall
+	if firewall == nil {
+		return
+	}
+	if firewall.Name == "" {
+		firewall.Name = generateAzureFirewallName(c.ObjectMeta.Name)
+	}
+	if firewall.SKU == "" {
+		firewall.SKU = StandardAzureFirewallSKUTier
+	}
+	// Ensure defaults for the Subnet settings.
+	if firewall.Subnet.Name == "" {
+		firewall.Subnet.Name = DefaultAzureFirewallSubnetName
+	}
+	if len(firewall.Subnet.CIDRBlocks) == 0 {
+		firewall.Subnet.CIDRBlocks = []string{DefaultAzureFirewallSubnetCIDR}
+	}
+	if firewall.Subnet.Role == "" {
+		firewall.Subnet.Role = DefaultAzureFirewallSubnetRole
+	}
+	// Ensure defaults for the PublicIP settings.
+	if firewall.PublicIPsCount == nil {
+		firewall.PublicIPsCount = ptr.To[int32](1)
+	}
+	firewall.PublicIPs = make([]PublicIPSpec, *firewall.PublicIPsCount)
+	for i := range firewall.PublicIPs {
+		firewall.PublicIPs[i] = PublicIPSpec{
+			Name: withIndex(generateAzureFirewallPublicIPName(c.ObjectMeta.Name), i+1),
+		}
+	}
+}
+
 func (lb *LoadBalancerClassSpec) setAPIServerLBDefaults() {
 	if lb.Type == "" {
 		lb.Type = Public
@@ -420,6 +561,16 @@ func generateAzureBastionPublicIPName(clusterName string) string {
 	return fmt.Sprintf("%s-azure-bastion-pip", clusterName)
 }
 
+// generateAzureFirewallName generates an azure firewall name.
+func generateAzureFirewallName(clusterName string) string {
+	return fmt.Sprintf("%s-azure-firewall", clusterName)
+}
+
+// generateAzureFirewallPublicIPName generates an azure firewall public ip name.
+func generateAzureFirewallPublicIPName(clusterName string) string {
+	return fmt.Sprintf("%s-azure-firewall-pip", clusterName)
+}
+
 // generateControlPlaneSecurityGroupName generates a control plane security group name, based on the cluster name.
 func generateControlPlaneSecurityGroupName(clusterName string) string {
 	return fmt.Sprintf("%s-%s", clusterName, "controlplane-nsg")
@@ -963,6 +963,142 @@ func TestSubnetDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "subnets without CIDR blocks in a custom vnet",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Vnet: VnetSpec{VnetClassSpec: VnetClassSpec{CIDRBlocks: []string{"192.168.0.0/16"}}},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Vnet: VnetSpec{VnetClassSpec: VnetClassSpec{CIDRBlocks: []string{"192.168.0.0/16"}}},
+						Subnets: Subnets{
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetControlPlane,
+									CIDRBlocks: []string{"192.168.0.0/24"},
+									Name:       "cluster-test-controlplane-subnet",
+								},
+								SecurityGroup: SecurityGroup{Name: "cluster-test-controlplane-nsg"},
+							},
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetNode,
+									CIDRBlocks: []string{"192.168.1.0/24"},
+									Name:       "cluster-test-node-subnet",
+								},
+								SecurityGroup: SecurityGroup{Name: "cluster-test-node-nsg"},
+								RouteTable:    RouteTable{Name: "cluster-test-node-routetable"},
+								NatGateway: NatGateway{NatGatewayClassSpec: NatGatewayClassSpec{
+									Name: "cluster-test-node-natgw",
+								}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "subnets with and without CIDR blocks in a custom vnet with a custom prefix length",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cluster-test",
+					Annotations: map[string]string{SubnetPrefixLengthAnnotation: "26"},
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Vnet: VnetSpec{VnetClassSpec: VnetClassSpec{CIDRBlocks: []string{"192.168.0.0/24"}}},
+						Subnets: Subnets{
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetControlPlane,
+									CIDRBlocks: []string{"192.168.0.0/26"},
+									Name:       "my-controlplane-subnet",
+								},
+							},
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role: SubnetNode,
+									Name: "my-node-subnet-1",
+								},
+							},
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetNode,
+									CIDRBlocks: []string{"192.168.0.64/26"},
+									Name:       "my-node-subnet-2",
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "cluster-test",
+					Annotations: map[string]string{SubnetPrefixLengthAnnotation: "26"},
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Vnet: VnetSpec{VnetClassSpec: VnetClassSpec{CIDRBlocks: []string{"192.168.0.0/24"}}},
+						Subnets: Subnets{
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetControlPlane,
+									CIDRBlocks: []string{"192.168.0.0/26"},
+									Name:       "my-controlplane-subnet",
+								},
+								SecurityGroup: SecurityGroup{Name: "cluster-test-controlplane-nsg"},
+							},
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetNode,
+									CIDRBlocks: []string{"192.168.0.128/26"},
+									Name:       "my-node-subnet-1",
+								},
+								SecurityGroup: SecurityGroup{Name: "cluster-test-node-nsg"},
+								RouteTable:    RouteTable{Name: "cluster-test-node-routetable"},
+								NatGateway: NatGateway{
+									NatGatewayClassSpec: NatGatewayClassSpec{
+										Name: "cluster-test-node-natgw-1",
+									},
+									NatGatewayIP: PublicIPSpec{
+										Name: "pip-cluster-test-node-natgw-1",
+									},
+								},
+							},
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetNode,
+									CIDRBlocks: []string{"192.168.0.64/26"},
+									Name:       "my-node-subnet-2",
+								},
+								SecurityGroup: SecurityGroup{Name: "cluster-test-node-nsg"},
+								RouteTable:    RouteTable{Name: "cluster-test-node-routetable"},
+								NatGateway: NatGateway{
+									NatGatewayClassSpec: NatGatewayClassSpec{
+										Name: "cluster-test-node-natgw-2",
+									},
+									NatGatewayIP: PublicIPSpec{
+										Name: "pip-cluster-test-node-natgw-2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -2405,6 +2541,64 @@ func TestBastionDefault(t *testing.T) {
 		},
 	}
 
+	customVnetCluster := func(vnetCIDR string, subnetCIDRs ...string) *AzureCluster {
+		cluster := &AzureCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+			},
+			Spec: AzureClusterSpec{
+				NetworkSpec: NetworkSpec{
+					Vnet: VnetSpec{
+						VnetClassSpec: VnetClassSpec{
+							CIDRBlocks: []string{vnetCIDR},
+						},
+					},
+				},
+				BastionSpec: BastionSpec{
+					AzureBastion: &AzureBastion{},
+				},
+			},
+		}
+		for _, cidr := range subnetCIDRs {
+			cluster.Spec.NetworkSpec.Subnets = append(cluster.Spec.NetworkSpec.Subnets, SubnetSpec{
+				SubnetClassSpec: SubnetClassSpec{
+					CIDRBlocks: []string{cidr},
+				},
+			})
+		}
+		return cluster
+	}
+	withBastionSubnetCIDRs := func(cluster *AzureCluster, cidrs ...string) *AzureCluster {
+		cluster.Spec.BastionSpec.AzureBastion = &AzureBastion{
+			Name: "foo-azure-bastion",
+			Subnet: SubnetSpec{
+				SubnetClassSpec: SubnetClassSpec{
+					CIDRBlocks: cidrs,
+					Role:       DefaultAzureBastionSubnetRole,
+					Name:       "AzureBastionSubnet",
+				},
+			},
+			PublicIP: PublicIPSpec{
+				Name: "foo-azure-bastion-pip",
+			},
+		}
+		return cluster
+	}
+	cases["azure bastion subnet is carved out of a custom vnet after the cluster's subnets"] = struct {
+		cluster *AzureCluster
+		output  *AzureCluster
+	}{
+		cluster: customVnetCluster("172.16.0.0/16", "172.16.0.0/24", "172.16.1.0/24"),
+		output:  withBastionSubnetCIDRs(customVnetCluster("172.16.0.0/16", "172.16.0.0/24", "172.16.1.0/24"), "172.16.2.0/26"),
+	}
+	cases["azure bastion subnet is left empty when a custom vnet has no room for it"] = struct {
+		cluster *AzureCluster
+		output  *AzureCluster
+	}{
+		cluster: customVnetCluster("172.16.0.0/24", "172.16.0.0/25", "172.16.0.128/25"),
+		output:  withBastionSubnetCIDRs(customVnetCluster("172.16.0.0/24", "172.16.0.0/25", "172.16.0.128/25")),
+	}
+
 	for name := range cases {
 		c := cases[name]
 		t.Run(name, func(t *testing.T) {
@@ -2418,3 +2612,118 @@ func TestBastionDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureFirewallDefault(t *testing.T) {
+	cases := map[string]struct {
+		cluster *AzureCluster
+		output  *AzureCluster
+	}{
+		"no firewall set": {
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{},
+			},
+		},
+		"azure firewall enabled with no settings": {
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AzureFirewall: &AzureFirewall{},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AzureFirewall: &AzureFirewall{
+							Name: "foo-azure-firewall",
+							SKU:  StandardAzureFirewallSKUTier,
+							Subnet: SubnetSpec{
+								SubnetClassSpec: SubnetClassSpec{
+									CIDRBlocks: []string{DefaultAzureFirewallSubnetCIDR},
+									Role:       DefaultAzureFirewallSubnetRole,
+									Name:       "AzureFirewallSubnet",
+								},
+							},
+							PublicIPsCount: ptr.To[int32](1),
+							PublicIPs: []PublicIPSpec{
+								{Name: "foo-azure-firewall-pip-1"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"azure firewall public IPs follow the count": {
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AzureFirewall: &AzureFirewall{
+							Name:           "my-firewall",
+							SKU:            PremiumAzureFirewallSKUTier,
+							PublicIPsCount: ptr.To[int32](2),
+							PublicIPs: []PublicIPSpec{
+								{Name: "foo-azure-firewall-pip-1"},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AzureFirewall: &AzureFirewall{
+							Name: "my-firewall",
+							SKU:  PremiumAzureFirewallSKUTier,
+							Subnet: SubnetSpec{
+								SubnetClassSpec: SubnetClassSpec{
+									CIDRBlocks: []string{DefaultAzureFirewallSubnetCIDR},
+									Role:       DefaultAzureFirewallSubnetRole,
+									Name:       "AzureFirewallSubnet",
+								},
+							},
+							PublicIPsCount: ptr.To[int32](2),
+							PublicIPs: []PublicIPSpec{
+								{Name: "foo-azure-firewall-pip-1"},
+								{Name: "foo-azure-firewall-pip-2"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name := range cases {
+		c := cases[name]
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			c.cluster.setAzureFirewallDefaults()
+			if !reflect.DeepEqual(c.cluster, c.output) {
+				expected, _ := json.MarshalIndent(c.output, "", "\t")
+				actual, _ := json.MarshalIndent(c.cluster, "", "\t")
+				t.Errorf("Expected %s, got %s", string(expected), string(actual))
+			}
+		})
+	}
+}
@@ -41,6 +41,57 @@ type AzureClusterSpec struct {
 	// +optional
 	ResourceGroup string `json:"resourceGroup,omitempty"`
 
+	// NodeResourceGroup is the name of the resource group in which the compute resources of the cluster's machines,
+	// i.e. their virtual machines, scale sets, network interfaces, disks and availability sets, are created.
+	// It is created if it doesn't exist. Network resources are created in the resource group of the virtual network,
+	// see NetworkSpec.Vnet.ResourceGroup. Defaults to ResourceGroup.
+	// +optional
+	NodeResourceGroup string `json:"nodeResourceGroup,omitempty"`
+
+	// ResourceGroupMode defines whether CAPZ creates and deletes the cluster's resource groups, i.e. ResourceGroup,
+	// NodeResourceGroup and NetworkSpec.Vnet.ResourceGroup. When Unmanaged, the resource groups must exist before
+	// the cluster is created. Defaults to Managed.
+	// +kubebuilder:validation:Enum=Managed;Unmanaged
+	// +optional
+	ResourceGroupMode ResourceGroupMode `json:"resourceGroupMode,omitempty"`
+
+	// PolicyAssignments are the Azure Policy assignments CAPZ creates for the cluster's resource group, or for
+	// resources in it. Assignments removed from the list are deleted.
+	// +optional
+	PolicyAssignments []PolicyAssignment `json:"policyAssignments,omitempty"`
+
+	// UserAssignedIdentities are user-assigned managed identities CAPZ creates in the cluster's resource group, e.g.
+	// to be assigned to the cluster's machines or to be granted roles on other resources. Their resource, principal
+	// and client IDs are reported in the status. The identities are deleted with the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	UserAssignedIdentities []ManagedIdentity `json:"userAssignedIdentities,omitempty"`
+
+	// NetAppVolumes are Azure NetApp Files volumes CAPZ creates for the workloads of the cluster, e.g. to be mounted
+	// by the NetApp CSI driver. Their NetApp accounts and capacity pools are created in the cluster's resource group.
+	// The volumes are deleted with the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	NetAppVolumes []NetAppVolume `json:"netAppVolumes,omitempty"`
+
+	// DiskEncryptionSets are disk encryption sets CAPZ creates in the cluster's resource group from customer-managed
+	// Key Vault keys, to be referenced by the disks of the cluster's machines. Their IDs are reported in the status.
+	// The disk encryption sets are deleted with the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	DiskEncryptionSets []DiskEncryptionSet `json:"diskEncryptionSets,omitempty"`
+
+	// TagPropagationPolicy defines which of the cluster's resources receive its AdditionalTags, e.g. to stay within
+	// the tag limits of some resource types. The tags of the cluster's machines are not affected. Defaults to all
+	// resources. Excluding a resource type removes the AdditionalTags from its existing resources only when they are
+	// managed through Azure Service Operator, such as resource groups and virtual networks; existing resources of the
+	// other types keep them.
+	// +optional
+	TagPropagationPolicy *TagPropagationPolicy `json:"tagPropagationPolicy,omitempty"`
+
 	// BastionSpec encapsulates all things related to the Bastions in the cluster.
 	// +optional
 	BastionSpec BastionSpec `json:"bastionSpec,omitempty"`
@@ -74,6 +125,29 @@ type AzureClusterStatus struct {
 	// next reconciliation loop.
 	// +optional
 	LongRunningOperationStates Futures `json:"longRunningOperationStates,omitempty"`
+
+	// PublicIPs are the DNS configurations of the public IPs of the cluster which have a DNS name.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PublicIPs []PublicIPStatus `json:"publicIPs,omitempty"`
+
+	// AzureFirewallPrivateIP is the private IP address of the cluster's Azure Firewall. It is the next hop of the
+	// default route of the node subnets when the firewall routes node egress.
+	// +optional
+	AzureFirewallPrivateIP string `json:"azureFirewallPrivateIP,omitempty"`
+
+	// UserAssignedIdentities are the IDs of the user-assigned managed identities CAPZ created for the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	UserAssignedIdentities []ManagedIdentityStatus `json:"userAssignedIdentities,omitempty"`
+
+	// DiskEncryptionSets are the IDs and active keys of the disk encryption sets CAPZ created for the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	DiskEncryptionSets []DiskEncryptionSetStatus `json:"diskEncryptionSets,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/binary"
 	"fmt"
 	"net"
+	"net/url"
 	"reflect"
 	"regexp"
+	"strings"
 
 	valid "github.com/asaskevich/govalidator"
 	corev1 "k8s.io/api/core/v1"
@@ -29,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -50,6 +54,14 @@ const (
 	MinLBIdleTimeoutInMinutes = 4
 	// MaxLBIdleTimeoutInMinutes is the maximum number of minutes for the LB idle timeout.
 	MaxLBIdleTimeoutInMinutes = 30
+	// MinLBProbeIntervalInSeconds is the minimum number of seconds between LB health probes.
+	MinLBProbeIntervalInSeconds = 5
+	// MinLBNumberOfProbes is the minimum number of failed LB health probes before a backend is taken out of rotation.
+	MinLBNumberOfProbes = 1
+	// MinLBFrontendPort is the minimum port of the API server load balancer frontend.
+	MinLBFrontendPort = 1
+	// MaxLBFrontendPort is the maximum port of the API server load balancer frontend.
+	MaxLBFrontendPort = 65535
 	// Network security rules should be a number between 100 and 4096.
 	// https://learn.microsoft.com/azure/virtual-network/network-security-groups-overview#security-rules
 	minRulePriority = 100
@@ -60,6 +72,22 @@ const (
 	serviceEndpointLocationRegexPattern = `^([a-z]{1,42}\d{0,5}|[*])$`
 	// described in https://learn.microsoft.com/azure/azure-resource-manager/management/resource-name-rules.
 	privateEndpointRegex = `^[-\w\._]+$`
+	// described in https://learn.microsoft.com/azure/virtual-network/ip-services/public-ip-addresses#domain-name-label.
+	domainNameLabelRegex = `^[a-z][a-z0-9-]{1,61}[a-z0-9]$`
+	// logAnalyticsWorkspaceResourceType is the resource type of the workspaces diagnostic settings send logs to.
+	logAnalyticsWorkspaceResourceType = "Microsoft.OperationalInsights/workspaces"
+	// loadBalancerFrontendIPConfigResourceType is the resource type of the Gateway Load Balancer frontends load balancers are chained to.
+	loadBalancerFrontendIPConfigResourceType = "Microsoft.Network/loadBalancers/frontendIPConfigurations"
+	// policyDefinitionResourceType is the resource type of the policy definitions policy assignments assign.
+	policyDefinitionResourceType = "Microsoft.Authorization/policyDefinitions"
+	// policySetDefinitionResourceType is the resource type of the policy set definitions policy assignments assign.
+	policySetDefinitionResourceType = "Microsoft.Authorization/policySetDefinitions"
+	// subnetResourceType is the resource type of the subnets NetApp volumes are placed in.
+	subnetResourceType = "Microsoft.Network/virtualNetworks/subnets"
+	// keyVaultResourceType is the resource type of the Key Vaults containing the keys of disk encryption sets.
+	keyVaultResourceType = "Microsoft.KeyVault/vaults"
+	// described in https://learn.microsoft.com/azure/azure-resource-manager/management/resource-name-rules.
+	policyAssignmentNamePattern = `^[^<>*%&:\\?.+/]*[^<>*%&:\\?.+/ ]$`
 	// resource ID Pattern.
 	resourceIDPattern = `(?i)subscriptions/(.+)/resourceGroups/(.+)/providers/(.+?)/(.+?)/(.+)`
 )
@@ -67,6 +95,7 @@ const (
 var (
 	serviceEndpointServiceRegex  = regexp.MustCompile(serviceEndpointServiceRegexPattern)
 	serviceEndpointLocationRegex = regexp.MustCompile(serviceEndpointLocationRegexPattern)
+	policyAssignmentNameRegex    = regexp.MustCompile(policyAssignmentNamePattern)
 )
 
 // validateCluster validates a cluster.
@@ -92,6 +121,12 @@ func (c *AzureCluster) validateClusterSpec(old *AzureCluster) field.ErrorList {
 	}
 	allErrs = append(allErrs, validateNetworkSpec(c.Spec.NetworkSpec, oldNetworkSpec, field.NewPath("spec").Child("networkSpec"))...)
 
+	if c.Spec.NodeResourceGroup != "" {
+		if err := validateResourceGroup(c.Spec.NodeResourceGroup, field.NewPath("spec").Child("nodeResourceGroup")); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
 	var oldCloudProviderConfigOverrides *CloudProviderConfigOverrides
 	if old != nil {
 		oldCloudProviderConfigOverrides = old.Spec.CloudProviderConfigOverrides
@@ -99,6 +134,8 @@ func (c *AzureCluster) validateClusterSpec(old *AzureCluster) field.ErrorList {
 	allErrs = append(allErrs, validateCloudProviderConfigOverrides(c.Spec.CloudProviderConfigOverrides, oldCloudProviderConfigOverrides,
 		field.NewPath("spec").Child("cloudProviderConfigOverrides"))...)
 
+	allErrs = append(allErrs, ValidateTags(c.Spec.AdditionalTags, field.NewPath("spec").Child("additionalTags"))...)
+
 	// If ClusterSpec has non-nil ExtendedLocation field but not enable EdgeZone feature gate flag, ClusterSpec validation failed.
 	if !feature.Gates.Enabled(feature.EdgeZone) && c.Spec.ExtendedLocation != nil {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "ExtendedLocation"), "can be set only if the EdgeZone feature flag is enabled"))
@@ -108,10 +145,25 @@ func (c *AzureCluster) validateClusterSpec(old *AzureCluster) field.ErrorList {
 		allErrs = append(allErrs, err)
 	}
 
+	if c.Spec.BastionSpec.AzureBastion != nil {
+		allErrs = append(allErrs, validatePublicIP(c.Spec.BastionSpec.AzureBastion.PublicIP, field.NewPath("spec").Child("bastionSpec", "azureBastion", "publicIP"))...)
+
+		var oldSubnet *SubnetSpec
+		if old != nil && old.Spec.BastionSpec.AzureBastion != nil {
+			oldSubnet = &old.Spec.BastionSpec.AzureBastion.Subnet
+		}
+		allErrs = append(allErrs, validateAzureBastionSubnet(c.Spec.BastionSpec.AzureBastion.Subnet, oldSubnet, c.Spec.NetworkSpec.Vnet,
+			field.NewPath("spec").Child("bastionSpec", "azureBastion", "subnet"))...)
+	}
+
 	if err := validateIdentityRef(c.Spec.IdentityRef, field.NewPath("spec").Child("identityRef")); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, validatePolicyAssignments(c.Spec.PolicyAssignments, c.Spec.SubscriptionID, c.Spec.ResourceGroup, field.NewPath("spec").Child("policyAssignments"))...)
+	allErrs = append(allErrs, validateNetAppVolumes(c.Spec.NetAppVolumes, field.NewPath("spec").Child("netAppVolumes"))...)
+	allErrs = append(allErrs, validateDiskEncryptionSets(c.Spec.DiskEncryptionSets, field.NewPath("spec").Child("diskEncryptionSets"))...)
+
 	return allErrs
 }
 
@@ -142,6 +194,29 @@ func validateBastionSpec(bastionSpec BastionSpec, fldPath *field.Path) *field.Er
 	return nil
 }
 
+// validateAzureBastionSubnet validates the Subnet of an AzureBastion. It must be in the vnet address space, and at
+// least as large as Azure requires unless it already existed.
+func validateAzureBastionSubnet(subnet SubnetSpec, old *SubnetSpec, vnet VnetSpec, fldPath *field.Path) field.ErrorList {
+	if len(subnet.CIDRBlocks) == 0 {
+		return field.ErrorList{field.Required(fldPath.Child("cidrBlocks"),
+			fmt.Sprintf("the vnet address space %s has no room for a /%d subnet", vnet.CIDRBlocks, AzureBastionSubnetPrefixLength))}
+	}
+
+	var allErrs field.ErrorList
+	if old == nil || !reflect.DeepEqual(subnet.CIDRBlocks, old.CIDRBlocks) {
+		for _, cidr := range subnet.CIDRBlocks {
+			if _, n, err := net.ParseCIDR(cidr); err == nil && n.IP.To4() != nil {
+				if ones, _ := n.Mask.Size(); ones > AzureBastionSubnetPrefixLength {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("cidrBlocks"), cidr,
+						fmt.Sprintf("the AzureBastion subnet must be /%d or larger", AzureBastionSubnetPrefixLength)))
+				}
+			}
+		}
+	}
+	allErrs = append(allErrs, validateSubnetCIDR(subnet.CIDRBlocks, vnet.CIDRBlocks, fldPath.Child("cidrBlocks"))...)
+	return allErrs
+}
+
 // validateIdentityRef validates an IdentityRef.
 func validateIdentityRef(identityRef *corev1.ObjectReference, fldPath *field.Path) *field.Error {
 	if identityRef == nil {
@@ -172,6 +247,10 @@ func validateNetworkSpec(networkSpec NetworkSpec, old NetworkSpec, fldPath *fiel
 		allErrs = append(allErrs, validateVnetPeerings(networkSpec.Vnet.Peerings, fldPath.Child("peerings"))...)
 	}
 
+	allErrs = append(allErrs, validateVnetDDoSProtection(networkSpec.Vnet.VnetClassSpec, fldPath.Child("vnet"))...)
+
+	allErrs = append(allErrs, validateVnetDNSServers(networkSpec.Vnet.DNSServers, fldPath.Child("vnet", "dnsServers"))...)
+
 	var cidrBlocks []string
 	controlPlaneSubnet, err := networkSpec.GetControlPlaneSubnet()
 	if err != nil {
@@ -195,14 +274,154 @@ func validateNetworkSpec(networkSpec NetworkSpec, old NetworkSpec, fldPath *fiel
 
 	allErrs = append(allErrs, validateControlPlaneOutboundLB(networkSpec.ControlPlaneOutboundLB, networkSpec.APIServerLB, fldPath.Child("controlPlaneOutboundLB"))...)
 
+	allErrs = append(allErrs, validateLoadBalancerPublicIPs(&networkSpec.APIServerLB, fldPath.Child("apiServerLB"))...)
+	allErrs = append(allErrs, validateLoadBalancerPublicIPs(networkSpec.NodeOutboundLB, fldPath.Child("nodeOutboundLB"))...)
+	allErrs = append(allErrs, validateLoadBalancerPublicIPs(networkSpec.ControlPlaneOutboundLB, fldPath.Child("controlPlaneOutboundLB"))...)
+	for i, subnet := range networkSpec.Subnets {
+		allErrs = append(allErrs, validatePublicIP(subnet.NatGateway.NatGatewayIP, fldPath.Child("subnets").Index(i).Child("natGateway", "ip"))...)
+	}
+	if networkSpec.AzureFirewall != nil {
+		for i, publicIP := range networkSpec.AzureFirewall.PublicIPs {
+			allErrs = append(allErrs, validatePublicIP(publicIP, fldPath.Child("azureFirewall", "publicIPs").Index(i))...)
+		}
+	}
+
+	allErrs = append(allErrs, validateGatewayLoadBalancers(&networkSpec.APIServerLB, fldPath.Child("apiServerLB"))...)
+	allErrs = append(allErrs, validateGatewayLoadBalancers(networkSpec.NodeOutboundLB, fldPath.Child("nodeOutboundLB"))...)
+	allErrs = append(allErrs, validateGatewayLoadBalancers(networkSpec.ControlPlaneOutboundLB, fldPath.Child("controlPlaneOutboundLB"))...)
+
 	allErrs = append(allErrs, validatePrivateDNSZoneName(networkSpec.PrivateDNSZoneName, networkSpec.APIServerLB.Type, fldPath.Child("privateDNSZoneName"))...)
 
+	if err := validateAzureFirewall(networkSpec.AzureFirewall, fldPath.Child("azureFirewall")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	allErrs = append(allErrs, validateDiagnosticSettings(networkSpec.DiagnosticSettings, fldPath.Child("diagnosticSettings"))...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
 	return allErrs
 }
 
+// validateAzureFirewall validates an AzureFirewall.
+func validateAzureFirewall(firewall *AzureFirewall, fldPath *field.Path) *field.Error {
+	if firewall == nil {
+		return nil
+	}
+	if firewall.Subnet.Name != DefaultAzureFirewallSubnetName {
+		return field.Invalid(fldPath.Child("subnet").Child("name"), firewall.Subnet.Name,
+			fmt.Sprintf("the Azure Firewall subnet must be named %s", DefaultAzureFirewallSubnetName))
+	}
+	return nil
+}
+
+// validateDiagnosticSettings validates a list of DiagnosticSettings.
+func validateDiagnosticSettings(settings []DiagnosticSetting, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := make(map[string]struct{}, len(settings))
+	for i, setting := range settings {
+		if _, ok := names[setting.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), setting.Name))
+		}
+		names[setting.Name] = struct{}{}
+
+		if id, err := azureutil.ParseResourceID(setting.WorkspaceID); err != nil || !strings.EqualFold(id.ResourceType.String(), logAnalyticsWorkspaceResourceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("workspaceID"), setting.WorkspaceID,
+				"must be the resource ID of a Log Analytics workspace"))
+		}
+
+		if len(setting.LogCategories) == 0 && len(setting.MetricCategories) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i),
+				"at least one of logCategories or metricCategories must be specified"))
+		}
+	}
+	return allErrs
+}
+
+// validatePolicyAssignments validates a list of PolicyAssignments. Their scope must be the cluster's resource group,
+// or a resource in it.
+func validatePolicyAssignments(assignments []PolicyAssignment, subscriptionID, resourceGroup string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := make(map[string]struct{}, len(assignments))
+	for i, assignment := range assignments {
+		if !policyAssignmentNameRegex.MatchString(assignment.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), assignment.Name,
+				fmt.Sprintf("name doesn't match regex %s", policyAssignmentNameRegex)))
+		}
+		key := strings.ToLower(assignment.Scope + "/" + assignment.Name)
+		if _, ok := names[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), assignment.Name))
+		}
+		names[key] = struct{}{}
+
+		if id, err := azureutil.ParseResourceID(assignment.PolicyDefinitionID); err != nil ||
+			(!strings.EqualFold(id.ResourceType.String(), policyDefinitionResourceType) && !strings.EqualFold(id.ResourceType.String(), policySetDefinitionResourceType)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("policyDefinitionID"), assignment.PolicyDefinitionID,
+				"must be the resource ID of a policy definition or policy set definition"))
+		}
+
+		if assignment.Scope == "" {
+			continue
+		}
+		if id, err := azureutil.ParseResourceID(assignment.Scope); err != nil ||
+			!strings.EqualFold(id.ResourceGroupName, resourceGroup) ||
+			(subscriptionID != "" && !strings.EqualFold(id.SubscriptionID, subscriptionID)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("scope"), assignment.Scope,
+				"must be the resource ID of the cluster's resource group or of a resource in it"))
+		}
+	}
+	return allErrs
+}
+
+// validateNetAppVolumes validates a list of NetAppVolumes. Volumes sharing a capacity pool must agree on its service
+// level and size.
+func validateNetAppVolumes(volumes []NetAppVolume, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	pools := make(map[string]NetAppCapacityPool, len(volumes))
+	for i, volume := range volumes {
+		if id, err := azureutil.ParseResourceID(volume.SubnetID); err != nil || !strings.EqualFold(id.ResourceType.String(), subnetResourceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("subnetID"), volume.SubnetID, "must be the resource ID of a subnet"))
+		}
+
+		key := strings.ToLower(volume.AccountName + "/" + volume.CapacityPool.Name)
+		if pool, ok := pools[key]; ok && (pool.ServiceLevel != volume.CapacityPool.ServiceLevel || pool.SizeTiB != volume.CapacityPool.SizeTiB) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("capacityPool"), volume.CapacityPool,
+				fmt.Sprintf("capacity pool %s of account %s must have the same service level and size for all of its volumes", volume.CapacityPool.Name, volume.AccountName)))
+			continue
+		}
+		pools[key] = volume.CapacityPool
+	}
+	return allErrs
+}
+
+// validateDiskEncryptionSets validates a list of DiskEncryptionSets. A user-assigned identity must be given exactly
+// when the disk encryption set accesses its key with a user-assigned identity.
+func validateDiskEncryptionSets(sets []DiskEncryptionSet, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, set := range sets {
+		if keyURL, err := url.Parse(set.KeyURL); err != nil || keyURL.Scheme != "https" || keyURL.Host == "" || !strings.HasPrefix(keyURL.Path, "/keys/") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("keyURL"), set.KeyURL, "must be the https URL of a Key Vault key"))
+		}
+
+		if set.KeyVaultID != "" {
+			if id, err := azureutil.ParseResourceID(set.KeyVaultID); err != nil || !strings.EqualFold(id.ResourceType.String(), keyVaultResourceType) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("keyVaultID"), set.KeyVaultID, "must be the resource ID of a Key Vault"))
+			}
+		}
+
+		switch {
+		case set.Identity == VMIdentityUserAssigned && set.UserAssignedIdentity == nil:
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("userAssignedIdentity"),
+				"must be specified when identity is UserAssigned"))
+		case set.Identity != VMIdentityUserAssigned && set.UserAssignedIdentity != nil:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("userAssignedIdentity"),
+				"can only be specified when identity is UserAssigned"))
+		}
+	}
+	return allErrs
+}
+
 // validateResourceGroup validates a ResourceGroup.
 func validateResourceGroup(resourceGroup string, fldPath *field.Path) *field.Error {
 	if success, _ := regexp.MatchString(resourceGroupRegex, resourceGroup); !success {
@@ -242,6 +461,10 @@ func validateSubnets(subnets Subnets, vnet VnetSpec, fldPath *field.Path) field.
 				allErrs = append(allErrs, err...)
 			}
 		}
+		allErrs = append(allErrs, validateSecurityRulesMode(subnet.SecurityGroup.SecurityGroupClass, fldPath.Index(i).Child("securityGroup"))...)
+		allErrs = append(allErrs, ValidateTags(subnet.SecurityGroup.Tags, fldPath.Index(i).Child("securityGroup", "tags"))...)
+		allErrs = append(allErrs, ValidateTags(subnet.RouteTable.Tags, fldPath.Index(i).Child("routeTable", "tags"))...)
+		allErrs = append(allErrs, validateRoutes(subnet.RouteTable.Routes, fldPath.Index(i).Child("routeTable", "routes"))...)
 		allErrs = append(allErrs, validateSubnetCIDR(subnet.CIDRBlocks, vnet.CIDRBlocks, fldPath.Index(i).Child("cidrBlocks"))...)
 
 		if len(subnet.ServiceEndpoints) > 0 {
@@ -261,6 +484,36 @@ func validateSubnets(subnets Subnets, vnet VnetSpec, fldPath *field.Path) field.
 	return allErrs
 }
 
+// validateRoutes validates the routes of a route table.
+func validateRoutes(routes []Route, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := make(map[string]bool, len(routes))
+	for i, route := range routes {
+		if route.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("name"), "name is required"))
+		} else if names[strings.ToLower(route.Name)] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), route.Name))
+		}
+		names[strings.ToLower(route.Name)] = true
+
+		if _, _, err := net.ParseCIDR(route.AddressPrefix); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("addressPrefix"), route.AddressPrefix, "invalid CIDR format"))
+		}
+
+		switch {
+		case route.NextHopType == RouteNextHopTypeVirtualAppliance && route.NextHopIPAddress == "":
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("nextHopIPAddress"),
+				fmt.Sprintf("nextHopIPAddress is required when nextHopType is %s", RouteNextHopTypeVirtualAppliance)))
+		case route.NextHopType != RouteNextHopTypeVirtualAppliance && route.NextHopIPAddress != "":
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("nextHopIPAddress"),
+				fmt.Sprintf("nextHopIPAddress can only be set when nextHopType is %s", RouteNextHopTypeVirtualAppliance)))
+		case route.NextHopIPAddress != "" && net.ParseIP(route.NextHopIPAddress) == nil:
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("nextHopIPAddress"), route.NextHopIPAddress, "invalid IP address"))
+		}
+	}
+	return allErrs
+}
+
 // validateSubnetName validates the Name of a Subnet.
 func validateSubnetName(name string, fldPath *field.Path) *field.Error {
 	if success, _ := regexp.Match(subnetRegex, []byte(name)); !success {
@@ -314,6 +567,33 @@ func validateVnetCIDR(vnetCIDRBlocks []string, fldPath *field.Path) field.ErrorL
 	return allErrs
 }
 
+// validateVnetDDoSProtection validates the DDoS protection settings of a Vnet.
+func validateVnetDDoSProtection(vnet VnetClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if vnet.DDoSProtectionPlanID != "" && !ptr.Deref(vnet.EnableDDoSProtection, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("enableDDoSProtection"), vnet.EnableDDoSProtection,
+			"enableDDoSProtection must be true when ddosProtectionPlanID is set"))
+	}
+	return allErrs
+}
+
+// validateVnetDNSServers validates the custom DNS servers of a Vnet.
+func validateVnetDNSServers(dnsServers []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := make(map[string]struct{}, len(dnsServers))
+	for i, server := range dnsServers {
+		if net.ParseIP(server) == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), server, "DNS server must be a valid IP address"))
+			continue
+		}
+		if _, ok := seen[server]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), server))
+		}
+		seen[server] = struct{}{}
+	}
+	return allErrs
+}
+
 // validateVnetPeerings validates a list of virtual network peerings.
 func validateVnetPeerings(peerings VnetPeerings, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -348,6 +628,10 @@ func validateInternalLBIPAddress(address string, cidrs []string, fldPath *field.
 	for _, cidr := range cidrs {
 		_, subnet, _ := net.ParseCIDR(cidr)
 		if subnet.Contains(ip) {
+			if isReservedSubnetIP(ip, subnet) {
+				return field.Invalid(fldPath, address,
+					fmt.Sprintf("Internal LB IP address is reserved by Azure in control plane subnet %s", cidr))
+			}
 			return nil
 		}
 	}
@@ -355,6 +639,19 @@ func validateInternalLBIPAddress(address string, cidrs []string, fldPath *field.
 		fmt.Sprintf("Internal LB IP address needs to be in control plane subnet range (%s)", cidrs))
 }
 
+// isReservedSubnetIP returns true if ip is one of the addresses Azure reserves in an IPv4 subnet, i.e. its first four
+// addresses and its broadcast address.
+func isReservedSubnetIP(ip net.IP, subnet *net.IPNet) bool {
+	ip4, network := ip.To4(), subnet.IP.To4()
+	if ip4 == nil || network == nil {
+		return false
+	}
+	offset := binary.BigEndian.Uint32(ip4) - binary.BigEndian.Uint32(network)
+	ones, bits := subnet.Mask.Size()
+	last := uint32(1)<<(bits-ones) - 1
+	return offset < 4 || offset == last
+}
+
 // validateSecurityRule validates a SecurityRule.
 func validateSecurityRule(rule SecurityRule, fldPath *field.Path) (allErrs field.ErrorList) {
 	if rule.Priority < minRulePriority || rule.Priority > maxRulePriority {
@@ -368,6 +665,34 @@ func validateSecurityRule(rule SecurityRule, fldPath *field.Path) (allErrs field
 	return allErrs
 }
 
+// validateSecurityRulesMode validates the RulesMode and ReservedPriorities of a security group.
+func validateSecurityRulesMode(sg SecurityGroupClass, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if sg.RulesMode != SecurityRulesModeAdditive {
+		if sg.ReservedPriorities != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("reservedPriorities"),
+				fmt.Sprintf("reserved priorities are only supported with the %s rules mode", SecurityRulesModeAdditive)))
+		}
+		return allErrs
+	}
+
+	if sg.ReservedPriorities == nil {
+		return append(allErrs, field.Required(fldPath.Child("reservedPriorities"),
+			fmt.Sprintf("reserved priorities are required with the %s rules mode", SecurityRulesModeAdditive)))
+	}
+	if sg.ReservedPriorities.Min > sg.ReservedPriorities.Max {
+		return append(allErrs, field.Invalid(fldPath.Child("reservedPriorities"), *sg.ReservedPriorities,
+			"the minimum reserved priority must not be greater than the maximum"))
+	}
+	for i, rule := range sg.SecurityRules {
+		if !sg.ReservedPriorities.Contains(rule.Priority) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("securityRules").Index(i).Child("priority"), rule.Priority,
+				fmt.Sprintf("security rule priorities should be between the reserved priorities %d and %d", sg.ReservedPriorities.Min, sg.ReservedPriorities.Max)))
+		}
+	}
+	return allErrs
+}
+
 func validateAPIServerLB(lb LoadBalancerSpec, old LoadBalancerSpec, cidrs []string, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -384,6 +709,9 @@ func validateAPIServerLB(lb LoadBalancerSpec, old LoadBalancerSpec, cidrs []stri
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "API Server load balancer name should not be modified after AzureCluster creation."))
 	}
 
+	allErrs = append(allErrs, validateBackendPools(lb, fldPath)...)
+	allErrs = append(allErrs, validateNoInboundNATPools(lb, fldPath)...)
+
 	// There should only be one IP config.
 	if len(lb.FrontendIPs) != 1 || ptr.Deref[int32](lb.FrontendIPsCount, 1) != 1 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("frontendIPConfigs"), lb.FrontendIPs,
@@ -418,6 +746,85 @@ func validateAPIServerLB(lb LoadBalancerSpec, old LoadBalancerSpec, cidrs []stri
 	return allErrs
 }
 
+// validateLoadBalancerPublicIPs validates the public IPs of the frontend IPs of a load balancer.
+func validateLoadBalancerPublicIPs(lb *LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if lb == nil {
+		return allErrs
+	}
+	for i, frontendIP := range lb.FrontendIPs {
+		if frontendIP.PublicIP != nil {
+			allErrs = append(allErrs, validatePublicIP(*frontendIP.PublicIP, fldPath.Child("frontendIPs").Index(i).Child("publicIP"))...)
+		}
+	}
+	return allErrs
+}
+
+// validatePublicIP validates the DNS name, SKU and availability zones of a public IP.
+func validatePublicIP(publicIP PublicIPSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if err := validatePublicIPDNSName(publicIP.DNSName, fldPath.Child("dnsName")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if len(publicIP.Zones) == 0 {
+		return allErrs
+	}
+	if publicIP.SKU == PublicIPSKUBasic {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("zones"),
+			fmt.Sprintf("availability zones are only supported with the %s public IP SKU", PublicIPSKUStandard)))
+	}
+	zones := make(map[string]struct{}, len(publicIP.Zones))
+	for i, zone := range publicIP.Zones {
+		if zone == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("zones").Index(i), "availability zone must not be empty"))
+			continue
+		}
+		if _, ok := zones[zone]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("zones").Index(i), zone))
+		}
+		zones[zone] = struct{}{}
+	}
+	return allErrs
+}
+
+// validatePublicIPDNSName validates that the first label of the DNS name of a public IP can be used as its domain name label.
+func validatePublicIPDNSName(dnsName string, fldPath *field.Path) *field.Error {
+	if dnsName == "" {
+		return nil
+	}
+	if !valid.IsDNSName(dnsName) {
+		return field.Invalid(fldPath, dnsName, "must be a valid DNS name")
+	}
+	label := strings.Split(dnsName, ".")[0]
+	if !regexp.MustCompile(domainNameLabelRegex).MatchString(label) {
+		return field.Invalid(fldPath, dnsName,
+			fmt.Sprintf("the domain name label %q must be 3 to 63 characters long, start with a lowercase letter, end with a lowercase letter or a number and only contain lowercase letters, numbers and hyphens", label))
+	}
+	return nil
+}
+
+// validateGatewayLoadBalancers validates the Gateway Load Balancer references of the frontend IPs of a load balancer.
+func validateGatewayLoadBalancers(lb *LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if lb == nil {
+		return allErrs
+	}
+	for i, frontendIP := range lb.FrontendIPs {
+		if frontendIP.GatewayLoadBalancer == nil {
+			continue
+		}
+		gwPath := fldPath.Child("frontendIPs").Index(i).Child("gatewayLoadBalancer")
+		if lb.Type != Public {
+			allErrs = append(allErrs, field.Forbidden(gwPath, "Gateway Load Balancers can only be chained to the frontend IPs of public load balancers"))
+		}
+		if id, err := azureutil.ParseResourceID(frontendIP.GatewayLoadBalancer.ID); err != nil || !strings.EqualFold(id.ResourceType.String(), loadBalancerFrontendIPConfigResourceType) {
+			allErrs = append(allErrs, field.Invalid(gwPath.Child("id"), frontendIP.GatewayLoadBalancer.ID,
+				"must be the resource ID of a load balancer frontend IP configuration"))
+		}
+	}
+	return allErrs
+}
+
 func validateNodeOutboundLB(lb *LoadBalancerSpec, old *LoadBalancerSpec, apiserverLB LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -444,6 +851,9 @@ func validateNodeOutboundLB(lb *LoadBalancerSpec, old *LoadBalancerSpec, apiserv
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "Node outbound load balancer Name should not be modified after AzureCluster creation."))
 	}
 
+	allErrs = append(allErrs, validateNoAdditionalBackendPools(*lb, fldPath)...)
+	allErrs = append(allErrs, validateInboundNATPools(*lb, fldPath)...)
+
 	if old != nil && old.FrontendIPsCount == lb.FrontendIPsCount {
 		if len(old.FrontendIPs) != len(lb.FrontendIPs) {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("frontendIPs"), "Node outbound load balancer FrontendIPs cannot be modified after AzureCluster creation."))
@@ -486,9 +896,84 @@ func validateControlPlaneOutboundLB(lb *LoadBalancerSpec, apiserverLB LoadBalanc
 		}
 	}
 
+	if lb != nil {
+		allErrs = append(allErrs, validateNoAdditionalBackendPools(*lb, fldPath)...)
+		allErrs = append(allErrs, validateNoInboundNATPools(*lb, fldPath)...)
+	}
+
+	return allErrs
+}
+
+// validateBackendPools validates the additional backend pools of the API server load balancer and the backend pool
+// referenced by its load balancing rule.
+func validateBackendPools(lb LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{lb.BackendPool.Name: true}
+	for i, pool := range lb.AdditionalBackendPools {
+		if pool.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("additionalBackendPools").Index(i).Child("name"), "name is required"))
+			continue
+		}
+		if names[pool.Name] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("additionalBackendPools").Index(i).Child("name"), pool.Name))
+		}
+		names[pool.Name] = true
+	}
+
+	// The load balancing rule must be detached from a pool before the pool is removed.
+	if lb.RuleBackendPool != "" && !names[lb.RuleBackendPool] {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ruleBackendPool"), lb.RuleBackendPool,
+			"ruleBackendPool must be the name of the backendPool or of one of the additionalBackendPools of the load balancer"))
+	}
+
 	return allErrs
 }
 
+// validateNoAdditionalBackendPools validates that an outbound load balancer, which has no load balancing rule,
+// doesn't declare additional backend pools.
+func validateNoAdditionalBackendPools(lb LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(lb.AdditionalBackendPools) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalBackendPools"), "additional backend pools are only supported on the API server load balancer"))
+	}
+	if lb.RuleBackendPool != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ruleBackendPool"), "ruleBackendPool is only supported on the API server load balancer"))
+	}
+
+	return allErrs
+}
+
+// validateInboundNATPools validates the inbound NAT pools of the node outbound load balancer.
+func validateInboundNATPools(lb LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, pool := range lb.InboundNATPools {
+		poolPath := fldPath.Child("inboundNATPools").Index(i)
+		if names[pool.Name] {
+			allErrs = append(allErrs, field.Duplicate(poolPath.Child("name"), pool.Name))
+		}
+		names[pool.Name] = true
+		if pool.FrontendPortRangeEnd < pool.FrontendPortRangeStart {
+			allErrs = append(allErrs, field.Invalid(poolPath.Child("frontendPortRangeEnd"), pool.FrontendPortRangeEnd,
+				"frontendPortRangeEnd must not be lower than frontendPortRangeStart"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateNoInboundNATPools validates that a load balancer other than the node outbound load balancer doesn't declare
+// inbound NAT pools.
+func validateNoInboundNATPools(lb LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	if len(lb.InboundNATPools) > 0 {
+		return field.ErrorList{field.Forbidden(fldPath.Child("inboundNATPools"), "inbound NAT pools are only supported on the node outbound load balancer")}
+	}
+	return nil
+}
+
 // validatePrivateDNSZoneName validates the PrivateDNSZoneName.
 func validatePrivateDNSZoneName(privateDNSZoneName string, apiserverLBType LBType, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -541,14 +1026,105 @@ func validateClassSpecForAPIServerLB(lb LoadBalancerClassSpec, old *LoadBalancer
 		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("type"), "API Server load balancer type should not be modified after AzureCluster creation."))
 	}
 
-	// IdletimeoutInMinutes should be immutable.
-	if old != nil && old.IdleTimeoutInMinutes != nil && !ptr.Equal(old.IdleTimeoutInMinutes, lb.IdleTimeoutInMinutes) {
-		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("idleTimeoutInMinutes"), "API Server load balancer idle timeout cannot be modified after AzureCluster creation."))
-	}
-
 	if lb.IdleTimeoutInMinutes != nil && (*lb.IdleTimeoutInMinutes < MinLBIdleTimeoutInMinutes || *lb.IdleTimeoutInMinutes > MaxLBIdleTimeoutInMinutes) {
 		allErrs = append(allErrs, field.Invalid(apiServerLBPath.Child("idleTimeoutInMinutes"), *lb.IdleTimeoutInMinutes,
-			fmt.Sprintf("Node outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
+			fmt.Sprintf("API Server load balancer idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLBIdleTimeoutInMinutes)))
+	}
+
+	// HA ports are only supported on Standard Internal load balancers.
+	if lb.EnableHAPorts && (lb.Type != Internal || lb.SKU != SKUStandard) {
+		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("enableHAPorts"), "HA ports can only be enabled on a Standard Internal load balancer"))
+	}
+
+	// The outbound rule of a Public load balancer requires the default outbound SNAT of its load balancing rule to be disabled.
+	if lb.Type == Public && !ptr.Deref(lb.DisableOutboundSNAT, true) {
+		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("disableOutboundSNAT"),
+			"outbound SNAT must be disabled on the load balancing rule of a Public load balancer, which has an outbound rule"))
+	}
+
+	// EnableHAPorts should be immutable.
+	if old != nil && old.Type != "" && old.EnableHAPorts != lb.EnableHAPorts {
+		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("enableHAPorts"), "API Server load balancer HA ports cannot be modified after AzureCluster creation."))
+	}
+
+	if lb.FrontendPort != nil {
+		if *lb.FrontendPort < MinLBFrontendPort || *lb.FrontendPort > MaxLBFrontendPort {
+			allErrs = append(allErrs, field.Invalid(apiServerLBPath.Child("frontendPort"), *lb.FrontendPort,
+				fmt.Sprintf("API Server load balancer frontend port should be between %d and %d", MinLBFrontendPort, MaxLBFrontendPort)))
+		}
+		// An HA ports rule balances all ports, so it doesn't have a frontend port.
+		if lb.EnableHAPorts {
+			allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("frontendPort"), "frontend port cannot be set when HA ports are enabled"))
+		}
+	}
+
+	// FrontendPort should be immutable as the port of the control plane endpoint is.
+	if old != nil && old.Type != "" && !ptr.Equal(old.FrontendPort, lb.FrontendPort) {
+		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("frontendPort"), "API Server load balancer frontend port cannot be modified after AzureCluster creation."))
+	}
+
+	allErrs = append(allErrs, validateHealthProbe(lb.HealthProbe, apiServerLBPath.Child("healthProbe"))...)
+	allErrs = append(allErrs, validateInboundNATRuleSettings(lb.InboundNATRules, apiServerLBPath.Child("inboundNATRules"))...)
+
+	return allErrs
+}
+
+// validateInboundNATRuleSettings validates the settings of the inbound NAT rules of the API server load balancer.
+func validateInboundNATRuleSettings(settings *InboundNATRuleSettings, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if settings == nil {
+		return allErrs
+	}
+
+	protocol := settings.Protocol
+	if protocol == "" {
+		protocol = InboundNATPoolProtocolTCP
+	}
+
+	if settings.EnableFloatingIP && protocol == InboundNATPoolProtocolAll {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableFloatingIP"), "floating IP is not supported for inbound NAT rules with protocol All"))
+	}
+
+	if settings.EnableTCPReset && protocol != InboundNATPoolProtocolTCP {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableTCPReset"), fmt.Sprintf("TCP reset is not supported for inbound NAT rules with protocol %s", protocol)))
+	}
+
+	return allErrs
+}
+
+// validateHealthProbe validates the health probe of a load balancer.
+func validateHealthProbe(probe *HealthProbe, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if probe == nil {
+		return allErrs
+	}
+
+	switch probe.Protocol {
+	case ProbeProtocolTCP:
+		if probe.RequestPath != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("requestPath"), "request path must not be set for Tcp health probes"))
+		}
+	case ProbeProtocolHTTP, ProbeProtocolHTTPS:
+		if probe.RequestPath == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("requestPath"), fmt.Sprintf("request path is required for %s health probes", probe.Protocol)))
+		} else if !strings.HasPrefix(probe.RequestPath, "/") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requestPath"), probe.RequestPath, "request path must start with /"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), probe.Protocol,
+			[]string{string(ProbeProtocolTCP), string(ProbeProtocolHTTP), string(ProbeProtocolHTTPS)}))
+	}
+
+	if probe.IntervalInSeconds != nil && *probe.IntervalInSeconds < MinLBProbeIntervalInSeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("intervalInSeconds"), *probe.IntervalInSeconds,
+			fmt.Sprintf("health probe interval should be at least %d seconds", MinLBProbeIntervalInSeconds)))
+	}
+
+	if probe.NumberOfProbes != nil && *probe.NumberOfProbes < MinLBNumberOfProbes {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("numberOfProbes"), *probe.NumberOfProbes,
+			fmt.Sprintf("number of health probes should be at least %d", MinLBNumberOfProbes)))
 	}
 
 	return allErrs
@@ -575,13 +1151,21 @@ func validateClassSpecForNodeOutboundLB(lb *LoadBalancerClassSpec, old *LoadBala
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"), "Node outbound load balancer Type cannot be modified after AzureCluster creation."))
 	}
 
-	if old != nil && !ptr.Equal(old.IdleTimeoutInMinutes, lb.IdleTimeoutInMinutes) {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("idleTimeoutInMinutes"), "Node outbound load balancer idle timeout cannot be modified after AzureCluster creation."))
-	}
-
 	if lb.IdleTimeoutInMinutes != nil && (*lb.IdleTimeoutInMinutes < MinLBIdleTimeoutInMinutes || *lb.IdleTimeoutInMinutes > MaxLBIdleTimeoutInMinutes) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutInMinutes"), *lb.IdleTimeoutInMinutes,
-			fmt.Sprintf("Node outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
+			fmt.Sprintf("Node outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLBIdleTimeoutInMinutes)))
+	}
+
+	if lb.EnableHAPorts {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableHAPorts"), "HA ports can only be enabled on a Standard Internal load balancer"))
+	}
+
+	if lb.FrontendPort != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("frontendPort"), "frontend port can only be set on the API Server load balancer"))
+	}
+
+	if lb.InboundNATRules != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("inboundNATRules"), "inbound NAT rules can only be configured on the API Server load balancer"))
 	}
 
 	return allErrs
@@ -603,7 +1187,19 @@ func validateClassSpecForControlPlaneOutboundLB(lb *LoadBalancerClassSpec, apise
 
 		if lb.IdleTimeoutInMinutes != nil && (*lb.IdleTimeoutInMinutes < MinLBIdleTimeoutInMinutes || *lb.IdleTimeoutInMinutes > MaxLBIdleTimeoutInMinutes) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutInMinutes"), *lb.IdleTimeoutInMinutes,
-				fmt.Sprintf("Control plane outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
+				fmt.Sprintf("Control plane outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLBIdleTimeoutInMinutes)))
+		}
+
+		if lb.EnableHAPorts {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableHAPorts"), "HA ports can only be enabled on a Standard Internal load balancer"))
+		}
+
+		if lb.FrontendPort != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("frontendPort"), "frontend port can only be set on the API Server load balancer"))
+		}
+
+		if lb.InboundNATRules != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("inboundNATRules"), "inbound NAT rules can only be configured on the API Server load balancer"))
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
@@ -361,6 +362,453 @@ func TestNetworkSpecWithoutPreexistingVnetValid(t *testing.T) {
 	})
 }
 
+func TestValidateVnetDDoSProtection(t *testing.T) {
+	tests := []struct {
+		name    string
+		vnet    VnetClassSpec
+		wantErr bool
+	}{
+		{
+			name:    "ddos protection not configured",
+			vnet:    VnetClassSpec{},
+			wantErr: false,
+		},
+		{
+			name: "ddos protection enabled with a plan",
+			vnet: VnetClassSpec{
+				DDoSProtectionPlanID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/ddosProtectionPlans/plan",
+				EnableDDoSProtection: ptr.To(true),
+			},
+			wantErr: false,
+		},
+		{
+			name: "ddos protection plan without protection enabled",
+			vnet: VnetClassSpec{
+				DDoSProtectionPlanID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/ddosProtectionPlans/plan",
+			},
+			wantErr: true,
+		},
+		{
+			name: "ddos protection plan with protection disabled",
+			vnet: VnetClassSpec{
+				DDoSProtectionPlanID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/ddosProtectionPlans/plan",
+				EnableDDoSProtection: ptr.To(false),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateVnetDDoSProtection(tc.vnet, field.NewPath("spec", "networkSpec", "vnet"))
+			if tc.wantErr {
+				g.Expect(errs).To(HaveLen(1))
+				g.Expect(errs[0].Type).To(Equal(field.ErrorTypeInvalid))
+				g.Expect(errs[0].Field).To(Equal("spec.networkSpec.vnet.enableDDoSProtection"))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateVnetDNSServers(t *testing.T) {
+	tests := []struct {
+		name       string
+		dnsServers []string
+		wantErr    field.ErrorType
+	}{
+		{
+			name:       "no dns servers",
+			dnsServers: nil,
+		},
+		{
+			name:       "valid dns servers",
+			dnsServers: []string{"10.0.0.4", "fd00::4"},
+		},
+		{
+			name:       "invalid dns server",
+			dnsServers: []string{"10.0.0.4", "dns.example.com"},
+			wantErr:    field.ErrorTypeInvalid,
+		},
+		{
+			name:       "duplicate dns server",
+			dnsServers: []string{"10.0.0.4", "10.0.0.4"},
+			wantErr:    field.ErrorTypeDuplicate,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateVnetDNSServers(tc.dnsServers, field.NewPath("spec", "networkSpec", "vnet", "dnsServers"))
+			if tc.wantErr != "" {
+				g.Expect(errs).To(HaveLen(1))
+				g.Expect(errs[0].Type).To(Equal(tc.wantErr))
+				g.Expect(errs[0].Field).To(Equal("spec.networkSpec.vnet.dnsServers[1]"))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateAzureFirewall(t *testing.T) {
+	tests := []struct {
+		name     string
+		firewall *AzureFirewall
+		wantErr  bool
+	}{
+		{
+			name:     "no firewall",
+			firewall: nil,
+			wantErr:  false,
+		},
+		{
+			name: "firewall with the required subnet name",
+			firewall: &AzureFirewall{
+				Subnet: SubnetSpec{SubnetClassSpec: SubnetClassSpec{Name: "AzureFirewallSubnet"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "firewall with a custom subnet name",
+			firewall: &AzureFirewall{
+				Subnet: SubnetSpec{SubnetClassSpec: SubnetClassSpec{Name: "my-firewall-subnet"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateAzureFirewall(tc.firewall, field.NewPath("spec", "networkSpec", "azureFirewall"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeNil())
+				g.Expect(err.Field).To(Equal("spec.networkSpec.azureFirewall.subnet.name"))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidateDiagnosticSettings(t *testing.T) {
+	const workspaceID = "/subscriptions/123/resourceGroups/logs/providers/Microsoft.OperationalInsights/workspaces/my-workspace"
+	tests := []struct {
+		name       string
+		settings   []DiagnosticSetting
+		wantFields []string
+	}{
+		{
+			name: "valid diagnostic settings",
+			settings: []DiagnosticSetting{
+				{
+					Name:          "nsg-logs",
+					Targets:       []DiagnosticSettingTarget{DiagnosticSettingTargetSecurityGroup},
+					WorkspaceID:   workspaceID,
+					LogCategories: []string{"NetworkSecurityGroupEvent"},
+				},
+				{
+					Name:             "metrics",
+					Targets:          []DiagnosticSettingTarget{DiagnosticSettingTargetVirtualNetwork, DiagnosticSettingTargetLoadBalancer},
+					WorkspaceID:      workspaceID,
+					MetricCategories: []string{"AllMetrics"},
+				},
+			},
+		},
+		{
+			name: "duplicate names",
+			settings: []DiagnosticSetting{
+				{Name: "logs", WorkspaceID: workspaceID, MetricCategories: []string{"AllMetrics"}},
+				{Name: "logs", WorkspaceID: workspaceID, MetricCategories: []string{"AllMetrics"}},
+			},
+			wantFields: []string{"spec.networkSpec.diagnosticSettings[1].name"},
+		},
+		{
+			name: "workspace ID is not a resource ID",
+			settings: []DiagnosticSetting{
+				{Name: "logs", WorkspaceID: "my-workspace", MetricCategories: []string{"AllMetrics"}},
+			},
+			wantFields: []string{"spec.networkSpec.diagnosticSettings[0].workspaceID"},
+		},
+		{
+			name: "workspace ID is not a Log Analytics workspace",
+			settings: []DiagnosticSetting{
+				{
+					Name:             "logs",
+					WorkspaceID:      "/subscriptions/123/resourceGroups/logs/providers/Microsoft.Storage/storageAccounts/logs",
+					MetricCategories: []string{"AllMetrics"},
+				},
+			},
+			wantFields: []string{"spec.networkSpec.diagnosticSettings[0].workspaceID"},
+		},
+		{
+			name: "no categories",
+			settings: []DiagnosticSetting{
+				{Name: "logs", WorkspaceID: workspaceID},
+			},
+			wantFields: []string{"spec.networkSpec.diagnosticSettings[0]"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateDiagnosticSettings(tc.settings, field.NewPath("spec", "networkSpec", "diagnosticSettings"))
+			fields := make([]string, len(errs))
+			for i, err := range errs {
+				fields[i] = err.Field
+			}
+			g.Expect(fields).To(ConsistOf(tc.wantFields))
+		})
+	}
+}
+
+func TestValidatePolicyAssignments(t *testing.T) {
+	const policyDefinitionID = "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c"
+	tests := []struct {
+		name        string
+		assignments []PolicyAssignment
+		wantFields  []string
+	}{
+		{
+			name: "valid policy assignments",
+			assignments: []PolicyAssignment{
+				{
+					Name:               "allowed-locations",
+					PolicyDefinitionID: policyDefinitionID,
+					Parameters: map[string]apiextensionsv1.JSON{
+						"listOfAllowedLocations": {Raw: []byte(`["westus2"]`)},
+					},
+				},
+				{
+					Name:               "vnet-policy",
+					PolicyDefinitionID: "/subscriptions/123/providers/Microsoft.Authorization/policySetDefinitions/my-initiative",
+					Scope:              "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet",
+				},
+			},
+		},
+		{
+			name: "duplicate names in the same scope",
+			assignments: []PolicyAssignment{
+				{Name: "allowed-locations", PolicyDefinitionID: policyDefinitionID},
+				{Name: "allowed-locations", PolicyDefinitionID: policyDefinitionID},
+			},
+			wantFields: []string{"spec.policyAssignments[1].name"},
+		},
+		{
+			name: "invalid name",
+			assignments: []PolicyAssignment{
+				{Name: "allowed/locations", PolicyDefinitionID: policyDefinitionID},
+			},
+			wantFields: []string{"spec.policyAssignments[0].name"},
+		},
+		{
+			name: "policy definition ID is not a policy definition",
+			assignments: []PolicyAssignment{
+				{Name: "allowed-locations", PolicyDefinitionID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet"},
+			},
+			wantFields: []string{"spec.policyAssignments[0].policyDefinitionID"},
+		},
+		{
+			name: "scope in another resource group",
+			assignments: []PolicyAssignment{
+				{Name: "allowed-locations", PolicyDefinitionID: policyDefinitionID, Scope: "/subscriptions/123/resourceGroups/other-rg"},
+			},
+			wantFields: []string{"spec.policyAssignments[0].scope"},
+		},
+		{
+			name: "scope in another subscription",
+			assignments: []PolicyAssignment{
+				{Name: "allowed-locations", PolicyDefinitionID: policyDefinitionID, Scope: "/subscriptions/456/resourceGroups/my-rg"},
+			},
+			wantFields: []string{"spec.policyAssignments[0].scope"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validatePolicyAssignments(tc.assignments, "123", "my-rg", field.NewPath("spec", "policyAssignments"))
+			fields := make([]string, len(errs))
+			for i, err := range errs {
+				fields[i] = err.Field
+			}
+			g.Expect(fields).To(ConsistOf(tc.wantFields))
+		})
+	}
+}
+
+func TestValidateNetAppVolumes(t *testing.T) {
+	const subnetID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/anf"
+	pool := NetAppCapacityPool{Name: "pool", ServiceLevel: "Premium", SizeTiB: 4}
+	tests := []struct {
+		name       string
+		volumes    []NetAppVolume
+		wantFields []string
+	}{
+		{
+			name: "valid volumes sharing a capacity pool",
+			volumes: []NetAppVolume{
+				{Name: "postgres", AccountName: "account", CapacityPool: pool, SizeGiB: 100, SubnetID: subnetID},
+				{Name: "mysql", AccountName: "account", CapacityPool: pool, SizeGiB: 500, SubnetID: subnetID},
+			},
+		},
+		{
+			name: "subnet ID is not a subnet",
+			volumes: []NetAppVolume{
+				{Name: "postgres", AccountName: "account", CapacityPool: pool, SizeGiB: 100, SubnetID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet"},
+			},
+			wantFields: []string{"spec.netAppVolumes[0].subnetID"},
+		},
+		{
+			name: "volumes disagree on their capacity pool",
+			volumes: []NetAppVolume{
+				{Name: "postgres", AccountName: "account", CapacityPool: pool, SizeGiB: 100, SubnetID: subnetID},
+				{Name: "mysql", AccountName: "account", CapacityPool: NetAppCapacityPool{Name: "pool", ServiceLevel: "Ultra", SizeTiB: 4}, SizeGiB: 100, SubnetID: subnetID},
+			},
+			wantFields: []string{"spec.netAppVolumes[1].capacityPool"},
+		},
+		{
+			name: "pools of the same name in different accounts",
+			volumes: []NetAppVolume{
+				{Name: "postgres", AccountName: "account", CapacityPool: pool, SizeGiB: 100, SubnetID: subnetID},
+				{Name: "mysql", AccountName: "other", CapacityPool: NetAppCapacityPool{Name: "pool", ServiceLevel: "Ultra", SizeTiB: 2}, SizeGiB: 100, SubnetID: subnetID},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateNetAppVolumes(tc.volumes, field.NewPath("spec", "netAppVolumes"))
+			fields := make([]string, len(errs))
+			for i, err := range errs {
+				fields[i] = err.Field
+			}
+			g.Expect(fields).To(ConsistOf(tc.wantFields))
+		})
+	}
+}
+
+func TestValidateAzureBastionSubnet(t *testing.T) {
+	vnet := VnetSpec{
+		VnetClassSpec: VnetClassSpec{
+			CIDRBlocks: []string{"172.16.0.0/16"},
+		},
+	}
+	subnet := func(cidrs ...string) SubnetSpec {
+		return SubnetSpec{
+			SubnetClassSpec: SubnetClassSpec{
+				Name:       DefaultAzureBastionSubnetName,
+				Role:       SubnetBastion,
+				CIDRBlocks: cidrs,
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		subnet     SubnetSpec
+		old        *SubnetSpec
+		wantFields []string
+	}{
+		{
+			name:   "valid /26 subnet",
+			subnet: subnet("172.16.2.0/26"),
+		},
+		{
+			name:   "valid subnet larger than /26",
+			subnet: subnet("172.16.2.0/24"),
+		},
+		{
+			name:       "no room in the vnet for the subnet",
+			subnet:     subnet(),
+			wantFields: []string{"spec.bastionSpec.azureBastion.subnet.cidrBlocks"},
+		},
+		{
+			name:       "subnet smaller than /26",
+			subnet:     subnet("172.16.2.0/27"),
+			wantFields: []string{"spec.bastionSpec.azureBastion.subnet.cidrBlocks"},
+		},
+		{
+			name:   "existing subnet smaller than /26",
+			subnet: subnet("172.16.2.0/27"),
+			old:    ptr.To(subnet("172.16.2.0/27")),
+		},
+		{
+			name:       "subnet outside of the vnet",
+			subnet:     subnet("10.255.255.192/26"),
+			wantFields: []string{"spec.bastionSpec.azureBastion.subnet.cidrBlocks"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateAzureBastionSubnet(tc.subnet, tc.old, vnet, field.NewPath("spec", "bastionSpec", "azureBastion", "subnet"))
+			fields := make([]string, len(errs))
+			for i, err := range errs {
+				fields[i] = err.Field
+			}
+			g.Expect(fields).To(ConsistOf(tc.wantFields))
+		})
+	}
+}
+
+func TestValidateDiskEncryptionSets(t *testing.T) {
+	const keyURL = "https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef"
+	identity := &UserAssignedIdentity{ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/des"}
+	tests := []struct {
+		name       string
+		sets       []DiskEncryptionSet
+		wantFields []string
+	}{
+		{
+			name: "valid disk encryption sets",
+			sets: []DiskEncryptionSet{
+				{Name: "system", KeyURL: keyURL, Identity: VMIdentitySystemAssigned},
+				{Name: "user", KeyURL: "https://my-vault.vault.azure.net/keys/my-key", KeyVaultID: "/subscriptions/456/resourceGroups/kv-rg/providers/Microsoft.KeyVault/vaults/my-vault", Identity: VMIdentityUserAssigned, UserAssignedIdentity: identity, RotationToLatestKeyVersionEnabled: ptr.To(true)},
+			},
+		},
+		{
+			name: "key URL is not a Key Vault key",
+			sets: []DiskEncryptionSet{
+				{Name: "des", KeyURL: "http://my-vault.vault.azure.net/keys/my-key", Identity: VMIdentitySystemAssigned},
+				{Name: "other", KeyURL: "https://my-vault.vault.azure.net/secrets/my-secret", Identity: VMIdentitySystemAssigned},
+			},
+			wantFields: []string{"spec.diskEncryptionSets[0].keyURL", "spec.diskEncryptionSets[1].keyURL"},
+		},
+		{
+			name: "Key Vault ID is not a Key Vault",
+			sets: []DiskEncryptionSet{
+				{Name: "des", KeyURL: keyURL, KeyVaultID: "/subscriptions/456/resourceGroups/kv-rg/providers/Microsoft.Storage/storageAccounts/sa", Identity: VMIdentitySystemAssigned},
+			},
+			wantFields: []string{"spec.diskEncryptionSets[0].keyVaultID"},
+		},
+		{
+			name: "user-assigned identity is missing",
+			sets: []DiskEncryptionSet{
+				{Name: "des", KeyURL: keyURL, Identity: VMIdentityUserAssigned},
+			},
+			wantFields: []string{"spec.diskEncryptionSets[0].userAssignedIdentity"},
+		},
+		{
+			name: "user-assigned identity with system-assigned identity",
+			sets: []DiskEncryptionSet{
+				{Name: "des", KeyURL: keyURL, Identity: VMIdentitySystemAssigned, UserAssignedIdentity: identity},
+			},
+			wantFields: []string{"spec.diskEncryptionSets[0].userAssignedIdentity"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateDiskEncryptionSets(tc.sets, field.NewPath("spec", "diskEncryptionSets"))
+			fields := make([]string, len(errs))
+			for i, err := range errs {
+				fields[i] = err.Field
+			}
+			g.Expect(fields).To(ConsistOf(tc.wantFields))
+		})
+	}
+}
+
 func TestResourceGroupValid(t *testing.T) {
 	type test struct {
 		name          string
@@ -482,6 +930,32 @@ func TestSubnetsInvalidSubnetName(t *testing.T) {
 	})
 }
 
+func TestSubnetsInvalidTags(t *testing.T) {
+	type test struct {
+		name    string
+		subnets Subnets
+	}
+
+	testCase := test{
+		name:    "subnets - invalid security group and route table tags",
+		subnets: createValidSubnets(),
+	}
+
+	testCase.subnets[0].SecurityGroup.Tags = Tags{"azure-cost-center": "subnet-0"}
+	testCase.subnets[1].RouteTable.Tags = Tags{"cost/center": "subnet-1"}
+
+	t.Run(testCase.name, func(t *testing.T) {
+		g := NewWithT(t)
+		errs := validateSubnets(testCase.subnets, createValidVnet(),
+			field.NewPath("spec").Child("networkSpec").Child("subnets"))
+		g.Expect(errs).To(HaveLen(2))
+		g.Expect(errs[0].Type).To(Equal(field.ErrorTypeInvalid))
+		g.Expect(errs[0].Field).To(Equal("spec.networkSpec.subnets[0].securityGroup.tags[azure-cost-center]"))
+		g.Expect(errs[1].Type).To(Equal(field.ErrorTypeInvalid))
+		g.Expect(errs[1].Field).To(Equal("spec.networkSpec.subnets[1].routeTable.tags[cost/center]"))
+	})
+}
+
 func TestSubnetsInvalidLackRequiredSubnet(t *testing.T) {
 	type test struct {
 		name    string
@@ -711,122 +1185,862 @@ func TestValidateSecurityRule(t *testing.T) {
 	}
 }
 
-func TestValidateAPIServerLB(t *testing.T) {
-	testcases := []struct {
-		name        string
-		lb          LoadBalancerSpec
-		old         LoadBalancerSpec
-		cpCIDRS     []string
-		wantErr     bool
-		expectedErr field.Error
+func TestValidateRoutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		routes  []Route
+		wantErr bool
 	}{
 		{
-			name: "invalid SKU",
+			name: "valid routes",
+			routes: []Route{
+				{
+					Name:             "to-appliance",
+					AddressPrefix:    "10.1.0.0/16",
+					NextHopType:      RouteNextHopTypeVirtualAppliance,
+					NextHopIPAddress: "10.0.0.4",
+				},
+				{
+					Name:          "to-internet",
+					AddressPrefix: "10.2.0.0/16",
+					NextHopType:   RouteNextHopTypeInternet,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "next hop IP address missing for a virtual appliance",
+			routes: []Route{
+				{
+					Name:          "to-appliance",
+					AddressPrefix: "10.1.0.0/16",
+					NextHopType:   RouteNextHopTypeVirtualAppliance,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "next hop IP address set for another next hop type",
+			routes: []Route{
+				{
+					Name:             "to-internet",
+					AddressPrefix:    "10.2.0.0/16",
+					NextHopType:      RouteNextHopTypeInternet,
+					NextHopIPAddress: "10.0.0.4",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid next hop IP address",
+			routes: []Route{
+				{
+					Name:             "to-appliance",
+					AddressPrefix:    "10.1.0.0/16",
+					NextHopType:      RouteNextHopTypeVirtualAppliance,
+					NextHopIPAddress: "10.0.0",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid address prefix",
+			routes: []Route{
+				{
+					Name:          "to-internet",
+					AddressPrefix: "10.2.0.0",
+					NextHopType:   RouteNextHopTypeInternet,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate route names",
+			routes: []Route{
+				{
+					Name:          "to-internet",
+					AddressPrefix: "10.2.0.0/16",
+					NextHopType:   RouteNextHopTypeInternet,
+				},
+				{
+					Name:          "To-Internet",
+					AddressPrefix: "10.3.0.0/16",
+					NextHopType:   RouteNextHopTypeInternet,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := validateRoutes(
+				testCase.routes,
+				field.NewPath("spec").Child("networkSpec").Child("subnets").Index(0).Child("routeTable", "routes"),
+			)
+			if testCase.wantErr {
+				g.Expect(err).To(HaveLen(1))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidatePublicIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		publicIP PublicIPSpec
+		wantErr  bool
+	}{
+		{
+			name:     "public IP without zones",
+			publicIP: PublicIPSpec{Name: "my-publicip"},
+			wantErr:  false,
+		},
+		{
+			name:     "zone-redundant public IP",
+			publicIP: PublicIPSpec{Name: "my-publicip", Zones: []string{"1", "2", "3"}},
+			wantErr:  false,
+		},
+		{
+			name:     "single-zone standard SKU public IP",
+			publicIP: PublicIPSpec{Name: "my-publicip", SKU: PublicIPSKUStandard, Zones: []string{"1"}},
+			wantErr:  false,
+		},
+		{
+			name:     "basic SKU public IP without zones",
+			publicIP: PublicIPSpec{Name: "my-publicip", SKU: PublicIPSKUBasic},
+			wantErr:  false,
+		},
+		{
+			name:     "basic SKU public IP with zones",
+			publicIP: PublicIPSpec{Name: "my-publicip", SKU: PublicIPSKUBasic, Zones: []string{"1"}},
+			wantErr:  true,
+		},
+		{
+			name:     "public IP with duplicate zones",
+			publicIP: PublicIPSpec{Name: "my-publicip", Zones: []string{"1", "1"}},
+			wantErr:  true,
+		},
+		{
+			name:     "public IP with an empty zone",
+			publicIP: PublicIPSpec{Name: "my-publicip", Zones: []string{""}},
+			wantErr:  true,
+		},
+		{
+			name:     "public IP with a DNS name",
+			publicIP: PublicIPSpec{Name: "my-publicip", DNSName: "my-cluster-1a2b3c.westus2.cloudapp.azure.com"},
+			wantErr:  false,
+		},
+		{
+			name:     "public IP with a DNS name which isn't valid",
+			publicIP: PublicIPSpec{Name: "my-publicip", DNSName: "my_cluster..westus2"},
+			wantErr:  true,
+		},
+		{
+			name:     "public IP with a domain name label starting with a number",
+			publicIP: PublicIPSpec{Name: "my-publicip", DNSName: "1cluster.westus2.cloudapp.azure.com"},
+			wantErr:  true,
+		},
+		{
+			name:     "public IP with an uppercase domain name label",
+			publicIP: PublicIPSpec{Name: "my-publicip", DNSName: "MyCluster.westus2.cloudapp.azure.com"},
+			wantErr:  true,
+		},
+		{
+			name:     "public IP with a too short domain name label",
+			publicIP: PublicIPSpec{Name: "my-publicip", DNSName: "ab.westus2.cloudapp.azure.com"},
+			wantErr:  true,
+		},
+		{
+			name:     "public IP with a domain name label ending with a hyphen",
+			publicIP: PublicIPSpec{Name: "my-publicip", DNSName: "my-cluster-"},
+			wantErr:  true,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := validatePublicIP(testCase.publicIP, field.NewPath("spec").Child("networkSpec").Child("apiServerLB").Child("frontendIPs").Index(0).Child("publicIP"))
+			if testCase.wantErr {
+				g.Expect(err).To(HaveLen(1))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateGatewayLoadBalancers(t *testing.T) {
+	const gatewayFrontendID = "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/gateway-lb/frontendIPConfigurations/gateway-frontend"
+	tests := []struct {
+		name    string
+		lb      *LoadBalancerSpec
+		wantErr bool
+	}{
+		{
+			name:    "no load balancer",
+			lb:      nil,
+			wantErr: false,
+		},
+		{
+			name: "public load balancer chained to a gateway load balancer",
+			lb: &LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name:                "frontend",
+						GatewayLoadBalancer: &GatewayLoadBalancerReference{ID: gatewayFrontendID},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public},
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal load balancer chained to a gateway load balancer",
+			lb: &LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name:                "frontend",
+						GatewayLoadBalancer: &GatewayLoadBalancerReference{ID: gatewayFrontendID},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Internal},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gateway load balancer reference to a load balancer instead of a frontend IP configuration",
+			lb: &LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name:                "frontend",
+						GatewayLoadBalancer: &GatewayLoadBalancerReference{ID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/gateway-lb"},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid gateway load balancer reference",
+			lb: &LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name:                "frontend",
+						GatewayLoadBalancer: &GatewayLoadBalancerReference{ID: "gateway-frontend"},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public},
+			},
+			wantErr: true,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := validateGatewayLoadBalancers(testCase.lb, field.NewPath("spec").Child("networkSpec").Child("apiServerLB"))
+			if testCase.wantErr {
+				g.Expect(err).To(HaveLen(1))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateSecurityRulesMode(t *testing.T) {
+	rule := SecurityRule{
+		Name:        "allow_apiserver",
+		Description: "Allow K8s API Server",
+		Priority:    150,
+	}
+	tests := []struct {
+		name    string
+		sg      SecurityGroupClass
+		wantErr bool
+	}{
+		{
+			name: "default rules mode",
+			sg: SecurityGroupClass{
+				SecurityRules: SecurityRules{rule},
+			},
+			wantErr: false,
+		},
+		{
+			name: "reserved priorities without the additive rules mode",
+			sg: SecurityGroupClass{
+				SecurityRules:      SecurityRules{rule},
+				RulesMode:          SecurityRulesModeManaged,
+				ReservedPriorities: &SecurityRulePriorityRange{Min: 100, Max: 199},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additive rules mode with the rules in the reserved priorities",
+			sg: SecurityGroupClass{
+				SecurityRules:      SecurityRules{rule},
+				RulesMode:          SecurityRulesModeAdditive,
+				ReservedPriorities: &SecurityRulePriorityRange{Min: 100, Max: 199},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additive rules mode without reserved priorities",
+			sg: SecurityGroupClass{
+				SecurityRules: SecurityRules{rule},
+				RulesMode:     SecurityRulesModeAdditive,
+			},
+			wantErr: true,
+		},
+		{
+			name: "additive rules mode with an invalid reserved priorities range",
+			sg: SecurityGroupClass{
+				SecurityRules:      SecurityRules{rule},
+				RulesMode:          SecurityRulesModeAdditive,
+				ReservedPriorities: &SecurityRulePriorityRange{Min: 199, Max: 100},
+			},
+			wantErr: true,
+		},
+		{
+			name: "additive rules mode with a rule outside of the reserved priorities",
+			sg: SecurityGroupClass{
+				SecurityRules:      SecurityRules{rule},
+				RulesMode:          SecurityRulesModeAdditive,
+				ReservedPriorities: &SecurityRulePriorityRange{Min: 200, Max: 299},
+			},
+			wantErr: true,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := validateSecurityRulesMode(
+				testCase.sg,
+				field.NewPath("spec").Child("networkSpec").Child("subnets").Index(0).Child("securityGroup"),
+			)
+			if testCase.wantErr {
+				g.Expect(err).To(HaveLen(1))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateAPIServerLB(t *testing.T) {
+	testcases := []struct {
+		name        string
+		lb          LoadBalancerSpec
+		old         LoadBalancerSpec
+		cpCIDRS     []string
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "invalid SKU",
+			lb: LoadBalancerSpec{
+				Name: "my-awesome-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-config",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					SKU:  "Awesome",
+					Type: Public,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueNotSupported",
+				Field:    "apiServerLB.sku",
+				BadValue: "Awesome",
+				Detail:   "supported values: \"Standard\"",
+			},
+		},
+		{
+			name: "invalid Type",
+			lb: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: "Foo",
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueNotSupported",
+				Field:    "apiServerLB.type",
+				BadValue: "Foo",
+				Detail:   "supported values: \"Public\", \"Internal\"",
+			},
+		},
+		{
+			name: "invalid Name",
+			lb: LoadBalancerSpec{
+				Name: "***",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.name",
+				BadValue: "***",
+				Detail:   "name of load balancer doesn't match regex ^[-\\w\\._]+$",
+			},
+		},
+		{
+			name: "too many IP configs",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+					{
+						Name: "ip-2",
+					},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:  "FieldValueInvalid",
+				Field: "apiServerLB.frontendIPConfigs",
+				BadValue: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+					{
+						Name: "ip-2",
+					},
+				},
+				Detail: "API Server Load balancer should have 1 Frontend IP",
+			},
+		},
+		{
+			name: "public LB with private IP",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						FrontendIPClass: FrontendIPClass{
+							PrivateIPAddress: "10.0.0.4",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.frontendIPConfigs[0].privateIP",
+				Detail: "Public Load Balancers cannot have a Private IP",
+			},
+		},
+		{
+			name: "internal LB with public IP",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name: "my-invalid-ip",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.frontendIPConfigs[0].publicIP",
+				Detail: "Internal Load Balancers cannot have a Public IP",
+			},
+		},
+		{
+			name: "internal LB with invalid private IP",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						FrontendIPClass: FrontendIPClass{
+							PrivateIPAddress: "NAIP",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.frontendIPConfigs[0].privateIP",
+				BadValue: "NAIP",
+				Detail:   "Internal LB IP address isn't a valid IPv4 or IPv6 address",
+			},
+		},
+		{
+			name: "internal LB with out of range private IP",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						FrontendIPClass: FrontendIPClass{
+							PrivateIPAddress: "20.1.2.3",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+				},
+			},
+			cpCIDRS: []string{"10.0.0.0/24", "10.1.0.0/24"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.frontendIPConfigs[0].privateIP",
+				BadValue: "20.1.2.3",
+				Detail:   "Internal LB IP address needs to be in control plane subnet range ([10.0.0.0/24 10.1.0.0/24])",
+			},
+		},
+		{
+			name: "internal LB with in range private IP",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						FrontendIPClass: FrontendIPClass{
+							PrivateIPAddress: "10.1.0.4",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+					SKU:  SKUStandard,
+				},
+				Name: "my-private-lb",
+			},
+			cpCIDRS: []string{"10.0.0.0/24", "10.1.0.0/24"},
+			wantErr: false,
+		},
+		{
+			name: "changed idle timeout and TCP reset",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:                 Public,
+					SKU:                  SKUStandard,
+					IdleTimeoutInMinutes: ptr.To[int32](30),
+					EnableTCPReset:       ptr.To(true),
+				},
+			},
+			old: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:                 Public,
+					SKU:                  SKUStandard,
+					IdleTimeoutInMinutes: ptr.To[int32](4),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "idle timeout out of range",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:                 Public,
+					SKU:                  SKUStandard,
+					IdleTimeoutInMinutes: ptr.To[int32](31),
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.idleTimeoutInMinutes",
+				BadValue: 31,
+				Detail:   "API Server load balancer idle timeout should be between 4 and 30 minutes",
+			},
+		},
+		{
+			name: "HA ports on an internal LB",
+			lb: LoadBalancerSpec{
+				Name: "my-private-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:          Internal,
+					SKU:           SKUStandard,
+					EnableHAPorts: true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "HA ports on a public LB",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:          Public,
+					SKU:           SKUStandard,
+					EnableHAPorts: true,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.enableHAPorts",
+				Detail: "HA ports can only be enabled on a Standard Internal load balancer",
+			},
+		},
+		{
+			name: "custom frontend port",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:         Public,
+					SKU:          SKUStandard,
+					FrontendPort: ptr.To[int32](443),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "frontend port out of range",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:         Public,
+					SKU:          SKUStandard,
+					FrontendPort: ptr.To[int32](65536),
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.frontendPort",
+				BadValue: 65536,
+				Detail:   "API Server load balancer frontend port should be between 1 and 65535",
+			},
+		},
+		{
+			name: "frontend port with HA ports",
+			lb: LoadBalancerSpec{
+				Name: "my-private-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:          Internal,
+					SKU:           SKUStandard,
+					EnableHAPorts: true,
+					FrontendPort:  ptr.To[int32](443),
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.frontendPort",
+				Detail: "frontend port cannot be set when HA ports are enabled",
+			},
+		},
+		{
+			name: "changed frontend port",
 			lb: LoadBalancerSpec{
-				Name: "my-awesome-lb",
+				Name: "my-lb",
 				FrontendIPs: []FrontendIP{
 					{
-						Name: "ip-config",
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:         Public,
+					SKU:          SKUStandard,
+					FrontendPort: ptr.To[int32](443),
+				},
+			},
+			old: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
 					},
 				},
 				LoadBalancerClassSpec: LoadBalancerClassSpec{
-					SKU:  "Awesome",
 					Type: Public,
+					SKU:  SKUStandard,
 				},
 			},
 			wantErr: true,
 			expectedErr: field.Error{
-				Type:     "FieldValueNotSupported",
-				Field:    "apiServerLB.sku",
-				BadValue: "Awesome",
-				Detail:   "supported values: \"Standard\"",
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.frontendPort",
+				Detail: "API Server load balancer frontend port cannot be modified after AzureCluster creation.",
 			},
 		},
 		{
-			name: "invalid Type",
+			name: "inbound NAT rules with floating IP and TCP reset",
 			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
 				LoadBalancerClassSpec: LoadBalancerClassSpec{
-					Type: "Foo",
+					Type: Public,
+					SKU:  SKUStandard,
+					InboundNATRules: &InboundNATRuleSettings{
+						EnableFloatingIP: true,
+						EnableTCPReset:   true,
+					},
 				},
 			},
-			wantErr: true,
-			expectedErr: field.Error{
-				Type:     "FieldValueNotSupported",
-				Field:    "apiServerLB.type",
-				BadValue: "Foo",
-				Detail:   "supported values: \"Public\", \"Internal\"",
-			},
+			wantErr: false,
 		},
 		{
-			name: "invalid Name",
+			name: "inbound NAT rules with floating IP and protocol All",
 			lb: LoadBalancerSpec{
-				Name: "***",
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+					InboundNATRules: &InboundNATRuleSettings{
+						Protocol:         InboundNATPoolProtocolAll,
+						EnableFloatingIP: true,
+					},
+				},
 			},
 			wantErr: true,
 			expectedErr: field.Error{
-				Type:     "FieldValueInvalid",
-				Field:    "apiServerLB.name",
-				BadValue: "***",
-				Detail:   "name of load balancer doesn't match regex ^[-\\w\\._]+$",
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.inboundNATRules.enableFloatingIP",
+				Detail: "floating IP is not supported for inbound NAT rules with protocol All",
 			},
 		},
 		{
-			name: "too many IP configs",
+			name: "inbound NAT rules with TCP reset and protocol Udp",
 			lb: LoadBalancerSpec{
+				Name: "my-lb",
 				FrontendIPs: []FrontendIP{
 					{
 						Name: "ip-1",
 					},
-					{
-						Name: "ip-2",
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+					InboundNATRules: &InboundNATRuleSettings{
+						Protocol:       InboundNATPoolProtocolUDP,
+						EnableTCPReset: true,
 					},
 				},
 			},
 			wantErr: true,
 			expectedErr: field.Error{
-				Type:  "FieldValueInvalid",
-				Field: "apiServerLB.frontendIPConfigs",
-				BadValue: []FrontendIP{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.inboundNATRules.enableTCPReset",
+				Detail: "TCP reset is not supported for inbound NAT rules with protocol Udp",
+			},
+		},
+		{
+			name: "outbound SNAT enabled on an internal LB",
+			lb: LoadBalancerSpec{
+				Name: "my-private-lb",
+				FrontendIPs: []FrontendIP{
 					{
 						Name: "ip-1",
 					},
-					{
-						Name: "ip-2",
-					},
 				},
-				Detail: "API Server Load balancer should have 1 Frontend IP",
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:                Internal,
+					SKU:                 SKUStandard,
+					DisableOutboundSNAT: ptr.To(false),
+				},
 			},
+			wantErr: false,
 		},
 		{
-			name: "public LB with private IP",
+			name: "outbound SNAT enabled on a public LB with an outbound rule",
 			lb: LoadBalancerSpec{
+				Name: "my-lb",
 				FrontendIPs: []FrontendIP{
 					{
 						Name: "ip-1",
-						FrontendIPClass: FrontendIPClass{
-							PrivateIPAddress: "10.0.0.4",
-						},
 					},
 				},
 				LoadBalancerClassSpec: LoadBalancerClassSpec{
-					Type: Public,
+					Type:                Public,
+					SKU:                 SKUStandard,
+					DisableOutboundSNAT: ptr.To(false),
 				},
 			},
 			wantErr: true,
 			expectedErr: field.Error{
 				Type:   "FieldValueForbidden",
-				Field:  "apiServerLB.frontendIPConfigs[0].privateIP",
-				Detail: "Public Load Balancers cannot have a Private IP",
+				Field:  "apiServerLB.disableOutboundSNAT",
+				Detail: "outbound SNAT must be disabled on the load balancing rule of a Public load balancer, which has an outbound rule",
 			},
 		},
 		{
-			name: "internal LB with public IP",
+			name: "internal LB with a private IP reserved by Azure",
 			lb: LoadBalancerSpec{
 				FrontendIPs: []FrontendIP{
 					{
 						Name: "ip-1",
-						PublicIP: &PublicIPSpec{
-							Name: "my-invalid-ip",
+						FrontendIPClass: FrontendIPClass{
+							PrivateIPAddress: "10.1.0.3",
 						},
 					},
 				},
@@ -834,21 +2048,23 @@ func TestValidateAPIServerLB(t *testing.T) {
 					Type: Internal,
 				},
 			},
+			cpCIDRS: []string{"10.0.0.0/24", "10.1.0.0/24"},
 			wantErr: true,
 			expectedErr: field.Error{
-				Type:   "FieldValueForbidden",
-				Field:  "apiServerLB.frontendIPConfigs[0].publicIP",
-				Detail: "Internal Load Balancers cannot have a Public IP",
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.frontendIPConfigs[0].privateIP",
+				BadValue: "10.1.0.3",
+				Detail:   "Internal LB IP address is reserved by Azure in control plane subnet 10.1.0.0/24",
 			},
 		},
 		{
-			name: "internal LB with invalid private IP",
+			name: "internal LB with the broadcast address of the subnet as private IP",
 			lb: LoadBalancerSpec{
 				FrontendIPs: []FrontendIP{
 					{
 						Name: "ip-1",
 						FrontendIPClass: FrontendIPClass{
-							PrivateIPAddress: "NAIP",
+							PrivateIPAddress: "10.0.0.255",
 						},
 					},
 				},
@@ -856,57 +2072,95 @@ func TestValidateAPIServerLB(t *testing.T) {
 					Type: Internal,
 				},
 			},
+			cpCIDRS: []string{"10.0.0.0/24", "10.1.0.0/24"},
 			wantErr: true,
 			expectedErr: field.Error{
 				Type:     "FieldValueInvalid",
 				Field:    "apiServerLB.frontendIPConfigs[0].privateIP",
-				BadValue: "NAIP",
-				Detail:   "Internal LB IP address isn't a valid IPv4 or IPv6 address",
+				BadValue: "10.0.0.255",
+				Detail:   "Internal LB IP address is reserved by Azure in control plane subnet 10.0.0.0/24",
 			},
 		},
 		{
-			name: "internal LB with out of range private IP",
+			name: "two backend pools with the load balancing rule on the additional pool",
 			lb: LoadBalancerSpec{
+				Name: "my-lb",
 				FrontendIPs: []FrontendIP{
 					{
 						Name: "ip-1",
-						FrontendIPClass: FrontendIPClass{
-							PrivateIPAddress: "20.1.2.3",
-						},
 					},
 				},
+				BackendPool:            BackendPool{Name: "my-lb-blue"},
+				AdditionalBackendPools: []BackendPool{{Name: "my-lb-green"}},
+				RuleBackendPool:        "my-lb-green",
 				LoadBalancerClassSpec: LoadBalancerClassSpec{
-					Type: Internal,
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "removed backend pool still referenced by the load balancing rule",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				BackendPool:     BackendPool{Name: "my-lb-blue"},
+				RuleBackendPool: "my-lb-green",
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			old: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+					},
+				},
+				BackendPool:            BackendPool{Name: "my-lb-blue"},
+				AdditionalBackendPools: []BackendPool{{Name: "my-lb-green"}},
+				RuleBackendPool:        "my-lb-green",
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
 				},
 			},
-			cpCIDRS: []string{"10.0.0.0/24", "10.1.0.0/24"},
 			wantErr: true,
 			expectedErr: field.Error{
 				Type:     "FieldValueInvalid",
-				Field:    "apiServerLB.frontendIPConfigs[0].privateIP",
-				BadValue: "20.1.2.3",
-				Detail:   "Internal LB IP address needs to be in control plane subnet range ([10.0.0.0/24 10.1.0.0/24])",
+				Field:    "apiServerLB.ruleBackendPool",
+				BadValue: "my-lb-green",
+				Detail:   "ruleBackendPool must be the name of the backendPool or of one of the additionalBackendPools of the load balancer",
 			},
 		},
 		{
-			name: "internal LB with in range private IP",
+			name: "duplicate backend pool names",
 			lb: LoadBalancerSpec{
+				Name: "my-lb",
 				FrontendIPs: []FrontendIP{
 					{
 						Name: "ip-1",
-						FrontendIPClass: FrontendIPClass{
-							PrivateIPAddress: "10.1.0.3",
-						},
 					},
 				},
+				BackendPool:            BackendPool{Name: "my-lb-blue"},
+				AdditionalBackendPools: []BackendPool{{Name: "my-lb-blue"}},
 				LoadBalancerClassSpec: LoadBalancerClassSpec{
-					Type: Internal,
+					Type: Public,
 					SKU:  SKUStandard,
 				},
-				Name: "my-private-lb",
 			},
-			cpCIDRS: []string{"10.0.0.0/24", "10.1.0.0/24"},
-			wantErr: false,
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "apiServerLB.additionalBackendPools[0].name",
+				BadValue: "my-lb-blue",
+			},
 		},
 	}
 
@@ -924,6 +2178,115 @@ func TestValidateAPIServerLB(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHealthProbe(t *testing.T) {
+	testcases := []struct {
+		name        string
+		probe       *HealthProbe
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name:    "no health probe",
+			probe:   nil,
+			wantErr: false,
+		},
+		{
+			name: "valid Https health probe",
+			probe: &HealthProbe{
+				Protocol:          ProbeProtocolHTTPS,
+				RequestPath:       "/healthz",
+				IntervalInSeconds: ptr.To[int32](5),
+				NumberOfProbes:    ptr.To[int32](2),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "valid Tcp health probe",
+			probe:   &HealthProbe{Protocol: ProbeProtocolTCP},
+			wantErr: false,
+		},
+		{
+			name:    "Tcp health probe with request path",
+			probe:   &HealthProbe{Protocol: ProbeProtocolTCP, RequestPath: "/healthz"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.healthProbe.requestPath",
+				Detail: "request path must not be set for Tcp health probes",
+			},
+		},
+		{
+			name:    "Http health probe without request path",
+			probe:   &HealthProbe{Protocol: ProbeProtocolHTTP},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueRequired",
+				Field:  "apiServerLB.healthProbe.requestPath",
+				Detail: "request path is required for Http health probes",
+			},
+		},
+		{
+			name:    "Https health probe with relative request path",
+			probe:   &HealthProbe{Protocol: ProbeProtocolHTTPS, RequestPath: "healthz"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.healthProbe.requestPath",
+				BadValue: "healthz",
+				Detail:   "request path must start with /",
+			},
+		},
+		{
+			name:    "unsupported protocol",
+			probe:   &HealthProbe{Protocol: "Udp"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueNotSupported",
+				Field:    "apiServerLB.healthProbe.protocol",
+				BadValue: ProbeProtocol("Udp"),
+				Detail:   "supported values: \"Tcp\", \"Http\", \"Https\"",
+			},
+		},
+		{
+			name:    "interval too short",
+			probe:   &HealthProbe{Protocol: ProbeProtocolTCP, IntervalInSeconds: ptr.To[int32](1)},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.healthProbe.intervalInSeconds",
+				BadValue: 1,
+				Detail:   "health probe interval should be at least 5 seconds",
+			},
+		},
+		{
+			name:    "no probes",
+			probe:   &HealthProbe{Protocol: ProbeProtocolTCP, NumberOfProbes: ptr.To[int32](0)},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.healthProbe.numberOfProbes",
+				BadValue: 0,
+				Detail:   "number of health probes should be at least 1",
+			},
+		},
+	}
+
+	for _, test := range testcases {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := validateHealthProbe(test.probe, field.NewPath("apiServerLB", "healthProbe"))
+			if test.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestPrivateDNSZoneName(t *testing.T) {
 	testcases := []struct {
 		name        string
@@ -1145,6 +2508,46 @@ func TestValidateNodeOutboundLB(t *testing.T) {
 				Detail:   "Max front end ips allowed is 16",
 			},
 		},
+		{
+			name: "inbound NAT pools",
+			lb: &LoadBalancerSpec{
+				InboundNATPools: []InboundNATPool{
+					{Name: "ssh", FrontendPortRangeStart: 50000, FrontendPortRangeEnd: 50099, BackendPort: 22},
+					{Name: "dns", FrontendPortRangeStart: 53000, FrontendPortRangeEnd: 53000, BackendPort: 53, Protocol: InboundNATPoolProtocolUDP},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate inbound NAT pool names",
+			lb: &LoadBalancerSpec{
+				InboundNATPools: []InboundNATPool{
+					{Name: "ssh", FrontendPortRangeStart: 50000, FrontendPortRangeEnd: 50099, BackendPort: 22},
+					{Name: "ssh", FrontendPortRangeStart: 51000, FrontendPortRangeEnd: 51099, BackendPort: 22},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "nodeOutboundLB.inboundNATPools[1].name",
+				BadValue: "ssh",
+			},
+		},
+		{
+			name: "inbound NAT pool frontend port range ends before it starts",
+			lb: &LoadBalancerSpec{
+				InboundNATPools: []InboundNATPool{
+					{Name: "ssh", FrontendPortRangeStart: 50099, FrontendPortRangeEnd: 50000, BackendPort: 22},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "nodeOutboundLB.inboundNATPools[0].frontendPortRangeEnd",
+				BadValue: 50000,
+				Detail:   "frontendPortRangeEnd must not be lower than frontendPortRangeStart",
+			},
+		},
 	}
 
 	for _, test := range testcases {
@@ -1225,6 +2628,25 @@ func TestValidateControlPlaneNodeOutboundLB(t *testing.T) {
 				Detail:   "Max front end ips allowed is 16",
 			},
 		},
+		{
+			name: "cp outbound lb cannot have inbound NAT pools",
+			lb: &LoadBalancerSpec{
+				InboundNATPools: []InboundNATPool{
+					{Name: "ssh", FrontendPortRangeStart: 50000, FrontendPortRangeEnd: 50099, BackendPort: 22},
+				},
+			},
+			apiServerLB: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "controlPlaneOutboundLB.inboundNATPools",
+				Detail: "inbound NAT pools are only supported on the node outbound load balancer",
+			},
+		},
 	}
 
 	for _, test := range testcases {
@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+	"net"
 	"reflect"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -63,6 +65,20 @@ func (c *AzureCluster) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "NodeResourceGroup"),
+		old.Spec.NodeResourceGroup,
+		c.Spec.NodeResourceGroup); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "ResourceGroupMode"),
+		old.Spec.ResourceGroupMode,
+		c.Spec.ResourceGroupMode); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("Spec", "SubscriptionID"),
 		old.Spec.SubscriptionID,
@@ -131,6 +147,7 @@ func (c *AzureCluster) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings
 	}
 
 	allErrs = append(allErrs, c.validateSubnetUpdate(old)...)
+	allErrs = append(allErrs, c.validateVnetCIDRUpdate(old)...)
 
 	if len(allErrs) == 0 {
 		return c.validateCluster(old)
@@ -187,6 +204,53 @@ func (c *AzureCluster) validateSubnetUpdate(old *AzureCluster) field.ErrorList {
 	return allErrs
 }
 
+// validateVnetCIDRUpdate validates that a change to ClusterSpec.NetworkSpec.Vnet.CIDRBlocks keeps the address space
+// of every existing subnet. Expanding the address space is allowed, but Azure rejects removing the address space of a
+// subnet only after the subnets have been affected.
+func (c *AzureCluster) validateVnetCIDRUpdate(old *AzureCluster) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(old.Spec.NetworkSpec.Vnet.CIDRBlocks) == 0 || reflect.DeepEqual(c.Spec.NetworkSpec.Vnet.CIDRBlocks, old.Spec.NetworkSpec.Vnet.CIDRBlocks) {
+		return allErrs
+	}
+
+	var vnetNws []*net.IPNet
+	for _, vnetCidr := range c.Spec.NetworkSpec.Vnet.CIDRBlocks {
+		if _, vnetNw, err := net.ParseCIDR(vnetCidr); err == nil {
+			vnetNws = append(vnetNws, vnetNw)
+		}
+	}
+
+	for _, subnet := range old.Spec.NetworkSpec.Subnets {
+		for _, subnetCidr := range subnet.CIDRBlocks {
+			_, subnetNw, err := net.ParseCIDR(subnetCidr)
+			if err != nil {
+				continue
+			}
+			if !cidrContainedIn(subnetNw, vnetNws) {
+				allErrs = append(allErrs,
+					field.Invalid(field.NewPath("spec", "networkSpec", "vnet", "cidrBlocks"),
+						c.Spec.NetworkSpec.Vnet.CIDRBlocks, fmt.Sprintf("vnet address space must contain the CIDR block %s of subnet %s", subnetCidr, subnet.Name)),
+				)
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// cidrContainedIn returns true if the whole network is contained in one of the networks.
+func cidrContainedIn(network *net.IPNet, networks []*net.IPNet) bool {
+	ones, bits := network.Mask.Size()
+	for _, n := range networks {
+		nOnes, nBits := n.Mask.Size()
+		if nBits == bits && nOnes <= ones && n.Contains(network.IP) {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (c *AzureCluster) ValidateDelete() (admission.Warnings, error) {
 	return nil, nil
@@ -82,6 +82,24 @@ func TestAzureCluster_ValidateCreate(t *testing.T) {
 			}(),
 			wantErr: true,
 		},
+		{
+			name: "azurecluster with invalid node resource group name",
+			cluster: func() *AzureCluster {
+				cluster := createValidCluster()
+				cluster.Spec.NodeResourceGroup = "invalid-rg-name###"
+				return cluster
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "azurecluster with separate node resource group - valid spec",
+			cluster: func() *AzureCluster {
+				cluster := createValidCluster()
+				cluster.Spec.NodeResourceGroup = "compute-rg"
+				return cluster
+			}(),
+			wantErr: false,
+		},
 		{
 			name: "azurecluster with pre-existing vnet - invalid subnet name",
 			cluster: func() *AzureCluster {
@@ -233,6 +251,38 @@ func TestAzureCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "azurecluster node resource group is immutable",
+			oldCluster: &AzureCluster{
+				Spec: AzureClusterSpec{
+					ResourceGroup:     "demoResourceGroup",
+					NodeResourceGroup: "demoNodeResourceGroup",
+				},
+			},
+			cluster: &AzureCluster{
+				Spec: AzureClusterSpec{
+					ResourceGroup:     "demoResourceGroup",
+					NodeResourceGroup: "demoNodeResourceGroup-2",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "azurecluster resource group mode is immutable",
+			oldCluster: &AzureCluster{
+				Spec: AzureClusterSpec{
+					ResourceGroup:     "demoResourceGroup",
+					ResourceGroupMode: ResourceGroupModeUnmanaged,
+				},
+			},
+			cluster: &AzureCluster{
+				Spec: AzureClusterSpec{
+					ResourceGroup:     "demoResourceGroup",
+					ResourceGroupMode: ResourceGroupModeManaged,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "azurecluster subscription ID is immutable",
 			oldCluster: &AzureCluster{
@@ -339,6 +389,36 @@ func TestAzureCluster_ValidateUpdate(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name:       "vnet CIDR blocks can be expanded",
+			oldCluster: createValidClusterWithCIDRBlocks([]string{"10.0.0.0/16"}, "10.0.0.0/24", "10.0.1.0/24"),
+			cluster:    createValidClusterWithCIDRBlocks([]string{"10.0.0.0/16", "10.1.0.0/16"}, "10.0.0.0/24", "10.0.1.0/24"),
+			wantErr:    false,
+		},
+		{
+			name:       "vnet CIDR blocks can be replaced with a larger prefix",
+			oldCluster: createValidClusterWithCIDRBlocks([]string{"10.0.0.0/16"}, "10.0.0.0/24", "10.0.1.0/24"),
+			cluster:    createValidClusterWithCIDRBlocks([]string{"10.0.0.0/8"}, "10.0.0.0/24", "10.0.1.0/24"),
+			wantErr:    false,
+		},
+		{
+			name:       "vnet CIDR blocks are unchanged",
+			oldCluster: createValidClusterWithCIDRBlocks([]string{"10.0.0.0/16"}, "10.0.0.0/24", "10.0.1.0/24"),
+			cluster:    createValidClusterWithCIDRBlocks([]string{"10.0.0.0/16"}, "10.0.0.0/24", "10.0.1.0/24"),
+			wantErr:    false,
+		},
+		{
+			name:       "vnet CIDR blocks cannot be shrunk to exclude a subnet",
+			oldCluster: createValidClusterWithCIDRBlocks([]string{"10.0.0.0/16"}, "10.0.0.0/24", "10.0.1.0/24"),
+			cluster:    createValidClusterWithCIDRBlocks([]string{"10.0.0.0/24"}, "10.0.0.0/24", "10.0.1.0/24"),
+			wantErr:    true,
+		},
+		{
+			name:       "vnet CIDR blocks cannot be shrunk to exclude part of a subnet",
+			oldCluster: createValidClusterWithCIDRBlocks([]string{"10.0.0.0/16"}, "10.0.0.0/23", "10.0.2.0/24"),
+			cluster:    createValidClusterWithCIDRBlocks([]string{"10.0.0.0/24", "10.0.2.0/24"}, "10.0.0.0/23", "10.0.2.0/24"),
+			wantErr:    true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -354,3 +434,11 @@ func TestAzureCluster_ValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func createValidClusterWithCIDRBlocks(vnetCIDRBlocks []string, controlPlaneSubnetCIDR, nodeSubnetCIDR string) *AzureCluster {
+	cluster := createValidCluster()
+	cluster.Spec.NetworkSpec.Vnet.CIDRBlocks = vnetCIDRBlocks
+	cluster.Spec.NetworkSpec.Subnets[0].CIDRBlocks = []string{controlPlaneSubnetCIDR}
+	cluster.Spec.NetworkSpec.Subnets[1].CIDRBlocks = []string{nodeSubnetCIDR}
+	return cluster
+}
@@ -56,6 +56,13 @@ type AzureClusterIdentitySpec struct {
 	// ClientSecret is a secret reference which should contain either a Service Principal password or certificate secret.
 	// +optional
 	ClientSecret corev1.SecretReference `json:"clientSecret,omitempty"`
+	// ClientSecretKeyVaultRef is a reference to an Azure Key Vault secret which contains either a Service Principal
+	// password or certificate secret. The secret is read with the managed identity of the controller, which must be
+	// allowed to get secrets from the Key Vault. It takes precedence over ClientSecret, which is used as a fallback
+	// when the Key Vault can't be reached.
+	// Only applicable when type is ServicePrincipal, ServicePrincipalCertificate or ManualServicePrincipal.
+	// +optional
+	ClientSecretKeyVaultRef *KeyVaultSecretReference `json:"clientSecretKeyVaultRef,omitempty"`
 	// TenantID is the service principal primary tenant id.
 	TenantID string `json:"tenantID"`
 	// AllowedNamespaces is used to identify the namespaces the clusters are allowed to use the identity from.
@@ -69,6 +76,16 @@ type AzureClusterIdentitySpec struct {
 	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces"`
 }
 
+// KeyVaultSecretReference is a reference to a secret in Azure Key Vault.
+type KeyVaultSecretReference struct {
+	// VaultURI is the URI of the Key Vault, e.g. https://my-vault.vault.azure.net/.
+	// +kubebuilder:validation:MinLength=1
+	VaultURI string `json:"vaultURI"`
+	// SecretName is the name of the secret in the Key Vault. The latest version of the secret is used.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+}
+
 // AzureClusterIdentityStatus defines the observed state of AzureClusterIdentity.
 type AzureClusterIdentityStatus struct {
 	// Conditions defines current service state of the AzureClusterIdentity.
@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"net/url"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -29,8 +31,29 @@ func (c *AzureClusterIdentity) validateClusterIdentity() (admission.Warnings, er
 	} else if c.Spec.Type != UserAssignedMSI && c.Spec.ResourceID != "" {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "resourceID"), c.Spec.ResourceID))
 	}
+	allErrs = append(allErrs, validateClientSecretKeyVaultRef(c.Spec.Type, c.Spec.ClientSecretKeyVaultRef, field.NewPath("spec", "clientSecretKeyVaultRef"))...)
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
 	return nil, apierrors.NewInvalid(GroupVersion.WithKind(AzureClusterIdentityKind).GroupKind(), c.Name, allErrs)
 }
+
+// validateClientSecretKeyVaultRef validates a Key Vault reference of an identity's client secret.
+func validateClientSecretKeyVaultRef(identityType IdentityType, ref *KeyVaultSecretReference, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if ref == nil {
+		return allErrs
+	}
+	switch identityType {
+	case ServicePrincipal, ServicePrincipalCertificate, ManualServicePrincipal:
+	default:
+		return append(allErrs, field.Forbidden(fldPath, "clientSecretKeyVaultRef is only applicable to service principal identities"))
+	}
+	if vaultURI, err := url.Parse(ref.VaultURI); err != nil || vaultURI.Scheme != "https" || vaultURI.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("vaultURI"), ref.VaultURI, "must be an https URI, e.g. https://my-vault.vault.azure.net/"))
+	}
+	if ref.SecretName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretName"), "secretName is required"))
+	}
+	return allErrs
+}
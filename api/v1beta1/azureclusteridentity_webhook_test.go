@@ -78,6 +78,65 @@ func TestAzureClusterIdentity_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "azureclusteridentity with service principal and key vault secret",
+			clusterIdentity: &AzureClusterIdentity{
+				Spec: AzureClusterIdentitySpec{
+					Type:     ServicePrincipal,
+					ClientID: fakeClientID,
+					TenantID: fakeTenantID,
+					ClientSecretKeyVaultRef: &KeyVaultSecretReference{
+						VaultURI:   "https://my-vault.vault.azure.net/",
+						SecretName: "my-client-secret",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "azureclusteridentity with service principal and key vault secret with an invalid vault URI",
+			clusterIdentity: &AzureClusterIdentity{
+				Spec: AzureClusterIdentitySpec{
+					Type:     ServicePrincipal,
+					ClientID: fakeClientID,
+					TenantID: fakeTenantID,
+					ClientSecretKeyVaultRef: &KeyVaultSecretReference{
+						VaultURI:   "http://my-vault.vault.azure.net/",
+						SecretName: "my-client-secret",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "azureclusteridentity with service principal and key vault secret without a secret name",
+			clusterIdentity: &AzureClusterIdentity{
+				Spec: AzureClusterIdentitySpec{
+					Type:     ServicePrincipal,
+					ClientID: fakeClientID,
+					TenantID: fakeTenantID,
+					ClientSecretKeyVaultRef: &KeyVaultSecretReference{
+						VaultURI: "https://my-vault.vault.azure.net/",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "azureclusteridentity with workload identity and key vault secret",
+			clusterIdentity: &AzureClusterIdentity{
+				Spec: AzureClusterIdentitySpec{
+					Type:     WorkloadIdentity,
+					ClientID: fakeClientID,
+					TenantID: fakeTenantID,
+					ClientSecretKeyVaultRef: &KeyVaultSecretReference{
+						VaultURI:   "https://my-vault.vault.azure.net/",
+						SecretName: "my-client-secret",
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
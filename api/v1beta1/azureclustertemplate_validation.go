@@ -46,6 +46,14 @@ func (c *AzureClusterTemplate) validateClusterTemplateSpec() field.ErrorList {
 		field.NewPath("spec").Child("template").Child("spec").
 			Child("networkSpec").Child("vnet").Child("cidrBlocks"))...)
 
+	allErrs = append(allErrs, validateVnetDDoSProtection(
+		c.Spec.Template.Spec.NetworkSpec.Vnet.VnetClassSpec,
+		field.NewPath("spec").Child("template").Child("spec").Child("networkSpec").Child("vnet"))...)
+
+	allErrs = append(allErrs, validateVnetDNSServers(
+		c.Spec.Template.Spec.NetworkSpec.Vnet.DNSServers,
+		field.NewPath("spec").Child("template").Child("spec").Child("networkSpec").Child("vnet").Child("dnsServers"))...)
+
 	allErrs = append(allErrs, validateSubnetTemplates(
 		c.Spec.Template.Spec.NetworkSpec.Subnets,
 		c.Spec.Template.Spec.NetworkSpec.Vnet,
@@ -112,6 +120,7 @@ func validateSubnetTemplates(subnets SubnetTemplatesSpec, vnet VnetTemplateSpec,
 				allErrs = append(allErrs, err...)
 			}
 		}
+		allErrs = append(allErrs, validateSecurityRulesMode(subnet.SecurityGroup, fld.Index(i).Child("securityGroup"))...)
 		allErrs = append(allErrs, validateSubnetCIDR(subnet.CIDRBlocks, vnet.CIDRBlocks, fld.Index(i).Child("cidrBlocks"))...)
 	}
 	for k, v := range requiredSubnetRoles {
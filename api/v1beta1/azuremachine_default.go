@@ -48,10 +48,14 @@ func (s *AzureMachineSpec) SetDefaultSSHPublicKey() error {
 	return nil
 }
 
-// SetDefaultCachingType sets the default cache type for an AzureMachine.
+// SetDefaultCachingType sets the default cache type for an AzureMachine. Ephemeral OS disks only support ReadOnly caching.
 func (s *AzureMachineSpec) SetDefaultCachingType() {
 	if s.OSDisk.CachingType == "" {
-		s.OSDisk.CachingType = "None"
+		if s.OSDisk.DiffDiskSettings != nil && s.OSDisk.DiffDiskSettings.Option == string(armcompute.DiffDiskOptionsLocal) {
+			s.OSDisk.CachingType = string(armcompute.CachingTypesReadOnly)
+		} else {
+			s.OSDisk.CachingType = "None"
+		}
 	}
 }
 
@@ -596,6 +596,13 @@ func createMachineWithUserAssignedIdentities(identitiesList []UserAssignedIdenti
 	return machine
 }
 
+func createMachineWithAvailabilitySet(availabilitySetID *string, settings *AvailabilitySetSettings) *AzureMachine {
+	machine := hardcodedAzureMachineWithSSHKey(generateSSHPublicKey(true))
+	machine.Spec.AvailabilitySetID = availabilitySetID
+	machine.Spec.AvailabilitySet = settings
+	return machine
+}
+
 func hardcodedAzureMachineWithSSHKey(sshPublicKey string) *AzureMachine {
 	return &AzureMachine{
 		ObjectMeta: metav1.ObjectMeta{
@@ -28,6 +28,9 @@ const (
 	// MachineFinalizer allows ReconcileAzureMachine to clean up Azure resources associated with AzureMachine before
 	// removing it from the apiserver.
 	MachineFinalizer = "azuremachine.infrastructure.cluster.x-k8s.io"
+
+	// VMAdminUsername is the name of the admin user of the Virtual Machines created for AzureMachines.
+	VMAdminUsername = "capi"
 )
 
 // AzureMachineSpec defines the desired state of AzureMachine.
@@ -86,6 +89,31 @@ type AzureMachineSpec struct {
 	// +optional
 	SSHPublicKey string `json:"sshPublicKey"`
 
+	// AdditionalSSHPublicKeys are SSH public keys authorized for the admin user of the Virtual Machine in addition to
+	// SSHPublicKey, e.g. to give several operators access to it. Linux only.
+	// +optional
+	AdditionalSSHPublicKeys []SSHPublicKey `json:"additionalSSHPublicKeys,omitempty"`
+
+	// WindowsConfiguration configures the operating system of Windows Virtual Machines. Only allowed if the OSType of the
+	// OSDisk is Windows.
+	// +optional
+	WindowsConfiguration *WindowsConfiguration `json:"windowsConfiguration,omitempty"`
+
+	// BootstrapDataSource selects how the bootstrap data is passed to the Virtual Machine. CustomData is read by
+	// both cloud-init and Ignition. UserData is only supported for Ignition bootstrap data, which the bootstrap data
+	// secret declares with its format key. CustomScriptExtension runs the bootstrap data as a shell script with the
+	// Custom Script Extension, for Linux images which don't run cloud-init. Defaults to CustomData.
+	// +kubebuilder:validation:Enum=CustomData;UserData;CustomScriptExtension
+	// +optional
+	BootstrapDataSource *BootstrapDataSource `json:"bootstrapDataSource,omitempty"`
+
+	// RegistryMirrors configures containerd to pull the images of the given registries from mirrors. The mirror
+	// configuration is merged into the cloud-init bootstrap data. Linux only.
+	// +listType=map
+	// +listMapKey=registry
+	// +optional
+	RegistryMirrors []RegistryMirror `json:"registryMirrors,omitempty"`
+
 	// AdditionalTags is an optional set of tags to add to an instance, in addition to the ones added by default by the
 	// Azure provider. If both the AzureCluster and the AzureMachine specify the same tag name with different values, the
 	// AzureMachine's value takes precedence.
@@ -120,6 +148,20 @@ type AzureMachineSpec struct {
 	// +optional
 	SpotVMOptions *SpotVMOptions `json:"spotVMOptions,omitempty"`
 
+	// AvailabilitySetID is the resource ID of an existing availability set to place the VM in. If set, the VM
+	// joins this availability set instead of the one CAPZ creates for the machine's control plane,
+	// MachineDeployment or MachineSet, and the availability set is neither created nor deleted by CAPZ.
+	// The availability set must be in the resource group and location of the VM. It can't be combined with a
+	// failure domain or Spot VMs.
+	// +optional
+	AvailabilitySetID *string `json:"availabilitySetID,omitempty"`
+
+	// AvailabilitySet configures the availability set CAPZ creates for the machine's control plane,
+	// MachineDeployment or MachineSet. The settings only take effect when the availability set is created,
+	// so all machines sharing an availability set should use the same settings.
+	// +optional
+	AvailabilitySet *AvailabilitySetSettings `json:"availabilitySet,omitempty"`
+
 	// SecurityProfile specifies the Security profile settings for a virtual machine.
 	// +optional
 	SecurityProfile *SecurityProfile `json:"securityProfile,omitempty"`
@@ -144,6 +186,22 @@ type AzureMachineSpec struct {
 	NetworkInterfaces []NetworkInterface `json:"networkInterfaces,omitempty"`
 }
 
+// AvailabilitySetSettings defines the settings of an availability set created by CAPZ.
+type AvailabilitySetSettings struct {
+	// PlatformFaultDomainCount is the number of fault domains of the availability set. It must not exceed the
+	// maximum fault domain count of the region. Defaults to the maximum fault domain count of the region.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3
+	// +optional
+	PlatformFaultDomainCount *int32 `json:"platformFaultDomainCount,omitempty"`
+
+	// PlatformUpdateDomainCount is the number of update domains of the availability set. Defaults to 5.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	PlatformUpdateDomainCount *int32 `json:"platformUpdateDomainCount,omitempty"`
+}
+
 // SpotVMOptions defines the options relevant to running the Machine on Spot VMs.
 type SpotVMOptions struct {
 	// MaxPrice defines the maximum price the user is willing to pay for Spot VM instances
@@ -155,6 +213,83 @@ type SpotVMOptions struct {
 	EvictionPolicy *SpotEvictionPolicy `json:"evictionPolicy,omitempty"`
 }
 
+// BootstrapDataSource is how the bootstrap data is passed to a Virtual Machine.
+type BootstrapDataSource string
+
+const (
+	// BootstrapDataSourceCustomData passes the bootstrap data as custom data, which Azure makes available to the
+	// Virtual Machine when it is provisioned.
+	BootstrapDataSourceCustomData BootstrapDataSource = "CustomData"
+	// BootstrapDataSourceUserData passes the bootstrap data as user data, which the Virtual Machine reads from the
+	// Azure Instance Metadata Service.
+	BootstrapDataSourceUserData BootstrapDataSource = "UserData"
+	// BootstrapDataSourceCustomScriptExtension runs the bootstrap data as a shell script with the Custom Script
+	// Extension, for images which don't run cloud-init.
+	BootstrapDataSourceCustomScriptExtension BootstrapDataSource = "CustomScriptExtension"
+)
+
+// RegistryMirror configures the mirrors containerd pulls the images of a registry from.
+type RegistryMirror struct {
+	// Registry is the host, and optionally the port, of the mirrored registry, e.g. docker.io or
+	// registry.k8s.io. The special value _default configures the mirrors of all registries without a
+	// mirror configuration of their own.
+	// +kubebuilder:validation:MinLength=1
+	Registry string `json:"registry"`
+
+	// Endpoints are the URLs of the mirrors, e.g. https://mirror.example.com. containerd tries them in order
+	// before falling back to the registry itself.
+	// +kubebuilder:validation:MinItems=1
+	Endpoints []string `json:"endpoints"`
+}
+
+// WinRMProtocol is the protocol of a Windows Remote Management listener.
+type WinRMProtocol string
+
+const (
+	// WinRMProtocolHTTP is the HTTP protocol.
+	WinRMProtocolHTTP WinRMProtocol = "Http"
+	// WinRMProtocolHTTPS is the HTTPS protocol.
+	WinRMProtocolHTTPS WinRMProtocol = "Https"
+)
+
+// WindowsConfiguration defines the Windows operating system settings of a Virtual Machine.
+type WindowsConfiguration struct {
+	// AdminPasswordSecretRef is a reference to the key of a Secret in the namespace of the AzureMachine holding the
+	// password of the administrator account. The password must meet the Azure complexity requirements. If not set, a
+	// random password is generated, and access is provided with the SSH public key.
+	// +optional
+	AdminPasswordSecretRef *corev1.SecretKeySelector `json:"adminPasswordSecretRef,omitempty"`
+
+	// EnableAutomaticUpdates enables automatic Windows updates. Defaults to false.
+	// +optional
+	EnableAutomaticUpdates *bool `json:"enableAutomaticUpdates,omitempty"`
+
+	// TimeZone is the time zone of the Virtual Machine, e.g. "Pacific Standard Time".
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// WinRMListeners are the Windows Remote Management listeners of the Virtual Machine.
+	// +optional
+	WinRMListeners []WinRMListener `json:"winRMListeners,omitempty"`
+}
+
+// WinRMListener defines a Windows Remote Management listener.
+type WinRMListener struct {
+	// Protocol is the protocol of the listener.
+	// +kubebuilder:validation:Enum=Http;Https
+	Protocol WinRMProtocol `json:"protocol"`
+
+	// CertificateURL is the URL of the certificate of an Https listener, which has been uploaded to a Key Vault as a
+	// secret. Required for Https listeners.
+	// +optional
+	CertificateURL string `json:"certificateURL,omitempty"`
+
+	// KeyVaultID is the resource ID of the Key Vault holding the certificate of an Https listener. Required for Https
+	// listeners.
+	// +optional
+	KeyVaultID string `json:"keyVaultID,omitempty"`
+}
+
 // SystemAssignedIdentityRole defines the role and scope to assign to the system assigned identity.
 type SystemAssignedIdentityRole struct {
 	// Name is the name of the role assignment to create for a system assigned identity. It can be any valid UUID.
@@ -19,14 +19,32 @@ package v1beta1
 import (
 	"encoding/base64"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/ssh"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 )
 
+const (
+	// availabilitySetResourceType is the resource type of the availability sets an AzureMachine can reference.
+	availabilitySetResourceType = "Microsoft.Compute/availabilitySets"
+	// diskEncryptionSetResourceType is the resource type of the disk encryption sets managed disks can reference.
+	diskEncryptionSetResourceType = "Microsoft.Compute/diskEncryptionSets"
+	// hostGroupResourceType is the resource type of the dedicated host groups node pools can be placed in.
+	hostGroupResourceType = "Microsoft.Compute/hostGroups"
+	// defaultRegistryMirror is the registry name containerd uses for the mirrors of all registries.
+	defaultRegistryMirror = "_default"
+)
+
 // ValidateAzureMachineSpec checks an AzureMachineSpec and returns any validation errors.
 func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 	var allErrs field.ErrorList
@@ -47,6 +65,30 @@ func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := ValidateAdditionalSSHPublicKeys(spec.AdditionalSSHPublicKeys, field.NewPath("additionalSSHPublicKeys")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateWindowsConfiguration(spec.WindowsConfiguration, spec.OSDisk.OSType, field.NewPath("windowsConfiguration")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateBootstrapDataSource(spec.BootstrapDataSource, spec.OSDisk.OSType, field.NewPath("bootstrapDataSource")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateRegistryMirrors(spec.RegistryMirrors, spec.OSDisk.OSType, field.NewPath("registryMirrors")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateAvailabilitySetID(spec.AvailabilitySetID, spec.FailureDomain, spec.SpotVMOptions, field.NewPath("availabilitySetID")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if spec.AvailabilitySetID != nil && spec.AvailabilitySet != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("availabilitySet"), "cannot be set together with availabilitySetID"))
+	}
+
 	if errs := ValidateUserAssignedIdentity(spec.Identity, spec.UserAssignedIdentities, field.NewPath("userAssignedIdentities")); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -59,6 +101,10 @@ func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := ValidateTags(spec.AdditionalTags, field.NewPath("additionalTags")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if errs := ValidateNetwork(spec.SubnetName, spec.AcceleratedNetworking, spec.NetworkInterfaces, field.NewPath("networkInterfaces")); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -107,6 +153,175 @@ func ValidateSSHKey(sshKey string, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// ValidateAdditionalSSHPublicKeys validates a list of SSH public keys authorized for the admin user of a Virtual Machine.
+func ValidateAdditionalSSHPublicKeys(keys []SSHPublicKey, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := make(map[SSHPublicKey]struct{}, len(keys))
+	for i, key := range keys {
+		if key.Username != VMAdminUsername {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i).Child("username"), key.Username, []string{VMAdminUsername}))
+		}
+		allErrs = append(allErrs, ValidateSSHKey(key.KeyData, fldPath.Index(i).Child("keyData"))...)
+		if _, ok := seen[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), key.Username))
+		}
+		seen[key] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// ValidateBootstrapDataSource validates how the bootstrap data is passed to a Virtual Machine. Passing it as user
+// data is only supported for Ignition, which doesn't run on Windows, and only the Linux Custom Script Extension runs
+// inline scripts.
+func ValidateBootstrapDataSource(source *BootstrapDataSource, osType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if osType != WindowsOS {
+		return allErrs
+	}
+	switch ptr.Deref(source, BootstrapDataSourceCustomData) {
+	case BootstrapDataSourceUserData:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "bootstrap data can only be passed as user data to Linux Virtual Machines"))
+	case BootstrapDataSourceCustomScriptExtension:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "bootstrap data can only be run with the Custom Script Extension on Linux Virtual Machines"))
+	}
+	return allErrs
+}
+
+// ValidateRegistryMirrors validates the registry mirrors of a Virtual Machine. The mirrors are configured through
+// cloud-init, which doesn't run on Windows.
+func ValidateRegistryMirrors(mirrors []RegistryMirror, osType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(mirrors) == 0 {
+		return allErrs
+	}
+	if osType == WindowsOS {
+		return append(allErrs, field.Forbidden(fldPath, "registry mirrors are only supported for Linux Virtual Machines"))
+	}
+
+	for i, mirror := range mirrors {
+		if !isValidRegistryHost(mirror.Registry) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("registry"), mirror.Registry,
+				fmt.Sprintf("must be a host name or IP address with an optional port, or %s", defaultRegistryMirror)))
+		}
+		for j, endpoint := range mirror.Endpoints {
+			if err := validateRegistryMirrorEndpoint(endpoint); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("endpoints").Index(j), endpoint, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// isValidRegistryHost returns true if the registry is the host, and optionally the port, of a registry.
+func isValidRegistryHost(registry string) bool {
+	if registry == defaultRegistryMirror {
+		return true
+	}
+	host := registry
+	if h, port, err := net.SplitHostPort(registry); err == nil {
+		if n, err := strconv.Atoi(port); err != nil || len(validation.IsValidPortNum(n)) > 0 {
+			return false
+		}
+		host = h
+	}
+	return net.ParseIP(host) != nil || len(validation.IsDNS1123Subdomain(host)) == 0
+}
+
+// validateRegistryMirrorEndpoint checks that a registry mirror endpoint is an http or https URL without credentials.
+func validateRegistryMirrorEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.New("must be a valid URL")
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return errors.New("must be an http or https URL")
+	}
+	if u.Host == "" || !isValidRegistryHost(u.Host) {
+		return errors.New("must contain a valid host")
+	}
+	if u.User != nil {
+		return errors.New("must not contain credentials")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return errors.New("must not contain a query or fragment")
+	}
+	return nil
+}
+
+// ValidateAvailabilitySetID validates the reference to an existing availability set. Azure doesn't allow placing a VM
+// in an availability set and an availability zone, and Spot VMs can't be placed in availability sets.
+func ValidateAvailabilitySetID(availabilitySetID *string, failureDomain *string, spotVMOptions *SpotVMOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if availabilitySetID == nil {
+		return allErrs
+	}
+
+	if id, err := azureutil.ParseResourceID(*availabilitySetID); err != nil || !strings.EqualFold(id.ResourceType.String(), availabilitySetResourceType) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *availabilitySetID, "must be the resource ID of an availability set"))
+	}
+	if failureDomain != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set together with failureDomain"))
+	}
+	if spotVMOptions != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set together with spotVMOptions"))
+	}
+	return allErrs
+}
+
+// ValidateHostGroupID validates the reference to the dedicated host group a node pool is placed in.
+func ValidateHostGroupID(hostGroupID *string, fldPath *field.Path) field.ErrorList {
+	if hostGroupID == nil {
+		return nil
+	}
+	if resourceID, err := azureutil.ParseResourceID(*hostGroupID); err != nil || !strings.EqualFold(resourceID.ResourceType.String(), hostGroupResourceType) {
+		return field.ErrorList{field.Invalid(fldPath, *hostGroupID, "must be the resource ID of a dedicated host group")}
+	}
+	return nil
+}
+
+// ValidateWindowsConfiguration validates the Windows operating system settings of a Virtual Machine.
+func ValidateWindowsConfiguration(config *WindowsConfiguration, osType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	if osType != WindowsOS {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "windowsConfiguration is only allowed if the osDisk osType is Windows"))
+	}
+
+	if ref := config.AdminPasswordSecretRef; ref != nil {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("adminPasswordSecretRef", "name"), "the name of the admin password secret is required"))
+		}
+		if ref.Key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("adminPasswordSecretRef", "key"), "the key of the admin password secret is required"))
+		}
+	}
+
+	for i, listener := range config.WinRMListeners {
+		listenerPath := fldPath.Child("winRMListeners").Index(i)
+		switch listener.Protocol {
+		case WinRMProtocolHTTPS:
+			if listener.CertificateURL == "" {
+				allErrs = append(allErrs, field.Required(listenerPath.Child("certificateURL"), "a certificate URL is required for Https listeners"))
+			}
+			if _, err := azureutil.ParseResourceID(listener.KeyVaultID); err != nil {
+				allErrs = append(allErrs, field.Invalid(listenerPath.Child("keyVaultID"), listener.KeyVaultID, "a Key Vault resource ID is required for Https listeners"))
+			}
+		case WinRMProtocolHTTP:
+			if listener.CertificateURL != "" || listener.KeyVaultID != "" {
+				allErrs = append(allErrs, field.Forbidden(listenerPath, "a certificate can only be set for Https listeners"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateSystemAssignedIdentity validates the system-assigned identities list.
 func ValidateSystemAssignedIdentity(identityType VMIdentity, oldIdentity, newIdentity string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -206,6 +421,8 @@ func ValidateDataDisks(dataDisks []DataDisk, fieldPath *field.Path) field.ErrorL
 
 		// validate cachingType
 		allErrs = append(allErrs, validateCachingType(disk.CachingType, fieldPath, disk.ManagedDisk)...)
+
+		allErrs = append(allErrs, validateWriteAccelerator(disk, fieldPath.Child("writeAcceleratorEnabled"))...)
 	}
 	return allErrs
 }
@@ -232,6 +449,14 @@ func ValidateOSDisk(osDisk OSDisk, fieldPath *field.Path) field.ErrorList {
 		}
 	}
 
+	if osDisk.DiffDiskSettings != nil && osDisk.DiffDiskSettings.Option == string(armcompute.DiffDiskOptionsLocal) && osDisk.CachingType != string(armcompute.CachingTypesReadOnly) {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("CachingType"),
+			osDisk.CachingType,
+			fmt.Sprintf("cachingType '%s' is not supported when diffDiskSettings.option is '%s'. Allowed values are: '%s'", osDisk.CachingType, armcompute.DiffDiskOptionsLocal, armcompute.CachingTypesReadOnly),
+		))
+	}
+
 	if osDisk.DiffDiskSettings != nil && osDisk.ManagedDisk != nil && osDisk.ManagedDisk.DiskEncryptionSet != nil {
 		allErrs = append(allErrs, field.Invalid(
 			fieldPath.Child("managedDisks").Child("diskEncryptionSet"),
@@ -250,6 +475,13 @@ func validateManagedDisk(m *ManagedDiskParameters, fieldPath *field.Path, isOSDi
 	if m != nil {
 		allErrs = append(allErrs, validateStorageAccountType(m.StorageAccountType, fieldPath.Child("StorageAccountType"), isOSDisk)...)
 
+		if m.DiskEncryptionSet != nil {
+			allErrs = append(allErrs, validateDiskEncryptionSetID(m.DiskEncryptionSet.ID, fieldPath.Child("diskEncryptionSet", "id"))...)
+		}
+		if m.SecurityProfile != nil && m.SecurityProfile.DiskEncryptionSet != nil {
+			allErrs = append(allErrs, validateDiskEncryptionSetID(m.SecurityProfile.DiskEncryptionSet.ID, fieldPath.Child("securityProfile", "diskEncryptionSet", "id"))...)
+		}
+
 		// DiskEncryptionSet can only be set when SecurityEncryptionType is set to DiskWithVMGuestState
 		// https://learn.microsoft.com/en-us/rest/api/compute/virtual-machines/create-or-update?tabs=HTTP#securityencryptiontypes
 		if isOSDisk && m.SecurityProfile != nil && m.SecurityProfile.DiskEncryptionSet != nil {
@@ -266,6 +498,31 @@ func validateManagedDisk(m *ManagedDiskParameters, fieldPath *field.Path, isOSDi
 	return allErrs
 }
 
+// validateDiskEncryptionSetID validates that id is the resource ID of a disk encryption set.
+func validateDiskEncryptionSetID(id string, fieldPath *field.Path) field.ErrorList {
+	if resourceID, err := azureutil.ParseResourceID(id); err != nil || !strings.EqualFold(resourceID.ResourceType.String(), diskEncryptionSetResourceType) {
+		return field.ErrorList{field.Invalid(fieldPath, id, "must be the resource ID of a disk encryption set")}
+	}
+	return nil
+}
+
+// validateWriteAccelerator validates that Write Accelerator is only enabled for Premium_LRS data disks without
+// ReadWrite caching.
+func validateWriteAccelerator(disk DataDisk, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !ptr.Deref(disk.WriteAcceleratorEnabled, false) {
+		return allErrs
+	}
+
+	if disk.ManagedDisk == nil || disk.ManagedDisk.StorageAccountType != string(armcompute.StorageAccountTypesPremiumLRS) {
+		allErrs = append(allErrs, field.Invalid(fieldPath, *disk.WriteAcceleratorEnabled, fmt.Sprintf("write accelerator is only supported for data disks with storageAccountType '%s'", armcompute.StorageAccountTypesPremiumLRS)))
+	}
+	if disk.CachingType == string(armcompute.CachingTypesReadWrite) {
+		allErrs = append(allErrs, field.Invalid(fieldPath, *disk.WriteAcceleratorEnabled, fmt.Sprintf("write accelerator is not supported for data disks with cachingType '%s'", armcompute.CachingTypesReadWrite)))
+	}
+	return allErrs
+}
+
 // ValidateDataDisksUpdate validates updates to Data disks.
 func ValidateDataDisksUpdate(oldDataDisks, newDataDisks []DataDisk, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -301,6 +558,10 @@ func ValidateDataDisksUpdate(oldDataDisks, newDataDisks []DataDisk, fieldPath *f
 			if newDisk.CachingType != oldDisk.CachingType {
 				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("cachingType"), newDataDisks, fieldErrMsg))
 			}
+
+			if !ptr.Equal(newDisk.WriteAcceleratorEnabled, oldDisk.WriteAcceleratorEnabled) {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("writeAcceleratorEnabled"), newDataDisks, fieldErrMsg))
+			}
 		} else {
 			allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("nameSuffix"), newDataDisks, diskErrMsg))
 		}
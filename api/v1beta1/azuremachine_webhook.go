@@ -85,70 +85,119 @@ func (mw *azureMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newOb
 		return nil, apierrors.NewBadRequest("expected an AzureMachine resource")
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "VMSize"),
+		old.Spec.VMSize,
+		m.Spec.VMSize); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "FailureDomain"),
+		old.Spec.FailureDomain,
+		m.Spec.FailureDomain); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "Image"),
 		old.Spec.Image,
 		m.Spec.Image); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "Identity"),
 		old.Spec.Identity,
 		m.Spec.Identity); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "SystemAssignedIdentityRole"),
 		old.Spec.SystemAssignedIdentityRole,
 		m.Spec.SystemAssignedIdentityRole); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "UserAssignedIdentities"),
 		old.Spec.UserAssignedIdentities,
 		m.Spec.UserAssignedIdentities); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "RoleAssignmentName"),
 		old.Spec.RoleAssignmentName,
 		m.Spec.RoleAssignmentName); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "OSDisk"),
 		old.Spec.OSDisk,
 		m.Spec.OSDisk); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "DataDisks"),
 		old.Spec.DataDisks,
 		m.Spec.DataDisks); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "AdditionalCapabilities"),
+		old.Spec.AdditionalCapabilities,
+		m.Spec.AdditionalCapabilities); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "SSHPublicKey"),
 		old.Spec.SSHPublicKey,
 		m.Spec.SSHPublicKey); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "AdditionalSSHPublicKeys"),
+		old.Spec.AdditionalSSHPublicKeys,
+		m.Spec.AdditionalSSHPublicKeys); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "WindowsConfiguration"),
+		old.Spec.WindowsConfiguration,
+		m.Spec.WindowsConfiguration); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "BootstrapDataSource"),
+		old.Spec.BootstrapDataSource,
+		m.Spec.BootstrapDataSource); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "RegistryMirrors"),
+		old.Spec.RegistryMirrors,
+		m.Spec.RegistryMirrors); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "AllocatePublicIP"),
 		old.Spec.AllocatePublicIP,
 		m.Spec.AllocatePublicIP); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "EnableIPForwarding"),
 		old.Spec.EnableIPForwarding,
 		m.Spec.EnableIPForwarding); err != nil {
@@ -165,14 +214,35 @@ func (mw *azureMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newOb
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "DNSServers"),
+		old.Spec.DNSServers,
+		m.Spec.DNSServers); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "SpotVMOptions"),
 		old.Spec.SpotVMOptions,
 		m.Spec.SpotVMOptions); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "AvailabilitySetID"),
+		old.Spec.AvailabilitySetID,
+		m.Spec.AvailabilitySetID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
+		field.NewPath("Spec", "AvailabilitySet"),
+		old.Spec.AvailabilitySet,
+		m.Spec.AvailabilitySet); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateImmutableRequiresRecreation(
 		field.NewPath("Spec", "SecurityProfile"),
 		old.Spec.SecurityProfile,
 		m.Spec.SecurityProfile); err != nil {
@@ -180,7 +250,7 @@ func (mw *azureMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newOb
 	}
 
 	if old.Spec.Diagnostics != nil {
-		if err := webhookutils.ValidateImmutable(
+		if err := validateImmutableRequiresRecreation(
 			field.NewPath("Spec", "Diagnostics"),
 			old.Spec.Diagnostics,
 			m.Spec.Diagnostics); err != nil {
@@ -201,7 +271,7 @@ func (mw *azureMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newOb
 		if !reflect.DeepEqual(m.Spec.NetworkInterfaces, old.Spec.NetworkInterfaces) {
 			allErrs = append(allErrs,
 				field.Invalid(field.NewPath("spec", "networkInterfaces"),
-					m.Spec.NetworkInterfaces, "field is immutable"),
+					m.Spec.NetworkInterfaces, "field is immutable"+recreationSuggestion),
 			)
 		}
 	}
@@ -212,6 +282,20 @@ func (mw *azureMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newOb
 	return nil, apierrors.NewInvalid(GroupVersion.WithKind(AzureMachineKind).GroupKind(), m.Name, allErrs)
 }
 
+// recreationSuggestion is appended to immutability errors for fields which Azure only applies when the VM is created.
+const recreationSuggestion = ", the virtual machine must be recreated for a change to take effect: delete the Machine or roll out a new machine template instead"
+
+// validateImmutableRequiresRecreation validates that a field which is only applied when the VM is created is unchanged,
+// and suggests recreating the machine in the returned error. Fields such as AdditionalTags which are reconciled on an
+// existing VM must not be validated with it.
+func validateImmutableRequiresRecreation(path *field.Path, oldVal, newVal any) *field.Error {
+	err := webhookutils.ValidateImmutable(path, oldVal, newVal)
+	if err != nil {
+		err.Detail += recreationSuggestion
+	}
+	return err
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (mw *azureMachineWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	return nil, nil
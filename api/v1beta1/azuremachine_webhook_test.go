@@ -87,6 +87,19 @@ func TestAzureMachine_ValidateCreate(t *testing.T) {
 			machine: createMachineWithSSHPublicKey("invalid ssh key"),
 			wantErr: true,
 		},
+		{
+			name:    "azuremachine with availability set settings",
+			machine: createMachineWithAvailabilitySet(nil, &AvailabilitySetSettings{PlatformFaultDomainCount: ptr.To[int32](2), PlatformUpdateDomainCount: ptr.To[int32](10)}),
+			wantErr: false,
+		},
+		{
+			name: "azuremachine with availability set settings and a reference to an existing availability set",
+			machine: createMachineWithAvailabilitySet(
+				ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/availabilitySets/my-as"),
+				&AvailabilitySetSettings{PlatformFaultDomainCount: ptr.To[int32](2)},
+			),
+			wantErr: true,
+		},
 		{
 			name: "azuremachine with list of user-assigned identities",
 			machine: createMachineWithUserAssignedIdentities([]UserAssignedIdentity{
@@ -517,6 +530,74 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "invalidTest: azuremachine.spec.AvailabilitySet is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					AvailabilitySet: &AvailabilitySetSettings{PlatformFaultDomainCount: ptr.To[int32](2)},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					AvailabilitySet: &AvailabilitySetSettings{PlatformFaultDomainCount: ptr.To[int32](3)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.AvailabilitySetID is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					AvailabilitySetID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/availabilitySets/my-as"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.BootstrapDataSource is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapDataSource: ptr.To(BootstrapDataSourceCustomData),
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapDataSource: ptr.To(BootstrapDataSourceUserData),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validTest: azuremachine.spec.BootstrapDataSource is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapDataSource: ptr.To(BootstrapDataSourceUserData),
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapDataSource: ptr.To(BootstrapDataSourceUserData),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.RegistryMirrors is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					RegistryMirrors: []RegistryMirror{{Registry: "docker.io", Endpoints: []string{"https://mirror.example.com"}}},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					RegistryMirrors: []RegistryMirror{{Registry: "docker.io", Endpoints: []string{"https://other-mirror.example.com"}}},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalidTest: azuremachine.spec.AllocatePublicIP is immutable",
 			oldMachine: &AzureMachine{
@@ -805,6 +886,88 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalidTest: azuremachine.spec.VMSize is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					VMSize: "Standard_D2s_v3",
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					VMSize: "Standard_D4s_v3",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.FailureDomain is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					FailureDomain: ptr.To("1"),
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					FailureDomain: ptr.To("2"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.AdditionalCapabilities is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					AdditionalCapabilities: &AdditionalCapabilities{UltraSSDEnabled: ptr.To(true)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.DNSServers is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					DNSServers: []string{"10.0.0.4"},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					DNSServers: []string{"10.0.0.5"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validTest: azuremachine.spec.AdditionalTags is mutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					VMSize:         "Standard_D2s_v3",
+					AdditionalTags: Tags{"team": "a"},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					VMSize:         "Standard_D2s_v3",
+					AdditionalTags: Tags{"team": "b", "environment": "test"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "validTest: azuremachine.spec.ProviderID can be set",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ProviderID: ptr.To("azure:///subscriptions/123/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm"),
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -821,6 +984,18 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 	}
 }
 
+func TestAzureMachine_ValidateUpdateSuggestsRecreation(t *testing.T) {
+	g := NewWithT(t)
+	mw := &azureMachineWebhook{}
+	oldMachine := &AzureMachine{Spec: AzureMachineSpec{VMSize: "Standard_D2s_v3"}}
+	newMachine := &AzureMachine{Spec: AzureMachineSpec{VMSize: "Standard_D4s_v3"}}
+
+	_, err := mw.ValidateUpdate(context.Background(), oldMachine, newMachine)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("Spec.VMSize"))
+	g.Expect(err.Error()).To(ContainSubstring("must be recreated"))
+}
+
 type mockDefaultClient struct {
 	client.Client
 	SubscriptionID string
@@ -876,6 +1051,14 @@ func TestAzureMachine_Default(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(cacheTypeNotSpecifiedTest.machine.Spec.OSDisk.CachingType).To(Equal("None"))
 
+	ephemeralCacheTypeNotSpecifiedTest := test{machine: &AzureMachine{ObjectMeta: testObjectMeta, Spec: AzureMachineSpec{OSDisk: OSDisk{
+		CachingType:      "",
+		DiffDiskSettings: &DiffDiskSettings{Option: string(armcompute.DiffDiskOptionsLocal)},
+	}}}}
+	err = mw.Default(context.Background(), ephemeralCacheTypeNotSpecifiedTest.machine)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ephemeralCacheTypeNotSpecifiedTest.machine.Spec.OSDisk.CachingType).To(Equal("ReadOnly"))
+
 	for _, possibleCachingType := range armcompute.PossibleCachingTypesValues() {
 		cacheTypeSpecifiedTest := test{machine: &AzureMachine{ObjectMeta: testObjectMeta, Spec: AzureMachineSpec{OSDisk: OSDisk{CachingType: string(possibleCachingType)}}}}
 		err = mw.Default(context.Background(), cacheTypeSpecifiedTest.machine)
@@ -218,3 +218,16 @@ func (m *AzureManagedControlPlane) setDefaultAKSExtensions() {
 		}
 	}
 }
+
+func (m *AzureManagedControlPlane) setDefaultAzureMonitorProfile() {
+	if m.Spec.AzureMonitorProfile == nil || m.Spec.AzureMonitorProfile.Metrics == nil {
+		return
+	}
+	metrics := m.Spec.AzureMonitorProfile.Metrics
+	if metrics.WorkspaceName == "" {
+		metrics.WorkspaceName = m.Name
+	}
+	if len(metrics.Streams) == 0 {
+		metrics.Streams = []string{DefaultAzureMonitorMetricsStream}
+	}
+}
@@ -31,6 +31,9 @@ const (
 
 	// PrivateDNSZoneModeNone represents mode None for azuremanagedcontrolplane.
 	PrivateDNSZoneModeNone string = "None"
+
+	// DefaultAzureMonitorMetricsStream is the stream of Prometheus metrics sent to the Azure Monitor workspace by default.
+	DefaultAzureMonitorMetricsStream = "Microsoft-PrometheusMetrics"
 )
 
 // ManagedControlPlaneOutboundType enumerates the values for the managed control plane OutboundType.
@@ -61,6 +64,21 @@ const (
 	ManagedControlPlaneIdentityTypeUserAssigned ManagedControlPlaneIdentityType = ManagedControlPlaneIdentityType(VMIdentityUserAssigned)
 )
 
+// ManagedControlPlanePowerState enumerates the values for the desired power state of an AKS cluster.
+type ManagedControlPlanePowerState string
+
+const (
+	// ManagedControlPlanePowerStateRunning means the AKS cluster should be running.
+	ManagedControlPlanePowerStateRunning ManagedControlPlanePowerState = "Running"
+
+	// ManagedControlPlanePowerStateStopped means the control plane and the agent nodes of the AKS cluster should be
+	// stopped, which stops their billing.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/start-stop-cluster
+	ManagedControlPlanePowerStateStopped ManagedControlPlanePowerState = "Stopped"
+)
+
 // NetworkPluginMode is the mode the network plugin should use.
 type NetworkPluginMode string
 
@@ -95,6 +113,27 @@ type AzureManagedControlPlaneSpec struct {
 	// +optional
 	NodeResourceGroupName string `json:"nodeResourceGroupName,omitempty"`
 
+	// NodeResourceGroupTags is an optional set of tags to add to the node resource group of the AKS cluster.
+	// They are tracked independently of the AdditionalTags of the managed cluster.
+	// +optional
+	NodeResourceGroupTags Tags `json:"nodeResourceGroupTags,omitempty"`
+
+	// PowerState is the desired power state of the AKS cluster. AKS rejects changes to a stopped cluster,
+	// so they are applied once the cluster is running again.
+	// Defaults to Running.
+	// +kubebuilder:validation:Enum=Running;Stopped
+	// +optional
+	PowerState *ManagedControlPlanePowerState `json:"powerState,omitempty"`
+
+	// CertificateRotation requests a rotation of the AKS cluster certificates whenever it is advanced past
+	// status.lastCertificateRotation, e.g. by setting it to the current time. The rotation recreates the nodes
+	// and takes up to 30 minutes.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/certificate-rotation
+	// +optional
+	CertificateRotation *metav1.Time `json:"certificateRotation,omitempty"`
+
 	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
 	// Immutable, populated by the AKS API at create.
 	// +optional
@@ -102,11 +141,19 @@ type AzureManagedControlPlaneSpec struct {
 
 	// SSHPublicKey is a string literal containing an ssh public key base64 encoded.
 	// Use empty string to autogenerate new key. Use null value to not set key.
-	// Immutable.
+	// The key of an existing cluster can be rotated by changing it, but it can't be set or unset.
 	// +optional
 	SSHPublicKey *string `json:"sshPublicKey,omitempty"`
 
-	// DNSPrefix allows the user to customize dns prefix.
+	// AdminUsername is the administrator username of the Linux nodes, which SSHPublicKey is authorized for.
+	// It requires SSHPublicKey to be set. Defaults to "azureuser".
+	// Immutable.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][-A-Za-z0-9_]*$`
+	// +optional
+	AdminUsername *string `json:"adminUsername,omitempty"`
+
+	// DNSPrefix allows the user to customize dns prefix. It must be 1 to 54 alphanumerics and hyphens, and start and
+	// end with an alphanumeric. Defaults to the name of the AzureManagedControlPlane.
 	// Immutable.
 	// +optional
 	DNSPrefix *string `json:"dnsPrefix,omitempty"`
@@ -130,10 +177,11 @@ type HTTPProxyConfig struct {
 	HTTPSProxy *string `json:"httpsProxy,omitempty"`
 
 	// NoProxy indicates the endpoints that should not go through proxy.
+	// Each entry is a domain name, optionally starting with "." or "*.", an IP address or a CIDR.
 	// +optional
 	NoProxy []string `json:"noProxy,omitempty"`
 
-	// TrustedCA is the alternative CA cert to use for connecting to proxy servers.
+	// TrustedCA is the alternative CA cert to use for connecting to proxy servers, as a base64 encoded PEM bundle.
 	// +optional
 	TrustedCA *string `json:"trustedCa,omitempty"`
 }
@@ -272,6 +320,10 @@ type AzureManagedControlPlaneStatus struct {
 	// +optional
 	OIDCIssuerProfile *OIDCIssuerProfileStatus `json:"oidcIssuerProfile,omitempty"`
 
+	// LastCertificateRotation is the spec.certificateRotation the AKS cluster certificates were last rotated for.
+	// +optional
+	LastCertificateRotation *metav1.Time `json:"lastCertificateRotation,omitempty"`
+
 	// Version defines the Kubernetes version for the control plane instance.
 	// +optional
 	Version string `json:"version"`
@@ -428,6 +480,42 @@ type OIDCIssuerProfile struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+// ACRReference references an Azure Container Registry attached to an AKS cluster.
+type ACRReference struct {
+	// ID is the resource ID of the Azure Container Registry.
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id"`
+}
+
+// AzureMonitorProfile configures the Azure Monitor integration of an AKS cluster.
+type AzureMonitorProfile struct {
+	// Metrics configures the collection of the Prometheus metrics of the cluster.
+	// +optional
+	Metrics *AzureMonitorMetrics `json:"metrics,omitempty"`
+}
+
+// AzureMonitorMetrics configures the Azure Monitor managed service for Prometheus. CAPZ creates an Azure Monitor
+// workspace and a data collection rule which sends the metrics of the cluster to the workspace.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/azure-monitor/containers/prometheus-metrics-enable
+type AzureMonitorMetrics struct {
+	// Enabled enables the collection of Prometheus metrics. Disabling it deletes the workspace and data collection
+	// rule CAPZ created.
+	Enabled bool `json:"enabled"`
+
+	// WorkspaceName is the name of the Azure Monitor workspace created in the resource group of the cluster.
+	// Defaults to the name of the AzureManagedControlPlane.
+	// Immutable.
+	// +optional
+	WorkspaceName string `json:"workspaceName,omitempty"`
+
+	// Streams are the streams the data collection rule sends to the workspace. Only "Microsoft-PrometheusMetrics" is
+	// supported. Defaults to ["Microsoft-PrometheusMetrics"].
+	// +optional
+	Streams []string `json:"streams,omitempty"`
+}
+
 // AKSExtension represents the configuration for an AKS cluster extension.
 // See also [AKS doc].
 //
@@ -18,6 +18,9 @@ package v1beta1
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"net"
 	"reflect"
@@ -26,11 +29,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	webhookutils "sigs.k8s.io/cluster-api-provider-azure/util/webhook"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capifeature "sigs.k8s.io/cluster-api/feature"
@@ -45,8 +51,12 @@ var (
 	rScaleDownTime             = regexp.MustCompile(`^(\d+)m$`)
 	rScaleDownDelayAfterDelete = regexp.MustCompile(`^(\d+)s$`)
 	rScanInterval              = regexp.MustCompile(`^(\d+)s$`)
+	aksAdminUsernameRegex      = regexp.MustCompile(`^[A-Za-z][-A-Za-z0-9_]*$`)
 )
 
+// containerRegistryResourceType is the resource type of the Azure Container Registries an AKS cluster can pull from.
+const containerRegistryResourceType = "Microsoft.ContainerRegistry/registries"
+
 // SetupAzureManagedControlPlaneWebhookWithManager sets up and registers the webhook with the manager.
 func SetupAzureManagedControlPlaneWebhookWithManager(mgr ctrl.Manager) error {
 	mw := &azureManagedControlPlaneWebhook{Client: mgr.GetClient()}
@@ -96,6 +106,7 @@ func (mw *azureManagedControlPlaneWebhook) Default(ctx context.Context, obj runt
 	m.setDefaultOIDCIssuerProfile()
 	m.setDefaultDNSPrefix()
 	m.setDefaultAKSExtensions()
+	m.setDefaultAzureMonitorProfile()
 
 	return nil
 }
@@ -160,10 +171,20 @@ func (mw *azureManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, o
 		allErrs = append(allErrs, err)
 	}
 
+	// The SSHPublicKey can be rotated, but it can't be set or unset on an existing cluster.
+	if ptr.Deref(old.Spec.SSHPublicKey, "") == "" || ptr.Deref(m.Spec.SSHPublicKey, "") == "" {
+		if err := webhookutils.ValidateImmutable(
+			field.NewPath("Spec", "SSHPublicKey"),
+			old.Spec.SSHPublicKey,
+			m.Spec.SSHPublicKey); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
 	if err := webhookutils.ValidateImmutable(
-		field.NewPath("Spec", "SSHPublicKey"),
-		old.Spec.SSHPublicKey,
-		m.Spec.SSHPublicKey); err != nil {
+		field.NewPath("Spec", "AdminUsername"),
+		old.Spec.AdminUsername,
+		m.Spec.AdminUsername); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
@@ -195,11 +216,13 @@ func (mw *azureManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, o
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
-		field.NewPath("Spec", "HTTPProxyConfig"),
-		old.Spec.HTTPProxyConfig,
-		m.Spec.HTTPProxyConfig); err != nil {
-		allErrs = append(allErrs, err)
+	if old.Spec.HTTPProxyConfig != nil && m.Spec.HTTPProxyConfig == nil {
+		allErrs = append(allErrs,
+			field.Forbidden(
+				field.NewPath("Spec", "HTTPProxyConfig"),
+				"HTTPProxyConfig cannot be removed from an existing cluster",
+			),
+		)
 	}
 
 	if err := webhookutils.ValidateImmutable(
@@ -270,6 +293,19 @@ func (mw *azureManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, o
 		allErrs = append(allErrs, errs...)
 	}
 
+	if old.Spec.AzureMonitorProfile != nil && old.Spec.AzureMonitorProfile.Metrics != nil {
+		if m.Spec.AzureMonitorProfile == nil || m.Spec.AzureMonitorProfile.Metrics == nil {
+			allErrs = append(allErrs, field.Forbidden(
+				field.NewPath("Spec", "AzureMonitorProfile", "Metrics"),
+				"cannot be removed, set enabled to false to delete the Azure Monitor resources of the cluster instead"))
+		} else if err := webhookutils.ValidateImmutable(
+			field.NewPath("Spec", "AzureMonitorProfile", "Metrics", "WorkspaceName"),
+			old.Spec.AzureMonitorProfile.Metrics.WorkspaceName,
+			m.Spec.AzureMonitorProfile.Metrics.WorkspaceName); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
 	if len(allErrs) == 0 {
 		return nil, m.Validate(mw.Client)
 	}
@@ -287,11 +323,14 @@ func (m *AzureManagedControlPlane) Validate(cli client.Client) error {
 	var allErrs field.ErrorList
 	validators := []func(client client.Client) field.ErrorList{
 		m.validateSSHKey,
+		m.validateAdminUsername,
 		m.validateAPIServerAccessProfile,
 		m.validateIdentity,
 		m.validateNetworkPluginMode,
 		m.validateDNSPrefix,
 		m.validateDisableLocalAccounts,
+		m.validateAdditionalTags,
+		m.validateNodeResourceGroupTags,
 	}
 	for _, validator := range validators {
 		if err := validator(cli); err != nil {
@@ -312,6 +351,7 @@ func (m *AzureManagedControlPlane) Validate(cli client.Client) error {
 		m.Labels,
 		m.Namespace,
 		m.Spec.DNSServiceIP,
+		m.Spec.NetworkPlugin,
 		m.Spec.VirtualNetwork.Subnet,
 		field.NewPath("Spec"))...)
 
@@ -321,6 +361,12 @@ func (m *AzureManagedControlPlane) Validate(cli client.Client) error {
 
 	allErrs = append(allErrs, validateAKSExtensions(m.Spec.Extensions, field.NewPath("spec").Child("AKSExtensions"))...)
 
+	allErrs = append(allErrs, validateHTTPProxyConfig(m.Spec.HTTPProxyConfig, field.NewPath("spec").Child("HTTPProxyConfig"))...)
+
+	allErrs = append(allErrs, validateACRReferences(m.Spec.ACRReferences, field.NewPath("spec").Child("ACRReferences"))...)
+
+	allErrs = append(allErrs, validateAzureMonitorProfile(m.Spec.AzureMonitorProfile, field.NewPath("spec").Child("AzureMonitorProfile"))...)
+
 	return allErrs.ToAggregate()
 }
 
@@ -333,7 +379,7 @@ func (m *AzureManagedControlPlane) validateDNSPrefix(_ client.Client) field.Erro
 	// 1. Between 1 and 54 characters long: {1,54}
 	// 2. Alphanumerics and hyphens: [a-zA-Z0-9-]
 	// 3. Start and end with alphanumeric: ^[a-zA-Z0-9].*[a-zA-Z0-9]$
-	pattern := `^[a-zA-Z0-9][a-zA-Z0-9-]{0,52}[a-zA-Z0-9]$`
+	pattern := `^[a-zA-Z0-9]([a-zA-Z0-9-]{0,52}[a-zA-Z0-9])?$`
 	regex := regexp.MustCompile(pattern)
 	if regex.MatchString(ptr.Deref(m.Spec.DNSPrefix, "")) {
 		return nil
@@ -354,6 +400,16 @@ func (m *AzureManagedControlPlane) validateDisableLocalAccounts(_ client.Client)
 	return nil
 }
 
+// validateAdditionalTags validates the tags applied to the managed cluster's Azure resources.
+func (m *AzureManagedControlPlane) validateAdditionalTags(_ client.Client) field.ErrorList {
+	return ValidateTags(m.Spec.AdditionalTags, field.NewPath("Spec", "AdditionalTags"))
+}
+
+// validateNodeResourceGroupTags validates the tags applied to the managed cluster's node resource group.
+func (m *AzureManagedControlPlane) validateNodeResourceGroupTags(_ client.Client) field.ErrorList {
+	return ValidateTags(m.Spec.NodeResourceGroupTags, field.NewPath("Spec", "NodeResourceGroupTags"))
+}
+
 // validateVersion validates the Kubernetes version.
 func validateVersion(version string, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -375,6 +431,24 @@ func (m *AzureManagedControlPlane) validateSSHKey(_ client.Client) field.ErrorLi
 	return nil
 }
 
+// validateAdminUsername validates the admin username of the Linux nodes.
+func (m *AzureManagedControlPlane) validateAdminUsername(_ client.Client) field.ErrorList {
+	if m.Spec.AdminUsername == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	if !aksAdminUsernameRegex.MatchString(*m.Spec.AdminUsername) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("Spec", "AdminUsername"), *m.Spec.AdminUsername,
+			"AdminUsername is invalid, does not match regex: "+aksAdminUsernameRegex.String()))
+	}
+	// The admin username is only configured along with the SSH public key of the Linux profile.
+	if m.Spec.SSHPublicKey == nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("Spec", "AdminUsername"), "AdminUsername can only be set along with SSHPublicKey"))
+	}
+	return allErrs
+}
+
 // validateLoadBalancerProfile validates a LoadBalancerProfile.
 func validateLoadBalancerProfile(loadBalancerProfile *LoadBalancerProfile, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -433,10 +507,12 @@ func (m *AzureManagedControlPlane) validateAPIServerAccessProfile(_ client.Clien
 }
 
 // validateManagedClusterNetwork validates the Cluster network values.
-func validateManagedClusterNetwork(cli client.Client, labels map[string]string, namespace string, dnsServiceIP *string, subnet ManagedControlPlaneSubnet, fldPath *field.Path) field.ErrorList {
+func validateManagedClusterNetwork(cli client.Client, labels map[string]string, namespace string, dnsServiceIP *string, networkPlugin *string, subnet ManagedControlPlaneSubnet, fldPath *field.Path) field.ErrorList {
 	var (
-		allErrs     field.ErrorList
-		serviceCIDR string
+		allErrs      field.ErrorList
+		serviceCIDR  string
+		serviceCIDRs []string
+		podCIDRs     []string
 	)
 
 	ctx := context.Background()
@@ -458,25 +534,34 @@ func validateManagedClusterNetwork(cli client.Client, labels map[string]string,
 		return allErrs
 	}
 
+	// A user may provide zero or one CIDR blocks. If they provide an empty array,
+	// we ignore it and use the default. AKS only supports > 1 Service/Pod CIDR for
+	// dual-stack kubenet clusters, which have one CIDR block of each IP family.
+	maxCIDRBlocks := 1
+	if ptr.Deref(networkPlugin, "") == KubenetNetworkPluginName {
+		maxCIDRBlocks = 2
+	}
+	servicesPath := field.NewPath("Cluster", "Spec", "ClusterNetwork", "Services", "CIDRBlocks")
+	podsPath := field.NewPath("Cluster", "Spec", "ClusterNetwork", "Pods", "CIDRBlocks")
 	if clusterNetwork := ownerCluster.Spec.ClusterNetwork; clusterNetwork != nil {
 		if clusterNetwork.Services != nil {
-			// A user may provide zero or one CIDR blocks. If they provide an empty array,
-			// we ignore it and use the default. AKS doesn't support > 1 Service/Pod CIDR.
-			if len(clusterNetwork.Services.CIDRBlocks) > 1 {
-				allErrs = append(allErrs, field.TooMany(field.NewPath("Cluster", "Spec", "ClusterNetwork", "Services", "CIDRBlocks"), len(clusterNetwork.Services.CIDRBlocks), 1))
-			}
-			if len(clusterNetwork.Services.CIDRBlocks) == 1 {
-				serviceCIDR = clusterNetwork.Services.CIDRBlocks[0]
+			serviceCIDRs = clusterNetwork.Services.CIDRBlocks
+			allErrs = append(allErrs, validateClusterNetworkCIDRBlocks(serviceCIDRs, maxCIDRBlocks, servicesPath)...)
+			if len(serviceCIDRs) > 0 {
+				serviceCIDR = IPv4CIDRBlock(serviceCIDRs)
 			}
 		}
 		if clusterNetwork.Pods != nil {
-			// A user may provide zero or one CIDR blocks. If they provide an empty array,
-			// we ignore it and use the default. AKS doesn't support > 1 Service/Pod CIDR.
-			if len(clusterNetwork.Pods.CIDRBlocks) > 1 {
-				allErrs = append(allErrs, field.TooMany(field.NewPath("Cluster", "Spec", "ClusterNetwork", "Pods", "CIDRBlocks"), len(clusterNetwork.Pods.CIDRBlocks), 1))
-			}
+			podCIDRs = clusterNetwork.Pods.CIDRBlocks
+			allErrs = append(allErrs, validateClusterNetworkCIDRBlocks(podCIDRs, maxCIDRBlocks, podsPath)...)
 		}
 	}
+	if len(serviceCIDRs) > 0 && len(podCIDRs) > 0 && len(serviceCIDRs) != len(podCIDRs) {
+		allErrs = append(allErrs, field.Invalid(servicesPath, serviceCIDRs, "must have one CIDR block of each IP family of the pod CIDR blocks"))
+	}
+	if ptr.Deref(networkPlugin, "") == KubenetNetworkPluginName && len(allErrs) == 0 {
+		allErrs = append(allErrs, validateKubenetPodCIDRBlocks(podCIDRs, serviceCIDRs, subnet.CIDRBlock, podsPath)...)
+	}
 
 	if dnsServiceIP != nil {
 		if serviceCIDR == "" {
@@ -850,6 +935,98 @@ func validateAKSExtensions(extensions []AKSExtension, fldPath *field.Path) field
 	return allErrs
 }
 
+// validateACRReferences validates that each ACR reference is the resource ID of a container registry.
+func validateACRReferences(references []ACRReference, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, reference := range references {
+		if id, err := azureutil.ParseResourceID(reference.ID); err != nil || !strings.EqualFold(id.ResourceType.String(), containerRegistryResourceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("ID"), reference.ID, "must be the resource ID of an Azure Container Registry"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAzureMonitorProfile validates that the data collection rule of the Prometheus metrics sends each supported
+// stream at most once.
+func validateAzureMonitorProfile(profile *AzureMonitorProfile, fldPath *field.Path) field.ErrorList {
+	if profile == nil || profile.Metrics == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	streams := make(map[string]struct{}, len(profile.Metrics.Streams))
+	for i, stream := range profile.Metrics.Streams {
+		streamPath := fldPath.Child("Metrics").Child("Streams").Index(i)
+		if stream != DefaultAzureMonitorMetricsStream {
+			allErrs = append(allErrs, field.NotSupported(streamPath, stream, []string{DefaultAzureMonitorMetricsStream}))
+		} else if _, ok := streams[stream]; ok {
+			allErrs = append(allErrs, field.Duplicate(streamPath, stream))
+		}
+		streams[stream] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// validateHTTPProxyConfig validates the NoProxy entries and the TrustedCA of an HTTPProxyConfig.
+func validateHTTPProxyConfig(httpProxyConfig *HTTPProxyConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if httpProxyConfig == nil {
+		return nil
+	}
+
+	for i, noProxy := range httpProxyConfig.NoProxy {
+		if !isValidNoProxy(noProxy) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("NoProxy").Index(i), noProxy,
+				"must be a domain name, optionally starting with '.' or '*.', an IP address or a CIDR"))
+		}
+	}
+
+	if httpProxyConfig.TrustedCA != nil {
+		if err := validateTrustedCA(*httpProxyConfig.TrustedCA); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("TrustedCA"), *httpProxyConfig.TrustedCA, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// isValidNoProxy returns true if a NoProxy entry is a domain name, an IP address or a CIDR.
+func isValidNoProxy(noProxy string) bool {
+	if net.ParseIP(noProxy) != nil {
+		return true
+	}
+	if _, _, err := net.ParseCIDR(noProxy); err == nil {
+		return true
+	}
+	domain := strings.TrimPrefix(strings.TrimPrefix(noProxy, "*."), ".")
+	return len(validation.IsDNS1123Subdomain(strings.ToLower(domain))) == 0
+}
+
+// validateTrustedCA validates that a TrustedCA is a base64 encoded PEM bundle of certificates.
+func validateTrustedCA(trustedCA string) error {
+	data, err := base64.StdEncoding.DecodeString(trustedCA)
+	if err != nil {
+		return errors.New("must be base64 encoded")
+	}
+	var certs int
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			return errors.Errorf("must only contain certificates, found a PEM block of type %s", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return errors.Wrap(err, "must contain valid certificates")
+		}
+		certs++
+	}
+	if certs == 0 {
+		return errors.New("must be a base64 encoded PEM certificate")
+	}
+	return nil
+}
+
 // validateAutoScalerProfile validates an AutoScalerProfile.
 func validateAutoScalerProfile(autoScalerProfile *AutoScalerProfile, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -1012,14 +1189,80 @@ func (m *AzureManagedControlPlane) validateIdentity(_ client.Client) field.Error
 	return nil
 }
 
+// validateClusterNetworkCIDRBlocks validates the Service or Pod CIDR blocks of the Cluster. More than one CIDR block
+// must be one IPv4 and one IPv6 CIDR block.
+func validateClusterNetworkCIDRBlocks(cidrBlocks []string, maxCIDRBlocks int, fldPath *field.Path) field.ErrorList {
+	if len(cidrBlocks) > maxCIDRBlocks {
+		return field.ErrorList{field.TooMany(fldPath, len(cidrBlocks), maxCIDRBlocks)}
+	}
+	if len(cidrBlocks) < 2 {
+		return nil
+	}
+
+	var ipv4, ipv6 int
+	for _, cidrBlock := range cidrBlocks {
+		ip, _, err := net.ParseCIDR(cidrBlock)
+		if err != nil {
+			return field.ErrorList{field.Invalid(fldPath, cidrBlock, fmt.Sprintf("failed to parse CIDR block: %v", err))}
+		}
+		if ip.To4() != nil {
+			ipv4++
+		} else {
+			ipv6++
+		}
+	}
+	if ipv4 != 1 || ipv6 != 1 {
+		return field.ErrorList{field.Invalid(fldPath, cidrBlocks, "must be one IPv4 and one IPv6 CIDR block")}
+	}
+	return nil
+}
+
+// validateKubenetPodCIDRBlocks validates that the pod CIDR blocks of a kubenet cluster don't overlap with its service
+// CIDR blocks or the CIDR block of its node subnet.
+func validateKubenetPodCIDRBlocks(podCIDRs, serviceCIDRs []string, subnetCIDR string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	otherCIDRs := append([]string{}, serviceCIDRs...)
+	if subnetCIDR != "" {
+		otherCIDRs = append(otherCIDRs, subnetCIDR)
+	}
+	for _, podCIDR := range podCIDRs {
+		_, podNet, err := net.ParseCIDR(podCIDR)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, podCIDR, fmt.Sprintf("failed to parse CIDR block: %v", err)))
+			continue
+		}
+		for _, otherCIDR := range otherCIDRs {
+			_, otherNet, err := net.ParseCIDR(otherCIDR)
+			if err != nil {
+				continue
+			}
+			if podNet.Contains(otherNet.IP) || otherNet.Contains(podNet.IP) {
+				allErrs = append(allErrs, field.Invalid(fldPath, podCIDR, fmt.Sprintf("must not overlap with CIDR block %s", otherCIDR)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// IPv4CIDRBlock returns the IPv4 CIDR block of the CIDR blocks of a dual-stack cluster, or the first CIDR block otherwise.
+func IPv4CIDRBlock(cidrBlocks []string) string {
+	for _, cidrBlock := range cidrBlocks {
+		if ip, _, err := net.ParseCIDR(cidrBlock); err == nil && ip.To4() != nil {
+			return cidrBlock
+		}
+	}
+	return cidrBlocks[0]
+}
+
 // validateNetworkPluginMode validates a NetworkPluginMode.
 func (m *AzureManagedControlPlane) validateNetworkPluginMode(_ client.Client) field.ErrorList {
 	var allErrs field.ErrorList
 
-	const kubenet = "kubenet"
 	if ptr.Deref(m.Spec.NetworkPluginMode, "") == NetworkPluginModeOverlay &&
-		ptr.Deref(m.Spec.NetworkPlugin, "") == kubenet {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("Spec", "NetworkPluginMode"), m.Spec.NetworkPluginMode, fmt.Sprintf("cannot be set to %q when NetworkPlugin is %q", NetworkPluginModeOverlay, kubenet)))
+		ptr.Deref(m.Spec.NetworkPlugin, "") == KubenetNetworkPluginName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("Spec", "NetworkPluginMode"), m.Spec.NetworkPluginMode, fmt.Sprintf("cannot be set to %q when NetworkPlugin is %q", NetworkPluginModeOverlay, KubenetNetworkPluginName)))
 	}
 
 	if len(allErrs) > 0 {
@@ -18,16 +18,26 @@ package v1beta1
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capifeature "sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestDefaultingWebhook(t *testing.T) {
@@ -433,6 +443,267 @@ func TestValidateAutoScalerProfile(t *testing.T) {
 	}
 }
 
+func TestValidateHTTPProxyConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *HTTPProxyConfig
+		expectErr bool
+	}{
+		{
+			name:      "nil HTTPProxyConfig",
+			config:    nil,
+			expectErr: false,
+		},
+		{
+			name: "Valid HTTPProxyConfig",
+			config: &HTTPProxyConfig{
+				HTTPProxy:  ptr.To("http://1.2.3.4:8080"),
+				HTTPSProxy: ptr.To("https://5.6.7.8:8443"),
+				NoProxy:    []string{"localhost", "127.0.0.1", "10.0.0.0/8", ".svc", "*.cluster.local", "Contoso.com"},
+				TrustedCA:  ptr.To(generateTrustedCA()),
+			},
+			expectErr: false,
+		},
+		{
+			name: "Testing invalid HTTPProxyConfig.NoProxy",
+			config: &HTTPProxyConfig{
+				NoProxy: []string{"localhost", "http://contoso.com"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing empty HTTPProxyConfig.NoProxy entry",
+			config: &HTTPProxyConfig{
+				NoProxy: []string{""},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing HTTPProxyConfig.TrustedCA which isn't base64 encoded",
+			config: &HTTPProxyConfig{
+				TrustedCA: ptr.To("-----BEGIN CERTIFICATE-----"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing HTTPProxyConfig.TrustedCA which isn't a PEM certificate",
+			config: &HTTPProxyConfig{
+				TrustedCA: ptr.To(base64.StdEncoding.EncodeToString([]byte("ca"))),
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing HTTPProxyConfig.TrustedCA with an invalid certificate",
+			config: &HTTPProxyConfig{
+				TrustedCA: ptr.To(base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("ca")}))),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateHTTPProxyConfig(tt.config, field.NewPath("spec").Child("HTTPProxyConfig"))
+			if tt.expectErr {
+				g.Expect(allErrs).NotTo(BeNil())
+			} else {
+				g.Expect(allErrs).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidateACRReferences(t *testing.T) {
+	tests := []struct {
+		name       string
+		references []ACRReference
+		expectErr  bool
+	}{
+		{
+			name:       "no references",
+			references: nil,
+			expectErr:  false,
+		},
+		{
+			name: "valid registry IDs",
+			references: []ACRReference{
+				{ID: "/subscriptions/123/resourceGroups/acr-rg/providers/Microsoft.ContainerRegistry/registries/myregistry"},
+				{ID: "/subscriptions/456/resourceGroups/other-rg/providers/microsoft.containerregistry/registries/other"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "ID which isn't a resource ID",
+			references: []ACRReference{
+				{ID: "myregistry.azurecr.io"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "ID of a resource which isn't a container registry",
+			references: []ACRReference{
+				{ID: "/subscriptions/123/resourceGroups/acr-rg/providers/Microsoft.KeyVault/vaults/myvault"},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateACRReferences(tt.references, field.NewPath("spec").Child("ACRReferences"))
+			if tt.expectErr {
+				g.Expect(allErrs).NotTo(BeNil())
+			} else {
+				g.Expect(allErrs).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidateAzureMonitorProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   *AzureMonitorProfile
+		expectErr bool
+	}{
+		{
+			name:      "no profile",
+			profile:   nil,
+			expectErr: false,
+		},
+		{
+			name: "prometheus metrics stream",
+			profile: &AzureMonitorProfile{
+				Metrics: &AzureMonitorMetrics{Enabled: true, Streams: []string{DefaultAzureMonitorMetricsStream}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "unsupported stream",
+			profile: &AzureMonitorProfile{
+				Metrics: &AzureMonitorMetrics{Enabled: true, Streams: []string{DefaultAzureMonitorMetricsStream, "Microsoft-InsightsMetrics"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate stream",
+			profile: &AzureMonitorProfile{
+				Metrics: &AzureMonitorMetrics{Enabled: true, Streams: []string{DefaultAzureMonitorMetricsStream, DefaultAzureMonitorMetricsStream}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateAzureMonitorProfile(tt.profile, field.NewPath("spec").Child("AzureMonitorProfile"))
+			if tt.expectErr {
+				g.Expect(allErrs).NotTo(BeNil())
+			} else {
+				g.Expect(allErrs).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidateManagedClusterNetwork(t *testing.T) {
+	tests := []struct {
+		name          string
+		networkPlugin *string
+		podCIDRs      []string
+		serviceCIDRs  []string
+		dnsServiceIP  *string
+		subnetCIDR    string
+		wantErr       string
+	}{
+		{
+			name:          "single-stack kubenet",
+			networkPlugin: ptr.To("kubenet"),
+			podCIDRs:      []string{"10.244.0.0/16"},
+			serviceCIDRs:  []string{"10.0.0.0/16"},
+			subnetCIDR:    "10.240.0.0/16",
+		},
+		{
+			name:          "dual-stack kubenet",
+			networkPlugin: ptr.To("kubenet"),
+			podCIDRs:      []string{"10.244.0.0/16", "fd12:3456:789a::/64"},
+			serviceCIDRs:  []string{"fd12:3456:789a:1::/108", "10.0.0.0/16"},
+			dnsServiceIP:  ptr.To("10.0.0.10"),
+			subnetCIDR:    "10.240.0.0/16",
+		},
+		{
+			name:          "dual-stack pods with the azure network plugin",
+			networkPlugin: ptr.To("azure"),
+			podCIDRs:      []string{"10.244.0.0/16", "fd12:3456:789a::/64"},
+			wantErr:       "Too many: 2: must have at most 1 items",
+		},
+		{
+			name:          "two IPv4 pod CIDR blocks with kubenet",
+			networkPlugin: ptr.To("kubenet"),
+			podCIDRs:      []string{"10.244.0.0/16", "10.245.0.0/16"},
+			wantErr:       "must be one IPv4 and one IPv6 CIDR block",
+		},
+		{
+			name:          "dual-stack pods with single-stack services",
+			networkPlugin: ptr.To("kubenet"),
+			podCIDRs:      []string{"10.244.0.0/16", "fd12:3456:789a::/64"},
+			serviceCIDRs:  []string{"10.0.0.0/16"},
+			wantErr:       "must have one CIDR block of each IP family of the pod CIDR blocks",
+		},
+		{
+			name:          "kubenet pod CIDR block overlapping a service CIDR block",
+			networkPlugin: ptr.To("kubenet"),
+			podCIDRs:      []string{"10.244.0.0/16", "fd12:3456:789a::/64"},
+			serviceCIDRs:  []string{"10.0.0.0/16", "fd12:3456:789a::/108"},
+			subnetCIDR:    "10.240.0.0/16",
+			wantErr:       "must not overlap with CIDR block fd12:3456:789a::/108",
+		},
+		{
+			name:          "kubenet pod CIDR block overlapping the node subnet",
+			networkPlugin: ptr.To("kubenet"),
+			podCIDRs:      []string{"10.240.0.0/12"},
+			serviceCIDRs:  []string{"10.0.0.0/16"},
+			subnetCIDR:    "10.240.0.0/16",
+			wantErr:       "must not overlap with CIDR block 10.240.0.0/16",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-cluster",
+					Namespace: "default",
+				},
+				Spec: clusterv1.ClusterSpec{
+					ClusterNetwork: &clusterv1.ClusterNetwork{
+						Pods:     &clusterv1.NetworkRanges{CIDRBlocks: tc.podCIDRs},
+						Services: &clusterv1.NetworkRanges{CIDRBlocks: tc.serviceCIDRs},
+					},
+				},
+			}
+			scheme := runtime.NewScheme()
+			_ = clusterv1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cluster).Build()
+
+			errs := validateManagedClusterNetwork(fakeClient, map[string]string{clusterv1.ClusterNameLabel: "my-cluster"}, "default",
+				tc.dnsServiceIP, tc.networkPlugin, ManagedControlPlaneSubnet{CIDRBlock: tc.subnetCIDR}, field.NewPath("Spec"))
+			if tc.wantErr == "" {
+				g.Expect(errs).To(BeEmpty())
+				return
+			}
+			g.Expect(errs).NotTo(BeEmpty())
+			g.Expect(errs.ToAggregate().Error()).To(ContainSubstring(tc.wantErr))
+		})
+	}
+}
+
 func TestValidatingWebhook(t *testing.T) {
 	// NOTE: AzureManageControlPlane is behind AKS feature gate flag; the webhook
 	// must prevent creating new objects in case the feature flag is disabled.
@@ -455,6 +726,47 @@ func TestValidatingWebhook(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "Testing valid AdminUsername",
+			amcp: AzureManagedControlPlane{
+				ObjectMeta: getAMCPMetaData(),
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.17.8",
+					},
+					SSHPublicKey:  ptr.To(generateSSHPublicKey(true)),
+					AdminUsername: ptr.To("capz-admin"),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Testing invalid AdminUsername",
+			amcp: AzureManagedControlPlane{
+				ObjectMeta: getAMCPMetaData(),
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.17.8",
+					},
+					SSHPublicKey:  ptr.To(generateSSHPublicKey(true)),
+					AdminUsername: ptr.To("1admin"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing AdminUsername without SSHPublicKey",
+			amcp: AzureManagedControlPlane{
+				ObjectMeta: getAMCPMetaData(),
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.17.8",
+					},
+					AdminUsername: ptr.To("capzadmin"),
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "Testing invalid DNSServiceIP",
 			amcp: AzureManagedControlPlane{
@@ -1334,6 +1646,30 @@ func TestAzureManagedControlPlane_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Testing Valid DNSPrefix with a single character",
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					DNSPrefix: ptr.To("a"),
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.17.8",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Testing inValid DNSPrefix ending with a hyphen",
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					DNSPrefix: ptr.To("no-trailing-hyphen-"),
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.17.8",
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Testing valid DNSPrefix ",
 			amcp: &AzureManagedControlPlane{
@@ -1452,6 +1788,30 @@ func TestAzureManagedControlPlane_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "NodeResourceGroupTags are valid",
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					NodeResourceGroupTags: Tags{"team": "a"},
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.21.2",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "NodeResourceGroupTags must not use reserved prefixes",
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					NodeResourceGroupTags: Tags{"microsoft-team": "a"},
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.21.2",
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	client := mockClient{ReturnError: false}
 	for _, tc := range tests {
@@ -1506,6 +1866,7 @@ func TestAzureManagedControlPlane_ValidateCreateFailure(t *testing.T) {
 
 func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 	commonSSHKey := generateSSHPublicKey(true)
+	trustedCA := generateTrustedCA()
 	tests := []struct {
 		name    string
 		oldAMCP *AzureManagedControlPlane
@@ -1743,7 +2104,29 @@ func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "AzureManagedControlPlane SSHPublicKey is immutable",
+			name: "AzureManagedControlPlane SSHPublicKey can be rotated",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP: ptr.To("192.168.0.10"),
+						Version:      "v1.18.0",
+					},
+					SSHPublicKey: ptr.To(generateSSHPublicKey(true)),
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP: ptr.To("192.168.0.10"),
+						Version:      "v1.18.0",
+					},
+					SSHPublicKey: ptr.To(generateSSHPublicKey(true)),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AzureManagedControlPlane SSHPublicKey can't be unset",
 			oldAMCP: &AzureManagedControlPlane{
 				Spec: AzureManagedControlPlaneSpec{
 					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
@@ -1754,6 +2137,18 @@ func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 				},
 			},
 			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP: ptr.To("192.168.0.10"),
+						Version:      "v1.18.0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "AzureManagedControlPlane SSHPublicKey must be valid when rotated",
+			oldAMCP: &AzureManagedControlPlane{
 				Spec: AzureManagedControlPlaneSpec{
 					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
 						DNSServiceIP: ptr.To("192.168.0.10"),
@@ -1762,6 +2157,39 @@ func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 					SSHPublicKey: ptr.To(generateSSHPublicKey(true)),
 				},
 			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP: ptr.To("192.168.0.10"),
+						Version:      "v1.18.0",
+					},
+					SSHPublicKey: ptr.To(generateSSHPublicKey(false)),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "AzureManagedControlPlane AdminUsername is immutable",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP: ptr.To("192.168.0.10"),
+						Version:      "v1.18.0",
+					},
+					SSHPublicKey:  ptr.To(commonSSHKey),
+					AdminUsername: ptr.To("capzadmin"),
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP: ptr.To("192.168.0.10"),
+						Version:      "v1.18.0",
+					},
+					SSHPublicKey:  ptr.To(commonSSHKey),
+					AdminUsername: ptr.To("otheradmin"),
+				},
+			},
 			wantErr: true,
 		},
 		{
@@ -2258,18 +2686,19 @@ func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "AzureManagedControlPlane HTTPProxyConfig is immutable",
+			name: "AzureManagedControlPlane HTTPProxyConfig is mutable",
 			oldAMCP: &AzureManagedControlPlane{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-cluster",
 				},
 				Spec: AzureManagedControlPlaneSpec{
 					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
 						HTTPProxyConfig: &HTTPProxyConfig{
 							HTTPProxy:  ptr.To("http://1.2.3.4:8080"),
 							HTTPSProxy: ptr.To("https://5.6.7.8:8443"),
 							NoProxy:    []string{"endpoint1", "endpoint2"},
-							TrustedCA:  ptr.To("ca"),
+							TrustedCA:  ptr.To(trustedCA),
 						},
 					},
 				},
@@ -2280,15 +2709,43 @@ func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 				},
 				Spec: AzureManagedControlPlaneSpec{
 					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
 						HTTPProxyConfig: &HTTPProxyConfig{
 							HTTPProxy:  ptr.To("http://10.20.3.4:8080"),
 							HTTPSProxy: ptr.To("https://5.6.7.8:8443"),
 							NoProxy:    []string{"endpoint1", "endpoint2"},
-							TrustedCA:  ptr.To("ca"),
+							TrustedCA:  ptr.To(trustedCA),
 						},
 					},
 				},
 			},
+			wantErr: false,
+		},
+		{
+			name: "AzureManagedControlPlane HTTPProxyConfig cannot be removed",
+			oldAMCP: &AzureManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster",
+				},
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						HTTPProxyConfig: &HTTPProxyConfig{
+							HTTPProxy: ptr.To("http://1.2.3.4:8080"),
+						},
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster",
+				},
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+					},
+				},
+			},
 			wantErr: true,
 		},
 		{
@@ -2734,6 +3191,75 @@ func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "AzureManagedControlPlane AzureMonitorProfile Metrics WorkspaceName is immutable",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AzureMonitorProfile: &AzureMonitorProfile{
+							Metrics: &AzureMonitorMetrics{Enabled: true, WorkspaceName: "workspace1"},
+						},
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AzureMonitorProfile: &AzureMonitorProfile{
+							Metrics: &AzureMonitorMetrics{Enabled: true, WorkspaceName: "workspace2"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "AzureManagedControlPlane AzureMonitorProfile Metrics can be disabled",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AzureMonitorProfile: &AzureMonitorProfile{
+							Metrics: &AzureMonitorMetrics{Enabled: true, WorkspaceName: "workspace1", Streams: []string{"Microsoft-PrometheusMetrics"}},
+						},
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AzureMonitorProfile: &AzureMonitorProfile{
+							Metrics: &AzureMonitorMetrics{Enabled: false, WorkspaceName: "workspace1", Streams: []string{"Microsoft-PrometheusMetrics"}},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AzureManagedControlPlane AzureMonitorProfile Metrics cannot be removed",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AzureMonitorProfile: &AzureMonitorProfile{
+							Metrics: &AzureMonitorMetrics{Enabled: true, WorkspaceName: "workspace1", Streams: []string{"Microsoft-PrometheusMetrics"}},
+						},
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "AzureManagedControlPlane AKSExtensions ConfigurationSettings and AutoUpgradeMinorVersion are mutable",
 			oldAMCP: &AzureManagedControlPlane{
@@ -2885,6 +3411,20 @@ func getKnownValidAzureManagedControlPlane() *AzureManagedControlPlane {
 	}
 }
 
+func generateTrustedCA() string {
+	privateKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "proxy-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	cert, _ := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}))
+}
+
 func getAMCPMetaData() metav1.ObjectMeta {
 	return metav1.ObjectMeta{
 		Name: "test-AMCP",
@@ -200,6 +200,7 @@ func (mcp *AzureManagedControlPlaneTemplate) validateManagedControlPlaneTemplate
 		mcp.Labels,
 		mcp.Namespace,
 		mcp.Spec.Template.Spec.DNSServiceIP,
+		mcp.Spec.Template.Spec.NetworkPlugin,
 		mcp.Spec.Template.Spec.VirtualNetwork.Subnet,
 		field.NewPath("spec").Child("template").Child("spec"))...)
 
@@ -209,6 +210,12 @@ func (mcp *AzureManagedControlPlaneTemplate) validateManagedControlPlaneTemplate
 
 	allErrs = append(allErrs, validateAKSExtensions(mcp.Spec.Template.Spec.Extensions, field.NewPath("spec").Child("Extensions"))...)
 
+	allErrs = append(allErrs, validateHTTPProxyConfig(mcp.Spec.Template.Spec.HTTPProxyConfig, field.NewPath("spec").Child("template").Child("spec").Child("HTTPProxyConfig"))...)
+
+	allErrs = append(allErrs, validateACRReferences(mcp.Spec.Template.Spec.ACRReferences, field.NewPath("spec").Child("template").Child("spec").Child("ACRReferences"))...)
+
+	allErrs = append(allErrs, validateAzureMonitorProfile(mcp.Spec.Template.Spec.AzureMonitorProfile, field.NewPath("spec").Child("template").Child("spec").Child("AzureMonitorProfile"))...)
+
 	return allErrs.ToAggregate()
 }
 
@@ -34,6 +34,18 @@ const (
 
 	// DefaultOSType represents the default operating system for azmachinepool.
 	DefaultOSType string = LinuxOS
+
+	// DefaultScalingMinSize is the default minimum number of nodes of an autoscaled node pool.
+	DefaultScalingMinSize = 1
+
+	// ScaleSetPrioritySpot is the scale set priority of Spot node pools.
+	ScaleSetPrioritySpot = "Spot"
+
+	// ScaleDownModeDelete deletes the nodes of a node pool when it is scaled down.
+	ScaleDownModeDelete = "Delete"
+
+	// ScaleDownModeDeallocate deallocates the nodes of a node pool when it is scaled down.
+	ScaleDownModeDeallocate = "Deallocate"
 )
 
 // NodePoolMode enumerates the values for agent pool mode.
@@ -429,7 +441,7 @@ type AzureManagedMachinePoolSpec struct {
 type ManagedMachinePoolScaling struct {
 	// MinSize is the minimum number of nodes for auto-scaling.
 	MinSize *int `json:"minSize,omitempty"`
-	// MaxSize is the maximum number of nodes for auto-scaling.
+	// MaxSize is the maximum number of nodes for auto-scaling. It is required when auto-scaling is enabled.
 	MaxSize *int `json:"maxSize,omitempty"`
 }
 
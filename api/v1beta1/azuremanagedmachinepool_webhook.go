@@ -79,9 +79,26 @@ func (mw *azureManagedMachinePoolWebhook) Default(ctx context.Context, obj runti
 		m.Spec.OSType = ptr.To(DefaultOSType)
 	}
 
+	m.Spec.Scaling = setDefaultScaling(m.Spec.Scaling)
+
 	return nil
 }
 
+// setDefaultScaling sets the default minimum node count of an autoscaled node pool. A nil scaling disables autoscaling
+// and is left unset. MaxSize has no default: the replicas of the MachinePool follow the node count set by the
+// autoscaler, so they can't bound it.
+func setDefaultScaling(scaling *ManagedMachinePoolScaling) *ManagedMachinePoolScaling {
+	if scaling == nil {
+		return nil
+	}
+
+	result := scaling.DeepCopy()
+	if result.MinSize == nil {
+		result.MinSize = ptr.To(DefaultScalingMinSize)
+	}
+	return result
+}
+
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-azuremanagedmachinepool,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=azuremanagedmachinepools,versions=v1beta1,name=validation.azuremanagedmachinepools.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
@@ -105,6 +122,13 @@ func (mw *azureManagedMachinePoolWebhook) ValidateCreate(ctx context.Context, ob
 		m.Spec.MaxPods,
 		field.NewPath("Spec", "MaxPods")))
 
+	errs = append(errs, validateMaxPodsForNetworkPlugin(
+		mw.Client,
+		m.Labels,
+		m.Namespace,
+		m.Spec.MaxPods,
+		field.NewPath("Spec", "MaxPods")))
+
 	errs = append(errs, validateOSType(
 		m.Spec.Mode,
 		m.Spec.OSType,
@@ -129,6 +153,10 @@ func (mw *azureManagedMachinePoolWebhook) ValidateCreate(ctx context.Context, ob
 		m.Spec.NodePublicIPPrefixID,
 		field.NewPath("Spec", "EnableNodePublicIP")))
 
+	errs = append(errs, ValidateHostGroupID(
+		m.Spec.HostGroupID,
+		field.NewPath("Spec", "HostGroupID")).ToAggregate())
+
 	errs = append(errs, validateKubeletConfig(
 		m.Spec.KubeletConfig,
 		field.NewPath("Spec", "KubeletConfig")))
@@ -142,6 +170,19 @@ func (mw *azureManagedMachinePoolWebhook) ValidateCreate(ctx context.Context, ob
 		m.Spec.SubnetName,
 		field.NewPath("Spec", "SubnetName")))
 
+	errs = append(errs, validateScaleDownMode(
+		m.Spec.ScaleDownMode,
+		m.Spec.ScaleSetPriority,
+		field.NewPath("Spec", "ScaleDownMode")).ToAggregate())
+
+	errs = append(errs, validateScaling(
+		m.Spec.Scaling,
+		field.NewPath("Spec", "Scaling")).ToAggregate())
+
+	errs = append(errs, ValidateTags(
+		m.Spec.AdditionalTags,
+		field.NewPath("Spec", "AdditionalTags")).ToAggregate())
+
 	return nil, kerrors.NewAggregate(errs)
 }
 
@@ -252,6 +293,15 @@ func (mw *azureManagedMachinePoolWebhook) ValidateUpdate(ctx context.Context, ol
 		allErrs = append(allErrs, err)
 	}
 
+	allErrs = append(allErrs, validateScaleDownMode(
+		m.Spec.ScaleDownMode,
+		m.Spec.ScaleSetPriority,
+		field.NewPath("Spec", "ScaleDownMode"))...)
+
+	allErrs = append(allErrs, validateScaling(
+		m.Spec.Scaling,
+		field.NewPath("Spec", "Scaling"))...)
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("Spec", "EnableUltraSSD"),
 		old.Spec.EnableUltraSSD,
@@ -270,6 +320,12 @@ func (mw *azureManagedMachinePoolWebhook) ValidateUpdate(ctx context.Context, ol
 		m.Spec.NodePublicIPPrefixID); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "HostGroupID"),
+		old.Spec.HostGroupID,
+		m.Spec.HostGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
 
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("Spec", "KubeletConfig"),
@@ -359,6 +415,69 @@ func validateLastSystemNodePool(cli client.Client, labels map[string]string, nam
 	return nil
 }
 
+// validateMaxPodsForNetworkPlugin checks that maxPods doesn't exceed the limit AKS enforces for the network plugin
+// of the owner cluster's AzureManagedControlPlane. The check is skipped if the control plane doesn't exist yet.
+func validateMaxPodsForNetworkPlugin(cli client.Client, labels map[string]string, namespace string, maxPods *int, fldPath *field.Path) error {
+	if maxPods == nil {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	// Fetch the Cluster.
+	clusterName, ok := labels[clusterv1.ClusterNameLabel]
+	if !ok {
+		return nil
+	}
+
+	ownerCluster := &clusterv1.Cluster{}
+	key := client.ObjectKey{
+		Namespace: namespace,
+		Name:      clusterName,
+	}
+	if err := cli.Get(ctx, key, ownerCluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	controlPlaneRef := ownerCluster.Spec.ControlPlaneRef
+	if controlPlaneRef == nil || controlPlaneRef.Kind != AzureManagedControlPlaneKind {
+		return nil
+	}
+	// The control plane is in the namespace of the Cluster if its reference doesn't set one.
+	controlPlaneNamespace := controlPlaneRef.Namespace
+	if controlPlaneNamespace == "" {
+		controlPlaneNamespace = namespace
+	}
+	controlPlane := &AzureManagedControlPlane{}
+	key = client.ObjectKey{
+		Namespace: controlPlaneNamespace,
+		Name:      controlPlaneRef.Name,
+	}
+	if err := cli.Get(ctx, key, controlPlane); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	networkPlugin := ptr.Deref(controlPlane.Spec.NetworkPlugin, AzureNetworkPluginName)
+	maxPodsLimit := 250
+	if networkPlugin == KubenetNetworkPluginName {
+		maxPodsLimit = 110
+	}
+	if *maxPods > maxPodsLimit {
+		return field.Invalid(
+			fldPath,
+			maxPods,
+			fmt.Sprintf("MaxPods must be at most %d with network plugin %q", maxPodsLimit, networkPlugin))
+	}
+
+	return nil
+}
+
 func validateMaxPods(maxPods *int, fldPath *field.Path) error {
 	if maxPods != nil {
 		if ptr.Deref(maxPods, 0) < 10 || ptr.Deref(maxPods, 0) > 250 {
@@ -487,6 +606,32 @@ func validateMPSubnetName(subnetName *string, fldPath *field.Path) error {
 	return nil
 }
 
+// validateScaleDownMode enforces that Spot node pools delete their nodes when scaled down.
+// See: https://learn.microsoft.com/azure/aks/scale-down-mode.
+func validateScaleDownMode(scaleDownMode *string, scaleSetPriority *string, fldPath *field.Path) field.ErrorList {
+	if ptr.Deref(scaleDownMode, "") == ScaleDownModeDeallocate && ptr.Deref(scaleSetPriority, "") == ScaleSetPrioritySpot {
+		return field.ErrorList{field.Invalid(
+			fldPath,
+			scaleDownMode,
+			fmt.Sprintf("%s is not supported for Spot node pools", ScaleDownModeDeallocate))}
+	}
+	return nil
+}
+
+// validateScaling enforces that an autoscaled node pool has a max size which isn't below its min size.
+func validateScaling(scaling *ManagedMachinePoolScaling, fldPath *field.Path) field.ErrorList {
+	if scaling == nil {
+		return nil
+	}
+	if scaling.MaxSize == nil {
+		return field.ErrorList{field.Required(fldPath.Child("MaxSize"), "is required when autoscaling is enabled")}
+	}
+	if ptr.Deref(scaling.MinSize, 0) > *scaling.MaxSize {
+		return field.ErrorList{field.Invalid(fldPath.Child("MaxSize"), *scaling.MaxSize, "must not be less than MinSize")}
+	}
+	return nil
+}
+
 // validateKubeletConfig enforces the AKS API configuration for KubeletConfig.
 // See:  https://learn.microsoft.com/en-us/azure/aks/custom-node-configuration.
 func validateKubeletConfig(kubeletConfig *KubeletConfig, fldPath *field.Path) error {
@@ -22,8 +22,10 @@ import (
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
@@ -83,6 +85,69 @@ func TestAzureManagedMachinePoolDefaultingWebhook(t *testing.T) {
 	err = mw.Default(context.Background(), ammp)
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(*ammp.Spec.OsDiskType).To(Equal("Ephemeral"))
+
+	t.Logf("Testing ammp defaulting webhook leaves scaling unset when autoscaling is disabled")
+	g.Expect(ammp.Spec.Scaling).To(BeNil())
+}
+
+func TestAzureManagedMachinePoolDefaultingWebhookScaling(t *testing.T) {
+	tests := []struct {
+		name     string
+		scaling  *ManagedMachinePoolScaling
+		expected *ManagedMachinePoolScaling
+	}{
+		{
+			name:     "autoscaling disabled",
+			scaling:  nil,
+			expected: nil,
+		},
+		{
+			name:    "autoscaling enabled without bounds",
+			scaling: &ManagedMachinePoolScaling{},
+			expected: &ManagedMachinePoolScaling{
+				MinSize: ptr.To(DefaultScalingMinSize),
+			},
+		},
+		{
+			name: "autoscaling enabled without a max size",
+			scaling: &ManagedMachinePoolScaling{
+				MinSize: ptr.To(20),
+			},
+			expected: &ManagedMachinePoolScaling{
+				MinSize: ptr.To(20),
+			},
+		},
+		{
+			name: "autoscaling enabled with bounds",
+			scaling: &ManagedMachinePoolScaling{
+				MinSize: ptr.To(0),
+				MaxSize: ptr.To(3),
+			},
+			expected: &ManagedMachinePoolScaling{
+				MinSize: ptr.To(0),
+				MaxSize: ptr.To(3),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ammp := &AzureManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fooname",
+				},
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:    "User",
+						Scaling: tc.scaling,
+					},
+				},
+			}
+			mw := &azureManagedMachinePoolWebhook{}
+			g.Expect(mw.Default(context.Background(), ammp)).To(Succeed())
+			g.Expect(ammp.Spec.Scaling).To(Equal(tc.expected))
+		})
+	}
 }
 
 func TestAzureManagedMachinePoolUpdatingWebhook(t *testing.T) {
@@ -180,6 +245,30 @@ func TestAzureManagedMachinePoolUpdatingWebhook(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Cannot change OsDiskType of the agentpool",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:         "System",
+						SKU:          "StandardD2S_V3",
+						OSDiskSizeGB: ptr.To(256),
+						OsDiskType:   ptr.To("Ephemeral"),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:         "System",
+						SKU:          "StandardD2S_V3",
+						OSDiskSizeGB: ptr.To(256),
+						OsDiskType:   ptr.To("Managed"),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Cannot add AvailabilityZones after creating agentpool",
 			new: &AzureManagedMachinePool{
@@ -446,6 +535,24 @@ func TestAzureManagedMachinePoolUpdatingWebhook(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "HostGroupID is immutable",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						HostGroupID: ptr.To("/subscriptions/11111111-2222-aaaa-bbbb-cccccccccccc/resourceGroups/host-group-test/providers/Microsoft.Compute/hostGroups/host-group-new"),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						HostGroupID: ptr.To("/subscriptions/11111111-2222-aaaa-bbbb-cccccccccccc/resourceGroups/host-group-test/providers/Microsoft.Compute/hostGroups/host-group"),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "NodeTaints are mutable",
 			new: &AzureManagedMachinePool{
@@ -633,6 +740,44 @@ func TestAzureManagedMachinePoolUpdatingWebhook(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Can change ScaleDownMode from Delete to Deallocate",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ScaleDownMode: ptr.To(ScaleDownModeDeallocate),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ScaleDownMode: ptr.To(ScaleDownModeDelete),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Cannot change ScaleDownMode to Deallocate for a Spot node pool",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ScaleDownMode:    ptr.To(ScaleDownModeDeallocate),
+						ScaleSetPriority: ptr.To(ScaleSetPrioritySpot),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ScaleDownMode:    ptr.To(ScaleDownModeDelete),
+						ScaleSetPriority: ptr.To(ScaleSetPrioritySpot),
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	var client client.Client
 	for _, tc := range tests {
@@ -998,6 +1143,29 @@ func TestAzureManagedMachinePool_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "pool with invalid host group ID",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						HostGroupID: ptr.To("/subscriptions/11111111-2222-aaaa-bbbb-cccccccccccc/resourceGroups/public-ip-test/providers/Microsoft.Network/publicipprefixes/public-ip-prefix"),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "pool with host group ID ok",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						HostGroupID: ptr.To("/subscriptions/11111111-2222-aaaa-bbbb-cccccccccccc/resourceGroups/host-group-test/providers/Microsoft.Compute/hostGroups/host-group"),
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "pool without public ip prefix with node public IP unset ok",
 			ammp: &AzureManagedMachinePool{
@@ -1288,6 +1456,89 @@ func TestAzureManagedMachinePool_ValidateCreate(t *testing.T) {
 			wantErr:  true,
 			errorLen: 1,
 		},
+		{
+			name: "valid ScaleDownMode Delete",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ScaleDownMode: ptr.To(ScaleDownModeDelete),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid ScaleDownMode Deallocate",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ScaleDownMode:    ptr.To(ScaleDownModeDeallocate),
+						ScaleSetPriority: ptr.To("Regular"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid ScaleDownMode Delete for a Spot node pool",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ScaleDownMode:    ptr.To(ScaleDownModeDelete),
+						ScaleSetPriority: ptr.To(ScaleSetPrioritySpot),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ScaleDownMode Deallocate for a Spot node pool",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ScaleDownMode:    ptr.To(ScaleDownModeDeallocate),
+						ScaleSetPriority: ptr.To(ScaleSetPrioritySpot),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "valid Scaling",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Scaling: &ManagedMachinePoolScaling{MinSize: ptr.To(1), MaxSize: ptr.To(10)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid Scaling without a MaxSize",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Scaling: &ManagedMachinePoolScaling{MinSize: ptr.To(1)},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "invalid Scaling with a MaxSize below the MinSize",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Scaling: &ManagedMachinePoolScaling{MinSize: ptr.To(3), MaxSize: ptr.To(2)},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
 	}
 
 	var client client.Client
@@ -1425,6 +1676,119 @@ func TestAzureManagedMachinePool_validateLastSystemNodePool(t *testing.T) {
 	}
 }
 
+func TestValidateMaxPodsForNetworkPlugin(t *testing.T) {
+	labels := map[string]string{clusterv1.ClusterNameLabel: "my-cluster"}
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneRef: &corev1.ObjectReference{
+				Kind:      AzureManagedControlPlaneKind,
+				Name:      "my-control-plane",
+				Namespace: "default",
+			},
+		},
+	}
+	clusterWithoutControlPlaneNamespace := cluster.DeepCopy()
+	clusterWithoutControlPlaneNamespace.Spec.ControlPlaneRef.Namespace = ""
+	controlPlane := func(networkPlugin *string) *AzureManagedControlPlane {
+		return &AzureManagedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-control-plane",
+				Namespace: "default",
+			},
+			Spec: AzureManagedControlPlaneSpec{
+				AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+					NetworkPlugin: networkPlugin,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		labels  map[string]string
+		maxPods *int
+		wantErr bool
+	}{
+		{
+			name:    "maxPods not set",
+			objects: []runtime.Object{cluster, controlPlane(ptr.To("kubenet"))},
+			labels:  labels,
+			maxPods: nil,
+			wantErr: false,
+		},
+		{
+			name:    "valid maxPods for kubenet",
+			objects: []runtime.Object{cluster, controlPlane(ptr.To("kubenet"))},
+			labels:  labels,
+			maxPods: ptr.To(110),
+			wantErr: false,
+		},
+		{
+			name:    "maxPods above the kubenet limit",
+			objects: []runtime.Object{cluster, controlPlane(ptr.To("kubenet"))},
+			labels:  labels,
+			maxPods: ptr.To(111),
+			wantErr: true,
+		},
+		{
+			name:    "valid maxPods for azure",
+			objects: []runtime.Object{cluster, controlPlane(ptr.To("azure"))},
+			labels:  labels,
+			maxPods: ptr.To(250),
+			wantErr: false,
+		},
+		{
+			name:    "maxPods above the default network plugin limit",
+			objects: []runtime.Object{cluster, controlPlane(nil)},
+			labels:  labels,
+			maxPods: ptr.To(251),
+			wantErr: true,
+		},
+		{
+			name:    "maxPods above the kubenet limit with a control plane reference without namespace",
+			objects: []runtime.Object{clusterWithoutControlPlaneNamespace, controlPlane(ptr.To("kubenet"))},
+			labels:  labels,
+			maxPods: ptr.To(111),
+			wantErr: true,
+		},
+		{
+			name:    "owner cluster doesn't exist yet",
+			objects: nil,
+			labels:  labels,
+			maxPods: ptr.To(200),
+			wantErr: false,
+		},
+		{
+			name:    "no cluster name label",
+			objects: []runtime.Object{cluster, controlPlane(ptr.To("kubenet"))},
+			labels:  nil,
+			maxPods: ptr.To(200),
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tc.objects...).Build()
+			err := validateMaxPodsForNetworkPlugin(fakeClient, tc.labels, "default", tc.maxPods, field.NewPath("Spec", "MaxPods"))
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func getKnownValidAzureManagedMachinePool() *AzureManagedMachinePool {
 	return &AzureManagedMachinePool{
 		Spec: AzureManagedMachinePoolSpec{
@@ -65,6 +65,7 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) Default(ctx context.Context,
 	}
 
 	setDefault[*string](&mp.Spec.Template.Spec.OSType, ptr.To(DefaultOSType))
+	mp.Spec.Template.Spec.Scaling = setDefaultScaling(mp.Spec.Template.Spec.Scaling)
 
 	return nil
 }
@@ -124,6 +125,15 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateCreate(ctx context.Co
 		mp.Spec.Template.Spec.KubeletConfig,
 		field.NewPath("Spec", "Template", "Spec", "LinuxOSConfig")))
 
+	errs = append(errs, validateScaleDownMode(
+		mp.Spec.Template.Spec.ScaleDownMode,
+		mp.Spec.Template.Spec.ScaleSetPriority,
+		field.NewPath("Spec", "Template", "Spec", "ScaleDownMode")).ToAggregate())
+
+	errs = append(errs, validateScaling(
+		mp.Spec.Template.Spec.Scaling,
+		field.NewPath("Spec", "Template", "Spec", "Scaling")).ToAggregate())
+
 	return nil, kerrors.NewAggregate(errs)
 }
 
@@ -245,6 +255,12 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateUpdate(ctx context.Co
 		mp.Spec.Template.Spec.NodePublicIPPrefixID); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "Template", "Spec", "HostGroupID"),
+		old.Spec.Template.Spec.HostGroupID,
+		mp.Spec.Template.Spec.HostGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
 
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("Spec", "Template", "Spec", "KubeletConfig"),
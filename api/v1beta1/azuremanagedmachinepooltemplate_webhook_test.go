@@ -196,6 +196,16 @@ func TestManagedMachinePoolTemplateUpdateWebhook(t *testing.T) {
 			}),
 			wantErr: true,
 		},
+		{
+			name: "azuremanagedmachinepooltemplate hostGroupID is immutable",
+			oldMachinePoolTemplate: getAzureManagedMachinePoolTemplate(func(ammpt *AzureManagedMachinePoolTemplate) {
+				ammpt.Spec.Template.Spec.HostGroupID = ptr.To("fooHostGroupID")
+			}),
+			machinePoolTemplate: getAzureManagedMachinePoolTemplate(func(ammpt *AzureManagedMachinePoolTemplate) {
+				ammpt.Spec.Template.Spec.HostGroupID = ptr.To("barHostGroupID")
+			}),
+			wantErr: true,
+		},
 		{
 			name: "azuremanagedmachinepooltemplate kubeletConfig is immutable",
 			oldMachinePoolTemplate: getAzureManagedMachinePoolTemplate(func(ammpt *AzureManagedMachinePoolTemplate) {
@@ -73,11 +73,18 @@ const (
 	ScaleSetScaleUpReason = "ScaleSetScalingUp"
 	// ScaleSetScaleDownReason describes the machine pool scaling down.
 	ScaleSetScaleDownReason = "ScaleSetScalingDown"
+	// ScaleSetScaleDownBlockedReason describes the machine pool not being able to scale down to the desired replicas
+	// because too many of its machines are protected from scale-in.
+	ScaleSetScaleDownBlockedReason = "ScaleSetScaleDownBlocked"
 
 	// ScaleSetModelUpdatedCondition reports on the model state of the pool.
 	ScaleSetModelUpdatedCondition clusterv1.ConditionType = "ScaleSetModelUpdated"
 	// ScaleSetModelOutOfDateReason describes the machine pool model being out of date.
 	ScaleSetModelOutOfDateReason = "ScaleSetModelOutOfDate"
+
+	// NodeStartupTimeoutReason describes the node of a machine pool machine not having joined the workload cluster
+	// within the pool's node startup timeout.
+	NodeStartupTimeoutReason = "NodeStartupTimeout"
 )
 
 // AzureManagedCluster Conditions and Reasons.
@@ -118,6 +125,18 @@ const (
 	PrivateDNSRecordReadyCondition clusterv1.ConditionType = "PrivateDNSRecordReady"
 	// BastionHostReadyCondition means the bastion host exists and is ready to be used.
 	BastionHostReadyCondition clusterv1.ConditionType = "BastionHostReady"
+	// AzureFirewallReadyCondition means the Azure Firewall exists and is ready to be used.
+	AzureFirewallReadyCondition clusterv1.ConditionType = "AzureFirewallReady"
+	// DiagnosticSettingsReadyCondition means the diagnostic settings of the cluster's network resources exist and are up to date.
+	DiagnosticSettingsReadyCondition clusterv1.ConditionType = "DiagnosticSettingsReady"
+	// PolicyAssignmentsReadyCondition means the Azure Policy assignments of the cluster exist and are up to date.
+	PolicyAssignmentsReadyCondition clusterv1.ConditionType = "PolicyAssignmentsReady"
+	// UserAssignedIdentitiesReadyCondition means the user-assigned identities of the cluster exist and are ready to be used.
+	UserAssignedIdentitiesReadyCondition clusterv1.ConditionType = "UserAssignedIdentitiesReady"
+	// NetAppVolumesReadyCondition means the Azure NetApp Files volumes of the cluster exist and are ready to be used.
+	NetAppVolumesReadyCondition clusterv1.ConditionType = "NetAppVolumesReady"
+	// DiskEncryptionSetsReadyCondition means the disk encryption sets of the cluster exist and are ready to be used.
+	DiskEncryptionSetsReadyCondition clusterv1.ConditionType = "DiskEncryptionSetsReady"
 	// InboundNATRulesReadyCondition means the inbound NAT rules exist and are ready to be used.
 	InboundNATRulesReadyCondition clusterv1.ConditionType = "InboundNATRulesReady"
 	// AvailabilitySetReadyCondition means the availability set exists and is ready to be used.
@@ -134,6 +153,12 @@ const (
 	FleetReadyCondition clusterv1.ConditionType = "FleetReady"
 	// AKSExtensionsReadyCondition means the AKS Extensions exist and are ready to be used.
 	AKSExtensionsReadyCondition clusterv1.ConditionType = "AKSExtensionsReady"
+	// ACRReferencesReadyCondition means the kubelet identity of the AKS cluster can pull from the referenced Azure Container Registries.
+	ACRReferencesReadyCondition clusterv1.ConditionType = "ACRReferencesReady"
+	// AzureMonitorWorkspaceReadyCondition means the Azure Monitor workspace which stores the Prometheus metrics of the AKS cluster exists.
+	AzureMonitorWorkspaceReadyCondition clusterv1.ConditionType = "AzureMonitorWorkspaceReady"
+	// DataCollectionRulesReadyCondition means the data collection rules which send the Prometheus metrics of the AKS cluster to its Azure Monitor workspace exist.
+	DataCollectionRulesReadyCondition clusterv1.ConditionType = "DataCollectionRulesReady"
 
 	// CreatingReason means the resource is being created.
 	CreatingReason = "Creating"
@@ -147,6 +172,52 @@ const (
 	DeletionFailedReason = "DeletionFailed"
 	// UpdatingReason means the resource is being updated.
 	UpdatingReason = "Updating"
+	// ReconcilingReason means the resource hit a transient error and its reconciliation will be retried.
+	ReconcilingReason = "Reconciling"
+	// DryRunReason means changes to the resource were planned but not applied because the owner is in dry-run mode.
+	DryRunReason = "DryRun"
+)
+
+const (
+	// ServiceReconcileOrderAnnotation is an optional comma-separated list of service names set on an AzureCluster,
+	// e.g. "group,virtualnetworks,routetables,subnets", which are reconciled in the given order before all other
+	// services, which are reconciled in the default order afterward. Services are deleted in the reverse order.
+	ServiceReconcileOrderAnnotation = "sigs.k8s.io/cluster-api-provider-azure-service-reconcile-order"
+
+	// DisabledServicesAnnotation is an optional comma-separated list of service names set on an AzureCluster,
+	// e.g. "azurefirewalls,netappvolumes", which are neither reconciled nor deleted for the cluster, regardless of
+	// their spec. The group and vnetpeerings services can't be disabled. When the cluster's resource group is managed
+	// by CAPZ, deleting the cluster deletes the resource group along with the resources of disabled services in it.
+	DisabledServicesAnnotation = "sigs.k8s.io/cluster-api-provider-azure-disabled-services"
+
+	// DryRunAnnotation, when set to "true" on an AzureCluster, makes CAPZ report the changes it would make to
+	// Azure resources as events on the AzureCluster instead of applying them.
+	DryRunAnnotation = "sigs.k8s.io/cluster-api-provider-azure-dry-run"
+
+	// AdoptAnnotation is an optional comma-separated list of Azure resource IDs set on the owner of Azure resources,
+	// e.g. an AzureCluster, of pre-existing resources which CAPZ should adopt and manage from then on.
+	AdoptAnnotation = "sigs.k8s.io/cluster-api-provider-azure-adopt"
+
+	// SkipDeletionAnnotation, when set to "true" on an AzureCluster, AzureManagedControlPlane, or one of their
+	// machines or machine pools, makes CAPZ leave the Azure resources in place when the object is deleted. The
+	// annotation on a cluster also applies to all of its machines and machine pools.
+	SkipDeletionAnnotation = "sigs.k8s.io/cluster-api-provider-azure-skip-deletion"
+
+	// SubnetPrefixLengthAnnotation is an optional prefix length set on an AzureCluster, e.g. "26", of the subnet
+	// CIDR blocks carved out of a custom vnet address space for subnets without CIDR blocks. Defaults to
+	// DefaultSubnetPrefixLength.
+	SubnetPrefixLengthAnnotation = "sigs.k8s.io/cluster-api-provider-azure-subnet-prefix-length"
+
+	// PauseModelUpdatesAnnotation, when set to "true" on an AzureMachinePool, makes CAPZ stop updating the model of its
+	// scale set, e.g. its image or configuration, while still reconciling its capacity. Removing the annotation resumes
+	// model updates.
+	PauseModelUpdatesAnnotation = "sigs.k8s.io/cluster-api-provider-azure-pause-model-updates"
+
+	// ProtectFromScaleInAnnotation, when set to "true" on an AzureMachinePoolMachine, makes CAPZ set protectFromScaleIn
+	// on its scale set instance and never select it when scaling in its machine pool. Removing the annotation removes
+	// the protection. Scale sets in Flexible orchestration mode don't support protectFromScaleIn, so their instances are
+	// only excluded from scale-in by CAPZ.
+	ProtectFromScaleInAnnotation = "sigs.k8s.io/cluster-api-provider-azure-protect-from-scale-in"
 )
 
 const (
@@ -168,6 +239,8 @@ const (
 const (
 	// AzureNetworkPluginName is the name of the Azure network plugin.
 	AzureNetworkPluginName = "azure"
+	// KubenetNetworkPluginName is the name of the kubenet network plugin.
+	KubenetNetworkPluginName = "kubenet"
 )
 
 const (
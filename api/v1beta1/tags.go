@@ -19,16 +19,123 @@ package v1beta1
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// maxTagKeyLength is the maximum length of an Azure tag name.
+	maxTagKeyLength = 512
+	// maxTagValueLength is the maximum length of an Azure tag value.
+	maxTagValueLength = 256
+	// invalidTagKeyCharacters are the characters Azure does not allow in tag names.
+	invalidTagKeyCharacters = "<>%&\\?/"
 )
 
+// reservedTagKeyPrefixes are the tag name prefixes Azure reserves for its own use.
+var reservedTagKeyPrefixes = []string{"microsoft", "azure", "windows"}
+
 // Tags defines a map of tags.
 type Tags map[string]string
 
+// TaggedResourceType is a type of Azure resources of a cluster which can receive its AdditionalTags.
+// +kubebuilder:validation:Enum=ResourceGroup;VirtualNetwork;NetworkSecurityGroup;RouteTable;PublicIP;LoadBalancer;NatGateway;PrivateDNS;PrivateEndpoint;AzureFirewall;UserAssignedIdentity;NetApp;DiskEncryptionSet
+type TaggedResourceType string
+
+const (
+	// TaggedResourceTypeResourceGroup are the resource groups of the cluster.
+	TaggedResourceTypeResourceGroup TaggedResourceType = "ResourceGroup"
+	// TaggedResourceTypeVirtualNetwork is the virtual network of the cluster.
+	TaggedResourceTypeVirtualNetwork TaggedResourceType = "VirtualNetwork"
+	// TaggedResourceTypeNetworkSecurityGroup are the network security groups of the cluster's subnets.
+	TaggedResourceTypeNetworkSecurityGroup TaggedResourceType = "NetworkSecurityGroup"
+	// TaggedResourceTypeRouteTable are the route tables of the cluster's subnets.
+	TaggedResourceTypeRouteTable TaggedResourceType = "RouteTable"
+	// TaggedResourceTypePublicIP are the public IPs of the cluster's load balancers, NAT gateways, bastion and firewall.
+	TaggedResourceTypePublicIP TaggedResourceType = "PublicIP"
+	// TaggedResourceTypeLoadBalancer are the load balancers of the cluster.
+	TaggedResourceTypeLoadBalancer TaggedResourceType = "LoadBalancer"
+	// TaggedResourceTypeNatGateway are the NAT gateways of the cluster's node subnets.
+	TaggedResourceTypeNatGateway TaggedResourceType = "NatGateway"
+	// TaggedResourceTypePrivateDNS are the private DNS zone of the cluster and its links and records.
+	TaggedResourceTypePrivateDNS TaggedResourceType = "PrivateDNS"
+	// TaggedResourceTypePrivateEndpoint are the private endpoints of the cluster.
+	TaggedResourceTypePrivateEndpoint TaggedResourceType = "PrivateEndpoint"
+	// TaggedResourceTypeAzureFirewall is the Azure Firewall of the cluster.
+	TaggedResourceTypeAzureFirewall TaggedResourceType = "AzureFirewall"
+	// TaggedResourceTypeUserAssignedIdentity are the user-assigned identities of the cluster.
+	TaggedResourceTypeUserAssignedIdentity TaggedResourceType = "UserAssignedIdentity"
+	// TaggedResourceTypeNetApp are the NetApp accounts, capacity pools and volumes of the cluster.
+	TaggedResourceTypeNetApp TaggedResourceType = "NetApp"
+	// TaggedResourceTypeDiskEncryptionSet are the disk encryption sets of the cluster.
+	TaggedResourceTypeDiskEncryptionSet TaggedResourceType = "DiskEncryptionSet"
+)
+
+// TagPropagationPolicy defines which resources of a cluster receive its AdditionalTags.
+type TagPropagationPolicy struct {
+	// ResourceTypes are the types of resources which receive the AdditionalTags. Resources of other types only keep
+	// the tags CAPZ adds by default and the tags set on them specifically, e.g. the Tags of a security group.
+	// +listType=set
+	// +optional
+	ResourceTypes []TaggedResourceType `json:"resourceTypes,omitempty"`
+}
+
+// PropagatesTo returns true if the AdditionalTags are propagated to resources of the given type. A nil policy
+// propagates them to resources of all types.
+func (p *TagPropagationPolicy) PropagatesTo(resourceType TaggedResourceType) bool {
+	if p == nil {
+		return true
+	}
+	for _, t := range p.ResourceTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
 // Equals returns true if the tags are equal.
 func (t Tags) Equals(other Tags) bool {
 	return reflect.DeepEqual(t, other)
 }
 
+// ValidateTags validates that tags are accepted by Azure: names must not use a reserved prefix or
+// contain invalid characters, and names and values must not exceed the maximum length.
+// See https://learn.microsoft.com/azure/azure-resource-manager/management/tag-resources#limitations.
+func ValidateTags(tags Tags, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := tags[key]
+		keyPath := fldPath.Key(key)
+		if key == "" {
+			allErrs = append(allErrs, field.Invalid(keyPath, key, "tag name must not be empty"))
+		}
+		if len(key) > maxTagKeyLength {
+			allErrs = append(allErrs, field.TooLong(keyPath, key, maxTagKeyLength))
+		}
+		if strings.ContainsAny(key, invalidTagKeyCharacters) {
+			allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("tag name must not contain any of the characters %q", invalidTagKeyCharacters)))
+		}
+		lowerKey := strings.ToLower(key)
+		for _, prefix := range reservedTagKeyPrefixes {
+			if strings.HasPrefix(lowerKey, prefix) {
+				allErrs = append(allErrs, field.Invalid(keyPath, key, fmt.Sprintf("tag name must not start with the reserved prefix %q", prefix)))
+			}
+		}
+		if len(value) > maxTagValueLength {
+			allErrs = append(allErrs, field.TooLong(keyPath, value, maxTagValueLength))
+		}
+	}
+	return allErrs
+}
+
 // HasMatchingSpecVersionHash returns true if the resource has been tagged with a matching resource spec hash value.
 func (t Tags) HasMatchingSpecVersionHash(hash string) bool {
 	value, ok := t[SpecVersionHashTagKey()]
@@ -17,9 +17,11 @@ limitations under the License.
 package v1beta1
 
 import (
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 func TestTags_Merge(t *testing.T) {
@@ -87,3 +89,125 @@ func TestTags_Merge(t *testing.T) {
 		})
 	}
 }
+
+func TestTagPropagationPolicy_PropagatesTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *TagPropagationPolicy
+		expected bool
+	}{
+		{
+			name:     "nil policy propagates to all resources",
+			policy:   nil,
+			expected: true,
+		},
+		{
+			name:     "policy lists the resource type",
+			policy:   &TagPropagationPolicy{ResourceTypes: []TaggedResourceType{TaggedResourceTypeVirtualNetwork, TaggedResourceTypeNetworkSecurityGroup}},
+			expected: true,
+		},
+		{
+			name:     "policy does not list the resource type",
+			policy:   &TagPropagationPolicy{ResourceTypes: []TaggedResourceType{TaggedResourceTypeVirtualNetwork}},
+			expected: false,
+		},
+		{
+			name:     "empty policy propagates to no resources",
+			policy:   &TagPropagationPolicy{},
+			expected: false,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(tc.policy.PropagatesTo(TaggedResourceTypeNetworkSecurityGroup)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestValidateTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    Tags
+		wantErr bool
+	}{
+		{
+			name:    "nil tags",
+			tags:    nil,
+			wantErr: false,
+		},
+		{
+			name: "valid tags",
+			tags: Tags{
+				"environment":                "production",
+				"team":                       "platform",
+				"cost-center.example.com_id": "1234",
+			},
+			wantErr: false,
+		},
+		{
+			name: "reserved prefix",
+			tags: Tags{
+				"Microsoft.owner": "me",
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved prefix in lowercase",
+			tags: Tags{
+				"azure-team": "me",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid character",
+			tags: Tags{
+				"team/name": "platform",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty key",
+			tags: Tags{
+				"": "value",
+			},
+			wantErr: true,
+		},
+		{
+			name: "key too long",
+			tags: Tags{
+				strings.Repeat("a", 513): "value",
+			},
+			wantErr: true,
+		},
+		{
+			name: "value too long",
+			tags: Tags{
+				"key": strings.Repeat("a", 257),
+			},
+			wantErr: true,
+		},
+		{
+			name: "key and value at maximum length",
+			tags: Tags{
+				strings.Repeat("a", 512): strings.Repeat("a", 256),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			errs := ValidateTags(tc.tags, field.NewPath("additionalTags"))
+			if tc.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
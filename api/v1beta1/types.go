@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/net"
 )
@@ -29,6 +30,8 @@ const (
 	Node string = "node"
 	// Bastion subnet label.
 	Bastion string = "bastion"
+	// Firewall subnet label.
+	Firewall string = "firewall"
 )
 
 // SecurityEncryptionType represents the Encryption Type when the virtual machine is a
@@ -109,6 +112,15 @@ type NetworkSpec struct {
 	// +optional
 	ControlPlaneOutboundLB *LoadBalancerSpec `json:"controlPlaneOutboundLB,omitempty"`
 
+	// AzureFirewall is the configuration for an Azure Firewall deployed in the cluster's virtual network.
+	// +optional
+	AzureFirewall *AzureFirewall `json:"azureFirewall,omitempty"`
+
+	// DiagnosticSettings are the Azure Monitor diagnostic settings which forward the resource logs and metrics
+	// of the cluster's network resources to a Log Analytics workspace.
+	// +optional
+	DiagnosticSettings []DiagnosticSetting `json:"diagnosticSettings,omitempty"`
+
 	NetworkClassSpec `json:",inline"`
 }
 
@@ -225,6 +237,47 @@ type RouteTable struct {
 	// +optional
 	ID   string `json:"id,omitempty"`
 	Name string `json:"name"`
+	// Tags is a map of tags applied to the route table. They take precedence over the AdditionalTags of the
+	// AzureCluster with the same key.
+	// +optional
+	Tags Tags `json:"tags,omitempty"`
+	// Routes are the routes of the route table. Routes which are removed from the list are removed from the route
+	// table, routes added to the route table outside of CAPZ are kept.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Routes []Route `json:"routes,omitempty"`
+}
+
+// RouteNextHopType is the type of the next hop of a route.
+// +kubebuilder:validation:Enum=VirtualNetworkGateway;VnetLocal;Internet;VirtualAppliance;None
+type RouteNextHopType string
+
+const (
+	// RouteNextHopTypeVirtualNetworkGateway sends the traffic to the virtual network gateway of the virtual network.
+	RouteNextHopTypeVirtualNetworkGateway RouteNextHopType = "VirtualNetworkGateway"
+	// RouteNextHopTypeVnetLocal sends the traffic within the virtual network.
+	RouteNextHopTypeVnetLocal RouteNextHopType = "VnetLocal"
+	// RouteNextHopTypeInternet sends the traffic to the Internet.
+	RouteNextHopTypeInternet RouteNextHopType = "Internet"
+	// RouteNextHopTypeVirtualAppliance sends the traffic to the IP of a virtual appliance, such as a firewall.
+	RouteNextHopTypeVirtualAppliance RouteNextHopType = "VirtualAppliance"
+	// RouteNextHopTypeNone drops the traffic.
+	RouteNextHopTypeNone RouteNextHopType = "None"
+)
+
+// Route defines a route of a route table.
+type Route struct {
+	// Name is the name of the route, unique within the route table.
+	Name string `json:"name"`
+	// AddressPrefix is the destination CIDR the route applies to.
+	AddressPrefix string `json:"addressPrefix"`
+	// NextHopType is the type of the hop the traffic is sent to.
+	NextHopType RouteNextHopType `json:"nextHopType"`
+	// NextHopIPAddress is the IP address the traffic is forwarded to. It is required for, and only allowed with, the
+	// VirtualAppliance next hop type.
+	// +optional
+	NextHopIPAddress string `json:"nextHopIPAddress,omitempty"`
 }
 
 // NatGateway defines an Azure NAT gateway.
@@ -281,6 +334,51 @@ const (
 	SecurityRuleActionDeny SecurityRuleAccess = "Deny"
 )
 
+// SecurityRulesMode defines how the security rules of a security group are reconciled.
+type SecurityRulesMode string
+
+const (
+	// SecurityRulesModeManaged adds the security rules of the spec to the security group and removes the rules which
+	// were previously applied by CAPZ but are no longer in the spec.
+	SecurityRulesModeManaged SecurityRulesMode = "Managed"
+
+	// SecurityRulesModeAdditive merges the security rules of the spec with the rules added to the security group outside
+	// of CAPZ. Rules in the spec replace existing rules with the same name, and only rules previously applied by CAPZ are
+	// ever removed. The rules of the spec must use priorities from a reserved range, which rules added outside of CAPZ
+	// must not use.
+	SecurityRulesModeAdditive SecurityRulesMode = "Additive"
+)
+
+// ResourceGroupMode defines whether CAPZ manages the lifecycle of a cluster's resource groups.
+type ResourceGroupMode string
+
+const (
+	// ResourceGroupModeManaged creates the resource groups which don't exist and deletes the ones CAPZ created when
+	// the cluster is deleted.
+	ResourceGroupModeManaged ResourceGroupMode = "Managed"
+
+	// ResourceGroupModeUnmanaged only uses pre-existing resource groups. CAPZ verifies that they exist but never
+	// creates, tags or deletes them.
+	ResourceGroupModeUnmanaged ResourceGroupMode = "Unmanaged"
+)
+
+// SecurityRulePriorityRange defines an inclusive range of security rule priorities.
+type SecurityRulePriorityRange struct {
+	// Min is the lowest priority of the range.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=4096
+	Min int32 `json:"min"`
+	// Max is the highest priority of the range.
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=4096
+	Max int32 `json:"max"`
+}
+
+// Contains returns true if the priority is within the range.
+func (r SecurityRulePriorityRange) Contains(priority int32) bool {
+	return priority >= r.Min && priority <= r.Max
+}
+
 // SecurityRule defines an Azure security rule for security groups.
 type SecurityRule struct {
 	// Name is a unique name within the network security group.
@@ -338,6 +436,20 @@ type LoadBalancerSpec struct {
 	// BackendPool describes the backend pool of the load balancer.
 	// +optional
 	BackendPool BackendPool `json:"backendPool,omitempty"`
+	// AdditionalBackendPools are backend pools of the API server load balancer in addition to BackendPool, e.g. to shift
+	// its load balancing rule between pools during blue/green rollouts. Machines are only added to BackendPool.
+	// Pools removed from the list are removed from the load balancer.
+	// +optional
+	AdditionalBackendPools []BackendPool `json:"additionalBackendPools,omitempty"`
+	// RuleBackendPool is the name of the backend pool the load balancing rule of the API server load balancer sends its
+	// traffic to. It must be the name of BackendPool or of one of AdditionalBackendPools. Defaults to BackendPool.
+	// +optional
+	RuleBackendPool string `json:"ruleBackendPool,omitempty"`
+	// InboundNATPools are the inbound NAT pools of the node outbound load balancer, which map a range of its frontend
+	// ports to a backend port of the instances of the AzureMachinePools associated with them, e.g. for SSH access.
+	// Only supported on the node outbound load balancer.
+	// +optional
+	InboundNATPools []InboundNATPool `json:"inboundNATPools,omitempty"`
 
 	LoadBalancerClassSpec `json:",inline"`
 }
@@ -360,23 +472,165 @@ const (
 	Public = LBType("Public")
 )
 
+// ProbeProtocol defines the protocol of an Azure load balancer health probe.
+type ProbeProtocol string
+
+const (
+	// ProbeProtocolTCP is the value for a health probe which succeeds when a TCP connection can be established.
+	ProbeProtocolTCP = ProbeProtocol("Tcp")
+	// ProbeProtocolHTTP is the value for a health probe which succeeds when an HTTP request returns 200 OK.
+	ProbeProtocolHTTP = ProbeProtocol("Http")
+	// ProbeProtocolHTTPS is the value for a health probe which succeeds when an HTTPS request returns 200 OK.
+	ProbeProtocolHTTPS = ProbeProtocol("Https")
+)
+
+// HealthProbe defines the health probe of a load balancer.
+type HealthProbe struct {
+	// Protocol is the protocol of the health probe.
+	// +kubebuilder:validation:Enum=Tcp;Http;Https
+	Protocol ProbeProtocol `json:"protocol"`
+	// RequestPath is the URI requested for the health status of the backend, e.g. "/healthz".
+	// It is required for the Http and Https protocols and must not be set for the Tcp protocol.
+	// +optional
+	RequestPath string `json:"requestPath,omitempty"`
+	// IntervalInSeconds is the interval between health probes, which is at least 5 seconds. Defaults to 15.
+	// +optional
+	IntervalInSeconds *int32 `json:"intervalInSeconds,omitempty"`
+	// NumberOfProbes is the number of consecutive failed health probes after which a backend is taken out of
+	// rotation. Defaults to 4.
+	// +optional
+	NumberOfProbes *int32 `json:"numberOfProbes,omitempty"`
+}
+
+// InboundNATPoolProtocol defines the transport protocol of an Azure load balancer inbound NAT pool.
+type InboundNATPoolProtocol string
+
+const (
+	// InboundNATPoolProtocolTCP is the value for an inbound NAT pool of TCP ports.
+	InboundNATPoolProtocolTCP = InboundNATPoolProtocol("Tcp")
+	// InboundNATPoolProtocolUDP is the value for an inbound NAT pool of UDP ports.
+	InboundNATPoolProtocolUDP = InboundNATPoolProtocol("Udp")
+	// InboundNATPoolProtocolAll is the value for an inbound NAT pool of both TCP and UDP ports.
+	InboundNATPoolProtocolAll = InboundNATPoolProtocol("All")
+)
+
+// InboundNATPool defines an inbound NAT pool of a load balancer. Each instance of a Virtual Machine Scale Set
+// associated with the pool is reached on one port of its frontend port range.
+type InboundNATPool struct {
+	// Name is the name of the inbound NAT pool.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// FrontendPortRangeStart is the first port of the frontend port range.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65534
+	FrontendPortRangeStart int32 `json:"frontendPortRangeStart"`
+	// FrontendPortRangeEnd is the last port of the frontend port range. The range must have a port for each instance
+	// of the Virtual Machine Scale Sets associated with the pool.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	FrontendPortRangeEnd int32 `json:"frontendPortRangeEnd"`
+	// BackendPort is the port of the instances the frontend ports are mapped to, e.g. 22 for SSH.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	BackendPort int32 `json:"backendPort"`
+	// Protocol is the transport protocol of the inbound NAT pool. Defaults to Tcp.
+	// +kubebuilder:validation:Enum=Tcp;Udp;All
+	// +kubebuilder:default=Tcp
+	// +optional
+	Protocol InboundNATPoolProtocol `json:"protocol,omitempty"`
+}
+
+// InboundNATRuleSettings defines the settings of the inbound NAT rules which give SSH access to each control plane
+// machine through the API server load balancer.
+type InboundNATRuleSettings struct {
+	// Protocol is the transport protocol of the inbound NAT rules. Defaults to Tcp.
+	// +kubebuilder:validation:Enum=Tcp;Udp;All
+	// +optional
+	Protocol InboundNATPoolProtocol `json:"protocol,omitempty"`
+	// EnableFloatingIP enables floating IP (direct server return) on the inbound NAT rules, so that the traffic
+	// reaches the control plane machines with the frontend IP as its destination. It is not supported with the All
+	// protocol.
+	// +optional
+	EnableFloatingIP bool `json:"enableFloatingIP,omitempty"`
+	// EnableTCPReset sends a TCP reset to both ends of a connection of the inbound NAT rules when it is closed after
+	// being idle. It is only supported with the Tcp protocol.
+	// +optional
+	EnableTCPReset bool `json:"enableTCPReset,omitempty"`
+}
+
 // FrontendIP defines a load balancer frontend IP configuration.
 type FrontendIP struct {
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
 	// +optional
 	PublicIP *PublicIPSpec `json:"publicIP,omitempty"`
+	// GatewayLoadBalancer chains the frontend IP to the frontend IP configuration of a Gateway Load Balancer, so that
+	// the traffic of the frontend IP is sent through the network virtual appliances behind it.
+	// Only supported on frontend IPs of public load balancers.
+	// +optional
+	GatewayLoadBalancer *GatewayLoadBalancerReference `json:"gatewayLoadBalancer,omitempty"`
 
 	FrontendIPClass `json:",inline"`
 }
 
+// GatewayLoadBalancerReference is a reference to the frontend IP configuration of a Gateway Load Balancer.
+type GatewayLoadBalancerReference struct {
+	// ID is the Azure resource ID of the frontend IP configuration of the Gateway Load Balancer, e.g.
+	// /subscriptions/<subscription-id>/resourceGroups/<resource-group>/providers/Microsoft.Network/loadBalancers/<name>/frontendIPConfigurations/<frontend-name>.
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id"`
+}
+
+// PublicIPSKU defines an Azure public IP SKU.
+type PublicIPSKU string
+
+const (
+	// PublicIPSKUBasic is the Basic SKU of public IPs, which doesn't support availability zones.
+	PublicIPSKUBasic = PublicIPSKU("Basic")
+	// PublicIPSKUStandard is the Standard SKU of public IPs.
+	PublicIPSKUStandard = PublicIPSKU("Standard")
+)
+
 // PublicIPSpec defines the inputs to create an Azure public IP address.
 type PublicIPSpec struct {
 	Name string `json:"name"`
+	// DNSName is the fully qualified domain name of the public IP. Its first label is set as the domain name label of
+	// the public IP, so it must be unique within the location, be 3 to 63 characters long, start with a lowercase
+	// letter, end with a lowercase letter or a number and only contain lowercase letters, numbers and hyphens.
+	// The FQDN Azure assigns to the public IP is reported in the status of the AzureCluster.
 	// +optional
 	DNSName string `json:"dnsName,omitempty"`
 	// +optional
 	IPTags []IPTag `json:"ipTags,omitempty"`
+	// SKU is the SKU of the public IP. Defaults to Standard.
+	// +kubebuilder:validation:Enum=Basic;Standard
+	// +optional
+	SKU PublicIPSKU `json:"sku,omitempty"`
+	// Zones are the availability zones the public IP is allocated in, e.g. ["1", "2", "3"] for a zone-redundant public
+	// IP or ["1"] for a zonal one. Only supported with the Standard SKU. Defaults to the failure domains of the cluster.
+	// Zones are only applied when the public IP is created.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+}
+
+// PublicIPStatus is the observed DNS configuration of a public IP.
+type PublicIPStatus struct {
+	// Name is the name of the public IP.
+	Name string `json:"name"`
+	// FQDN is the fully qualified domain name Azure assigned to the public IP for its domain name label.
+	// +optional
+	FQDN string `json:"fqdn,omitempty"`
+}
+
+// SSHPublicKey is an SSH public key authorized for a user of a Virtual Machine.
+type SSHPublicKey struct {
+	// Username is the name of the user the key is authorized for. Azure only authorizes keys for the admin user of the
+	// Virtual Machine, so it must be "capi". Keys of other users need to be provisioned through the bootstrap data.
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+	// KeyData is the SSH public key string, base64-encoded.
+	// +kubebuilder:validation:MinLength=1
+	KeyData string `json:"keyData"`
 }
 
 // IPTag contains the IpTag associated with the object.
@@ -628,6 +882,11 @@ type DataDisk struct {
 	// +optional
 	// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
 	CachingType string `json:"cachingType,omitempty"`
+	// WriteAcceleratorEnabled enables Write Accelerator on the data disk, which lowers the latency of writes, e.g.
+	// for database logs. It is only supported for Premium_LRS disks that don't use ReadWrite caching, on VM sizes
+	// which support Write Accelerator, like M-series VMs.
+	// +optional
+	WriteAcceleratorEnabled *bool `json:"writeAcceleratorEnabled,omitempty"`
 }
 
 // VMExtension specifies the parameters for a custom VM extension.
@@ -705,6 +964,9 @@ const (
 
 	// SubnetBastion defines a Bastion subnet role.
 	SubnetBastion = SubnetRole(Bastion)
+
+	// SubnetFirewall defines an Azure Firewall subnet role.
+	SubnetFirewall = SubnetRole(Firewall)
 )
 
 // SubnetSpec configures an Azure subnet.
@@ -1000,6 +1262,237 @@ type AzureBastion struct {
 	EnableTunneling bool `json:"enableTunneling,omitempty"`
 }
 
+// AzureFirewallSKUTier is the tier of an Azure Firewall.
+type AzureFirewallSKUTier string
+
+const (
+	// StandardAzureFirewallSKUTier is the Standard tier of Azure Firewall.
+	StandardAzureFirewallSKUTier AzureFirewallSKUTier = "Standard"
+	// PremiumAzureFirewallSKUTier is the Premium tier of Azure Firewall.
+	PremiumAzureFirewallSKUTier AzureFirewallSKUTier = "Premium"
+)
+
+// AzureFirewall specifies how an Azure Firewall should be deployed in the cluster's virtual network.
+type AzureFirewall struct {
+	// Name is the name of the Azure Firewall.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// SKU configures the tier of the Azure Firewall. Can be either Standard or Premium. Defaults to Standard.
+	// +kubebuilder:default=Standard
+	// +kubebuilder:validation:Enum=Standard;Premium
+	// +optional
+	SKU AzureFirewallSKUTier `json:"sku,omitempty"`
+	// Subnet is the subnet the Azure Firewall is deployed into. Azure requires it to be named AzureFirewallSubnet.
+	// +optional
+	Subnet SubnetSpec `json:"subnet,omitempty"`
+	// PublicIPsCount is the number of public IPs attached to the Azure Firewall. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=250
+	// +optional
+	PublicIPsCount *int32 `json:"publicIPsCount,omitempty"`
+	// PublicIPs are the public IPs attached to the Azure Firewall. They are generated from PublicIPsCount.
+	// +optional
+	PublicIPs []PublicIPSpec `json:"publicIPs,omitempty"`
+	// FirewallPolicyID is the Azure resource ID of an existing firewall policy to associate with the Azure Firewall.
+	// +optional
+	FirewallPolicyID string `json:"firewallPolicyID,omitempty"`
+	// RouteNodeEgress adds a default route (0.0.0.0/0) to the route tables of the node subnets
+	// with the Azure Firewall's private IP as the next hop, forcing all node egress through the firewall.
+	// +optional
+	RouteNodeEgress bool `json:"routeNodeEgress,omitempty"`
+}
+
+// DiagnosticSettingTarget is a kind of network resource a diagnostic setting is created for.
+// +kubebuilder:validation:Enum=VirtualNetwork;SecurityGroup;LoadBalancer
+type DiagnosticSettingTarget string
+
+const (
+	// DiagnosticSettingTargetVirtualNetwork targets the cluster's virtual network.
+	DiagnosticSettingTargetVirtualNetwork DiagnosticSettingTarget = "VirtualNetwork"
+	// DiagnosticSettingTargetSecurityGroup targets the network security groups of the cluster's subnets.
+	DiagnosticSettingTargetSecurityGroup DiagnosticSettingTarget = "SecurityGroup"
+	// DiagnosticSettingTargetLoadBalancer targets the cluster's load balancers.
+	DiagnosticSettingTargetLoadBalancer DiagnosticSettingTarget = "LoadBalancer"
+)
+
+// DiagnosticSetting specifies an Azure Monitor diagnostic setting created for each of the targeted network
+// resources managed by CAPZ.
+type DiagnosticSetting struct {
+	// Name is the name of the diagnostic setting. It must be unique per targeted resource.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Targets are the kinds of network resources the diagnostic setting is created for.
+	// +kubebuilder:validation:MinItems=1
+	Targets []DiagnosticSettingTarget `json:"targets"`
+	// WorkspaceID is the Azure resource ID of the Log Analytics workspace the logs and metrics are sent to.
+	WorkspaceID string `json:"workspaceID"`
+	// LogCategories are the resource log categories to enable, e.g. NetworkSecurityGroupEvent.
+	// The available categories depend on the targeted resource.
+	// +optional
+	LogCategories []string `json:"logCategories,omitempty"`
+	// MetricCategories are the metric categories to enable, e.g. AllMetrics.
+	// +optional
+	MetricCategories []string `json:"metricCategories,omitempty"`
+}
+
+// PolicyAssignment specifies an Azure Policy assignment CAPZ creates for the cluster.
+type PolicyAssignment struct {
+	// Name is the name of the policy assignment. It must be unique per scope.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Name string `json:"name"`
+	// PolicyDefinitionID is the Azure resource ID of the policy definition or policy set definition to assign,
+	// e.g. /providers/Microsoft.Authorization/policyDefinitions/<policy-definition-name>.
+	PolicyDefinitionID string `json:"policyDefinitionID"`
+	// Scope is the Azure resource ID the policy is assigned to. It must be the cluster's resource group, or a
+	// resource in it. Defaults to the cluster's resource group.
+	// +optional
+	Scope string `json:"scope,omitempty"`
+	// Parameters are the values of the policy definition's parameters, keyed by parameter name.
+	// +optional
+	Parameters map[string]apiextensionsv1.JSON `json:"parameters,omitempty"`
+}
+
+// ManagedIdentity specifies a user-assigned managed identity CAPZ creates for the cluster.
+type ManagedIdentity struct {
+	// Name is the name of the user-assigned identity. It must be unique within the cluster's resource group.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`
+	Name string `json:"name"`
+}
+
+// ManagedIdentityStatus is the observed state of a user-assigned managed identity CAPZ created for the cluster.
+type ManagedIdentityStatus struct {
+	// Name is the name of the user-assigned identity.
+	Name string `json:"name"`
+	// ProviderID is the Azure resource ID of the identity, in the format expected by
+	// UserAssignedIdentity.ProviderID, e.g.
+	// 'azure:///subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{identityName}'.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+	// PrincipalID is the object ID of the identity's service principal, used to assign roles to the identity.
+	// +optional
+	PrincipalID string `json:"principalID,omitempty"`
+	// ClientID is the client ID of the identity, used to authenticate as the identity.
+	// +optional
+	ClientID string `json:"clientID,omitempty"`
+}
+
+// NetAppVolume specifies an Azure NetApp Files volume CAPZ creates for the workloads of the cluster.
+type NetAppVolume struct {
+	// Name is the name of the volume. It is also the file path of the volume's mount target, so it must be unique
+	// within the cluster's location.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9-]*$`
+	Name string `json:"name"`
+
+	// AccountName is the name of the NetApp account of the volume. The account is created in the cluster's
+	// resource group.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`
+	AccountName string `json:"accountName"`
+
+	// CapacityPool is the capacity pool of the NetApp account the volume is provisioned from. Volumes of the same
+	// account may share a capacity pool.
+	CapacityPool NetAppCapacityPool `json:"capacityPool"`
+
+	// SizeGiB is the quota of the volume in GiB.
+	// +kubebuilder:validation:Minimum=100
+	SizeGiB int64 `json:"sizeGiB"`
+
+	// Protocol is the protocol the volume is mounted with. Defaults to NFSv3.
+	// +kubebuilder:validation:Enum=NFSv3;NFSv4.1
+	// +kubebuilder:default=NFSv3
+	// +optional
+	Protocol NetAppVolumeProtocol `json:"protocol,omitempty"`
+
+	// SubnetID is the resource ID of the subnet the volume's mount target is placed in. The subnet must be
+	// delegated to Microsoft.NetApp/volumes, so it can't be one of the cluster's machine subnets.
+	SubnetID string `json:"subnetID"`
+}
+
+// NetAppCapacityPool specifies the capacity pool of an Azure NetApp Files volume.
+type NetAppCapacityPool struct {
+	// Name is the name of the capacity pool.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`
+	Name string `json:"name"`
+
+	// ServiceLevel is the service level of the capacity pool, which determines the throughput of its volumes.
+	// +kubebuilder:validation:Enum=Standard;Premium;Ultra
+	ServiceLevel string `json:"serviceLevel"`
+
+	// SizeTiB is the size of the capacity pool in TiB.
+	// +kubebuilder:validation:Minimum=1
+	SizeTiB int64 `json:"sizeTiB"`
+}
+
+// NetAppVolumeProtocol is the protocol an Azure NetApp Files volume is mounted with.
+type NetAppVolumeProtocol string
+
+const (
+	// NetAppVolumeProtocolNFSv3 mounts the volume with NFS version 3.
+	NetAppVolumeProtocolNFSv3 NetAppVolumeProtocol = "NFSv3"
+	// NetAppVolumeProtocolNFSv41 mounts the volume with NFS version 4.1.
+	NetAppVolumeProtocolNFSv41 NetAppVolumeProtocol = "NFSv4.1"
+)
+
+// DiskEncryptionSet specifies a disk encryption set CAPZ creates for the cluster from a customer-managed Key Vault key.
+type DiskEncryptionSet struct {
+	// Name is the name of the disk encryption set. It must be unique within the cluster's resource group.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=80
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]*$`
+	Name string `json:"name"`
+
+	// KeyURL is the URL of the Key Vault key the disks are encrypted with, e.g.
+	// 'https://{vaultName}.vault.azure.net/keys/{keyName}/{keyVersion}'. The key version may be omitted when
+	// RotationToLatestKeyVersionEnabled is true. Changing it rotates the disks to the new key.
+	KeyURL string `json:"keyURL"`
+
+	// KeyVaultID is the resource ID of the Key Vault containing the key. It is required when the Key Vault is in
+	// another subscription than the cluster.
+	// +optional
+	KeyVaultID string `json:"keyVaultID,omitempty"`
+
+	// Identity is the type of the managed identity the disk encryption set accesses the key with. The identity must
+	// be granted access to the key. Defaults to SystemAssigned.
+	// +kubebuilder:validation:Enum=SystemAssigned;UserAssigned
+	// +kubebuilder:default=SystemAssigned
+	// +optional
+	Identity VMIdentity `json:"identity,omitempty"`
+
+	// UserAssignedIdentity is the user-assigned identity the disk encryption set accesses the key with. It is
+	// required when Identity is UserAssigned.
+	// +optional
+	UserAssignedIdentity *UserAssignedIdentity `json:"userAssignedIdentity,omitempty"`
+
+	// RotationToLatestKeyVersionEnabled makes Azure rotate the disks to the latest version of the key automatically.
+	// +optional
+	RotationToLatestKeyVersionEnabled *bool `json:"rotationToLatestKeyVersionEnabled,omitempty"`
+}
+
+// DiskEncryptionSetStatus is the observed state of a disk encryption set CAPZ created for the cluster.
+type DiskEncryptionSetStatus struct {
+	// Name is the name of the disk encryption set.
+	Name string `json:"name"`
+	// ID is the resource ID of the disk encryption set, to be referenced by the diskEncryptionSet of the OS or data
+	// disks of the cluster's machines.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// PrincipalID is the object ID of the system-assigned identity of the disk encryption set, to be granted access
+	// to the key.
+	// +optional
+	PrincipalID string `json:"principalID,omitempty"`
+	// ActiveKeyURL is the URL of the key version the disks are currently encrypted with.
+	// +optional
+	ActiveKeyURL string `json:"activeKeyURL,omitempty"`
+}
+
 // FleetsMember defines the fleets member configuration.
 // See also [AKS doc].
 //
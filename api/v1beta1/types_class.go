@@ -200,7 +200,7 @@ type AzureManagedControlPlaneClassSpec struct {
 	KubeletUserAssignedIdentity string `json:"kubeletUserAssignedIdentity,omitempty"`
 
 	// HTTPProxyConfig is the HTTP proxy configuration for the cluster.
-	// Immutable.
+	// It can be updated, but not removed once set.
 	// +optional
 	HTTPProxyConfig *HTTPProxyConfig `json:"httpProxyConfig,omitempty"`
 
@@ -222,6 +222,19 @@ type AzureManagedControlPlaneClassSpec struct {
 	// Extensions is a list of AKS extensions to be installed on the cluster.
 	// +optional
 	Extensions []AKSExtension `json:"extensions,omitempty"`
+
+	// ACRReferences is a list of Azure Container Registries the cluster's nodes pull images from. The kubelet
+	// identity of the cluster is granted the AcrPull role on each registry. The role assignment is removed when
+	// a registry is removed from the list.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	ACRReferences []ACRReference `json:"acrReferences,omitempty"`
+
+	// AzureMonitorProfile configures the Azure Monitor managed service for Prometheus, which collects the metrics of
+	// the cluster in an Azure Monitor workspace.
+	// +optional
+	AzureMonitorProfile *AzureMonitorProfile `json:"azureMonitorProfile,omitempty"`
 }
 
 // AzureManagedMachinePoolClassSpec defines the AzureManagedMachinePool properties that may be shared across several Azure managed machinepools.
@@ -274,6 +287,7 @@ type AzureManagedMachinePoolClassSpec struct {
 	Scaling *ManagedMachinePoolScaling `json:"scaling,omitempty"`
 
 	// MaxPods specifies the kubelet `--max-pods` configuration for the node pool.
+	// It must be between 10 and 250, or at most 110 with the kubenet network plugin.
 	// Immutable.
 	// See also [AKS doc], [K8s doc].
 	//
@@ -317,6 +331,12 @@ type AzureManagedMachinePoolClassSpec struct {
 	// +optional
 	NodePublicIPPrefixID *string `json:"nodePublicIPPrefixID,omitempty"`
 
+	// HostGroupID is the resource ID of the dedicated host group the nodes are placed in. The host group must be in
+	// the location of the cluster and, if it is zonal, in the availability zones of the pool.
+	// Immutable.
+	// +optional
+	HostGroupID *string `json:"hostGroupID,omitempty"`
+
 	// ScaleSetPriority specifies the ScaleSetPriority value. Default to Regular. Possible values include: 'Regular', 'Spot'
 	// Immutable.
 	// +kubebuilder:validation:Enum=Regular;Spot
@@ -324,6 +344,8 @@ type AzureManagedMachinePoolClassSpec struct {
 	ScaleSetPriority *string `json:"scaleSetPriority,omitempty"`
 
 	// ScaleDownMode affects the cluster autoscaler behavior. Default to Delete. Possible values include: 'Deallocate', 'Delete'
+	// Deallocate stops the nodes scaled down instead of deleting them, which preserves their state and speeds up
+	// scaling up again. It isn't supported by Spot node pools.
 	// +kubebuilder:validation:Enum=Deallocate;Delete
 	// +kubebuilder:default=Delete
 	// +optional
@@ -424,6 +446,20 @@ type VnetClassSpec struct {
 	// Tags is a collection of tags describing the resource.
 	// +optional
 	Tags Tags `json:"tags,omitempty"`
+
+	// DDoSProtectionPlanID is the Azure resource ID of an existing DDoS Protection Standard plan
+	// to associate with the virtual network. Requires EnableDDoSProtection to be true.
+	// +optional
+	DDoSProtectionPlanID string `json:"ddosProtectionPlanID,omitempty"`
+
+	// EnableDDoSProtection indicates if DDoS protection is enabled for all the protected resources in the virtual network.
+	// +optional
+	EnableDDoSProtection *bool `json:"enableDDoSProtection,omitempty"`
+
+	// DNSServers is a list of IP addresses of custom DNS servers used by the virtual network.
+	// If empty, the virtual network uses the DNS servers provided by Azure.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
 }
 
 // SubnetClassSpec defines the SubnetSpec properties that may be shared across several Azure clusters.
@@ -432,7 +468,7 @@ type SubnetClassSpec struct {
 	Name string `json:"name"`
 
 	// Role defines the subnet role (eg. Node, ControlPlane)
-	// +kubebuilder:validation:Enum=node;control-plane;bastion
+	// +kubebuilder:validation:Enum=node;control-plane;bastion;firewall
 	Role SubnetRole `json:"role"`
 
 	// CIDRBlocks defines the subnet's address space, specified as one or more address prefixes in CIDR notation.
@@ -457,6 +493,36 @@ type LoadBalancerClassSpec struct {
 	// IdleTimeoutInMinutes specifies the timeout for the TCP idle connection.
 	// +optional
 	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
+	// EnableTCPReset sends a TCP reset to both ends of a connection of the load balancer's rules when it is closed
+	// after being idle for IdleTimeoutInMinutes.
+	// +optional
+	EnableTCPReset *bool `json:"enableTCPReset,omitempty"`
+	// EnableHAPorts configures the load balancing rule of the API server load balancer as an HA ports rule, which
+	// balances the flows of all protocols on all ports, e.g. for network virtual appliances behind the load balancer.
+	// It is only allowed on a Standard Internal load balancer.
+	// +optional
+	EnableHAPorts bool `json:"enableHAPorts,omitempty"`
+	// DisableOutboundSNAT disables the default outbound SNAT of the load balancing rule of the API server load
+	// balancer, so that outbound connectivity is provided by explicit outbound rules. Defaults to true. It cannot be
+	// set to false on a Public load balancer, whose outbound rule requires the default outbound SNAT to be disabled.
+	// +optional
+	DisableOutboundSNAT *bool `json:"disableOutboundSNAT,omitempty"`
+	// HealthProbe configures the health probe of the API server load balancer. Defaults to an Https probe of the
+	// "/readyz" path of the API server.
+	// +optional
+	HealthProbe *HealthProbe `json:"healthProbe,omitempty"`
+	// FrontendPort is the port clients connect to on the frontend of the API server load balancer. Its load balancing
+	// rule forwards the traffic to the API server port of the control plane machines, i.e. the Cluster's
+	// spec.clusterNetwork.apiServerPort or 6443. The port of the control plane endpoint defaults to it.
+	// Defaults to the API server port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	FrontendPort *int32 `json:"frontendPort,omitempty"`
+	// InboundNATRules configures the inbound NAT rules of the API server load balancer which give SSH access to each
+	// control plane machine. Changes only apply to the inbound NAT rules of new control plane machines.
+	// +optional
+	InboundNATRules *InboundNATRuleSettings `json:"inboundNATRules,omitempty"`
 }
 
 // FleetsMemberClassSpec defines the FleetsMemberSpec properties that may be shared across several Azure clusters.
@@ -476,6 +542,19 @@ type FleetsMemberClassSpec struct {
 type SecurityGroupClass struct {
 	// +optional
 	SecurityRules SecurityRules `json:"securityRules,omitempty"`
+	// RulesMode defines how SecurityRules are reconciled with the rules of the security group. "Managed" adds the
+	// SecurityRules and removes the rules previously applied by CAPZ which are no longer listed. "Additive" also replaces
+	// existing rules with the same name as one of the SecurityRules, and requires ReservedPriorities so that rules added
+	// outside of CAPZ are never removed and never collide with SecurityRules. Defaults to "Managed".
+	// +kubebuilder:validation:Enum=Managed;Additive
+	// +optional
+	RulesMode SecurityRulesMode `json:"rulesMode,omitempty"`
+	// ReservedPriorities is the range of priorities reserved for SecurityRules in the "Additive" RulesMode. Every rule
+	// of SecurityRules must use a priority of this range, and rules added to the security group outside of CAPZ must not.
+	// +optional
+	ReservedPriorities *SecurityRulePriorityRange `json:"reservedPriorities,omitempty"`
+	// Tags is a map of tags applied to the security group. They take precedence over the AdditionalTags of the
+	// AzureCluster with the same key.
 	// +optional
 	Tags Tags `json:"tags,omitempty"`
 }
@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -48,6 +49,21 @@ func (in *AADProfile) DeepCopy() *AADProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACRReference) DeepCopyInto(out *ACRReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACRReference.
+func (in *ACRReference) DeepCopy() *ACRReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ACRReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AKSExtension) DeepCopyInto(out *AKSExtension) {
 	*out = *in
@@ -348,6 +364,31 @@ func (in *AutoScalerProfile) DeepCopy() *AutoScalerProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailabilitySetSettings) DeepCopyInto(out *AvailabilitySetSettings) {
+	*out = *in
+	if in.PlatformFaultDomainCount != nil {
+		in, out := &in.PlatformFaultDomainCount, &out.PlatformFaultDomainCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PlatformUpdateDomainCount != nil {
+		in, out := &in.PlatformUpdateDomainCount, &out.PlatformUpdateDomainCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailabilitySetSettings.
+func (in *AvailabilitySetSettings) DeepCopy() *AvailabilitySetSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailabilitySetSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureBastion) DeepCopyInto(out *AzureBastion) {
 	*out = *in
@@ -515,6 +556,11 @@ func (in *AzureClusterIdentityList) DeepCopyObject() runtime.Object {
 func (in *AzureClusterIdentitySpec) DeepCopyInto(out *AzureClusterIdentitySpec) {
 	*out = *in
 	out.ClientSecret = in.ClientSecret
+	if in.ClientSecretKeyVaultRef != nil {
+		in, out := &in.ClientSecretKeyVaultRef, &out.ClientSecretKeyVaultRef
+		*out = new(KeyVaultSecretReference)
+		**out = **in
+	}
 	if in.AllowedNamespaces != nil {
 		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
 		*out = new(AllowedNamespaces)
@@ -591,6 +637,35 @@ func (in *AzureClusterSpec) DeepCopyInto(out *AzureClusterSpec) {
 	*out = *in
 	in.AzureClusterClassSpec.DeepCopyInto(&out.AzureClusterClassSpec)
 	in.NetworkSpec.DeepCopyInto(&out.NetworkSpec)
+	if in.PolicyAssignments != nil {
+		in, out := &in.PolicyAssignments, &out.PolicyAssignments
+		*out = make([]PolicyAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UserAssignedIdentities != nil {
+		in, out := &in.UserAssignedIdentities, &out.UserAssignedIdentities
+		*out = make([]ManagedIdentity, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetAppVolumes != nil {
+		in, out := &in.NetAppVolumes, &out.NetAppVolumes
+		*out = make([]NetAppVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskEncryptionSets != nil {
+		in, out := &in.DiskEncryptionSets, &out.DiskEncryptionSets
+		*out = make([]DiskEncryptionSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TagPropagationPolicy != nil {
+		in, out := &in.TagPropagationPolicy, &out.TagPropagationPolicy
+		*out = new(TagPropagationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	in.BastionSpec.DeepCopyInto(&out.BastionSpec)
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 }
@@ -627,6 +702,21 @@ func (in *AzureClusterStatus) DeepCopyInto(out *AzureClusterStatus) {
 		*out = make(Futures, len(*in))
 		copy(*out, *in)
 	}
+	if in.PublicIPs != nil {
+		in, out := &in.PublicIPs, &out.PublicIPs
+		*out = make([]PublicIPStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserAssignedIdentities != nil {
+		in, out := &in.UserAssignedIdentities, &out.UserAssignedIdentities
+		*out = make([]ManagedIdentityStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskEncryptionSets != nil {
+		in, out := &in.DiskEncryptionSets, &out.DiskEncryptionSets
+		*out = make([]DiskEncryptionSetStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureClusterStatus.
@@ -777,6 +867,34 @@ func (in *AzureComputeGalleryImage) DeepCopy() *AzureComputeGalleryImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureFirewall) DeepCopyInto(out *AzureFirewall) {
+	*out = *in
+	in.Subnet.DeepCopyInto(&out.Subnet)
+	if in.PublicIPsCount != nil {
+		in, out := &in.PublicIPsCount, &out.PublicIPsCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PublicIPs != nil {
+		in, out := &in.PublicIPs, &out.PublicIPs
+		*out = make([]PublicIPSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureFirewall.
+func (in *AzureFirewall) DeepCopy() *AzureFirewall {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureFirewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureMachine) DeepCopyInto(out *AzureMachine) {
 	*out = *in
@@ -872,6 +990,28 @@ func (in *AzureMachineSpec) DeepCopyInto(out *AzureMachineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AdditionalSSHPublicKeys != nil {
+		in, out := &in.AdditionalSSHPublicKeys, &out.AdditionalSSHPublicKeys
+		*out = make([]SSHPublicKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.WindowsConfiguration != nil {
+		in, out := &in.WindowsConfiguration, &out.WindowsConfiguration
+		*out = new(WindowsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootstrapDataSource != nil {
+		in, out := &in.BootstrapDataSource, &out.BootstrapDataSource
+		*out = new(BootstrapDataSource)
+		**out = **in
+	}
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]RegistryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AdditionalTags != nil {
 		in, out := &in.AdditionalTags, &out.AdditionalTags
 		*out = make(Tags, len(*in))
@@ -899,6 +1039,16 @@ func (in *AzureMachineSpec) DeepCopyInto(out *AzureMachineSpec) {
 		*out = new(SpotVMOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AvailabilitySetID != nil {
+		in, out := &in.AvailabilitySetID, &out.AvailabilitySetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilitySet != nil {
+		in, out := &in.AvailabilitySet, &out.AvailabilitySet
+		*out = new(AvailabilitySetSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SecurityProfile != nil {
 		in, out := &in.SecurityProfile, &out.SecurityProfile
 		*out = new(SecurityProfile)
@@ -1410,6 +1560,16 @@ func (in *AzureManagedControlPlaneClassSpec) DeepCopyInto(out *AzureManagedContr
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ACRReferences != nil {
+		in, out := &in.ACRReferences, &out.ACRReferences
+		*out = make([]ACRReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.AzureMonitorProfile != nil {
+		in, out := &in.AzureMonitorProfile, &out.AzureMonitorProfile
+		*out = new(AzureMonitorProfile)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedControlPlaneClassSpec.
@@ -1458,12 +1618,33 @@ func (in *AzureManagedControlPlaneList) DeepCopyObject() runtime.Object {
 func (in *AzureManagedControlPlaneSpec) DeepCopyInto(out *AzureManagedControlPlaneSpec) {
 	*out = *in
 	in.AzureManagedControlPlaneClassSpec.DeepCopyInto(&out.AzureManagedControlPlaneClassSpec)
+	if in.NodeResourceGroupTags != nil {
+		in, out := &in.NodeResourceGroupTags, &out.NodeResourceGroupTags
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PowerState != nil {
+		in, out := &in.PowerState, &out.PowerState
+		*out = new(ManagedControlPlanePowerState)
+		**out = **in
+	}
+	if in.CertificateRotation != nil {
+		in, out := &in.CertificateRotation, &out.CertificateRotation
+		*out = (*in).DeepCopy()
+	}
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	if in.SSHPublicKey != nil {
 		in, out := &in.SSHPublicKey, &out.SSHPublicKey
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdminUsername != nil {
+		in, out := &in.AdminUsername, &out.AdminUsername
+		*out = new(string)
+		**out = **in
+	}
 	if in.DNSPrefix != nil {
 		in, out := &in.DNSPrefix, &out.DNSPrefix
 		*out = new(string)
@@ -1506,6 +1687,10 @@ func (in *AzureManagedControlPlaneStatus) DeepCopyInto(out *AzureManagedControlP
 		*out = new(OIDCIssuerProfileStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastCertificateRotation != nil {
+		in, out := &in.LastCertificateRotation, &out.LastCertificateRotation
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedControlPlaneStatus.
@@ -1738,6 +1923,11 @@ func (in *AzureManagedMachinePoolClassSpec) DeepCopyInto(out *AzureManagedMachin
 		*out = new(string)
 		**out = **in
 	}
+	if in.HostGroupID != nil {
+		in, out := &in.HostGroupID, &out.HostGroupID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ScaleSetPriority != nil {
 		in, out := &in.ScaleSetPriority, &out.ScaleSetPriority
 		*out = new(string)
@@ -2007,6 +2197,46 @@ func (in *AzureMarketplaceImage) DeepCopy() *AzureMarketplaceImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureMonitorMetrics) DeepCopyInto(out *AzureMonitorMetrics) {
+	*out = *in
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMonitorMetrics.
+func (in *AzureMonitorMetrics) DeepCopy() *AzureMonitorMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureMonitorMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureMonitorProfile) DeepCopyInto(out *AzureMonitorProfile) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(AzureMonitorMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMonitorProfile.
+func (in *AzureMonitorProfile) DeepCopy() *AzureMonitorProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureMonitorProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureSharedGalleryImage) DeepCopyInto(out *AzureSharedGalleryImage) {
 	*out = *in
@@ -2205,6 +2435,11 @@ func (in *DataDisk) DeepCopyInto(out *DataDisk) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.WriteAcceleratorEnabled != nil {
+		in, out := &in.WriteAcceleratorEnabled, &out.WriteAcceleratorEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataDisk.
@@ -2217,6 +2452,36 @@ func (in *DataDisk) DeepCopy() *DataDisk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiagnosticSetting) DeepCopyInto(out *DiagnosticSetting) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]DiagnosticSettingTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogCategories != nil {
+		in, out := &in.LogCategories, &out.LogCategories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MetricCategories != nil {
+		in, out := &in.MetricCategories, &out.MetricCategories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiagnosticSetting.
+func (in *DiagnosticSetting) DeepCopy() *DiagnosticSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(DiagnosticSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Diagnostics) DeepCopyInto(out *Diagnostics) {
 	*out = *in
@@ -2252,6 +2517,31 @@ func (in *DiffDiskSettings) DeepCopy() *DiffDiskSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryptionSet) DeepCopyInto(out *DiskEncryptionSet) {
+	*out = *in
+	if in.UserAssignedIdentity != nil {
+		in, out := &in.UserAssignedIdentity, &out.UserAssignedIdentity
+		*out = new(UserAssignedIdentity)
+		**out = **in
+	}
+	if in.RotationToLatestKeyVersionEnabled != nil {
+		in, out := &in.RotationToLatestKeyVersionEnabled, &out.RotationToLatestKeyVersionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryptionSet.
+func (in *DiskEncryptionSet) DeepCopy() *DiskEncryptionSet {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryptionSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskEncryptionSetParameters) DeepCopyInto(out *DiskEncryptionSetParameters) {
 	*out = *in
@@ -2267,6 +2557,21 @@ func (in *DiskEncryptionSetParameters) DeepCopy() *DiskEncryptionSetParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryptionSetStatus) DeepCopyInto(out *DiskEncryptionSetStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryptionSetStatus.
+func (in *DiskEncryptionSetStatus) DeepCopy() *DiskEncryptionSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryptionSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtendedLocationSpec) DeepCopyInto(out *ExtendedLocationSpec) {
 	*out = *in
@@ -2351,6 +2656,11 @@ func (in *FrontendIP) DeepCopyInto(out *FrontendIP) {
 		*out = new(PublicIPSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GatewayLoadBalancer != nil {
+		in, out := &in.GatewayLoadBalancer, &out.GatewayLoadBalancer
+		*out = new(GatewayLoadBalancerReference)
+		**out = **in
+	}
 	out.FrontendIPClass = in.FrontendIPClass
 }
 
@@ -2413,6 +2723,21 @@ func (in Futures) DeepCopy() Futures {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayLoadBalancerReference) DeepCopyInto(out *GatewayLoadBalancerReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayLoadBalancerReference.
+func (in *GatewayLoadBalancerReference) DeepCopy() *GatewayLoadBalancerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayLoadBalancerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPProxyConfig) DeepCopyInto(out *HTTPProxyConfig) {
 	*out = *in
@@ -2448,6 +2773,31 @@ func (in *HTTPProxyConfig) DeepCopy() *HTTPProxyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthProbe) DeepCopyInto(out *HealthProbe) {
+	*out = *in
+	if in.IntervalInSeconds != nil {
+		in, out := &in.IntervalInSeconds, &out.IntervalInSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NumberOfProbes != nil {
+		in, out := &in.NumberOfProbes, &out.NumberOfProbes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProbe.
+func (in *HealthProbe) DeepCopy() *HealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPTag) DeepCopyInto(out *IPTag) {
 	*out = *in
@@ -2528,6 +2878,51 @@ func (in *ImagePlan) DeepCopy() *ImagePlan {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InboundNATPool) DeepCopyInto(out *InboundNATPool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InboundNATPool.
+func (in *InboundNATPool) DeepCopy() *InboundNATPool {
+	if in == nil {
+		return nil
+	}
+	out := new(InboundNATPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InboundNATRuleSettings) DeepCopyInto(out *InboundNATRuleSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InboundNATRuleSettings.
+func (in *InboundNATRuleSettings) DeepCopy() *InboundNATRuleSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(InboundNATRuleSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultSecretReference) DeepCopyInto(out *KeyVaultSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultSecretReference.
+func (in *KeyVaultSecretReference) DeepCopy() *KeyVaultSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 	*out = *in
@@ -2641,6 +3036,31 @@ func (in *LoadBalancerClassSpec) DeepCopyInto(out *LoadBalancerClassSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.EnableTCPReset != nil {
+		in, out := &in.EnableTCPReset, &out.EnableTCPReset
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableOutboundSNAT != nil {
+		in, out := &in.DisableOutboundSNAT, &out.DisableOutboundSNAT
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(HealthProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FrontendPort != nil {
+		in, out := &in.FrontendPort, &out.FrontendPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InboundNATRules != nil {
+		in, out := &in.InboundNATRules, &out.InboundNATRules
+		*out = new(InboundNATRuleSettings)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClassSpec.
@@ -2709,6 +3129,16 @@ func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 		**out = **in
 	}
 	out.BackendPool = in.BackendPool
+	if in.AdditionalBackendPools != nil {
+		in, out := &in.AdditionalBackendPools, &out.AdditionalBackendPools
+		*out = make([]BackendPool, len(*in))
+		copy(*out, *in)
+	}
+	if in.InboundNATPools != nil {
+		in, out := &in.InboundNATPools, &out.InboundNATPools
+		*out = make([]InboundNATPool, len(*in))
+		copy(*out, *in)
+	}
 	in.LoadBalancerClassSpec.DeepCopyInto(&out.LoadBalancerClassSpec)
 }
 
@@ -2808,6 +3238,36 @@ func (in *ManagedDiskParameters) DeepCopy() *ManagedDiskParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedIdentity) DeepCopyInto(out *ManagedIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedIdentity.
+func (in *ManagedIdentity) DeepCopy() *ManagedIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedIdentityStatus) DeepCopyInto(out *ManagedIdentityStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedIdentityStatus.
+func (in *ManagedIdentityStatus) DeepCopy() *ManagedIdentityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedIdentityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedMachinePoolScaling) DeepCopyInto(out *ManagedMachinePoolScaling) {
 	*out = *in
@@ -2865,6 +3325,37 @@ func (in *NatGatewayClassSpec) DeepCopy() *NatGatewayClassSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetAppCapacityPool) DeepCopyInto(out *NetAppCapacityPool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetAppCapacityPool.
+func (in *NetAppCapacityPool) DeepCopy() *NetAppCapacityPool {
+	if in == nil {
+		return nil
+	}
+	out := new(NetAppCapacityPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetAppVolume) DeepCopyInto(out *NetAppVolume) {
+	*out = *in
+	out.CapacityPool = in.CapacityPool
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetAppVolume.
+func (in *NetAppVolume) DeepCopy() *NetAppVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(NetAppVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkClassSpec) DeepCopyInto(out *NetworkClassSpec) {
 	*out = *in
@@ -2922,6 +3413,18 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = new(LoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AzureFirewall != nil {
+		in, out := &in.AzureFirewall, &out.AzureFirewall
+		*out = new(AzureFirewall)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiagnosticSettings != nil {
+		in, out := &in.DiagnosticSettings, &out.DiagnosticSettings
+		*out = make([]DiagnosticSetting, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.NetworkClassSpec = in.NetworkClassSpec
 }
 
@@ -3040,6 +3543,28 @@ func (in *OSDisk) DeepCopy() *OSDisk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyAssignment) DeepCopyInto(out *PolicyAssignment) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyAssignment.
+func (in *PolicyAssignment) DeepCopy() *PolicyAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpointSpec) DeepCopyInto(out *PrivateEndpointSpec) {
 	*out = *in
@@ -3121,6 +3646,11 @@ func (in *PublicIPSpec) DeepCopyInto(out *PublicIPSpec) {
 		*out = make([]IPTag, len(*in))
 		copy(*out, *in)
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPSpec.
@@ -3133,6 +3663,21 @@ func (in *PublicIPSpec) DeepCopy() *PublicIPSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPStatus) DeepCopyInto(out *PublicIPStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPStatus.
+func (in *PublicIPStatus) DeepCopy() *PublicIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitConfig) DeepCopyInto(out *RateLimitConfig) {
 	*out = *in
@@ -3174,9 +3719,56 @@ func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]Route, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
@@ -3189,6 +3781,21 @@ func (in *RouteTable) DeepCopy() *RouteTable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHPublicKey) DeepCopyInto(out *SSHPublicKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHPublicKey.
+func (in *SSHPublicKey) DeepCopy() *SSHPublicKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHPublicKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -3215,6 +3822,11 @@ func (in *SecurityGroupClass) DeepCopyInto(out *SecurityGroupClass) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReservedPriorities != nil {
+		in, out := &in.ReservedPriorities, &out.ReservedPriorities
+		*out = new(SecurityRulePriorityRange)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(Tags, len(*in))
@@ -3305,6 +3917,21 @@ func (in *SecurityRule) DeepCopy() *SecurityRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityRulePriorityRange) DeepCopyInto(out *SecurityRulePriorityRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRulePriorityRange.
+func (in *SecurityRulePriorityRange) DeepCopy() *SecurityRulePriorityRange {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityRulePriorityRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SecurityRules) DeepCopyInto(out *SecurityRules) {
 	{
@@ -3430,7 +4057,7 @@ func (in *SubnetClassSpec) DeepCopy() *SubnetClassSpec {
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
 	in.SecurityGroup.DeepCopyInto(&out.SecurityGroup)
-	out.RouteTable = in.RouteTable
+	in.RouteTable.DeepCopyInto(&out.RouteTable)
 	in.NatGateway.DeepCopyInto(&out.NatGateway)
 	in.SubnetClassSpec.DeepCopyInto(&out.SubnetClassSpec)
 }
@@ -3675,6 +4302,26 @@ func (in *SystemAssignedIdentityRole) DeepCopy() *SystemAssignedIdentityRole {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagPropagationPolicy) DeepCopyInto(out *TagPropagationPolicy) {
+	*out = *in
+	if in.ResourceTypes != nil {
+		in, out := &in.ResourceTypes, &out.ResourceTypes
+		*out = make([]TaggedResourceType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagPropagationPolicy.
+func (in *TagPropagationPolicy) DeepCopy() *TagPropagationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TagPropagationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Tags) DeepCopyInto(out *Tags) {
 	{
@@ -3849,6 +4496,16 @@ func (in *VnetClassSpec) DeepCopyInto(out *VnetClassSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.EnableDDoSProtection != nil {
+		in, out := &in.EnableDDoSProtection, &out.EnableDDoSProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VnetClassSpec.
@@ -4016,3 +4673,48 @@ func (in *VnetTemplateSpec) DeepCopy() *VnetTemplateSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WinRMListener) DeepCopyInto(out *WinRMListener) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WinRMListener.
+func (in *WinRMListener) DeepCopy() *WinRMListener {
+	if in == nil {
+		return nil
+	}
+	out := new(WinRMListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WindowsConfiguration) DeepCopyInto(out *WindowsConfiguration) {
+	*out = *in
+	if in.AdminPasswordSecretRef != nil {
+		in, out := &in.AdminPasswordSecretRef, &out.AdminPasswordSecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableAutomaticUpdates != nil {
+		in, out := &in.EnableAutomaticUpdates, &out.EnableAutomaticUpdates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WinRMListeners != nil {
+		in, out := &in.WinRMListeners, &out.WinRMListeners
+		*out = make([]WinRMListener, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WindowsConfiguration.
+func (in *WindowsConfiguration) DeepCopy() *WindowsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WindowsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -35,12 +35,50 @@ const (
 	// for annotation formatting rules.
 	ManagedClusterTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-tags-managedcluster"
 
+	// NodeResourceGroupTagsLastAppliedAnnotation is the key for the AzureManagedControlPlane
+	// object annotation which tracks the NodeResourceGroupTags for the node resource group of managed clusters.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	NodeResourceGroupTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-tags-noderesourcegroup"
+
+	// AKSOutboundTagsLastAppliedAnnotation is the key for the AzureManagedControlPlane
+	// object annotation which tracks the AdditionalTags for the outbound load balancer and
+	// public IPs AKS creates in the node resource group of managed clusters.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	AKSOutboundTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-tags-aks-outbound"
+
 	// SecurityRuleLastAppliedAnnotation is the key for the Azure Cluster
 	// object annotation which tracks the security rules for security groups.
 	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
 	// for annotation formatting rules.
 	SecurityRuleLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-security-rules"
 
+	// RouteLastAppliedAnnotation is the key for the AzureCluster object annotation
+	// which tracks the routes of route tables last applied by CAPZ, so that routes
+	// removed from the spec are removed from the route tables.
+	RouteLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-routes"
+
+	// BackendPoolLastAppliedAnnotation is the key for the AzureCluster object annotation
+	// which tracks the additional backend pools of load balancers last applied by CAPZ,
+	// so that pools removed from the spec are removed from the load balancers.
+	BackendPoolLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-backend-pools"
+
+	// InboundNATPoolLastAppliedAnnotation is the key for the AzureCluster object annotation
+	// which tracks the inbound NAT pools of load balancers last applied by CAPZ,
+	// so that pools removed from the spec are removed from the load balancers.
+	InboundNATPoolLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-inbound-nat-pools"
+
+	// PolicyAssignmentLastAppliedAnnotation is the key for the AzureCluster object annotation
+	// which tracks the policy assignments last applied by CAPZ, so that assignments removed
+	// from the spec are deleted.
+	PolicyAssignmentLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-policy-assignments"
+
+	// ACRAttachmentLastAppliedAnnotation is the key for the AzureManagedControlPlane object annotation
+	// which tracks the AcrPull role assignments last applied by CAPZ, so that they are removed when
+	// a registry is removed from the spec or the cluster is deleted.
+	ACRAttachmentLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-acr-attachments"
+
 	// CustomDataHashAnnotation is the key for the machine object annotation
 	// which tracks the hash of the custom data.
 	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
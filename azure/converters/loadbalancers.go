@@ -28,3 +28,28 @@ func SKUtoSDK(src infrav1.SKU) armnetwork.LoadBalancerSKUName {
 	}
 	return ""
 }
+
+// InboundNATPoolProtocolToSDK converts a CAPZ inbound NAT pool protocol to an Azure SDK transport protocol. It defaults
+// to TCP.
+func InboundNATPoolProtocolToSDK(src infrav1.InboundNATPoolProtocol) armnetwork.TransportProtocol {
+	switch src {
+	case infrav1.InboundNATPoolProtocolUDP:
+		return armnetwork.TransportProtocolUDP
+	case infrav1.InboundNATPoolProtocolAll:
+		return armnetwork.TransportProtocolAll
+	default:
+		return armnetwork.TransportProtocolTCP
+	}
+}
+
+// ProbeProtocolToSDK converts a CAPZ health probe protocol to an Azure SDK probe protocol.
+func ProbeProtocolToSDK(src infrav1.ProbeProtocol) armnetwork.ProbeProtocol {
+	switch src {
+	case infrav1.ProbeProtocolTCP:
+		return armnetwork.ProbeProtocolTCP
+	case infrav1.ProbeProtocolHTTP:
+		return armnetwork.ProbeProtocolHTTP
+	default:
+		return armnetwork.ProbeProtocolHTTPS
+	}
+}
@@ -52,3 +52,76 @@ func TestSKUtoSDK(t *testing.T) {
 		})
 	}
 }
+
+func TestInboundNATPoolProtocolToSDK(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol infrav1.InboundNATPoolProtocol
+		want     armnetwork.TransportProtocol
+	}{
+		{
+			name:     "tcp",
+			protocol: infrav1.InboundNATPoolProtocolTCP,
+			want:     armnetwork.TransportProtocolTCP,
+		},
+		{
+			name:     "udp",
+			protocol: infrav1.InboundNATPoolProtocolUDP,
+			want:     armnetwork.TransportProtocolUDP,
+		},
+		{
+			name:     "all",
+			protocol: infrav1.InboundNATPoolProtocolAll,
+			want:     armnetwork.TransportProtocolAll,
+		},
+		{
+			name:     "unset",
+			protocol: "",
+			want:     armnetwork.TransportProtocolTCP,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := InboundNATPoolProtocolToSDK(tt.protocol)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("InboundNATPoolProtocolToSDK(%s) mismatch (-want +got):\n%s", tt.name, diff)
+			}
+		})
+	}
+}
+
+func TestProbeProtocolToSDK(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol infrav1.ProbeProtocol
+		want     armnetwork.ProbeProtocol
+	}{
+		{
+			name:     "tcp",
+			protocol: infrav1.ProbeProtocolTCP,
+			want:     armnetwork.ProbeProtocolTCP,
+		},
+		{
+			name:     "http",
+			protocol: infrav1.ProbeProtocolHTTP,
+			want:     armnetwork.ProbeProtocolHTTP,
+		},
+		{
+			name:     "https",
+			protocol: infrav1.ProbeProtocolHTTPS,
+			want:     armnetwork.ProbeProtocolHTTPS,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := ProbeProtocolToSDK(tt.protocol)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ProbeProtocolToSDK(%s) mismatch (-want +got):\n%s", tt.name, diff)
+			}
+		})
+	}
+}
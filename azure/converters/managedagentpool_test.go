@@ -61,6 +61,9 @@ func Test_AgentPoolToManagedClusterAgentPoolProfile(t *testing.T) {
 					},
 					EnableFIPS:             ptr.To(true),
 					EnableEncryptionAtHost: ptr.To(true),
+					HostGroupReference: &genruntime.ResourceReference{
+						ARMID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-123/providers/Microsoft.Compute/hostGroups/hostgroup-123",
+					},
 				},
 			},
 
@@ -92,6 +95,9 @@ func Test_AgentPoolToManagedClusterAgentPoolProfile(t *testing.T) {
 					},
 					EnableFIPS:             ptr.To(true),
 					EnableEncryptionAtHost: ptr.To(true),
+					HostGroupReference: &genruntime.ResourceReference{
+						ARMID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-123/providers/Microsoft.Compute/hostGroups/hostgroup-123",
+					},
 				}))
 			},
 		},
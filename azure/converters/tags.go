@@ -17,6 +17,10 @@ limitations under the License.
 package converters
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -41,3 +45,45 @@ func MapToTags(src map[string]*string) infrav1.Tags {
 func TagsToMap(src infrav1.Tags) map[string]*string {
 	return azure.StringMapPtr(src)
 }
+
+// TagsToAnnotation converts infrav1.Tags into the map stored as JSON in a last-applied tags annotation.
+func TagsToAnnotation(src infrav1.Tags) map[string]interface{} {
+	annotation := make(map[string]interface{}, len(src))
+	for k, v := range src {
+		annotation[k] = v
+	}
+	return annotation
+}
+
+// AnnotationToTags converts a last-applied tags annotation decoded from JSON into infrav1.Tags.
+// Tag values are always strings in Azure, but an annotation written by hand or by another tool may
+// hold JSON numbers or booleans. Those are normalized to the string Azure would store for them, so
+// that they compare equal to the tags on the resource, e.g. 3 becomes "3" rather than "3e+00".
+func AnnotationToTags(src map[string]interface{}) infrav1.Tags {
+	if src == nil {
+		return nil
+	}
+
+	tags := make(infrav1.Tags, len(src))
+	for k, v := range src {
+		tags[k] = annotationValueToString(v)
+	}
+	return tags
+}
+
+func annotationValueToString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
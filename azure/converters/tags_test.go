@@ -17,6 +17,7 @@ limitations under the License.
 package converters
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/onsi/gomega"
@@ -149,3 +150,66 @@ func Test_MapToTagsMapRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func Test_AnnotationToTags(t *testing.T) {
+	cases := []struct {
+		name       string
+		annotation map[string]interface{}
+		expect     infrav1.Tags
+	}{
+		{
+			name:       "nil",
+			annotation: nil,
+			expect:     nil,
+		},
+		{
+			name:       "strings",
+			annotation: map[string]interface{}{"env": "prod", "count": "3"},
+			expect:     infrav1.Tags{"env": "prod", "count": "3"},
+		},
+		{
+			name: "json values",
+			annotation: map[string]interface{}{
+				"count":   float64(3),
+				"ratio":   0.5,
+				"account": float64(12345678901),
+				"enabled": true,
+				"empty":   nil,
+				"number":  json.Number("0042"),
+			},
+			expect: infrav1.Tags{
+				"count":   "3",
+				"ratio":   "0.5",
+				"account": "12345678901",
+				"enabled": "true",
+				"empty":   "",
+				"number":  "0042",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(AnnotationToTags(c.annotation)).To(gomega.Equal(c.expect))
+		})
+	}
+}
+
+func Test_TagsAnnotationRoundTrip(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	tags := infrav1.Tags{"count": "3", "account": "12345678901", "version": "1.0", "env": "prod"}
+
+	b, err := json.Marshal(TagsToAnnotation(tags))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	annotation := map[string]interface{}{}
+	g.Expect(json.Unmarshal(b, &annotation)).To(gomega.Succeed())
+	g.Expect(AnnotationToTags(annotation)).To(gomega.Equal(tags))
+
+	// A hand-written annotation with JSON numbers converts to the same tags.
+	annotation = map[string]interface{}{}
+	g.Expect(json.Unmarshal([]byte(`{"count":3,"account":12345678901,"version":"1.0","env":"prod"}`), &annotation)).To(gomega.Succeed())
+	g.Expect(AnnotationToTags(annotation)).To(gomega.Equal(tags))
+}
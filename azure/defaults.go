@@ -24,13 +24,17 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	"sigs.k8s.io/cluster-api-provider-azure/version"
 )
 
 const (
 	// DefaultUserName is the default username for a created VM.
-	DefaultUserName = "capi"
+	DefaultUserName = infrav1.VMAdminUsername
 	// DefaultAKSUserName is the default username for a created AKS VM.
 	DefaultAKSUserName = "azureuser"
 	// PublicCloudName is the name of the Azure public cloud.
@@ -64,6 +68,12 @@ const (
 	BootstrappingExtensionLinux = "CAPZ.Linux.Bootstrapping"
 	// BootstrappingExtensionWindows is the name of the Windows CAPZ bootstrapping VM extension.
 	BootstrappingExtensionWindows = "CAPZ.Windows.Bootstrapping"
+	// ApplicationHealthExtensionLinux is the name of the Linux application health VM extension.
+	ApplicationHealthExtensionLinux = "ApplicationHealthLinux"
+	// ApplicationHealthExtensionWindows is the name of the Windows application health VM extension.
+	ApplicationHealthExtensionWindows = "ApplicationHealthWindows"
+	// CustomScriptExtensionLinux is the name of the Linux Custom Script VM extension.
+	CustomScriptExtensionLinux = "CustomScript"
 )
 
 const (
@@ -255,6 +265,11 @@ func NetworkInterfaceID(subscriptionID, resourceGroup, nicName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s", subscriptionID, resourceGroup, nicName)
 }
 
+// LoadBalancerID returns the azure resource ID for a given load balancer.
+func LoadBalancerID(subscriptionID, resourceGroup, loadBalancerName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s", subscriptionID, resourceGroup, loadBalancerName)
+}
+
 // FrontendIPConfigID returns the azure resource ID for a given frontend IP config.
 func FrontendIPConfigID(subscriptionID, resourceGroup, loadBalancerName, configName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/frontendIPConfigurations/%s", subscriptionID, resourceGroup, loadBalancerName, configName)
@@ -265,6 +280,11 @@ func AddressPoolID(subscriptionID, resourceGroup, loadBalancerName, backendPoolN
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/backendAddressPools/%s", subscriptionID, resourceGroup, loadBalancerName, backendPoolName)
 }
 
+// InboundNATPoolID returns the azure resource ID for a given inbound NAT pool.
+func InboundNATPoolID(subscriptionID, resourceGroup, loadBalancerName, inboundNATPoolName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/inboundNatPools/%s", subscriptionID, resourceGroup, loadBalancerName, inboundNATPoolName)
+}
+
 // ProbeID returns the azure resource ID for a given probe.
 func ProbeID(subscriptionID, resourceGroup, loadBalancerName, probeName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/loadBalancers/%s/probes/%s", subscriptionID, resourceGroup, loadBalancerName, probeName)
@@ -300,6 +320,16 @@ func FleetID(subscriptionID, resourceGroup, fleetName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s", subscriptionID, resourceGroup, fleetName)
 }
 
+// AzureMonitorWorkspaceID returns the azure resource ID for a given Azure Monitor workspace.
+func AzureMonitorWorkspaceID(subscriptionID, resourceGroup, workspaceName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Monitor/accounts/%s", subscriptionID, resourceGroup, workspaceName)
+}
+
+// DataCollectionRuleID returns the azure resource ID for a given data collection rule.
+func DataCollectionRuleID(subscriptionID, resourceGroup, dataCollectionRuleName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/dataCollectionRules/%s", subscriptionID, resourceGroup, dataCollectionRuleName)
+}
+
 // GetBootstrappingVMExtension returns the CAPZ Bootstrapping VM extension.
 // The CAPZ Bootstrapping extension is a simple clone of https://github.com/Azure/custom-script-extension-linux for Linux or
 // https://learn.microsoft.com/azure/virtual-machines/extensions/custom-script-windows for Windows.
@@ -343,6 +373,21 @@ func GetBootstrappingVMExtension(osType string, cloud string, vmName string, cpu
 	return nil
 }
 
+// GetCustomScriptBootstrapVMExtension returns the Custom Script VM extension which runs the base64 encoded bootstrap
+// data as a script, for images which don't run cloud-init. See
+// https://learn.microsoft.com/azure/virtual-machines/extensions/custom-script-linux.
+func GetCustomScriptBootstrapVMExtension(vmName string, bootstrapData string) *ExtensionSpec {
+	return &ExtensionSpec{
+		Name:      CustomScriptExtensionLinux,
+		VMName:    vmName,
+		Publisher: "Microsoft.Azure.Extensions",
+		Version:   "2.1",
+		ProtectedSettings: map[string]string{
+			"script": bootstrapData,
+		},
+	}
+}
+
 // UserAgent specifies a string to append to the agent identifier.
 func UserAgent() string {
 	return fmt.Sprintf("cluster-api-provider-azure/%s", version.Get().String())
@@ -367,6 +412,8 @@ func ARMClientOptions(azureEnvironment string, extraPolicies ...policy.Policy) (
 	opts.PerCallPolicies = []policy.Policy{
 		correlationIDPolicy{},
 		userAgentPolicy{},
+		metricsPolicy{metrics: apiMetrics},
+		tracingPolicy{},
 	}
 	opts.PerCallPolicies = append(opts.PerCallPolicies, extraPolicies...)
 	opts.Retry.MaxRetries = -1 // Less than zero means one try and no retries.
@@ -396,6 +443,34 @@ func (p userAgentPolicy) Do(req *policy.Request) (*http.Response, error) {
 	return req.Next()
 }
 
+// tracingPolicy starts a span for each request.
+// It implements the policy.Policy interface.
+type tracingPolicy struct{}
+
+// Do starts a span named after the HTTP method and Azure resource type of a request, e.g.
+// "GET microsoft.network/virtualnetworks", and records the error on the span if the request fails.
+func (p tracingPolicy) Do(req *policy.Request) (*http.Response, error) {
+	service := serviceFromPath(req.Raw().URL.Path)
+	ctx, span := tele.Tracer().Start(req.Raw().Context(), req.Raw().Method+" "+service,
+		trace.WithAttributes(
+			attribute.String("http.method", req.Raw().Method),
+			attribute.String("azure.service", service),
+		),
+	)
+	defer span.End()
+
+	resp, err := req.WithContext(ctx).Next()
+	if err != nil {
+		tele.RecordError(ctx, err)
+		return resp, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
+
 // CustomPutPatchHeaderPolicy adds custom headers to a PUT or PATCH request.
 // It implements the policy.Policy interface.
 type CustomPutPatchHeaderPolicy struct {
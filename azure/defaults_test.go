@@ -27,6 +27,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/mock/gomock"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
@@ -80,7 +83,7 @@ func TestARMClientOptions(t *testing.T) {
 			g.Expect(err).NotTo(HaveOccurred())
 			g.Expect(opts.Cloud).To(Equal(tc.expectedCloud))
 			g.Expect(opts.Retry.MaxRetries).To(BeNumerically("==", -1))
-			g.Expect(opts.PerCallPolicies).To(HaveLen(2))
+			g.Expect(opts.PerCallPolicies).To(HaveLen(4))
 		})
 	}
 }
@@ -98,12 +101,14 @@ func TestPerCallPolicies(t *testing.T) {
 	}))
 	defer server.Close()
 
-	// Call the factory function and ensure it has both PerCallPolicies.
+	// Call the factory function and ensure it has all PerCallPolicies.
 	opts, err := ARMClientOptions("")
 	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(opts.PerCallPolicies).To(HaveLen(2))
+	g.Expect(opts.PerCallPolicies).To(HaveLen(4))
 	g.Expect(opts.PerCallPolicies).To(ContainElement(BeAssignableToTypeOf(correlationIDPolicy{})))
 	g.Expect(opts.PerCallPolicies).To(ContainElement(BeAssignableToTypeOf(userAgentPolicy{})))
+	g.Expect(opts.PerCallPolicies).To(ContainElement(BeAssignableToTypeOf(metricsPolicy{})))
+	g.Expect(opts.PerCallPolicies).To(ContainElement(BeAssignableToTypeOf(tracingPolicy{})))
 
 	// Create a request with a correlation ID.
 	ctx := context.WithValue(context.Background(), tele.CorrIDKeyVal, tele.CorrID(corrID))
@@ -202,6 +207,43 @@ func TestCustomPutPatchHeaderPolicy(t *testing.T) {
 	}
 }
 
+func TestTracingPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tele.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer tele.SetTracerProvider(nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx, span := tele.Tracer().Start(context.Background(), "parent")
+	pipeline := defaultTestPipeline([]policy.Policy{tracingPolicy{}})
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		req, err := runtime.NewRequest(ctx, method, server.URL+"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk")
+		g.Expect(err).NotTo(HaveOccurred())
+		resp, err := pipeline.Do(req)
+		g.Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+	}
+	span.End()
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(3))
+	g.Expect(spans[0].Name).To(Equal("GET microsoft.compute/disks"))
+	g.Expect(spans[0].Status.Code).To(Equal(codes.Unset))
+	g.Expect(spans[0].Parent.SpanID()).To(Equal(spans[2].SpanContext.SpanID()))
+	g.Expect(spans[1].Name).To(Equal("DELETE microsoft.compute/disks"))
+	g.Expect(spans[1].Status.Code).To(Equal(codes.Error))
+	g.Expect(spans[1].Parent.SpanID()).To(Equal(spans[2].SpanContext.SpanID()))
+}
+
 func defaultTestPipeline(policies []policy.Policy) runtime.Pipeline {
 	return runtime.NewPipeline(
 		"testmodule",
@@ -277,3 +319,16 @@ func TestGetBootstrappingVMExtension(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCustomScriptBootstrapVMExtension(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(GetCustomScriptBootstrapVMExtension("test-vm", "ZmFrZS1ib290c3RyYXAtZGF0YQ==")).To(Equal(&ExtensionSpec{
+		Name:      "CustomScript",
+		VMName:    "test-vm",
+		Publisher: "Microsoft.Azure.Extensions",
+		Version:   "2.1",
+		ProtectedSettings: map[string]string{
+			"script": "ZmFrZS1ib290c3RyYXAtZGF0YQ==",
+		},
+	}))
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+)
+
+// ErrorCodeOverrides maps patterns of Azure error codes, e.g. "InternalServerError" or "Quota*", to whether errors
+// with a matching code are transient or terminal. The patterns use the syntax of path.Match and are matched
+// case-insensitively. When several patterns match an error code, the longest one is used.
+type ErrorCodeOverrides map[string]ReconcileErrorType
+
+// errorCodeOverrides are the overrides of the default classification of Azure errors configured at startup.
+var errorCodeOverrides atomic.Pointer[ErrorCodeOverrides]
+
+// ParseErrorCodeOverrides parses error code overrides from patterns of Azure error codes mapped to "Transient" or
+// "Terminal". It returns an error if a pattern is malformed or is mapped to any other value.
+func ParseErrorCodeOverrides(overrides map[string]string) (ErrorCodeOverrides, error) {
+	parsed := make(ErrorCodeOverrides, len(overrides))
+	for pattern, errorType := range overrides {
+		if pattern == "" {
+			return nil, errors.New("error code pattern must not be empty")
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid error code pattern %q: %w", pattern, err)
+		}
+		switch {
+		case strings.EqualFold(errorType, string(TransientErrorType)):
+			parsed[strings.ToLower(pattern)] = TransientErrorType
+		case strings.EqualFold(errorType, string(TerminalErrorType)):
+			parsed[strings.ToLower(pattern)] = TerminalErrorType
+		default:
+			return nil, fmt.Errorf("invalid classification %q of error code pattern %q: must be %s or %s", errorType, pattern, TransientErrorType, TerminalErrorType)
+		}
+	}
+	return parsed, nil
+}
+
+// SetErrorCodeOverrides sets the overrides of the default classification of Azure errors.
+func SetErrorCodeOverrides(overrides ErrorCodeOverrides) {
+	errorCodeOverrides.Store(&overrides)
+}
+
+// Lookup returns the classification of the longest pattern matching an Azure error code. The second return value is
+// false if no pattern matches.
+func (o ErrorCodeOverrides) Lookup(code string) (ReconcileErrorType, bool) {
+	if code == "" || len(o) == 0 {
+		return "", false
+	}
+	code = strings.ToLower(code)
+	patterns := make([]string, 0, len(o))
+	for pattern := range o {
+		patterns = append(patterns, pattern)
+	}
+	// Sort by decreasing length, then alphabetically, so the most specific pattern is used deterministically.
+	sort.Slice(patterns, func(i, j int) bool {
+		if len(patterns[i]) != len(patterns[j]) {
+			return len(patterns[i]) > len(patterns[j])
+		}
+		return patterns[i] < patterns[j]
+	})
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, code); ok {
+			return o[pattern], true
+		}
+	}
+	return "", false
+}
+
+// ErrorCodeOverride returns whether the configured overrides classify the Azure error code as transient or terminal.
+// The second return value is false if no override matches, in which case the default classification applies.
+func ErrorCodeOverride(code string) (ReconcileErrorType, bool) {
+	overrides := errorCodeOverrides.Load()
+	if overrides == nil {
+		return "", false
+	}
+	return overrides.Lookup(code)
+}
+
+// ErrorCode returns the Azure error code of an error returned by the Azure SDK, or an empty string if it has none.
+func ErrorCode(err error) string {
+	var rerr *azcore.ResponseError
+	if errors.As(err, &rerr) {
+		return rerr.ErrorCode
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func TestParseErrorCodeOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		want      ErrorCodeOverrides
+		wantErr   bool
+	}{
+		{
+			name:      "no overrides",
+			overrides: nil,
+			want:      ErrorCodeOverrides{},
+		},
+		{
+			name:      "transient and terminal overrides",
+			overrides: map[string]string{"InternalServerError": "Transient", "Quota*": "terminal"},
+			want:      ErrorCodeOverrides{"internalservererror": TransientErrorType, "quota*": TerminalErrorType},
+		},
+		{
+			name:      "malformed pattern",
+			overrides: map[string]string{"Quota[": "Terminal"},
+			wantErr:   true,
+		},
+		{
+			name:      "empty pattern",
+			overrides: map[string]string{"": "Terminal"},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid classification",
+			overrides: map[string]string{"InternalServerError": "Retry"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := ParseErrorCodeOverrides(tt.overrides)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(got).To(Equal(tt.want))
+			}
+		})
+	}
+}
+
+func TestErrorCodeOverridesLookup(t *testing.T) {
+	overrides := ErrorCodeOverrides{
+		"internalservererror": TransientErrorType,
+		"quota*":              TerminalErrorType,
+		"quotaexceeded":       TransientErrorType,
+	}
+	tests := []struct {
+		name      string
+		code      string
+		want      ReconcileErrorType
+		wantFound bool
+	}{
+		{
+			name:      "override to transient",
+			code:      "InternalServerError",
+			want:      TransientErrorType,
+			wantFound: true,
+		},
+		{
+			name:      "override to terminal",
+			code:      "QuotaLimitReached",
+			want:      TerminalErrorType,
+			wantFound: true,
+		},
+		{
+			name:      "longest matching pattern wins",
+			code:      "QuotaExceeded",
+			want:      TransientErrorType,
+			wantFound: true,
+		},
+		{
+			name:      "no matching pattern",
+			code:      "AllocationFailed",
+			wantFound: false,
+		},
+		{
+			name:      "no error code",
+			code:      "",
+			wantFound: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, found := overrides.Lookup(tt.code)
+			g.Expect(found).To(Equal(tt.wantFound))
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(ErrorCode(errors.Wrap(&azcore.ResponseError{ErrorCode: "InternalServerError"}, "failed"))).To(Equal("InternalServerError"))
+	g.Expect(ErrorCode(errors.New("not an Azure error"))).To(BeEmpty())
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -33,6 +34,45 @@ func ResourceNotFound(err error) bool {
 	return hasStatusCode(err, http.StatusNotFound)
 }
 
+// IsThrottled parses an error to check if its status code is Too Many Requests (429).
+func IsThrottled(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// RetryAfter returns how long Azure asked to wait before retrying a request through the Retry-After header of the
+// response in an error. Both the delay-seconds and HTTP-date forms of the header are supported. The second return
+// value is false if the error has no response or the header is missing, invalid or not in the future.
+func RetryAfter(err error) (time.Duration, bool) {
+	var resp *http.Response
+	derr := autorest.DetailedError{} // azure-sdk-for-go v1
+	var rerr *azcore.ResponseError   // azure-sdk-for-go v2
+	switch {
+	case errors.As(err, &derr):
+		resp = derr.Response
+	case errors.As(err, &rerr):
+		resp = rerr.RawResponse
+	}
+	if resp == nil {
+		return 0, false
+	}
+
+	retryAfter := resp.Header.Get("Retry-After")
+	if retryAfter == "" {
+		return 0, false
+	}
+	var d time.Duration
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		d = time.Duration(seconds) * time.Second
+	} else if t, err := http.ParseTime(retryAfter); err == nil {
+		d = time.Until(t)
+	}
+	// A zero requeue would mean not retrying at all, so only honor a delay that lies in the future.
+	if d <= 0 {
+		return 0, false
+	}
+	return d, true
+}
+
 // hasStatusCode returns true if an error is a DetailedError or ResponseError with a matching status code.
 func hasStatusCode(err error, statusCode int) bool {
 	derr := autorest.DetailedError{} // azure-sdk-for-go v1
@@ -150,6 +190,12 @@ func IsOperationNotDoneError(target error) bool {
 	return errors.As(target, &OperationNotDoneError{})
 }
 
+// IsTransientError returns true if the target is a transient ReconcileError.
+func IsTransientError(target error) bool {
+	reconcileErr := &ReconcileError{}
+	return errors.As(target, reconcileErr) && reconcileErr.IsTransient()
+}
+
 // IsContextDeadlineExceededOrCanceledError checks if it's a context deadline
 // exceeded or canceled error.
 func IsContextDeadlineExceededOrCanceledError(err error) bool {
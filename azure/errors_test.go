@@ -113,3 +113,170 @@ func TestResourceNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryAfter(t *testing.T) {
+	responseWithRetryAfter := func(retryAfter string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{retryAfter}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		want    time.Duration
+		wantAny bool
+		ok      bool
+	}{
+		{
+			name: "seconds in response error",
+			err:  &azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: responseWithRetryAfter("30")},
+			want: 30 * time.Second,
+			ok:   true,
+		},
+		{
+			name: "seconds in detailed error",
+			err:  autorest.DetailedError{StatusCode: http.StatusTooManyRequests, Response: responseWithRetryAfter("45")},
+			want: 45 * time.Second,
+			ok:   true,
+		},
+		{
+			name: "seconds in wrapped response error",
+			err:  errors.Wrap(&azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: responseWithRetryAfter("5")}, "throttled"),
+			want: 5 * time.Second,
+			ok:   true,
+		},
+		{
+			name:    "HTTP-date",
+			err:     &azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: responseWithRetryAfter(time.Now().Add(2 * time.Minute).UTC().Format(http.TimeFormat))},
+			wantAny: true,
+			ok:      true,
+		},
+		{
+			name: "HTTP-date in the past",
+			err:  &azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: responseWithRetryAfter(time.Now().Add(-2 * time.Minute).UTC().Format(http.TimeFormat))},
+			ok:   false,
+		},
+		{
+			name: "zero seconds",
+			err:  &azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: responseWithRetryAfter("0")},
+			ok:   false,
+		},
+		{
+			name: "invalid header",
+			err:  &azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: responseWithRetryAfter("soon")},
+			ok:   false,
+		},
+		{
+			name: "missing header",
+			err:  &azcore.ResponseError{StatusCode: http.StatusTooManyRequests, RawResponse: &http.Response{StatusCode: http.StatusTooManyRequests}},
+			ok:   false,
+		},
+		{
+			name: "missing response",
+			err:  &azcore.ResponseError{StatusCode: http.StatusTooManyRequests},
+			ok:   false,
+		},
+		{
+			name: "generic error",
+			err:  errors.New("429: Too Many Requests"),
+			ok:   false,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := RetryAfter(tc.err)
+			if ok != tc.ok {
+				t.Fatalf("RetryAfter() ok = %v, want %v", ok, tc.ok)
+			}
+			switch {
+			case tc.wantAny:
+				// HTTP-dates have a resolution of one second.
+				if got <= time.Minute || got > 2*time.Minute {
+					t.Errorf("RetryAfter() = %v, want about 2m", got)
+				}
+			case got != tc.want:
+				t.Errorf("RetryAfter() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsThrottled(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "Too Many Requests response error",
+			err:  &azcore.ResponseError{StatusCode: http.StatusTooManyRequests},
+			want: true,
+		},
+		{
+			name: "Too Many Requests detailed error",
+			err:  autorest.DetailedError{StatusCode: http.StatusTooManyRequests},
+			want: true,
+		},
+		{
+			name: "Conflict response error",
+			err:  &azcore.ResponseError{StatusCode: http.StatusConflict},
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsThrottled(tc.err); got != tc.want {
+				t.Errorf("IsThrottled() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "transient error",
+			err:  WithTransientError(errors.New("boom"), time.Second),
+			want: true,
+		},
+		{
+			name: "wrapped transient error",
+			err:  errors.Wrap(WithTransientError(errors.New("boom"), time.Second), "failed"),
+			want: true,
+		},
+		{
+			name: "operation not done error",
+			err:  WithTransientError(NewOperationNotDoneError(nil), time.Second),
+			want: true,
+		},
+		{
+			name: "terminal error",
+			err:  WithTerminalError(errors.New("boom")),
+			want: false,
+		},
+		{
+			name: "plain error",
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := IsTransientError(tc.err); got != tc.want {
+				t.Errorf("IsTransientError() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
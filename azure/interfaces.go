@@ -39,6 +39,21 @@ type Pauser interface {
 	Pause(context.Context) error
 }
 
+// PauseDescriber may be implemented by a scope whose owner can have its reconciliation paused, e.g. with
+// the Cluster API paused annotation. Services may still read the state of Azure resources while the
+// owner is paused, but should not create or update them.
+type PauseDescriber interface {
+	IsPaused() bool
+}
+
+// DryRunner may be implemented by a scope whose owner can be reconciled in dry-run mode. Services may still
+// read the state of Azure resources in dry-run mode, but should record the changes they would make with
+// RecordPlannedChange instead of creating, updating, or deleting resources.
+type DryRunner interface {
+	IsDryRun() bool
+	RecordPlannedChange(serviceName, change string)
+}
+
 // ServiceReconciler is an Azure service reconciler which can reconcile an Azure service.
 type ServiceReconciler interface {
 	Name() string
@@ -76,6 +91,7 @@ type NetworkDescriber interface {
 	GetPrivateDNSZoneName() string
 	OutboundLBName(string) string
 	OutboundPoolName(string) string
+	OutboundLBInboundNATPools(string) []infrav1.InboundNATPool
 }
 
 // ClusterDescriber is an interface which can get common Azure Cluster information.
@@ -108,8 +124,10 @@ type AsyncStatusUpdater interface {
 // AsyncReconciler is an interface used to get the default timeouts and requeue time for a reconciler that reconciles services asynchronously.
 type AsyncReconciler interface {
 	DefaultedAzureCallTimeout() time.Duration
-	DefaultedAzureServiceReconcileTimeout() time.Duration
+	AzureServiceReconcileTimeout(serviceName string) time.Duration
 	DefaultedReconcilerRequeue() time.Duration
+	BackoffReconcilerRequeue(serviceName, key string) time.Duration
+	ResetReconcilerRequeueBackoff(serviceName, key string)
 }
 
 // ClusterScoper combines the ClusterDescriber and NetworkDescriber interfaces.
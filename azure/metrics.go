@@ -0,0 +1,149 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "capz"
+	metricsSubsystem = "azure_api"
+
+	// unknownService is the service label of requests whose Azure resource type cannot be determined.
+	unknownService = "unknown"
+	// errorResult is the result label of requests which failed without an HTTP response.
+	errorResult = "error"
+)
+
+// APIMetrics are the Prometheus metrics recorded for Azure API calls. The service label is the lowercase Azure
+// resource type, e.g. "microsoft.network/virtualnetworks", the operation label is the HTTP method, and the result
+// label is the HTTP status code. Resource names are never used as labels to keep the cardinality bounded.
+type APIMetrics struct {
+	Requests  *prometheus.CounterVec
+	Latency   *prometheus.HistogramVec
+	Throttled *prometheus.CounterVec
+}
+
+// NewAPIMetrics returns a new set of unregistered APIMetrics.
+func NewAPIMetrics() *APIMetrics {
+	return &APIMetrics{
+		Requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Number of Azure API calls by service, operation, and result.",
+		}, []string{"service", "operation", "result"}),
+		Latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of Azure API calls by service and operation.",
+			Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+		}, []string{"service", "operation"}),
+		Throttled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "throttled_requests_total",
+			Help:      "Number of Azure API calls rejected with 429 Too Many Requests by service and operation.",
+		}, []string{"service", "operation"}),
+	}
+}
+
+// Register registers the metrics with the given registerer.
+func (m *APIMetrics) Register(r prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{m.Requests, m.Latency, m.Throttled} {
+		if err := r.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apiMetrics are recorded for all clients created with ARMClientOptions and exposed on the controller-runtime
+// metrics endpoint.
+var apiMetrics = NewAPIMetrics()
+
+func init() {
+	if err := apiMetrics.Register(metrics.Registry); err != nil {
+		panic(err)
+	}
+}
+
+// metricsPolicy records APIMetrics for requests.
+// It implements the policy.Policy interface.
+type metricsPolicy struct {
+	metrics *APIMetrics
+}
+
+// Do records the result and latency of a request, and whether it was throttled.
+func (p metricsPolicy) Do(req *policy.Request) (*http.Response, error) {
+	service := serviceFromPath(req.Raw().URL.Path)
+	operation := req.Raw().Method
+
+	start := time.Now()
+	resp, err := req.Next()
+	p.metrics.Latency.WithLabelValues(service, operation).Observe(time.Since(start).Seconds())
+
+	result := errorResult
+	if resp != nil {
+		result = strconv.Itoa(resp.StatusCode)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			p.metrics.Throttled.WithLabelValues(service, operation).Inc()
+		}
+	}
+	p.metrics.Requests.WithLabelValues(service, operation, result).Inc()
+
+	return resp, err
+}
+
+// serviceFromPath returns the lowercase Azure resource type addressed by an ARM request path, e.g.
+// "microsoft.network/virtualnetworks/subnets" for a subnet or a list of subnets.
+func serviceFromPath(path string) string {
+	segments := strings.Split(strings.Trim(strings.ToLower(path), "/"), "/")
+
+	providers := -1
+	for i, segment := range segments {
+		if segment == "providers" {
+			providers = i
+		}
+	}
+	if providers < 0 || providers+2 >= len(segments) {
+		switch {
+		case len(segments) >= 3 && segments[2] == "resourcegroups":
+			return "microsoft.resources/resourcegroups"
+		case len(segments) >= 1 && segments[0] == "subscriptions":
+			return "microsoft.resources/subscriptions"
+		default:
+			return unknownService
+		}
+	}
+
+	// The resource types follow the provider namespace and alternate with resource names.
+	resourceType := segments[providers+1]
+	for i := providers + 2; i < len(segments); i += 2 {
+		resourceType += "/" + segments[i]
+	}
+	return resourceType
+}
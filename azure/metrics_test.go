@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetricsPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	m := NewAPIMetrics()
+	registry := prometheus.NewRegistry()
+	g.Expect(m.Register(registry)).To(Succeed())
+
+	pipeline := defaultTestPipeline([]policy.Policy{metricsPolicy{metrics: m}})
+	vnetPath := "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet"
+	for _, method := range []string{http.MethodGet, http.MethodGet, http.MethodPut} {
+		req, err := runtime.NewRequest(context.Background(), method, server.URL+vnetPath)
+		g.Expect(err).NotTo(HaveOccurred())
+		resp, err := pipeline.Do(req)
+		g.Expect(err).NotTo(HaveOccurred())
+		resp.Body.Close()
+	}
+
+	service := "microsoft.network/virtualnetworks"
+	g.Expect(testutil.ToFloat64(m.Requests.WithLabelValues(service, http.MethodGet, "200"))).To(Equal(2.0))
+	g.Expect(testutil.ToFloat64(m.Requests.WithLabelValues(service, http.MethodPut, "429"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(m.Throttled.WithLabelValues(service, http.MethodPut))).To(Equal(1.0))
+	g.Expect(testutil.CollectAndCount(m.Throttled)).To(Equal(1))
+	g.Expect(testutil.CollectAndCount(m.Latency)).To(Equal(2))
+
+	count, err := testutil.GatherAndCount(registry, "capz_azure_api_requests_total")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(count).To(Equal(2))
+}
+
+func TestServiceFromPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{
+			path:     "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet",
+			expected: "microsoft.network/virtualnetworks",
+		},
+		{
+			path:     "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet",
+			expected: "microsoft.network/virtualnetworks/subnets",
+		},
+		{
+			path:     "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachines",
+			expected: "microsoft.compute/virtualmachines",
+		},
+		{
+			path:     "/subscriptions/123/providers/Microsoft.Network/locations/eastus/operations/456",
+			expected: "microsoft.network/locations/operations",
+		},
+		{
+			path:     "/subscriptions/123/resourceGroups/my-rg",
+			expected: "microsoft.resources/resourcegroups",
+		},
+		{
+			path:     "/subscriptions/123/providers/Microsoft.Compute",
+			expected: "microsoft.resources/subscriptions",
+		},
+		{
+			path:     "/tenant/oauth2/v2.0/token",
+			expected: unknownService,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.path, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(serviceFromPath(tc.path)).To(Equal(tc.expected))
+		})
+	}
+}
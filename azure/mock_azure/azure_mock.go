@@ -127,6 +127,92 @@ func (mr *MockPauserMockRecorder) Pause(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockPauser)(nil).Pause), arg0)
 }
 
+// MockPauseDescriber is a mock of PauseDescriber interface.
+type MockPauseDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockPauseDescriberMockRecorder
+}
+
+// MockPauseDescriberMockRecorder is the mock recorder for MockPauseDescriber.
+type MockPauseDescriberMockRecorder struct {
+	mock *MockPauseDescriber
+}
+
+// NewMockPauseDescriber creates a new mock instance.
+func NewMockPauseDescriber(ctrl *gomock.Controller) *MockPauseDescriber {
+	mock := &MockPauseDescriber{ctrl: ctrl}
+	mock.recorder = &MockPauseDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPauseDescriber) EXPECT() *MockPauseDescriberMockRecorder {
+	return m.recorder
+}
+
+// IsPaused mocks base method.
+func (m *MockPauseDescriber) IsPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPaused indicates an expected call of IsPaused.
+func (mr *MockPauseDescriberMockRecorder) IsPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaused", reflect.TypeOf((*MockPauseDescriber)(nil).IsPaused))
+}
+
+// MockDryRunner is a mock of DryRunner interface.
+type MockDryRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockDryRunnerMockRecorder
+}
+
+// MockDryRunnerMockRecorder is the mock recorder for MockDryRunner.
+type MockDryRunnerMockRecorder struct {
+	mock *MockDryRunner
+}
+
+// NewMockDryRunner creates a new mock instance.
+func NewMockDryRunner(ctrl *gomock.Controller) *MockDryRunner {
+	mock := &MockDryRunner{ctrl: ctrl}
+	mock.recorder = &MockDryRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDryRunner) EXPECT() *MockDryRunnerMockRecorder {
+	return m.recorder
+}
+
+// IsDryRun mocks base method.
+func (m *MockDryRunner) IsDryRun() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDryRun")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDryRun indicates an expected call of IsDryRun.
+func (mr *MockDryRunnerMockRecorder) IsDryRun() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDryRun", reflect.TypeOf((*MockDryRunner)(nil).IsDryRun))
+}
+
+// RecordPlannedChange mocks base method.
+func (m *MockDryRunner) RecordPlannedChange(serviceName, change string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordPlannedChange", serviceName, change)
+}
+
+// RecordPlannedChange indicates an expected call of RecordPlannedChange.
+func (mr *MockDryRunnerMockRecorder) RecordPlannedChange(serviceName, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPlannedChange", reflect.TypeOf((*MockDryRunner)(nil).RecordPlannedChange), serviceName, change)
+}
+
 // MockServiceReconciler is a mock of ServiceReconciler interface.
 type MockServiceReconciler struct {
 	ctrl     *gomock.Controller
@@ -490,6 +576,20 @@ func (mr *MockNetworkDescriberMockRecorder) NodeSubnets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeSubnets", reflect.TypeOf((*MockNetworkDescriber)(nil).NodeSubnets))
 }
 
+// OutboundLBInboundNATPools mocks base method.
+func (m *MockNetworkDescriber) OutboundLBInboundNATPools(arg0 string) []v1beta1.InboundNATPool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboundLBInboundNATPools", arg0)
+	ret0, _ := ret[0].([]v1beta1.InboundNATPool)
+	return ret0
+}
+
+// OutboundLBInboundNATPools indicates an expected call of OutboundLBInboundNATPools.
+func (mr *MockNetworkDescriberMockRecorder) OutboundLBInboundNATPools(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboundLBInboundNATPools", reflect.TypeOf((*MockNetworkDescriber)(nil).OutboundLBInboundNATPools), arg0)
+}
+
 // OutboundLBName mocks base method.
 func (m *MockNetworkDescriber) OutboundLBName(arg0 string) string {
 	m.ctrl.T.Helper()
@@ -884,32 +984,46 @@ func (m *MockAsyncStatusUpdater) EXPECT() *MockAsyncStatusUpdaterMockRecorder {
 	return m.recorder
 }
 
-// DefaultedAzureCallTimeout mocks base method.
-func (m *MockAsyncStatusUpdater) DefaultedAzureCallTimeout() time.Duration {
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockAsyncStatusUpdater) AzureServiceReconcileTimeout(serviceName string) time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
-func (mr *MockAsyncStatusUpdaterMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockAsyncStatusUpdaterMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockAsyncStatusUpdater)(nil).DefaultedAzureCallTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockAsyncStatusUpdater)(nil).AzureServiceReconcileTimeout), serviceName)
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockAsyncStatusUpdater) DefaultedAzureServiceReconcileTimeout() time.Duration {
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockAsyncStatusUpdater) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockAsyncStatusUpdaterMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockAsyncStatusUpdaterMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockAsyncStatusUpdater)(nil).DefaultedAzureServiceReconcileTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockAsyncStatusUpdater)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
+// DefaultedAzureCallTimeout mocks base method.
+func (m *MockAsyncStatusUpdater) DefaultedAzureCallTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
+func (mr *MockAsyncStatusUpdaterMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockAsyncStatusUpdater)(nil).DefaultedAzureCallTimeout))
 }
 
 // DefaultedReconcilerRequeue mocks base method.
@@ -952,6 +1066,18 @@ func (mr *MockAsyncStatusUpdaterMockRecorder) GetLongRunningOperationState(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongRunningOperationState", reflect.TypeOf((*MockAsyncStatusUpdater)(nil).GetLongRunningOperationState), arg0, arg1, arg2)
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockAsyncStatusUpdater) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockAsyncStatusUpdaterMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockAsyncStatusUpdater)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockAsyncStatusUpdater) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
@@ -1023,32 +1149,46 @@ func (m *MockAsyncReconciler) EXPECT() *MockAsyncReconcilerMockRecorder {
 	return m.recorder
 }
 
-// DefaultedAzureCallTimeout mocks base method.
-func (m *MockAsyncReconciler) DefaultedAzureCallTimeout() time.Duration {
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockAsyncReconciler) AzureServiceReconcileTimeout(serviceName string) time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
-func (mr *MockAsyncReconcilerMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockAsyncReconcilerMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockAsyncReconciler)(nil).DefaultedAzureCallTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockAsyncReconciler)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockAsyncReconciler) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockAsyncReconciler) DefaultedAzureServiceReconcileTimeout() time.Duration {
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockAsyncReconcilerMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockAsyncReconciler)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
+// DefaultedAzureCallTimeout mocks base method.
+func (m *MockAsyncReconciler) DefaultedAzureCallTimeout() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
+	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockAsyncReconcilerMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
+// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
+func (mr *MockAsyncReconcilerMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockAsyncReconciler)(nil).DefaultedAzureServiceReconcileTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockAsyncReconciler)(nil).DefaultedAzureCallTimeout))
 }
 
 // DefaultedReconcilerRequeue mocks base method.
@@ -1065,6 +1205,18 @@ func (mr *MockAsyncReconcilerMockRecorder) DefaultedReconcilerRequeue() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedReconcilerRequeue", reflect.TypeOf((*MockAsyncReconciler)(nil).DefaultedReconcilerRequeue))
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockAsyncReconciler) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockAsyncReconcilerMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockAsyncReconciler)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // MockClusterScoper is a mock of ClusterScoper interface.
 type MockClusterScoper struct {
 	ctrl     *gomock.Controller
@@ -1158,6 +1310,34 @@ func (mr *MockClusterScoperMockRecorder) AvailabilitySetEnabled() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySetEnabled", reflect.TypeOf((*MockClusterScoper)(nil).AvailabilitySetEnabled))
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockClusterScoper) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockClusterScoperMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockClusterScoper)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockClusterScoper) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockClusterScoperMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockClusterScoper)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
 // BaseURI mocks base method.
 func (m *MockClusterScoper) BaseURI() string {
 	m.ctrl.T.Helper()
@@ -1284,20 +1464,6 @@ func (mr *MockClusterScoperMockRecorder) DefaultedAzureCallTimeout() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockClusterScoper)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockClusterScoper) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockClusterScoperMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockClusterScoper)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockClusterScoper) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -1534,6 +1700,20 @@ func (mr *MockClusterScoperMockRecorder) NodeSubnets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeSubnets", reflect.TypeOf((*MockClusterScoper)(nil).NodeSubnets))
 }
 
+// OutboundLBInboundNATPools mocks base method.
+func (m *MockClusterScoper) OutboundLBInboundNATPools(arg0 string) []v1beta1.InboundNATPool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboundLBInboundNATPools", arg0)
+	ret0, _ := ret[0].([]v1beta1.InboundNATPool)
+	return ret0
+}
+
+// OutboundLBInboundNATPools indicates an expected call of OutboundLBInboundNATPools.
+func (mr *MockClusterScoperMockRecorder) OutboundLBInboundNATPools(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboundLBInboundNATPools", reflect.TypeOf((*MockClusterScoper)(nil).OutboundLBInboundNATPools), arg0)
+}
+
 // OutboundLBName mocks base method.
 func (m *MockClusterScoper) OutboundLBName(arg0 string) string {
 	m.ctrl.T.Helper()
@@ -1562,6 +1742,18 @@ func (mr *MockClusterScoperMockRecorder) OutboundPoolName(arg0 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboundPoolName", reflect.TypeOf((*MockClusterScoper)(nil).OutboundPoolName), arg0)
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockClusterScoper) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockClusterScoperMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockClusterScoper)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // ResourceGroup mocks base method.
 func (m *MockClusterScoper) ResourceGroup() string {
 	m.ctrl.T.Helper()
@@ -1771,6 +1963,34 @@ func (mr *MockManagedClusterScoperMockRecorder) AvailabilitySetEnabled() *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySetEnabled", reflect.TypeOf((*MockManagedClusterScoper)(nil).AvailabilitySetEnabled))
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockManagedClusterScoper) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockManagedClusterScoperMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockManagedClusterScoper)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockManagedClusterScoper) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockManagedClusterScoperMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockManagedClusterScoper)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
 // BaseURI mocks base method.
 func (m *MockManagedClusterScoper) BaseURI() string {
 	m.ctrl.T.Helper()
@@ -1869,20 +2089,6 @@ func (mr *MockManagedClusterScoperMockRecorder) DefaultedAzureCallTimeout() *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockManagedClusterScoper)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockManagedClusterScoper) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockManagedClusterScoperMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockManagedClusterScoper)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockManagedClusterScoper) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -1995,6 +2201,18 @@ func (mr *MockManagedClusterScoperMockRecorder) NodeResourceGroup() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeResourceGroup", reflect.TypeOf((*MockManagedClusterScoper)(nil).NodeResourceGroup))
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockManagedClusterScoper) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockManagedClusterScoperMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockManagedClusterScoper)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // ResourceGroup mocks base method.
 func (m *MockManagedClusterScoper) ResourceGroup() string {
 	m.ctrl.T.Helper()
@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+// maxBootstrapDataSize is the maximum size in bytes of the base64 encoded custom data or user data of a VM or VMSS.
+const maxBootstrapDataSize = 64 * 1024
+
+// encodeBootstrapData returns the base64 encoded bootstrap data of a bootstrap data secret, with the given registry
+// mirrors merged into it. cloud-init bootstrap data which exceeds the size Azure accepts once encoded is gzip
+// compressed, which cloud-init detects and decompresses. Other formats, e.g. Ignition, are never compressed.
+func encodeBootstrapData(secret *corev1.Secret, mirrors []infrav1.RegistryMirror) (string, error) {
+	value, ok := secret.Data["value"]
+	if !ok {
+		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+	}
+	format := string(secret.Data["format"])
+	value, err := mergeRegistryMirrors(value, format, mirrors)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to configure the registry mirrors")
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(value)
+	if len(encoded) <= maxBootstrapDataSize {
+		return encoded, nil
+	}
+	if format != "" && format != "cloud-config" {
+		return "", errors.Errorf("%s bootstrap data is %d bytes once base64 encoded, which exceeds the limit of %d bytes", format, len(encoded), maxBootstrapDataSize)
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(value); err != nil {
+		return "", errors.Wrap(err, "failed to compress bootstrap data")
+	}
+	if err := w.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to compress bootstrap data")
+	}
+	encoded = base64.StdEncoding.EncodeToString(buf.Bytes())
+	if len(encoded) > maxBootstrapDataSize {
+		return "", errors.Errorf("bootstrap data is %d bytes once compressed and base64 encoded, which exceeds the limit of %d bytes", len(encoded), maxBootstrapDataSize)
+	}
+	return encoded, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestEncodeBootstrapData(t *testing.T) {
+	random := make([]byte, maxBootstrapDataSize)
+	if _, err := rand.Read(random); err != nil {
+		t.Fatal(err)
+	}
+	compressible := "#cloud-config\nruncmd:\n" + strings.Repeat("  - echo hello\n", 5000)
+	incompressible := "#cloud-config\nwrite_files:\n- content: " + hex.EncodeToString(random) + "\n"
+	ignition := `{"ignition":{"version":"3.4.0"},"passwd":{"users":[{"name":"core","sshAuthorizedKeys":["` + strings.Repeat("a", 50000) + `"]}]}}`
+
+	tests := []struct {
+		name           string
+		data           map[string][]byte
+		want           string
+		wantCompressed bool
+		expectedError  string
+	}{
+		{
+			name:          "secret value key is missing",
+			data:          map[string][]byte{"format": []byte("cloud-config")},
+			expectedError: "secret value key is missing",
+		},
+		{
+			name: "small bootstrap data is encoded as is",
+			data: map[string][]byte{"value": []byte("#cloud-config\nruncmd:\n  - echo hello\n"), "format": []byte("cloud-config")},
+			want: "#cloud-config\nruncmd:\n  - echo hello\n",
+		},
+		{
+			name:           "oversized cloud-config bootstrap data is compressed",
+			data:           map[string][]byte{"value": []byte(compressible), "format": []byte("cloud-config")},
+			want:           compressible,
+			wantCompressed: true,
+		},
+		{
+			name:           "oversized bootstrap data without format is compressed",
+			data:           map[string][]byte{"value": []byte(compressible)},
+			want:           compressible,
+			wantCompressed: true,
+		},
+		{
+			name:          "bootstrap data which is too large even when compressed",
+			data:          map[string][]byte{"value": []byte(incompressible), "format": []byte("cloud-config")},
+			expectedError: "exceeds the limit of 65536 bytes",
+		},
+		{
+			name: "small Ignition bootstrap data is encoded as is",
+			data: map[string][]byte{"value": []byte(`{"ignition":{"version":"3.4.0"}}`), "format": []byte("ignition")},
+			want: `{"ignition":{"version":"3.4.0"}}`,
+		},
+		{
+			name:          "oversized Ignition bootstrap data is not compressed",
+			data:          map[string][]byte{"value": []byte(ignition), "format": []byte("ignition")},
+			expectedError: "ignition bootstrap data is",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := encodeBootstrapData(&corev1.Secret{Data: tc.data}, nil)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedError)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(len(got)).To(BeNumerically("<=", maxBootstrapDataSize))
+
+			decoded, err := base64.StdEncoding.DecodeString(got)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.wantCompressed {
+				r, err := gzip.NewReader(bytes.NewReader(decoded))
+				g.Expect(err).NotTo(HaveOccurred())
+				decoded, err = io.ReadAll(r)
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(string(decoded)).To(Equal(tc.want))
+		})
+	}
+}
@@ -25,6 +25,8 @@ import (
 	"strconv"
 	"strings"
 
+	asocomputev1 "github.com/Azure/azure-service-operator/v2/api/compute/v1api20220702"
+	asomanagedidentityv1 "github.com/Azure/azure-service-operator/v2/api/managedidentity/v1api20230131"
 	asonetworkv1api20201101 "github.com/Azure/azure-service-operator/v2/api/network/v1api20201101"
 	asonetworkv1api20220701 "github.com/Azure/azure-service-operator/v2/api/network/v1api20220701"
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
@@ -34,21 +36,29 @@ import (
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/azurefirewalls"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bastionhosts"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/diagnosticsettings"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/diskencryptionsets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/loadbalancers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/natgateways"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/netappvolumes"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/policyassignments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privatedns"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/routetables"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/securitygroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/userassignedidentities"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualnetworks"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/vnetpeerings"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/futures"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -116,6 +126,14 @@ type ClusterScope struct {
 	Cluster      *clusterv1.Cluster
 	AzureCluster *infrav1.AzureCluster
 	azure.AsyncReconciler
+
+	plannedChanges []plannedChange
+}
+
+// plannedChange is a change to an Azure resource which was not applied in dry-run mode.
+type plannedChange struct {
+	serviceName string
+	change      string
 }
 
 // ClusterCache stores ClusterCache data locally so we don't have to hit the API multiple times within the same reconcile loop.
@@ -138,6 +156,40 @@ func (s *ClusterScope) GetDeletionTimestamp() *metav1.Time {
 	return s.Cluster.DeletionTimestamp
 }
 
+// IsPaused returns true if reconciliation of the AzureCluster is paused.
+func (s *ClusterScope) IsPaused() bool {
+	return annotations.IsPaused(s.Cluster, s.AzureCluster)
+}
+
+// IsDryRun returns true if the AzureCluster has the dry-run annotation set to "true".
+func (s *ClusterScope) IsDryRun() bool {
+	return s.AzureCluster.GetAnnotations()[infrav1.DryRunAnnotation] == "true"
+}
+
+// RecordPlannedChange records a change to an Azure resource which the named service did not apply in dry-run mode.
+func (s *ClusterScope) RecordPlannedChange(serviceName, change string) {
+	s.plannedChanges = append(s.plannedChanges, plannedChange{serviceName: serviceName, change: change})
+}
+
+// PlannedChanges returns the changes recorded in dry-run mode during this reconcile, in the order they were recorded.
+func (s *ClusterScope) PlannedChanges() []string {
+	changes := make([]string, 0, len(s.plannedChanges))
+	for _, c := range s.plannedChanges {
+		changes = append(changes, fmt.Sprintf("%s: %s", c.serviceName, c.change))
+	}
+	return changes
+}
+
+// hasPlannedChanges returns true if the named service recorded changes in dry-run mode during this reconcile.
+func (s *ClusterScope) hasPlannedChanges(serviceName string) bool {
+	for _, c := range s.plannedChanges {
+		if c.serviceName == serviceName {
+			return true
+		}
+	}
+	return false
+}
+
 // ASOOwner implements aso.Scope.
 func (s *ClusterScope) ASOOwner() client.Object {
 	return s.AzureCluster
@@ -162,7 +214,9 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 					Location:         s.Location(),
 					ExtendedLocation: s.ExtendedLocation(),
 					FailureDomains:   s.FailureDomains(),
-					AdditionalTags:   s.AdditionalTags(),
+					AdditionalTags:   s.additionalTagsFor(infrav1.TaggedResourceTypePublicIP),
+					SKU:              ip.PublicIP.SKU,
+					Zones:            ip.PublicIP.Zones,
 				})
 			}
 		}
@@ -177,8 +231,10 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 				Location:         s.Location(),
 				ExtendedLocation: s.ExtendedLocation(),
 				FailureDomains:   s.FailureDomains(),
-				AdditionalTags:   s.AdditionalTags(),
+				AdditionalTags:   s.additionalTagsFor(infrav1.TaggedResourceTypePublicIP),
 				IPTags:           s.APIServerPublicIP().IPTags,
+				SKU:              s.APIServerPublicIP().SKU,
+				Zones:            s.APIServerPublicIP().Zones,
 			},
 		}
 	}
@@ -196,7 +252,9 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 				Location:         s.Location(),
 				ExtendedLocation: s.ExtendedLocation(),
 				FailureDomains:   s.FailureDomains(),
-				AdditionalTags:   s.AdditionalTags(),
+				AdditionalTags:   s.additionalTagsFor(infrav1.TaggedResourceTypePublicIP),
+				SKU:              ip.PublicIP.SKU,
+				Zones:            ip.PublicIP.Zones,
 			})
 		}
 	}
@@ -213,8 +271,10 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 				ClusterName:    s.ClusterName(),
 				Location:       s.Location(),
 				FailureDomains: s.FailureDomains(),
-				AdditionalTags: s.AdditionalTags(),
+				AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypePublicIP),
 				IPTags:         subnet.NatGateway.NatGatewayIP.IPTags,
+				SKU:            subnet.NatGateway.NatGatewayIP.SKU,
+				Zones:          subnet.NatGateway.NatGatewayIP.Zones,
 			})
 		}
 		publicIPSpecs = append(publicIPSpecs, nodeNatGatewayIPSpecs...)
@@ -230,12 +290,33 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 			ClusterName:    s.ClusterName(),
 			Location:       s.Location(),
 			FailureDomains: s.FailureDomains(),
-			AdditionalTags: s.AdditionalTags(),
+			AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypePublicIP),
 			IPTags:         azureBastion.PublicIP.IPTags,
+			SKU:            azureBastion.PublicIP.SKU,
+			Zones:          azureBastion.PublicIP.Zones,
 		}
 		publicIPSpecs = append(publicIPSpecs, azureBastionPublicIP)
 	}
 
+	if azureFirewall := s.AzureFirewall(); azureFirewall != nil {
+		// public IPs for Azure Firewall.
+		for _, publicIP := range azureFirewall.PublicIPs {
+			publicIPSpecs = append(publicIPSpecs, &publicips.PublicIPSpec{
+				Name:           publicIP.Name,
+				ResourceGroup:  s.ResourceGroup(),
+				DNSName:        publicIP.DNSName,
+				IsIPv6:         false, // Public IP is IPv4 by default
+				ClusterName:    s.ClusterName(),
+				Location:       s.Location(),
+				FailureDomains: s.FailureDomains(),
+				AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypePublicIP),
+				IPTags:         publicIP.IPTags,
+				SKU:            publicIP.SKU,
+				Zones:          publicIP.Zones,
+			})
+		}
+	}
+
 	return publicIPSpecs
 }
 
@@ -255,12 +336,21 @@ func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 			SubnetName:           s.ControlPlaneSubnet().Name,
 			FrontendIPConfigs:    s.APIServerLB().FrontendIPs,
 			APIServerPort:        s.APIServerPort(),
+			FrontendPort:         s.APIServerLB().FrontendPort,
 			Type:                 s.APIServerLB().Type,
 			SKU:                  s.APIServerLB().SKU,
 			Role:                 infrav1.APIServerRole,
 			BackendPoolName:      s.APIServerLB().BackendPool.Name,
 			IdleTimeoutInMinutes: s.APIServerLB().IdleTimeoutInMinutes,
-			AdditionalTags:       s.AdditionalTags(),
+			EnableTCPReset:       s.APIServerLB().EnableTCPReset,
+			EnableHAPorts:        s.APIServerLB().EnableHAPorts,
+			DisableOutboundSNAT:  s.APIServerLB().DisableOutboundSNAT,
+			HealthProbe:          s.APIServerLB().HealthProbe,
+			AdditionalTags:       s.additionalTagsFor(infrav1.TaggedResourceTypeLoadBalancer),
+
+			AdditionalBackendPoolNames: additionalBackendPoolNames(s.APIServerLB()),
+			RuleBackendPoolName:        s.APIServerLB().RuleBackendPool,
+			LastAppliedBackendPools:    s.getLastAppliedBackendPools(s.APIServerLB().Name),
 		},
 	}
 
@@ -280,8 +370,12 @@ func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 			SKU:                  s.NodeOutboundLB().SKU,
 			BackendPoolName:      s.NodeOutboundLB().BackendPool.Name,
 			IdleTimeoutInMinutes: s.NodeOutboundLB().IdleTimeoutInMinutes,
+			EnableTCPReset:       s.NodeOutboundLB().EnableTCPReset,
 			Role:                 infrav1.NodeOutboundRole,
-			AdditionalTags:       s.AdditionalTags(),
+			AdditionalTags:       s.additionalTagsFor(infrav1.TaggedResourceTypeLoadBalancer),
+			InboundNATPools:      s.NodeOutboundLB().InboundNATPools,
+
+			LastAppliedInboundNATPools: s.getLastAppliedInboundNATPools(s.NodeOutboundLB().Name),
 		})
 	}
 
@@ -301,8 +395,9 @@ func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 			SKU:                  s.ControlPlaneOutboundLB().SKU,
 			BackendPoolName:      s.ControlPlaneOutboundLB().BackendPool.Name,
 			IdleTimeoutInMinutes: s.ControlPlaneOutboundLB().IdleTimeoutInMinutes,
+			EnableTCPReset:       s.ControlPlaneOutboundLB().EnableTCPReset,
 			Role:                 infrav1.ControlPlaneOutboundRole,
-			AdditionalTags:       s.AdditionalTags(),
+			AdditionalTags:       s.additionalTagsFor(infrav1.TaggedResourceTypeLoadBalancer),
 		})
 	}
 
@@ -314,13 +409,20 @@ func (s *ClusterScope) RouteTableSpecs() []azure.ResourceSpecGetter {
 	var specs []azure.ResourceSpecGetter
 	for _, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
 		if subnet.RouteTable.Name != "" {
-			specs = append(specs, &routetables.RouteTableSpec{
-				Name:           subnet.RouteTable.Name,
-				Location:       s.Location(),
-				ResourceGroup:  s.Vnet().ResourceGroup,
-				ClusterName:    s.ClusterName(),
-				AdditionalTags: s.AdditionalTags(),
-			})
+			rtSpec := &routetables.RouteTableSpec{
+				Name:              subnet.RouteTable.Name,
+				Location:          s.Location(),
+				ResourceGroup:     s.Vnet().ResourceGroup,
+				ClusterName:       s.ClusterName(),
+				AdditionalTags:    mergeAdditionalTags(s.additionalTagsFor(infrav1.TaggedResourceTypeRouteTable), subnet.RouteTable.Tags),
+				Routes:            subnet.RouteTable.Routes,
+				LastAppliedRoutes: s.getLastAppliedRoutes(subnet.RouteTable.Name),
+			}
+			// Point the node subnets' default route at the Azure Firewall once its private IP is known.
+			if firewall := s.AzureFirewall(); subnet.Role == infrav1.SubnetNode && firewall != nil && firewall.RouteNodeEgress {
+				rtSpec.DefaultRouteNextHopIP = s.AzureCluster.Status.AzureFirewallPrivateIP
+			}
+			specs = append(specs, rtSpec)
 		}
 	}
 
@@ -346,7 +448,7 @@ func (s *ClusterScope) NatGatewaySpecs() []azure.ASOResourceSpecGetter[*asonetwo
 					NatGatewayIP: infrav1.PublicIPSpec{
 						Name: subnet.NatGateway.NatGatewayIP.Name,
 					},
-					AdditionalTags: s.AdditionalTags(),
+					AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypeNatGateway),
 					// We need to know if the VNet is managed to decide if this NAT Gateway was-managed or not.
 					IsVnetManaged: s.IsVnetManaged(),
 				})
@@ -367,8 +469,10 @@ func (s *ClusterScope) NSGSpecs() []azure.ResourceSpecGetter {
 			ResourceGroup:            s.Vnet().ResourceGroup,
 			Location:                 s.Location(),
 			ClusterName:              s.ClusterName(),
-			AdditionalTags:           s.AdditionalTags(),
+			AdditionalTags:           mergeAdditionalTags(s.additionalTagsFor(infrav1.TaggedResourceTypeNetworkSecurityGroup), subnet.SecurityGroup.Tags),
 			LastAppliedSecurityRules: s.getLastAppliedSecurityRules(subnet.SecurityGroup.Name),
+			RulesMode:                subnet.SecurityGroup.RulesMode,
+			ReservedPriorities:       subnet.SecurityGroup.ReservedPriorities,
 		}
 	}
 
@@ -381,6 +485,9 @@ func (s *ClusterScope) SubnetSpecs() []azure.ASOResourceSpecGetter[*asonetworkv1
 	if s.IsAzureBastionEnabled() {
 		numberOfSubnets++
 	}
+	if s.AzureFirewall() != nil {
+		numberOfSubnets++
+	}
 
 	subnetSpecs := make([]azure.ASOResourceSpecGetter[*asonetworkv1api20201101.VirtualNetworksSubnet], 0, numberOfSubnets)
 
@@ -417,6 +524,20 @@ func (s *ClusterScope) SubnetSpecs() []azure.ASOResourceSpecGetter[*asonetworkv1
 		})
 	}
 
+	if azureFirewall := s.AzureFirewall(); azureFirewall != nil {
+		azureFirewallSubnet := azureFirewall.Subnet
+		subnetSpecs = append(subnetSpecs, &subnets.SubnetSpec{
+			Name:              azureFirewallSubnet.Name,
+			ResourceGroup:     s.ResourceGroup(),
+			SubscriptionID:    s.SubscriptionID(),
+			CIDRs:             azureFirewallSubnet.CIDRBlocks,
+			VNetName:          s.Vnet().Name,
+			VNetResourceGroup: s.Vnet().ResourceGroup,
+			IsVNetManaged:     s.IsVnetManaged(),
+			ServiceEndpoints:  azureFirewallSubnet.ServiceEndpoints,
+		})
+	}
+
 	return subnetSpecs
 }
 
@@ -427,7 +548,7 @@ func (s *ClusterScope) GroupSpecs() []azure.ASOResourceSpecGetter[*asoresourcesv
 			Name:           s.ResourceGroup(),
 			Location:       s.Location(),
 			ClusterName:    s.ClusterName(),
-			AdditionalTags: s.AdditionalTags(),
+			AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypeResourceGroup),
 		},
 	}
 	if s.Vnet().ResourceGroup != s.ResourceGroup() {
@@ -435,7 +556,15 @@ func (s *ClusterScope) GroupSpecs() []azure.ASOResourceSpecGetter[*asoresourcesv
 			Name:           s.Vnet().ResourceGroup,
 			Location:       s.Location(),
 			ClusterName:    s.ClusterName(),
-			AdditionalTags: s.AdditionalTags(),
+			AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypeResourceGroup),
+		})
+	}
+	if nodeRG := s.NodeResourceGroup(); nodeRG != s.ResourceGroup() && nodeRG != s.Vnet().ResourceGroup {
+		specs = append(specs, &groups.GroupSpec{
+			Name:           nodeRG,
+			Location:       s.Location(),
+			ClusterName:    s.ClusterName(),
+			AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypeResourceGroup),
 		})
 	}
 	return specs
@@ -479,13 +608,16 @@ func (s *ClusterScope) VnetPeeringSpecs() []azure.ResourceSpecGetter {
 // VNetSpec returns the virtual network spec.
 func (s *ClusterScope) VNetSpec() azure.ASOResourceSpecGetter[*asonetworkv1api20201101.VirtualNetwork] {
 	return &virtualnetworks.VNetSpec{
-		ResourceGroup:    s.Vnet().ResourceGroup,
-		Name:             s.Vnet().Name,
-		CIDRs:            s.Vnet().CIDRBlocks,
-		ExtendedLocation: s.ExtendedLocation(),
-		Location:         s.Location(),
-		ClusterName:      s.ClusterName(),
-		AdditionalTags:   s.AdditionalTags(),
+		ResourceGroup:        s.Vnet().ResourceGroup,
+		Name:                 s.Vnet().Name,
+		CIDRs:                s.Vnet().CIDRBlocks,
+		ExtendedLocation:     s.ExtendedLocation(),
+		Location:             s.Location(),
+		ClusterName:          s.ClusterName(),
+		AdditionalTags:       s.additionalTagsFor(infrav1.TaggedResourceTypeVirtualNetwork),
+		DDoSProtectionPlanID: s.Vnet().DDoSProtectionPlanID,
+		EnableDDoSProtection: ptr.Deref(s.Vnet().EnableDDoSProtection, false),
+		DNSServers:           s.Vnet().DNSServers,
 	}
 }
 
@@ -496,7 +628,7 @@ func (s *ClusterScope) PrivateDNSSpec() (zoneSpec azure.ResourceSpecGetter, link
 			Name:           s.GetPrivateDNSZoneName(),
 			ResourceGroup:  s.ResourceGroup(),
 			ClusterName:    s.ClusterName(),
-			AdditionalTags: s.AdditionalTags(),
+			AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypePrivateDNS),
 		}
 
 		links := make([]azure.ResourceSpecGetter, 1+len(s.Vnet().Peerings))
@@ -508,7 +640,7 @@ func (s *ClusterScope) PrivateDNSSpec() (zoneSpec azure.ResourceSpecGetter, link
 			VNetName:          s.Vnet().Name,
 			ResourceGroup:     s.ResourceGroup(),
 			ClusterName:       s.ClusterName(),
-			AdditionalTags:    s.AdditionalTags(),
+			AdditionalTags:    s.additionalTagsFor(infrav1.TaggedResourceTypePrivateDNS),
 		}
 		for i, peering := range s.Vnet().Peerings {
 			links[i+1] = privatedns.LinkSpec{
@@ -519,7 +651,7 @@ func (s *ClusterScope) PrivateDNSSpec() (zoneSpec azure.ResourceSpecGetter, link
 				VNetName:          peering.RemoteVnetName,
 				ResourceGroup:     s.ResourceGroup(),
 				ClusterName:       s.ClusterName(),
-				AdditionalTags:    s.AdditionalTags(),
+				AdditionalTags:    s.additionalTagsFor(infrav1.TaggedResourceTypePrivateDNS),
 			}
 		}
 
@@ -539,6 +671,278 @@ func (s *ClusterScope) PrivateDNSSpec() (zoneSpec azure.ResourceSpecGetter, link
 	return nil, nil, nil
 }
 
+// AzureFirewall returns the cluster AzureFirewall.
+func (s *ClusterScope) AzureFirewall() *infrav1.AzureFirewall {
+	return s.AzureCluster.Spec.NetworkSpec.AzureFirewall
+}
+
+// AzureFirewallSpec returns the Azure Firewall spec.
+func (s *ClusterScope) AzureFirewallSpec() azure.ResourceSpecGetter {
+	firewall := s.AzureFirewall()
+	if firewall == nil {
+		return nil
+	}
+	publicIPIDs := make([]string, len(firewall.PublicIPs))
+	for i, publicIP := range firewall.PublicIPs {
+		publicIPIDs[i] = azure.PublicIPID(s.SubscriptionID(), s.ResourceGroup(), publicIP.Name)
+	}
+	return &azurefirewalls.AzureFirewallSpec{
+		Name:             firewall.Name,
+		ResourceGroup:    s.ResourceGroup(),
+		Location:         s.Location(),
+		ClusterName:      s.ClusterName(),
+		SKU:              firewall.SKU,
+		SubnetID:         azure.SubnetID(s.SubscriptionID(), s.Vnet().ResourceGroup, s.Vnet().Name, firewall.Subnet.Name),
+		PublicIPIDs:      publicIPIDs,
+		FirewallPolicyID: firewall.FirewallPolicyID,
+		AdditionalTags:   s.additionalTagsFor(infrav1.TaggedResourceTypeAzureFirewall),
+	}
+}
+
+// DiagnosticSettingSpecs returns the diagnostic setting specs of the network resources managed by CAPZ.
+func (s *ClusterScope) DiagnosticSettingSpecs() []azure.ResourceSpecGetter {
+	var specs []azure.ResourceSpecGetter
+	for _, setting := range s.AzureCluster.Spec.NetworkSpec.DiagnosticSettings {
+		for _, target := range s.diagnosticSettingTargets(setting.Targets) {
+			specs = append(specs, &diagnosticsettings.DiagnosticSettingSpec{
+				Name:             setting.Name,
+				ResourceGroup:    target.resourceGroup,
+				TargetResourceID: target.id,
+				WorkspaceID:      setting.WorkspaceID,
+				LogCategories:    setting.LogCategories,
+				MetricCategories: setting.MetricCategories,
+			})
+		}
+	}
+
+	return specs
+}
+
+// PolicyAssignmentSpecs returns the Azure Policy assignment specs. Assignments without a scope are scoped to the
+// cluster's resource group.
+func (s *ClusterScope) PolicyAssignmentSpecs() []azure.ResourceSpecGetter {
+	var specs []azure.ResourceSpecGetter
+	for _, assignment := range s.AzureCluster.Spec.PolicyAssignments {
+		scope := assignment.Scope
+		if scope == "" {
+			scope = azure.ResourceGroupID(s.SubscriptionID(), s.ResourceGroup())
+		}
+		specs = append(specs, &policyassignments.PolicyAssignmentSpec{
+			Name:               assignment.Name,
+			ResourceGroup:      s.ResourceGroup(),
+			Scope:              scope,
+			PolicyDefinitionID: assignment.PolicyDefinitionID,
+			PolicyParameters:   assignment.Parameters,
+		})
+	}
+
+	return specs
+}
+
+// UserAssignedIdentitySpecs returns the user-assigned identity specs.
+func (s *ClusterScope) UserAssignedIdentitySpecs() []azure.ASOResourceSpecGetter[*asomanagedidentityv1.UserAssignedIdentity] {
+	var specs []azure.ASOResourceSpecGetter[*asomanagedidentityv1.UserAssignedIdentity]
+	for _, identity := range s.AzureCluster.Spec.UserAssignedIdentities {
+		specs = append(specs, &userassignedidentities.UserAssignedIdentitySpec{
+			Name:           identity.Name,
+			ResourceGroup:  s.ResourceGroup(),
+			Location:       s.Location(),
+			ClusterName:    s.ClusterName(),
+			AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypeUserAssignedIdentity),
+		})
+	}
+
+	return specs
+}
+
+// DiskEncryptionSetSpecs returns the disk encryption set specs.
+func (s *ClusterScope) DiskEncryptionSetSpecs() []azure.ASOResourceSpecGetter[*asocomputev1.DiskEncryptionSet] {
+	var specs []azure.ASOResourceSpecGetter[*asocomputev1.DiskEncryptionSet]
+	for _, set := range s.AzureCluster.Spec.DiskEncryptionSets {
+		spec := &diskencryptionsets.DiskEncryptionSetSpec{
+			Name:                              set.Name,
+			ResourceGroup:                     s.ResourceGroup(),
+			Location:                          s.Location(),
+			ClusterName:                       s.ClusterName(),
+			KeyURL:                            set.KeyURL,
+			KeyVaultID:                        set.KeyVaultID,
+			Identity:                          set.Identity,
+			RotationToLatestKeyVersionEnabled: set.RotationToLatestKeyVersionEnabled,
+			AdditionalTags:                    s.additionalTagsFor(infrav1.TaggedResourceTypeDiskEncryptionSet),
+		}
+		if set.UserAssignedIdentity != nil {
+			spec.UserAssignedIdentityID = strings.TrimPrefix(set.UserAssignedIdentity.ProviderID, azureutil.ProviderIDPrefix)
+		}
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
+// NetAppAccountSpecs returns the specs of the NetApp accounts of the cluster's NetApp volumes.
+func (s *ClusterScope) NetAppAccountSpecs() []azure.ResourceSpecGetter {
+	var specs []azure.ResourceSpecGetter
+	seen := make(map[string]bool)
+	for _, volume := range s.AzureCluster.Spec.NetAppVolumes {
+		key := strings.ToLower(volume.AccountName)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		specs = append(specs, &netappvolumes.AccountSpec{
+			Name:           volume.AccountName,
+			ResourceGroup:  s.ResourceGroup(),
+			SubscriptionID: s.SubscriptionID(),
+			Location:       s.Location(),
+			ClusterName:    s.ClusterName(),
+			AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypeNetApp),
+		})
+	}
+
+	return specs
+}
+
+// NetAppCapacityPoolSpecs returns the specs of the capacity pools of the cluster's NetApp volumes.
+func (s *ClusterScope) NetAppCapacityPoolSpecs() []azure.ResourceSpecGetter {
+	var specs []azure.ResourceSpecGetter
+	seen := make(map[string]bool)
+	for _, volume := range s.AzureCluster.Spec.NetAppVolumes {
+		key := strings.ToLower(volume.AccountName + "/" + volume.CapacityPool.Name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		specs = append(specs, &netappvolumes.CapacityPoolSpec{
+			Name:           volume.CapacityPool.Name,
+			AccountName:    volume.AccountName,
+			ResourceGroup:  s.ResourceGroup(),
+			SubscriptionID: s.SubscriptionID(),
+			Location:       s.Location(),
+			ServiceLevel:   volume.CapacityPool.ServiceLevel,
+			SizeTiB:        volume.CapacityPool.SizeTiB,
+			ClusterName:    s.ClusterName(),
+			AdditionalTags: s.additionalTagsFor(infrav1.TaggedResourceTypeNetApp),
+		})
+	}
+
+	return specs
+}
+
+// NetAppVolumeSpecs returns the NetApp volume specs.
+func (s *ClusterScope) NetAppVolumeSpecs() []azure.ResourceSpecGetter {
+	var specs []azure.ResourceSpecGetter
+	for _, volume := range s.AzureCluster.Spec.NetAppVolumes {
+		protocol := volume.Protocol
+		if protocol == "" {
+			protocol = infrav1.NetAppVolumeProtocolNFSv3
+		}
+		specs = append(specs, &netappvolumes.VolumeSpec{
+			Name:             volume.Name,
+			AccountName:      volume.AccountName,
+			CapacityPoolName: volume.CapacityPool.Name,
+			ResourceGroup:    s.ResourceGroup(),
+			SubscriptionID:   s.SubscriptionID(),
+			Location:         s.Location(),
+			ServiceLevel:     volume.CapacityPool.ServiceLevel,
+			SizeGiB:          volume.SizeGiB,
+			Protocol:         protocol,
+			SubnetID:         volume.SubnetID,
+			ClusterName:      s.ClusterName(),
+			AdditionalTags:   s.additionalTagsFor(infrav1.TaggedResourceTypeNetApp),
+		})
+	}
+
+	return specs
+}
+
+// SetUserAssignedIdentityStatus records the IDs of a user-assigned identity in the AzureCluster status.
+func (s *ClusterScope) SetUserAssignedIdentityStatus(status infrav1.ManagedIdentityStatus) {
+	for i, identity := range s.AzureCluster.Status.UserAssignedIdentities {
+		if identity.Name == status.Name {
+			s.AzureCluster.Status.UserAssignedIdentities[i] = status
+			return
+		}
+	}
+	s.AzureCluster.Status.UserAssignedIdentities = append(s.AzureCluster.Status.UserAssignedIdentities, status)
+}
+
+// SetDiskEncryptionSetStatus records the ID and active key of a disk encryption set in the AzureCluster status.
+func (s *ClusterScope) SetDiskEncryptionSetStatus(status infrav1.DiskEncryptionSetStatus) {
+	for i, set := range s.AzureCluster.Status.DiskEncryptionSets {
+		if set.Name == status.Name {
+			s.AzureCluster.Status.DiskEncryptionSets[i] = status
+			return
+		}
+	}
+	s.AzureCluster.Status.DiskEncryptionSets = append(s.AzureCluster.Status.DiskEncryptionSets, status)
+}
+
+// diagnosticSettingTarget identifies a resource a diagnostic setting is created for.
+type diagnosticSettingTarget struct {
+	id            string
+	resourceGroup string
+}
+
+// diagnosticSettingTargets returns the network resources of the given kinds which are managed by CAPZ.
+// The virtual network and security groups are only targeted if the virtual network is managed.
+func (s *ClusterScope) diagnosticSettingTargets(kinds []infrav1.DiagnosticSettingTarget) []diagnosticSettingTarget {
+	var targets []diagnosticSettingTarget
+	for _, kind := range kinds {
+		switch kind {
+		case infrav1.DiagnosticSettingTargetVirtualNetwork:
+			if s.IsVnetManaged() {
+				targets = append(targets, diagnosticSettingTarget{
+					id:            azure.VNetID(s.SubscriptionID(), s.Vnet().ResourceGroup, s.Vnet().Name),
+					resourceGroup: s.Vnet().ResourceGroup,
+				})
+			}
+		case infrav1.DiagnosticSettingTargetSecurityGroup:
+			if !s.IsVnetManaged() {
+				continue
+			}
+			seen := make(map[string]struct{})
+			for _, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
+				if _, ok := seen[subnet.SecurityGroup.Name]; ok || subnet.SecurityGroup.Name == "" {
+					continue
+				}
+				seen[subnet.SecurityGroup.Name] = struct{}{}
+				targets = append(targets, diagnosticSettingTarget{
+					id:            azure.SecurityGroupID(s.SubscriptionID(), s.Vnet().ResourceGroup, subnet.SecurityGroup.Name),
+					resourceGroup: s.Vnet().ResourceGroup,
+				})
+			}
+		case infrav1.DiagnosticSettingTargetLoadBalancer:
+			for _, lb := range []*infrav1.LoadBalancerSpec{s.APIServerLB(), s.NodeOutboundLB(), s.ControlPlaneOutboundLB()} {
+				if lb == nil || lb.Name == "" {
+					continue
+				}
+				targets = append(targets, diagnosticSettingTarget{
+					id:            azure.LoadBalancerID(s.SubscriptionID(), s.ResourceGroup(), lb.Name),
+					resourceGroup: s.ResourceGroup(),
+				})
+			}
+		}
+	}
+
+	return targets
+}
+
+// SetPublicIPFQDN records the FQDN Azure assigned to the public IP with the given name in the AzureCluster status.
+func (s *ClusterScope) SetPublicIPFQDN(name, fqdn string) {
+	for i, publicIP := range s.AzureCluster.Status.PublicIPs {
+		if publicIP.Name == name {
+			s.AzureCluster.Status.PublicIPs[i].FQDN = fqdn
+			return
+		}
+	}
+	s.AzureCluster.Status.PublicIPs = append(s.AzureCluster.Status.PublicIPs, infrav1.PublicIPStatus{Name: name, FQDN: fqdn})
+}
+
+// SetAzureFirewallPrivateIP records the private IP address of the Azure Firewall in the AzureCluster status.
+func (s *ClusterScope) SetAzureFirewallPrivateIP(ip string) {
+	s.AzureCluster.Status.AzureFirewallPrivateIP = ip
+}
+
 // IsAzureBastionEnabled returns true if the azure bastion is enabled.
 func (s *ClusterScope) IsAzureBastionEnabled() bool {
 	return s.AzureCluster.Spec.BastionSpec.AzureBastion != nil
@@ -684,6 +1088,47 @@ func (s *ClusterScope) ControlPlaneOutboundLB() *infrav1.LoadBalancerSpec {
 	return s.AzureCluster.Spec.NetworkSpec.ControlPlaneOutboundLB
 }
 
+// ValidatePublicIPSKUs returns an error if the SKU of a public IP is incompatible with the SKU of the load balancer or
+// NAT gateway it is attached to, which Azure only rejects once the resources are created.
+func (s *ClusterScope) ValidatePublicIPSKUs() error {
+	for _, lb := range []*infrav1.LoadBalancerSpec{s.APIServerLB(), s.NodeOutboundLB(), s.ControlPlaneOutboundLB()} {
+		if lb == nil {
+			continue
+		}
+		lbSKU := lb.SKU
+		if lbSKU == "" {
+			lbSKU = infrav1.SKUStandard
+		}
+		for _, frontendIP := range lb.FrontendIPs {
+			if frontendIP.PublicIP == nil {
+				continue
+			}
+			if ipSKU := publicIPSKU(*frontendIP.PublicIP); string(ipSKU) != string(lbSKU) {
+				return errors.Errorf("public IP %s of load balancer %s has the %s SKU, which is incompatible with the %s SKU of the load balancer",
+					frontendIP.PublicIP.Name, lb.Name, ipSKU, lbSKU)
+			}
+		}
+	}
+	for _, subnet := range s.Subnets() {
+		if !subnet.IsNatGatewayEnabled() {
+			continue
+		}
+		if ipSKU := publicIPSKU(subnet.NatGateway.NatGatewayIP); ipSKU != infrav1.PublicIPSKUStandard {
+			return errors.Errorf("public IP %s of NAT gateway %s has the %s SKU, which is incompatible with the %s SKU of NAT gateways",
+				subnet.NatGateway.NatGatewayIP.Name, subnet.NatGateway.Name, ipSKU, infrav1.PublicIPSKUStandard)
+		}
+	}
+	return nil
+}
+
+// publicIPSKU returns the SKU of a public IP, which defaults to Standard.
+func publicIPSKU(publicIP infrav1.PublicIPSpec) infrav1.PublicIPSKU {
+	if publicIP.SKU == "" {
+		return infrav1.PublicIPSKUStandard
+	}
+	return publicIP.SKU
+}
+
 // APIServerLBName returns the API Server LB name.
 func (s *ClusterScope) APIServerLBName() string {
 	return s.APIServerLB().Name
@@ -746,14 +1191,34 @@ func (s *ClusterScope) OutboundPoolName(role string) string {
 	return lb.BackendPool.Name
 }
 
+// OutboundLBInboundNATPools returns the inbound NAT pools of the outbound LB.
+func (s *ClusterScope) OutboundLBInboundNATPools(role string) []infrav1.InboundNATPool {
+	lb := s.outboundLB(role)
+	if lb == nil {
+		return nil
+	}
+	return lb.InboundNATPools
+}
+
 // ResourceGroup returns the cluster resource group.
 func (s *ClusterScope) ResourceGroup() string {
 	return s.AzureCluster.Spec.ResourceGroup
 }
 
+// ResourceGroupMode returns whether CAPZ manages the lifecycle of the cluster's resource groups.
+func (s *ClusterScope) ResourceGroupMode() infrav1.ResourceGroupMode {
+	if s.AzureCluster.Spec.ResourceGroupMode == "" {
+		return infrav1.ResourceGroupModeManaged
+	}
+	return s.AzureCluster.Spec.ResourceGroupMode
+}
+
 // NodeResourceGroup returns the resource group where nodes live.
-// For AzureClusters this is the same as the cluster RG.
+// For AzureClusters this is the cluster RG unless a separate node RG is set.
 func (s *ClusterScope) NodeResourceGroup() string {
+	if s.AzureCluster.Spec.NodeResourceGroup != "" {
+		return s.AzureCluster.Spec.NodeResourceGroup
+	}
 	return s.ResourceGroup()
 }
 
@@ -852,6 +1317,12 @@ func (s *ClusterScope) PatchObject(ctx context.Context) error {
 			infrav1.NATGatewaysReadyCondition,
 			infrav1.LoadBalancersReadyCondition,
 			infrav1.BastionHostReadyCondition,
+			infrav1.AzureFirewallReadyCondition,
+			infrav1.DiagnosticSettingsReadyCondition,
+			infrav1.PolicyAssignmentsReadyCondition,
+			infrav1.UserAssignedIdentitiesReadyCondition,
+			infrav1.DiskEncryptionSetsReadyCondition,
+			infrav1.NetAppVolumesReadyCondition,
 			infrav1.VNetReadyCondition,
 			infrav1.SubnetsReadyCondition,
 			infrav1.SecurityGroupsReadyCondition,
@@ -876,6 +1347,16 @@ func (s *ClusterScope) AdditionalTags() infrav1.Tags {
 	return tags
 }
 
+// additionalTagsFor returns the AdditionalTags for resources of the given type, or no tags if the
+// TagPropagationPolicy of the AzureCluster doesn't propagate them to resources of that type. Only the services
+// reconciling through ASO remove tags which are no longer desired, the other services only add and update tags.
+func (s *ClusterScope) additionalTagsFor(resourceType infrav1.TaggedResourceType) infrav1.Tags {
+	if !s.AzureCluster.Spec.TagPropagationPolicy.PropagatesTo(resourceType) {
+		return make(infrav1.Tags)
+	}
+	return s.AdditionalTags()
+}
+
 // APIServerPort returns the APIServerPort to use when creating the load balancer.
 func (s *ClusterScope) APIServerPort() int32 {
 	if s.Cluster.Spec.ClusterNetwork != nil && s.Cluster.Spec.ClusterNetwork.APIServerPort != nil {
@@ -884,6 +1365,14 @@ func (s *ClusterScope) APIServerPort() int32 {
 	return 6443
 }
 
+// APIServerFrontendPort returns the port clients use to reach the API server through the API server load balancer.
+func (s *ClusterScope) APIServerFrontendPort() int32 {
+	if port := s.APIServerLB().FrontendPort; port != nil {
+		return *port
+	}
+	return s.APIServerPort()
+}
+
 // APIServerHost returns the hostname used to reach the API server.
 func (s *ClusterScope) APIServerHost() string {
 	if s.IsAPIServerPrivate() {
@@ -1010,10 +1499,14 @@ func (s *ClusterScope) DeleteLongRunningOperationState(name, service, futureType
 // UpdateDeleteStatus updates a condition on the AzureCluster status after a DELETE operation.
 func (s *ClusterScope) UpdateDeleteStatus(condition clusterv1.ConditionType, service string, err error) {
 	switch {
+	case err == nil && s.hasPlannedChanges(service):
+		conditions.MarkFalse(s.AzureCluster, condition, infrav1.DryRunReason, clusterv1.ConditionSeverityInfo, "%s not deleted in dry-run mode", service)
 	case err == nil:
 		conditions.MarkFalse(s.AzureCluster, condition, infrav1.DeletedReason, clusterv1.ConditionSeverityInfo, "%s successfully deleted", service)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.AzureCluster, condition, infrav1.DeletingReason, clusterv1.ConditionSeverityInfo, "%s deleting", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.AzureCluster, condition, service, err)
 	default:
 		conditions.MarkFalse(s.AzureCluster, condition, infrav1.DeletionFailedReason, clusterv1.ConditionSeverityError, "%s failed to delete. err: %s", service, err.Error())
 	}
@@ -1022,10 +1515,14 @@ func (s *ClusterScope) UpdateDeleteStatus(condition clusterv1.ConditionType, ser
 // UpdatePutStatus updates a condition on the AzureCluster status after a PUT operation.
 func (s *ClusterScope) UpdatePutStatus(condition clusterv1.ConditionType, service string, err error) {
 	switch {
+	case err == nil && s.hasPlannedChanges(service):
+		conditions.MarkFalse(s.AzureCluster, condition, infrav1.DryRunReason, clusterv1.ConditionSeverityInfo, "%s not created or updated in dry-run mode", service)
 	case err == nil:
 		conditions.MarkTrue(s.AzureCluster, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.AzureCluster, condition, infrav1.CreatingReason, clusterv1.ConditionSeverityInfo, "%s creating or updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.AzureCluster, condition, service, err)
 	default:
 		conditions.MarkFalse(s.AzureCluster, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to create or update. err: %s", service, err.Error())
 	}
@@ -1034,10 +1531,14 @@ func (s *ClusterScope) UpdatePutStatus(condition clusterv1.ConditionType, servic
 // UpdatePatchStatus updates a condition on the AzureCluster status after a PATCH operation.
 func (s *ClusterScope) UpdatePatchStatus(condition clusterv1.ConditionType, service string, err error) {
 	switch {
+	case err == nil && s.hasPlannedChanges(service):
+		conditions.MarkFalse(s.AzureCluster, condition, infrav1.DryRunReason, clusterv1.ConditionSeverityInfo, "%s not updated in dry-run mode", service)
 	case err == nil:
 		conditions.MarkTrue(s.AzureCluster, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.AzureCluster, condition, infrav1.UpdatingReason, clusterv1.ConditionSeverityInfo, "%s updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.AzureCluster, condition, service, err)
 	default:
 		conditions.MarkFalse(s.AzureCluster, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to update. err: %s", service, err.Error())
 	}
@@ -1103,7 +1604,7 @@ func (s *ClusterScope) PrivateEndpointSpecs() []azure.ASOResourceSpecGetter[*aso
 				ApplicationSecurityGroups:  privateEndpoint.ApplicationSecurityGroups,
 				ManualApproval:             privateEndpoint.ManualApproval,
 				ClusterName:                s.ClusterName(),
-				AdditionalTags:             s.AdditionalTags(),
+				AdditionalTags:             s.additionalTagsFor(infrav1.TaggedResourceTypePrivateEndpoint),
 			}
 
 			for _, privateLinkServiceConnection := range privateEndpoint.PrivateLinkServiceConnections {
@@ -1136,3 +1637,57 @@ func (s *ClusterScope) getLastAppliedSecurityRules(nsgName string) map[string]in
 	}
 	return lastAppliedSecurityRules
 }
+
+func (s *ClusterScope) getLastAppliedBackendPools(lbName string) map[string]interface{} {
+	// Retrieve the last applied backend pools for all load balancers.
+	lastAppliedBackendPoolsAll, err := s.AnnotationJSON(azure.BackendPoolLastAppliedAnnotation)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	// Retrieve the last applied backend pools for this load balancer.
+	lastAppliedBackendPools, ok := lastAppliedBackendPoolsAll[lbName].(map[string]interface{})
+	if !ok {
+		lastAppliedBackendPools = map[string]interface{}{}
+	}
+	return lastAppliedBackendPools
+}
+
+func (s *ClusterScope) getLastAppliedInboundNATPools(lbName string) map[string]interface{} {
+	// Retrieve the last applied inbound NAT pools for all load balancers.
+	lastAppliedInboundNATPoolsAll, err := s.AnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	// Retrieve the last applied inbound NAT pools for this load balancer.
+	lastAppliedInboundNATPools, ok := lastAppliedInboundNATPoolsAll[lbName].(map[string]interface{})
+	if !ok {
+		lastAppliedInboundNATPools = map[string]interface{}{}
+	}
+	return lastAppliedInboundNATPools
+}
+
+// additionalBackendPoolNames returns the names of the additional backend pools of a load balancer.
+func additionalBackendPoolNames(lb *infrav1.LoadBalancerSpec) []string {
+	var names []string
+	for _, pool := range lb.AdditionalBackendPools {
+		names = append(names, pool.Name)
+	}
+	return names
+}
+
+func (s *ClusterScope) getLastAppliedRoutes(routeTableName string) map[string]interface{} {
+	// Retrieve the last applied routes for all route tables.
+	lastAppliedRoutesAll, err := s.AnnotationJSON(azure.RouteLastAppliedAnnotation)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	// Retrieve the last applied routes for this route table.
+	lastAppliedRoutes, ok := lastAppliedRoutesAll[routeTableName].(map[string]interface{})
+	if !ok {
+		lastAppliedRoutes = map[string]interface{}{}
+	}
+	return lastAppliedRoutes
+}
@@ -22,21 +22,30 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	asocomputev1 "github.com/Azure/azure-service-operator/v2/api/compute/v1api20220702"
 	asonetworkv1api20201101 "github.com/Azure/azure-service-operator/v2/api/network/v1api20201101"
 	asonetworkv1api20220701 "github.com/Azure/azure-service-operator/v2/api/network/v1api20220701"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bastionhosts"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/diagnosticsettings"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/diskencryptionsets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/loadbalancers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/natgateways"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/netappvolumes"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/policyassignments"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privatedns"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/routetables"
@@ -44,6 +53,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/vnetpeerings"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -898,18 +908,136 @@ func TestRouteTableSpecs(t *testing.T) {
 			},
 			want: []azure.ResourceSpecGetter{
 				&routetables.RouteTableSpec{
-					Name:           "fake-route-table-1",
-					ResourceGroup:  "my-rg",
-					Location:       "centralIndia",
-					ClusterName:    "my-cluster",
-					AdditionalTags: make(infrav1.Tags),
+					Name:              "fake-route-table-1",
+					ResourceGroup:     "my-rg",
+					Location:          "centralIndia",
+					ClusterName:       "my-cluster",
+					AdditionalTags:    make(infrav1.Tags),
+					LastAppliedRoutes: map[string]interface{}{},
 				},
 				&routetables.RouteTableSpec{
-					Name:           "fake-route-table-2",
-					ResourceGroup:  "my-rg",
-					Location:       "centralIndia",
-					ClusterName:    "my-cluster",
-					AdditionalTags: make(infrav1.Tags),
+					Name:              "fake-route-table-2",
+					ResourceGroup:     "my-rg",
+					Location:          "centralIndia",
+					ClusterName:       "my-cluster",
+					AdditionalTags:    make(infrav1.Tags),
+					LastAppliedRoutes: map[string]interface{}{},
+				},
+			},
+		},
+		{
+			name: "merges route table tags over the cluster additional tags",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+							AdditionalTags: infrav1.Tags{
+								"cost-center": "cluster",
+								"team":        "platform",
+							},
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							Vnet: infrav1.VnetSpec{
+								ResourceGroup: "my-rg",
+							},
+							Subnets: infrav1.Subnets{
+								{
+									RouteTable: infrav1.RouteTable{
+										Name: "fake-route-table-1",
+										Tags: infrav1.Tags{
+											"cost-center": "subnet-1",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				cache: &ClusterCache{},
+			},
+			want: []azure.ResourceSpecGetter{
+				&routetables.RouteTableSpec{
+					Name:          "fake-route-table-1",
+					ResourceGroup: "my-rg",
+					Location:      "centralIndia",
+					ClusterName:   "my-cluster",
+					AdditionalTags: infrav1.Tags{
+						"cost-center": "subnet-1",
+						"team":        "platform",
+					},
+					LastAppliedRoutes: map[string]interface{}{},
+				},
+			},
+		},
+		{
+			name: "routes node subnets through the azure firewall",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							Vnet: infrav1.VnetSpec{
+								ResourceGroup: "my-rg",
+							},
+							Subnets: infrav1.Subnets{
+								{
+									SubnetClassSpec: infrav1.SubnetClassSpec{
+										Role: infrav1.SubnetControlPlane,
+									},
+									RouteTable: infrav1.RouteTable{
+										Name: "fake-route-table-1",
+									},
+								},
+								{
+									SubnetClassSpec: infrav1.SubnetClassSpec{
+										Role: infrav1.SubnetNode,
+									},
+									RouteTable: infrav1.RouteTable{
+										Name: "fake-route-table-2",
+									},
+								},
+							},
+							AzureFirewall: &infrav1.AzureFirewall{
+								RouteNodeEgress: true,
+							},
+						},
+					},
+					Status: infrav1.AzureClusterStatus{
+						AzureFirewallPrivateIP: "10.255.255.132",
+					},
+				},
+				cache: &ClusterCache{},
+			},
+			want: []azure.ResourceSpecGetter{
+				&routetables.RouteTableSpec{
+					Name:              "fake-route-table-1",
+					ResourceGroup:     "my-rg",
+					Location:          "centralIndia",
+					ClusterName:       "my-cluster",
+					AdditionalTags:    make(infrav1.Tags),
+					LastAppliedRoutes: map[string]interface{}{},
+				},
+				&routetables.RouteTableSpec{
+					Name:                  "fake-route-table-2",
+					ResourceGroup:         "my-rg",
+					Location:              "centralIndia",
+					ClusterName:           "my-cluster",
+					AdditionalTags:        make(infrav1.Tags),
+					DefaultRouteNextHopIP: "10.255.255.132",
+					LastAppliedRoutes:     map[string]interface{}{},
 				},
 			},
 		},
@@ -1332,6 +1460,125 @@ func TestNSGSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "merges security group tags over the cluster additional tags",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+							AdditionalTags: infrav1.Tags{
+								"cost-center": "cluster",
+								"team":        "platform",
+							},
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							Vnet: infrav1.VnetSpec{
+								ResourceGroup: "my-rg",
+							},
+							Subnets: infrav1.Subnets{
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-1",
+										SecurityGroupClass: infrav1.SecurityGroupClass{
+											Tags: infrav1.Tags{
+												"cost-center": "subnet-1",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				cache: &ClusterCache{},
+			},
+			want: []azure.ResourceSpecGetter{
+				&securitygroups.NSGSpec{
+					Name:          "fake-security-group-1",
+					ResourceGroup: "my-rg",
+					Location:      "centralIndia",
+					ClusterName:   "my-cluster",
+					AdditionalTags: infrav1.Tags{
+						"cost-center": "subnet-1",
+						"team":        "platform",
+					},
+					LastAppliedSecurityRules: map[string]interface{}{},
+				},
+			},
+		},
+		{
+			name: "does not propagate the cluster additional tags if the tag propagation policy excludes security groups",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+							AdditionalTags: infrav1.Tags{
+								"cost-center": "cluster",
+								"team":        "platform",
+							},
+						},
+						TagPropagationPolicy: &infrav1.TagPropagationPolicy{
+							ResourceTypes: []infrav1.TaggedResourceType{infrav1.TaggedResourceTypeVirtualNetwork},
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							Vnet: infrav1.VnetSpec{
+								ResourceGroup: "my-rg",
+							},
+							Subnets: infrav1.Subnets{
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-1",
+										SecurityGroupClass: infrav1.SecurityGroupClass{
+											Tags: infrav1.Tags{
+												"cost-center": "subnet-1",
+											},
+										},
+									},
+								},
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-2",
+									},
+								},
+							},
+						},
+					},
+				},
+				cache: &ClusterCache{},
+			},
+			want: []azure.ResourceSpecGetter{
+				&securitygroups.NSGSpec{
+					Name:          "fake-security-group-1",
+					ResourceGroup: "my-rg",
+					Location:      "centralIndia",
+					ClusterName:   "my-cluster",
+					AdditionalTags: infrav1.Tags{
+						"cost-center": "subnet-1",
+					},
+					LastAppliedSecurityRules: map[string]interface{}{},
+				},
+				&securitygroups.NSGSpec{
+					Name:                     "fake-security-group-2",
+					ResourceGroup:            "my-rg",
+					Location:                 "centralIndia",
+					ClusterName:              "my-cluster",
+					AdditionalTags:           make(infrav1.Tags),
+					LastAppliedSecurityRules: map[string]interface{}{},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2867,6 +3114,59 @@ func TestAPIServerPort(t *testing.T) {
 	}
 }
 
+func TestAPIServerFrontendPort(t *testing.T) {
+	tests := []struct {
+		name           string
+		clusterNetwork *clusterv1.ClusterNetwork
+		frontendPort   *int32
+		expectedPort   int32
+	}{
+		{
+			name:         "defaults to the default API server port",
+			expectedPort: 6443,
+		},
+		{
+			name: "defaults to the API server port",
+			clusterNetwork: &clusterv1.ClusterNetwork{
+				APIServerPort: ptr.To[int32](7000),
+			},
+			expectedPort: 7000,
+		},
+		{
+			name: "custom frontend port is mapped to the API server port",
+			clusterNetwork: &clusterv1.ClusterNetwork{
+				APIServerPort: ptr.To[int32](7000),
+			},
+			frontendPort: ptr.To[int32](443),
+			expectedPort: 443,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := &ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					Spec: clusterv1.ClusterSpec{
+						ClusterNetwork: tc.clusterNetwork,
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							APIServerLB: infrav1.LoadBalancerSpec{
+								LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{
+									FrontendPort: tc.frontendPort,
+								},
+							},
+						},
+					},
+				},
+			}
+			g.Expect(clusterScope.APIServerFrontendPort()).To(Equal(tc.expectedPort))
+		})
+	}
+}
+
 func TestFailureDomains(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -3092,6 +3392,7 @@ func TestClusterScope_LBSpecs(t *testing.T) {
 					AdditionalTags: infrav1.Tags{
 						"foo": "bar",
 					},
+					LastAppliedBackendPools: map[string]interface{}{},
 				},
 				&loadbalancers.LBSpec{
 					Name:              "node-outbound-lb",
@@ -3117,6 +3418,7 @@ func TestClusterScope_LBSpecs(t *testing.T) {
 					AdditionalTags: infrav1.Tags{
 						"foo": "bar",
 					},
+					LastAppliedInboundNATPools: map[string]interface{}{},
 				},
 				&loadbalancers.LBSpec{
 					Name:              "cp-outbound-lb",
@@ -3210,6 +3512,8 @@ func TestClusterScope_LBSpecs(t *testing.T) {
 					BackendPoolName:      "api-server-lb-backend-pool",
 					IdleTimeoutInMinutes: ptr.To[int32](30),
 					AdditionalTags:       infrav1.Tags{},
+
+					LastAppliedBackendPools: map[string]interface{}{},
 				},
 			},
 		},
@@ -3914,32 +4218,422 @@ func TestPrivateEndpointSpecs(t *testing.T) {
 	}
 }
 
-func TestSetFailureDomain(t *testing.T) {
-	t.Parallel()
-
-	cases := map[string]struct {
-		discoveredFDs clusterv1.FailureDomains
-		specifiedFDs  clusterv1.FailureDomains
-		expectedFDs   clusterv1.FailureDomains
-	}{
-		"no failure domains specified": {
-			discoveredFDs: clusterv1.FailureDomains{
-				"fd1": clusterv1.FailureDomainSpec{ControlPlane: true},
-				"fd2": clusterv1.FailureDomainSpec{ControlPlane: false},
+func TestPrivateDNSSpecRecords(t *testing.T) {
+	newClusterScope := func(lbType infrav1.LBType, privateIP string) ClusterScope {
+		return ClusterScope{
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster",
+				},
 			},
-			expectedFDs: clusterv1.FailureDomains{
-				"fd1": clusterv1.FailureDomainSpec{ControlPlane: true},
-				"fd2": clusterv1.FailureDomainSpec{ControlPlane: false},
+			AzureClients: AzureClients{
+				EnvironmentSettings: auth.EnvironmentSettings{
+					Values: map[string]string{
+						auth.SubscriptionID: "123",
+					},
+				},
 			},
-		},
-		"no failure domains discovered": {
-			specifiedFDs: clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
-		},
-		"failure domain specified without intersection": {
-			discoveredFDs: clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
-			specifiedFDs:  clusterv1.FailureDomains{"fd2": clusterv1.FailureDomainSpec{ControlPlane: false}},
-			expectedFDs:   clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
-		},
+			AzureCluster: &infrav1.AzureCluster{
+				Spec: infrav1.AzureClusterSpec{
+					ResourceGroup: "my-rg",
+					NetworkSpec: infrav1.NetworkSpec{
+						Vnet: infrav1.VnetSpec{Name: "my-vnet", ResourceGroup: "my-rg"},
+						APIServerLB: infrav1.LoadBalancerSpec{
+							Name: "api-lb",
+							FrontendIPs: []infrav1.FrontendIP{
+								{
+									Name:            "api-lb-frontend",
+									FrontendIPClass: infrav1.FrontendIPClass{PrivateIPAddress: privateIP},
+								},
+							},
+							LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{Type: lbType},
+						},
+					},
+				},
+			},
+			cache: &ClusterCache{},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		clusterScope ClusterScope
+		want         []azure.ResourceSpecGetter
+	}{
+		{
+			name:         "returns no records for a public API server",
+			clusterScope: newClusterScope(infrav1.Public, ""),
+			want:         nil,
+		},
+		{
+			name:         "returns a record for the internal load balancer frontend IP of a private API server",
+			clusterScope: newClusterScope(infrav1.Internal, "10.0.0.100"),
+			want: []azure.ResourceSpecGetter{
+				privatedns.RecordSpec{
+					Record:        infrav1.AddressRecord{Hostname: azure.PrivateAPIServerHostname, IP: "10.0.0.100"},
+					ZoneName:      "my-cluster.capz.io",
+					ResourceGroup: "my-rg",
+				},
+			},
+		},
+		{
+			name:         "returns a record for a changed internal load balancer frontend IP",
+			clusterScope: newClusterScope(infrav1.Internal, "10.0.0.200"),
+			want: []azure.ResourceSpecGetter{
+				privatedns.RecordSpec{
+					Record:        infrav1.AddressRecord{Hostname: azure.PrivateAPIServerHostname, IP: "10.0.0.200"},
+					ZoneName:      "my-cluster.capz.io",
+					ResourceGroup: "my-rg",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			_, _, records := tt.clusterScope.PrivateDNSSpec()
+			g.Expect(records).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestDiagnosticSettingSpecs(t *testing.T) {
+	const workspaceID = "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/logs"
+	newClusterScope := func(vnet infrav1.VnetSpec, settings ...infrav1.DiagnosticSetting) ClusterScope {
+		return ClusterScope{
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster",
+				},
+			},
+			AzureClients: AzureClients{
+				EnvironmentSettings: auth.EnvironmentSettings{
+					Values: map[string]string{
+						auth.SubscriptionID: "123",
+					},
+				},
+			},
+			AzureCluster: &infrav1.AzureCluster{
+				Spec: infrav1.AzureClusterSpec{
+					ResourceGroup: "my-rg",
+					NetworkSpec: infrav1.NetworkSpec{
+						Vnet: vnet,
+						Subnets: infrav1.Subnets{
+							{SecurityGroup: infrav1.SecurityGroup{Name: "control-plane-nsg"}},
+							{SecurityGroup: infrav1.SecurityGroup{Name: "node-nsg"}},
+							{SecurityGroup: infrav1.SecurityGroup{Name: "node-nsg"}},
+						},
+						APIServerLB: infrav1.LoadBalancerSpec{Name: "api-lb"},
+						NodeOutboundLB: &infrav1.LoadBalancerSpec{
+							Name: "node-outbound-lb",
+						},
+						DiagnosticSettings: settings,
+					},
+				},
+			},
+			cache: &ClusterCache{},
+		}
+	}
+	managedVnet := infrav1.VnetSpec{Name: "my-vnet", ResourceGroup: "my-rg"}
+
+	tests := []struct {
+		name         string
+		clusterScope ClusterScope
+		want         []azure.ResourceSpecGetter
+	}{
+		{
+			name:         "returns nil if no diagnostic settings are specified",
+			clusterScope: newClusterScope(managedVnet),
+			want:         nil,
+		},
+		{
+			name: "returns a diagnostic setting for each targeted resource",
+			clusterScope: newClusterScope(managedVnet,
+				infrav1.DiagnosticSetting{
+					Name:             "metrics",
+					Targets:          []infrav1.DiagnosticSettingTarget{infrav1.DiagnosticSettingTargetVirtualNetwork, infrav1.DiagnosticSettingTargetLoadBalancer},
+					WorkspaceID:      workspaceID,
+					MetricCategories: []string{"AllMetrics"},
+				},
+				infrav1.DiagnosticSetting{
+					Name:          "nsg-logs",
+					Targets:       []infrav1.DiagnosticSettingTarget{infrav1.DiagnosticSettingTargetSecurityGroup},
+					WorkspaceID:   workspaceID,
+					LogCategories: []string{"NetworkSecurityGroupEvent"},
+				},
+			),
+			want: []azure.ResourceSpecGetter{
+				&diagnosticsettings.DiagnosticSettingSpec{
+					Name:             "metrics",
+					ResourceGroup:    "my-rg",
+					TargetResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet",
+					WorkspaceID:      workspaceID,
+					MetricCategories: []string{"AllMetrics"},
+				},
+				&diagnosticsettings.DiagnosticSettingSpec{
+					Name:             "metrics",
+					ResourceGroup:    "my-rg",
+					TargetResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/api-lb",
+					WorkspaceID:      workspaceID,
+					MetricCategories: []string{"AllMetrics"},
+				},
+				&diagnosticsettings.DiagnosticSettingSpec{
+					Name:             "metrics",
+					ResourceGroup:    "my-rg",
+					TargetResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/node-outbound-lb",
+					WorkspaceID:      workspaceID,
+					MetricCategories: []string{"AllMetrics"},
+				},
+				&diagnosticsettings.DiagnosticSettingSpec{
+					Name:             "nsg-logs",
+					ResourceGroup:    "my-rg",
+					TargetResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/control-plane-nsg",
+					WorkspaceID:      workspaceID,
+					LogCategories:    []string{"NetworkSecurityGroupEvent"},
+				},
+				&diagnosticsettings.DiagnosticSettingSpec{
+					Name:             "nsg-logs",
+					ResourceGroup:    "my-rg",
+					TargetResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/node-nsg",
+					WorkspaceID:      workspaceID,
+					LogCategories:    []string{"NetworkSecurityGroupEvent"},
+				},
+			},
+		},
+		{
+			name: "skips the virtual network and security groups if the virtual network is not managed",
+			clusterScope: newClusterScope(infrav1.VnetSpec{ID: "my-vnet-id", Name: "my-vnet", ResourceGroup: "vnet-rg"},
+				infrav1.DiagnosticSetting{
+					Name:             "metrics",
+					Targets:          []infrav1.DiagnosticSettingTarget{infrav1.DiagnosticSettingTargetVirtualNetwork, infrav1.DiagnosticSettingTargetSecurityGroup, infrav1.DiagnosticSettingTargetLoadBalancer},
+					WorkspaceID:      workspaceID,
+					MetricCategories: []string{"AllMetrics"},
+				},
+			),
+			want: []azure.ResourceSpecGetter{
+				&diagnosticsettings.DiagnosticSettingSpec{
+					Name:             "metrics",
+					ResourceGroup:    "my-rg",
+					TargetResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/api-lb",
+					WorkspaceID:      workspaceID,
+					MetricCategories: []string{"AllMetrics"},
+				},
+				&diagnosticsettings.DiagnosticSettingSpec{
+					Name:             "metrics",
+					ResourceGroup:    "my-rg",
+					TargetResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/node-outbound-lb",
+					WorkspaceID:      workspaceID,
+					MetricCategories: []string{"AllMetrics"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			g.Expect(tt.clusterScope.DiagnosticSettingSpecs()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestPolicyAssignmentSpecs(t *testing.T) {
+	const definitionID = "/providers/Microsoft.Authorization/policyDefinitions/871b6d14-10aa-478d-b590-94f262ecfa99"
+	newClusterScope := func(assignments ...infrav1.PolicyAssignment) ClusterScope {
+		return ClusterScope{
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster",
+				},
+			},
+			AzureClients: AzureClients{
+				EnvironmentSettings: auth.EnvironmentSettings{
+					Values: map[string]string{
+						auth.SubscriptionID: "123",
+					},
+				},
+			},
+			AzureCluster: &infrav1.AzureCluster{
+				Spec: infrav1.AzureClusterSpec{
+					ResourceGroup:     "my-rg",
+					PolicyAssignments: assignments,
+				},
+			},
+			cache: &ClusterCache{},
+		}
+	}
+	parameters := map[string]apiextensionsv1.JSON{
+		"tagName": {Raw: []byte(`"environment"`)},
+	}
+
+	tests := []struct {
+		name         string
+		clusterScope ClusterScope
+		want         []azure.ResourceSpecGetter
+	}{
+		{
+			name:         "returns nil if no policy assignments are specified",
+			clusterScope: newClusterScope(),
+			want:         nil,
+		},
+		{
+			name: "defaults the scope to the cluster's resource group",
+			clusterScope: newClusterScope(
+				infrav1.PolicyAssignment{
+					Name:               "require-tags",
+					PolicyDefinitionID: definitionID,
+					Parameters:         parameters,
+				},
+				infrav1.PolicyAssignment{
+					Name:               "require-tags-on-vnet",
+					PolicyDefinitionID: definitionID,
+					Scope:              "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet",
+				},
+			),
+			want: []azure.ResourceSpecGetter{
+				&policyassignments.PolicyAssignmentSpec{
+					Name:               "require-tags",
+					ResourceGroup:      "my-rg",
+					Scope:              "/subscriptions/123/resourceGroups/my-rg",
+					PolicyDefinitionID: definitionID,
+					PolicyParameters:   parameters,
+				},
+				&policyassignments.PolicyAssignmentSpec{
+					Name:               "require-tags-on-vnet",
+					ResourceGroup:      "my-rg",
+					Scope:              "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet",
+					PolicyDefinitionID: definitionID,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			g.Expect(tt.clusterScope.PolicyAssignmentSpecs()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestNetAppSpecs(t *testing.T) {
+	g := NewWithT(t)
+
+	const subnetID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/anf"
+	pool := infrav1.NetAppCapacityPool{Name: "pool", ServiceLevel: "Premium", SizeTiB: 4}
+	c := ClusterScope{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-cluster",
+			},
+		},
+		AzureClients: AzureClients{
+			EnvironmentSettings: auth.EnvironmentSettings{
+				Values: map[string]string{
+					auth.SubscriptionID: "123",
+				},
+			},
+		},
+		AzureCluster: &infrav1.AzureCluster{
+			Spec: infrav1.AzureClusterSpec{
+				ResourceGroup: "my-rg",
+				AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+					Location: "westus2",
+				},
+				NetAppVolumes: []infrav1.NetAppVolume{
+					{Name: "postgres", AccountName: "account", CapacityPool: pool, SizeGiB: 100, SubnetID: subnetID},
+					{Name: "mysql", AccountName: "account", CapacityPool: pool, SizeGiB: 200, Protocol: infrav1.NetAppVolumeProtocolNFSv41, SubnetID: subnetID},
+				},
+			},
+		},
+		cache: &ClusterCache{},
+	}
+
+	g.Expect(c.NetAppAccountSpecs()).To(Equal([]azure.ResourceSpecGetter{
+		&netappvolumes.AccountSpec{
+			Name:           "account",
+			ResourceGroup:  "my-rg",
+			SubscriptionID: "123",
+			Location:       "westus2",
+			ClusterName:    "my-cluster",
+			AdditionalTags: infrav1.Tags{},
+		},
+	}))
+	g.Expect(c.NetAppCapacityPoolSpecs()).To(Equal([]azure.ResourceSpecGetter{
+		&netappvolumes.CapacityPoolSpec{
+			Name:           "pool",
+			AccountName:    "account",
+			ResourceGroup:  "my-rg",
+			SubscriptionID: "123",
+			Location:       "westus2",
+			ServiceLevel:   "Premium",
+			SizeTiB:        4,
+			ClusterName:    "my-cluster",
+			AdditionalTags: infrav1.Tags{},
+		},
+	}))
+	g.Expect(c.NetAppVolumeSpecs()).To(Equal([]azure.ResourceSpecGetter{
+		&netappvolumes.VolumeSpec{
+			Name:             "postgres",
+			AccountName:      "account",
+			CapacityPoolName: "pool",
+			ResourceGroup:    "my-rg",
+			SubscriptionID:   "123",
+			Location:         "westus2",
+			ServiceLevel:     "Premium",
+			SizeGiB:          100,
+			Protocol:         infrav1.NetAppVolumeProtocolNFSv3,
+			SubnetID:         subnetID,
+			ClusterName:      "my-cluster",
+			AdditionalTags:   infrav1.Tags{},
+		},
+		&netappvolumes.VolumeSpec{
+			Name:             "mysql",
+			AccountName:      "account",
+			CapacityPoolName: "pool",
+			ResourceGroup:    "my-rg",
+			SubscriptionID:   "123",
+			Location:         "westus2",
+			ServiceLevel:     "Premium",
+			SizeGiB:          200,
+			Protocol:         infrav1.NetAppVolumeProtocolNFSv41,
+			SubnetID:         subnetID,
+			ClusterName:      "my-cluster",
+			AdditionalTags:   infrav1.Tags{},
+		},
+	}))
+}
+
+func TestSetFailureDomain(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		discoveredFDs clusterv1.FailureDomains
+		specifiedFDs  clusterv1.FailureDomains
+		expectedFDs   clusterv1.FailureDomains
+	}{
+		"no failure domains specified": {
+			discoveredFDs: clusterv1.FailureDomains{
+				"fd1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"fd2": clusterv1.FailureDomainSpec{ControlPlane: false},
+			},
+			expectedFDs: clusterv1.FailureDomains{
+				"fd1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"fd2": clusterv1.FailureDomainSpec{ControlPlane: false},
+			},
+		},
+		"no failure domains discovered": {
+			specifiedFDs: clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
+		},
+		"failure domain specified without intersection": {
+			discoveredFDs: clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
+			specifiedFDs:  clusterv1.FailureDomains{"fd2": clusterv1.FailureDomainSpec{ControlPlane: false}},
+			expectedFDs:   clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
+		},
 		"failure domain override to false succeeds": {
 			discoveredFDs: clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
 			specifiedFDs:  clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: false}},
@@ -3986,3 +4680,385 @@ func TestSetFailureDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupSpecs(t *testing.T) {
+	tests := []struct {
+		name              string
+		vnetResourceGroup string
+		nodeResourceGroup string
+		wantNames         []string
+		wantNodeRG        string
+	}{
+		{
+			name:              "cluster resource group only",
+			vnetResourceGroup: "my-rg",
+			wantNames:         []string{"my-rg"},
+			wantNodeRG:        "my-rg",
+		},
+		{
+			name:              "separate vnet and node resource groups",
+			vnetResourceGroup: "network-rg",
+			nodeResourceGroup: "compute-rg",
+			wantNames:         []string{"my-rg", "network-rg", "compute-rg"},
+			wantNodeRG:        "compute-rg",
+		},
+		{
+			name:              "node resource group is the vnet resource group",
+			vnetResourceGroup: "shared-rg",
+			nodeResourceGroup: "shared-rg",
+			wantNames:         []string{"my-rg", "shared-rg"},
+			wantNodeRG:        "shared-rg",
+		},
+		{
+			name:              "node resource group is the cluster resource group",
+			vnetResourceGroup: "my-rg",
+			nodeResourceGroup: "my-rg",
+			wantNames:         []string{"my-rg"},
+			wantNodeRG:        "my-rg",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			s := &ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "westus",
+						},
+						ResourceGroup:     "my-rg",
+						NodeResourceGroup: tc.nodeResourceGroup,
+						NetworkSpec: infrav1.NetworkSpec{
+							Vnet: infrav1.VnetSpec{
+								ResourceGroup: tc.vnetResourceGroup,
+							},
+						},
+					},
+				},
+			}
+			var names []string
+			for _, spec := range s.GroupSpecs() {
+				names = append(names, spec.ResourceRef().Name)
+			}
+			g.Expect(names).To(Equal(tc.wantNames))
+			g.Expect(s.NodeResourceGroup()).To(Equal(tc.wantNodeRG))
+		})
+	}
+}
+
+func TestClusterScope_IsPaused(t *testing.T) {
+	cases := map[string]struct {
+		clusterPaused bool
+		annotations   map[string]string
+		expected      bool
+	}{
+		"not paused": {
+			expected: false,
+		},
+		"cluster is paused": {
+			clusterPaused: true,
+			expected:      true,
+		},
+		"azure cluster has the paused annotation": {
+			annotations: map[string]string{clusterv1.PausedAnnotation: "true"},
+			expected:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			c := ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					Spec: clusterv1.ClusterSpec{
+						Paused: tc.clusterPaused,
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tc.annotations,
+					},
+				},
+			}
+
+			g.Expect(c.IsPaused()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestClusterScope_UpdatePutStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	c := ClusterScope{
+		AzureCluster: &infrav1.AzureCluster{},
+	}
+
+	// a transient error, e.g. throttling, is reported as reconciling rather than failed.
+	c.UpdatePutStatus(infrav1.SubnetsReadyCondition, "subnets", azure.WithTransientError(errors.New("throttled"), time.Minute))
+	conditions.SetSummary(c.AzureCluster)
+	g.Expect(conditions.IsFalse(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(Equal(infrav1.ReconcilingReason))
+	g.Expect(conditions.GetSeverity(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(HaveValue(Equal(clusterv1.ConditionSeverityInfo)))
+	g.Expect(conditions.GetMessage(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(ContainSubstring("throttled"))
+	g.Expect(conditions.IsFalse(c.AzureCluster, clusterv1.ReadyCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(c.AzureCluster, clusterv1.ReadyCondition)).To(Equal(infrav1.ReconcilingReason))
+
+	// the next successful reconcile marks the service and the summary ready.
+	c.UpdatePutStatus(infrav1.SubnetsReadyCondition, "subnets", nil)
+	conditions.SetSummary(c.AzureCluster)
+	g.Expect(conditions.IsTrue(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsTrue(c.AzureCluster, clusterv1.ReadyCondition)).To(BeTrue())
+
+	// any other error is reported as failed.
+	c.UpdatePutStatus(infrav1.SubnetsReadyCondition, "subnets", errors.New("boom"))
+	conditions.SetSummary(c.AzureCluster)
+	g.Expect(conditions.GetReason(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(Equal(infrav1.FailedReason))
+	g.Expect(conditions.GetReason(c.AzureCluster, clusterv1.ReadyCondition)).To(Equal(infrav1.FailedReason))
+
+	// a terminal Azure error reported by ASO, e.g. an exceeded quota, is reported as failed with its message.
+	c.UpdatePutStatus(infrav1.SubnetsReadyCondition, "subnets", azure.WithTerminalError(errors.New("resource is not Ready: QuotaExceeded: quota exceeded")))
+	conditions.SetSummary(c.AzureCluster)
+	g.Expect(conditions.GetReason(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(Equal(infrav1.FailedReason))
+	g.Expect(conditions.GetSeverity(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(HaveValue(Equal(clusterv1.ConditionSeverityError)))
+	g.Expect(conditions.GetMessage(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(ContainSubstring("QuotaExceeded: quota exceeded"))
+}
+
+func TestClusterScope_DryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	c := ClusterScope{
+		AzureCluster: &infrav1.AzureCluster{},
+	}
+	g.Expect(c.IsDryRun()).To(BeFalse())
+
+	c.AzureCluster.Annotations = map[string]string{infrav1.DryRunAnnotation: "true"}
+	g.Expect(c.IsDryRun()).To(BeTrue())
+
+	c.RecordPlannedChange("subnets", "create resource my-rg/my-subnet")
+	g.Expect(c.PlannedChanges()).To(Equal([]string{"subnets: create resource my-rg/my-subnet"}))
+
+	// a service with planned changes is not reported ready since its changes were not applied.
+	c.UpdatePutStatus(infrav1.SubnetsReadyCondition, "subnets", nil)
+	g.Expect(conditions.IsFalse(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(c.AzureCluster, infrav1.SubnetsReadyCondition)).To(Equal(infrav1.DryRunReason))
+
+	// a service without planned changes is already up to date.
+	c.UpdatePutStatus(infrav1.VNetReadyCondition, "virtualnetworks", nil)
+	g.Expect(conditions.IsTrue(c.AzureCluster, infrav1.VNetReadyCondition)).To(BeTrue())
+}
+
+func TestClusterScope_SetPublicIPFQDN(t *testing.T) {
+	g := NewWithT(t)
+
+	c := ClusterScope{
+		AzureCluster: &infrav1.AzureCluster{},
+	}
+
+	c.SetPublicIPFQDN("pip-my-cluster-apiserver", "my-cluster-1a2b3c.westus2.cloudapp.azure.com")
+	c.SetPublicIPFQDN("pip-my-cluster-bastion", "my-bastion.westus2.cloudapp.azure.com")
+	c.SetPublicIPFQDN("pip-my-cluster-apiserver", "my-cluster.westus2.cloudapp.azure.com")
+	g.Expect(c.AzureCluster.Status.PublicIPs).To(Equal([]infrav1.PublicIPStatus{
+		{Name: "pip-my-cluster-apiserver", FQDN: "my-cluster.westus2.cloudapp.azure.com"},
+		{Name: "pip-my-cluster-bastion", FQDN: "my-bastion.westus2.cloudapp.azure.com"},
+	}))
+}
+
+func TestClusterScope_SetAzureFirewallPrivateIP(t *testing.T) {
+	g := NewWithT(t)
+
+	firewall := &infrav1.AzureFirewall{Name: "my-cluster-firewall", RouteNodeEgress: true}
+	c := ClusterScope{
+		AzureCluster: &infrav1.AzureCluster{
+			Spec: infrav1.AzureClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					AzureFirewall: firewall.DeepCopy(),
+				},
+			},
+		},
+	}
+
+	c.SetAzureFirewallPrivateIP("10.255.255.132")
+	g.Expect(c.AzureCluster.Status.AzureFirewallPrivateIP).To(Equal("10.255.255.132"))
+	g.Expect(c.AzureCluster.Spec.NetworkSpec.AzureFirewall).To(Equal(firewall))
+}
+
+func TestClusterScope_SetUserAssignedIdentityStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	c := ClusterScope{
+		AzureCluster: &infrav1.AzureCluster{},
+	}
+
+	c.SetUserAssignedIdentityStatus(infrav1.ManagedIdentityStatus{Name: "workload", PrincipalID: "old-principal-id"})
+	c.SetUserAssignedIdentityStatus(infrav1.ManagedIdentityStatus{Name: "kubelet", PrincipalID: "kubelet-principal-id"})
+	c.SetUserAssignedIdentityStatus(infrav1.ManagedIdentityStatus{Name: "workload", PrincipalID: "principal-id", ClientID: "client-id"})
+	g.Expect(c.AzureCluster.Status.UserAssignedIdentities).To(Equal([]infrav1.ManagedIdentityStatus{
+		{Name: "workload", PrincipalID: "principal-id", ClientID: "client-id"},
+		{Name: "kubelet", PrincipalID: "kubelet-principal-id"},
+	}))
+}
+
+func TestClusterScope_DiskEncryptionSetSpecs(t *testing.T) {
+	g := NewWithT(t)
+
+	c := ClusterScope{
+		AzureClients: AzureClients{
+			EnvironmentSettings: auth.EnvironmentSettings{
+				Values: map[string]string{
+					auth.SubscriptionID: "123",
+				},
+			},
+		},
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-cluster",
+			},
+		},
+		AzureCluster: &infrav1.AzureCluster{
+			Spec: infrav1.AzureClusterSpec{
+				ResourceGroup: "my-rg",
+				AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+					Location: "eastus",
+				},
+				DiskEncryptionSets: []infrav1.DiskEncryptionSet{
+					{
+						Name:     "system",
+						KeyURL:   "https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef",
+						Identity: infrav1.VMIdentitySystemAssigned,
+					},
+					{
+						Name:                              "user",
+						KeyURL:                            "https://my-vault.vault.azure.net/keys/my-key",
+						Identity:                          infrav1.VMIdentityUserAssigned,
+						UserAssignedIdentity:              &infrav1.UserAssignedIdentity{ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/des"},
+						RotationToLatestKeyVersionEnabled: ptr.To(true),
+					},
+				},
+			},
+		},
+	}
+
+	g.Expect(c.DiskEncryptionSetSpecs()).To(Equal([]azure.ASOResourceSpecGetter[*asocomputev1.DiskEncryptionSet]{
+		&diskencryptionsets.DiskEncryptionSetSpec{
+			Name:           "system",
+			ResourceGroup:  "my-rg",
+			Location:       "eastus",
+			ClusterName:    "my-cluster",
+			KeyURL:         "https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef",
+			Identity:       infrav1.VMIdentitySystemAssigned,
+			AdditionalTags: infrav1.Tags{},
+		},
+		&diskencryptionsets.DiskEncryptionSetSpec{
+			Name:                              "user",
+			ResourceGroup:                     "my-rg",
+			Location:                          "eastus",
+			ClusterName:                       "my-cluster",
+			KeyURL:                            "https://my-vault.vault.azure.net/keys/my-key",
+			Identity:                          infrav1.VMIdentityUserAssigned,
+			UserAssignedIdentityID:            "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/des",
+			RotationToLatestKeyVersionEnabled: ptr.To(true),
+			AdditionalTags:                    infrav1.Tags{},
+		},
+	}))
+}
+
+func TestClusterScope_SetDiskEncryptionSetStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	c := ClusterScope{
+		AzureCluster: &infrav1.AzureCluster{},
+	}
+
+	c.SetDiskEncryptionSetStatus(infrav1.DiskEncryptionSetStatus{Name: "des", ID: "des-id", ActiveKeyURL: "old-key"})
+	c.SetDiskEncryptionSetStatus(infrav1.DiskEncryptionSetStatus{Name: "other", ID: "other-id", ActiveKeyURL: "other-key"})
+	c.SetDiskEncryptionSetStatus(infrav1.DiskEncryptionSetStatus{Name: "des", ID: "des-id", ActiveKeyURL: "new-key"})
+	g.Expect(c.AzureCluster.Status.DiskEncryptionSets).To(Equal([]infrav1.DiskEncryptionSetStatus{
+		{Name: "des", ID: "des-id", ActiveKeyURL: "new-key"},
+		{Name: "other", ID: "other-id", ActiveKeyURL: "other-key"},
+	}))
+}
+
+func TestClusterScope_ValidatePublicIPSKUs(t *testing.T) {
+	tests := []struct {
+		name          string
+		networkSpec   infrav1.NetworkSpec
+		expectedError string
+	}{
+		{
+			name: "standard load balancer with a public IP of the default SKU",
+			networkSpec: infrav1.NetworkSpec{
+				APIServerLB: infrav1.LoadBalancerSpec{
+					Name: "my-lb",
+					FrontendIPs: []infrav1.FrontendIP{
+						{Name: "my-frontend", PublicIP: &infrav1.PublicIPSpec{Name: "my-ip"}},
+					},
+					LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{SKU: infrav1.SKUStandard},
+				},
+			},
+		},
+		{
+			name: "standard load balancer with a standard public IP",
+			networkSpec: infrav1.NetworkSpec{
+				NodeOutboundLB: &infrav1.LoadBalancerSpec{
+					Name: "my-lb",
+					FrontendIPs: []infrav1.FrontendIP{
+						{Name: "my-frontend", PublicIP: &infrav1.PublicIPSpec{Name: "my-ip", SKU: infrav1.PublicIPSKUStandard}},
+					},
+					LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{SKU: infrav1.SKUStandard},
+				},
+			},
+		},
+		{
+			name: "standard load balancer with a basic public IP",
+			networkSpec: infrav1.NetworkSpec{
+				APIServerLB: infrav1.LoadBalancerSpec{
+					Name: "my-lb",
+					FrontendIPs: []infrav1.FrontendIP{
+						{Name: "my-frontend", PublicIP: &infrav1.PublicIPSpec{Name: "my-ip", SKU: infrav1.PublicIPSKUBasic}},
+					},
+					LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{SKU: infrav1.SKUStandard},
+				},
+			},
+			expectedError: "public IP my-ip of load balancer my-lb has the Basic SKU, which is incompatible with the Standard SKU of the load balancer",
+		},
+		{
+			name: "NAT gateway with a basic public IP",
+			networkSpec: infrav1.NetworkSpec{
+				Subnets: infrav1.Subnets{
+					{
+						SubnetClassSpec: infrav1.SubnetClassSpec{Name: "node-subnet", Role: infrav1.SubnetNode},
+						NatGateway: infrav1.NatGateway{
+							NatGatewayIP:        infrav1.PublicIPSpec{Name: "my-nat-ip", SKU: infrav1.PublicIPSKUBasic},
+							NatGatewayClassSpec: infrav1.NatGatewayClassSpec{Name: "my-nat-gateway"},
+						},
+					},
+				},
+			},
+			expectedError: "public IP my-nat-ip of NAT gateway my-nat-gateway has the Basic SKU, which is incompatible with the Standard SKU of NAT gateways",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := ClusterScope{
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{NetworkSpec: tc.networkSpec},
+				},
+			}
+			err := c.ValidatePublicIPSKUs()
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
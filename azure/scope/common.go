@@ -0,0 +1,29 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// markReconciling marks a condition false with the Reconciling reason after a service failed with a transient
+// error, which is retried by a later reconcile.
+func markReconciling(to conditions.Setter, condition clusterv1.ConditionType, service string, err error) {
+	conditions.MarkFalse(to, condition, infrav1.ReconcilingReason, clusterv1.ConditionSeverityInfo, "%s reconciling. err: %s", service, err.Error())
+}
@@ -51,6 +51,10 @@ type CredentialsProvider interface {
 type AzureCredentialsProvider struct {
 	Client   client.Client
 	Identity *infrav1.AzureClusterIdentity
+
+	// keyVaultSecrets reads the client secret of identities referencing a Key Vault secret.
+	// The controller's cache is used when it is nil.
+	keyVaultSecrets *keyVaultSecretCache
 }
 
 // AzureClusterCredentialsProvider wraps AzureCredentialsProvider with AzureCluster.
@@ -232,8 +236,23 @@ func (p *AzureCredentialsProvider) GetClientID() string {
 // GetClientSecret returns the Client Secret associated with the AzureCredentialsProvider's Identity.
 // NOTE: this only works if the Identity references a Service Principal Client Secret.
 // If using another type of credentials, such a Certificate, we return an empty string.
+// A Key Vault secret referenced by the Identity takes precedence over its Kubernetes secret, which is only read
+// when the Key Vault secret can't be.
 func (p *AzureCredentialsProvider) GetClientSecret(ctx context.Context) (string, error) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "azure.scope.AzureCredentialsProvider.GetClientSecret")
+	defer done()
+
 	if p.hasClientSecret() {
+		if ref := p.Identity.Spec.ClientSecretKeyVaultRef; ref != nil {
+			clientSecret, err := p.getKeyVaultClientSecret(ctx, *ref)
+			if err == nil {
+				return clientSecret, nil
+			}
+			if p.Identity.Spec.ClientSecret.Name == "" {
+				return "", errors.Wrap(err, "Unable to fetch ClientSecret from Key Vault")
+			}
+			log.Error(err, "Unable to fetch ClientSecret from Key Vault, falling back to the ClientSecret secret reference")
+		}
 		secretRef := p.Identity.Spec.ClientSecret
 		key := types.NamespacedName{
 			Namespace: secretRef.Namespace,
@@ -249,6 +268,18 @@ func (p *AzureCredentialsProvider) GetClientSecret(ctx context.Context) (string,
 	return "", nil
 }
 
+// getKeyVaultClientSecret returns the value of the Key Vault secret referenced by the Identity.
+func (p *AzureCredentialsProvider) getKeyVaultClientSecret(ctx context.Context, ref infrav1.KeyVaultSecretReference) (string, error) {
+	secrets := p.keyVaultSecrets
+	if secrets == nil {
+		var err error
+		if secrets, err = getKeyVaultSecretCache(); err != nil {
+			return "", err
+		}
+	}
+	return secrets.Get(ctx, ref)
+}
+
 // GetTenantID returns the Tenant ID associated with the AzureCredentialsProvider's Identity.
 func (p *AzureCredentialsProvider) GetTenantID() string {
 	return p.Identity.Spec.TenantID
@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// keyVaultSecretTTL is how long a secret read from Azure Key Vault is used before it is read again.
+const keyVaultSecretTTL = 10 * time.Minute
+
+// keyVaultSecretClient gets the latest version of secrets from Azure Key Vault.
+type keyVaultSecretClient interface {
+	GetSecret(ctx context.Context, vaultURI, secretName string) (string, error)
+}
+
+// azureKeyVaultSecretClient gets secrets from Azure Key Vault with the given credential.
+type azureKeyVaultSecretClient struct {
+	credential azcore.TokenCredential
+}
+
+// GetSecret returns the value of the latest version of a secret.
+func (c *azureKeyVaultSecretClient) GetSecret(ctx context.Context, vaultURI, secretName string) (string, error) {
+	client, err := azsecrets.NewClient(vaultURI, c.credential, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.GetSecret(ctx, secretName, "", nil)
+	if err != nil {
+		return "", err
+	}
+	return ptr.Deref(resp.Value, ""), nil
+}
+
+// keyVaultSecretCache caches secrets read from Azure Key Vault.
+// Unlike a ttllru.Cache, it keeps expired secrets so that they can still be used while the Key Vault is unreachable.
+type keyVaultSecretCache struct {
+	client  keyVaultSecretClient
+	ttl     time.Duration
+	mu      sync.Mutex
+	secrets map[infrav1.KeyVaultSecretReference]keyVaultSecret
+}
+
+// keyVaultSecret is a secret value and the time it was read from Azure Key Vault.
+type keyVaultSecret struct {
+	value     string
+	fetchedAt time.Time
+}
+
+var (
+	keyVaultCacheOnce sync.Once
+	keyVaultCache     *keyVaultSecretCache
+	keyVaultCacheErr  error
+)
+
+// getKeyVaultSecretCache returns the Key Vault secret cache of the controller, which reads secrets with the
+// controller's own managed identity.
+func getKeyVaultSecretCache() (*keyVaultSecretCache, error) {
+	keyVaultCacheOnce.Do(func() {
+		cred, err := azidentity.NewManagedIdentityCredential(nil)
+		if err != nil {
+			keyVaultCacheErr = errors.Wrap(err, "failed to create managed identity credential for Key Vault")
+			return
+		}
+		keyVaultCache = newKeyVaultSecretCache(&azureKeyVaultSecretClient{credential: cred}, keyVaultSecretTTL)
+	})
+	return keyVaultCache, keyVaultCacheErr
+}
+
+// newKeyVaultSecretCache returns a Key Vault secret cache which reads secrets again after the given time to live.
+func newKeyVaultSecretCache(client keyVaultSecretClient, ttl time.Duration) *keyVaultSecretCache {
+	return &keyVaultSecretCache{
+		client:  client,
+		ttl:     ttl,
+		secrets: make(map[infrav1.KeyVaultSecretReference]keyVaultSecret),
+	}
+}
+
+// Get returns the value of a Key Vault secret. The secret is read from the Key Vault if it isn't cached or its
+// cached value has expired. If the Key Vault can't be read, an expired value is returned when there is one.
+func (c *keyVaultSecretCache) Get(ctx context.Context, ref infrav1.KeyVaultSecretReference) (string, error) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "scope.keyVaultSecretCache.Get")
+	defer done()
+
+	c.mu.Lock()
+	cached, ok := c.secrets[ref]
+	c.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < c.ttl {
+		log.V(4).Info("Key Vault secret cache hit", "vaultURI", ref.VaultURI, "secretName", ref.SecretName)
+		return cached.value, nil
+	}
+
+	log.V(4).Info("Key Vault secret cache miss", "vaultURI", ref.VaultURI, "secretName", ref.SecretName)
+	value, err := c.client.GetSecret(ctx, ref.VaultURI, ref.SecretName)
+	if err != nil {
+		if ok {
+			log.Error(err, "failed to read secret from Key Vault, using the expired cached value", "vaultURI", ref.VaultURI, "secretName", ref.SecretName)
+			return cached.value, nil
+		}
+		return "", errors.Wrapf(err, "failed to get secret %s from Key Vault %s", ref.SecretName, ref.VaultURI)
+	}
+
+	c.mu.Lock()
+	c.secrets[ref] = keyVaultSecret{value: value, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return value, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeKeyVaultSecretClient struct {
+	secrets map[string]string
+	err     error
+	calls   int
+}
+
+func (c *fakeKeyVaultSecretClient) GetSecret(_ context.Context, vaultURI, secretName string) (string, error) {
+	c.calls++
+	if c.err != nil {
+		return "", c.err
+	}
+	return c.secrets[vaultURI+secretName], nil
+}
+
+func TestKeyVaultSecretCacheGet(t *testing.T) {
+	ref := infrav1.KeyVaultSecretReference{VaultURI: "https://my-vault.vault.azure.net/", SecretName: "my-client-secret"}
+
+	t.Run("reads the secret from Key Vault once", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeKeyVaultSecretClient{secrets: map[string]string{ref.VaultURI + ref.SecretName: "secret"}}
+		cache := newKeyVaultSecretCache(client, time.Hour)
+
+		for i := 0; i < 2; i++ {
+			secret, err := cache.Get(context.Background(), ref)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(secret).To(Equal("secret"))
+		}
+		g.Expect(client.calls).To(Equal(1))
+	})
+
+	t.Run("reads an expired secret again", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeKeyVaultSecretClient{secrets: map[string]string{ref.VaultURI + ref.SecretName: "secret"}}
+		cache := newKeyVaultSecretCache(client, 0)
+
+		_, err := cache.Get(context.Background(), ref)
+		g.Expect(err).NotTo(HaveOccurred())
+		client.secrets[ref.VaultURI+ref.SecretName] = "rotated"
+		secret, err := cache.Get(context.Background(), ref)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(secret).To(Equal("rotated"))
+		g.Expect(client.calls).To(Equal(2))
+	})
+
+	t.Run("uses an expired secret when Key Vault is unreachable", func(t *testing.T) {
+		g := NewWithT(t)
+		client := &fakeKeyVaultSecretClient{secrets: map[string]string{ref.VaultURI + ref.SecretName: "secret"}}
+		cache := newKeyVaultSecretCache(client, 0)
+
+		_, err := cache.Get(context.Background(), ref)
+		g.Expect(err).NotTo(HaveOccurred())
+		client.err = errors.New("unreachable")
+		secret, err := cache.Get(context.Background(), ref)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(secret).To(Equal("secret"))
+	})
+
+	t.Run("fails when Key Vault is unreachable and nothing is cached", func(t *testing.T) {
+		g := NewWithT(t)
+		cache := newKeyVaultSecretCache(&fakeKeyVaultSecretClient{err: errors.New("unreachable")}, time.Hour)
+
+		_, err := cache.Get(context.Background(), ref)
+		g.Expect(err).To(MatchError(ContainSubstring("failed to get secret my-client-secret from Key Vault")))
+	})
+}
+
+func TestGetClientSecretFromKeyVault(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	ref := &infrav1.KeyVaultSecretReference{VaultURI: "https://my-vault.vault.azure.net/", SecretName: "my-client-secret"}
+	kubeSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-client-secret", Namespace: "default"},
+		Data:       map[string][]byte{AzureSecretKey: []byte("kubernetes-secret")},
+	}
+
+	tests := []struct {
+		name          string
+		client        *fakeKeyVaultSecretClient
+		secretRef     corev1.SecretReference
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "reads the Key Vault secret",
+			client:   &fakeKeyVaultSecretClient{secrets: map[string]string{ref.VaultURI + ref.SecretName: "key-vault-secret"}},
+			expected: "key-vault-secret",
+		},
+		{
+			name:      "falls back to the Kubernetes secret when Key Vault is unreachable",
+			client:    &fakeKeyVaultSecretClient{err: errors.New("unreachable")},
+			secretRef: corev1.SecretReference{Name: "my-client-secret", Namespace: "default"},
+			expected:  "kubernetes-secret",
+		},
+		{
+			name:          "fails when Key Vault is unreachable without a Kubernetes secret",
+			client:        &fakeKeyVaultSecretClient{err: errors.New("unreachable")},
+			expectedError: "Unable to fetch ClientSecret from Key Vault",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			p := &AzureCredentialsProvider{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(kubeSecret.DeepCopy()).Build(),
+				Identity: &infrav1.AzureClusterIdentity{
+					Spec: infrav1.AzureClusterIdentitySpec{
+						Type:                    infrav1.ServicePrincipal,
+						ClientSecret:            tc.secretRef,
+						ClientSecretKeyVaultRef: ref,
+					},
+				},
+				keyVaultSecrets: newKeyVaultSecretCache(tc.client, time.Hour),
+			}
+			secret, err := p.GetClientSecret(context.Background())
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedError)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(secret).To(Equal(tc.expected))
+		})
+	}
+}
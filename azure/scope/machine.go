@@ -18,7 +18,6 @@ package scope
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"strings"
 
@@ -29,6 +28,7 @@ import (
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/availabilitysets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/disks"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/inboundnatrules"
@@ -41,10 +41,12 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/vmextensions"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/futures"
+	"sigs.k8s.io/cluster-api-provider-azure/util/maps"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capierrors "sigs.k8s.io/cluster-api/errors"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -108,10 +110,12 @@ type SKUCacher interface {
 
 // MachineCache stores common machine information so we don't have to hit the API multiple times within the same reconcile loop.
 type MachineCache struct {
-	BootstrapData      string
-	VMImage            *infrav1.Image
-	VMSKU              resourceskus.SKU
-	availabilitySetSKU resourceskus.SKU
+	BootstrapData       string
+	BootstrapDataFormat string
+	AdminPassword       string
+	VMImage             *infrav1.Image
+	VMSKU               resourceskus.SKU
+	availabilitySetSKU  resourceskus.SKU
 }
 
 // InitMachineCache sets cached information about the machine to be used in the scope.
@@ -128,11 +132,21 @@ func (m *MachineScope) InitMachineCache(ctx context.Context) error {
 			return err
 		}
 
+		m.cache.BootstrapDataFormat, err = m.GetBootstrapDataFormat(ctx)
+		if err != nil {
+			return err
+		}
+
 		m.cache.VMImage, err = m.GetVMImage(ctx)
 		if err != nil {
 			return err
 		}
 
+		m.cache.AdminPassword, err = m.GetAdminPassword(ctx)
+		if err != nil {
+			return err
+		}
+
 		skuCache := m.skuCache
 		if skuCache == nil {
 			cache, err := resourceskus.GetCache(m, m.Location())
@@ -151,11 +165,49 @@ func (m *MachineScope) InitMachineCache(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrapf(err, "failed to get availability set SKU %s in compute api", string(armcompute.AvailabilitySetSKUTypesAligned))
 		}
+
+		if err := m.validateZoneRedundantDisks(ctx, skuCache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateZoneRedundantDisks checks that the zone-redundant storage account types, e.g. Premium_ZRS, used by the
+// machine's OS and data disks are available in its location. Other storage account types are available in every
+// location.
+func (m *MachineScope) validateZoneRedundantDisks(ctx context.Context, skuCache SKUCacher) error {
+	var storageAccountTypes []string
+	if m.AzureMachine.Spec.OSDisk.ManagedDisk != nil {
+		storageAccountTypes = append(storageAccountTypes, m.AzureMachine.Spec.OSDisk.ManagedDisk.StorageAccountType)
+	}
+	for _, disk := range m.AzureMachine.Spec.DataDisks {
+		if disk.ManagedDisk != nil {
+			storageAccountTypes = append(storageAccountTypes, disk.ManagedDisk.StorageAccountType)
+		}
 	}
 
+	for _, storageAccountType := range storageAccountTypes {
+		if !strings.HasSuffix(storageAccountType, "_ZRS") {
+			continue
+		}
+		if _, err := skuCache.Get(ctx, storageAccountType, resourceskus.Disks); err != nil {
+			return errors.Wrapf(err, "storage account type %s is not available in location %s", storageAccountType, m.Location())
+		}
+	}
 	return nil
 }
 
+// VMCapabilities returns the capabilities of the machine's VM size in its location.
+// The machine cache must be initialized first.
+func (m *MachineScope) VMCapabilities() (resourceskus.VMCapabilities, error) {
+	if m.cache == nil {
+		return resourceskus.VMCapabilities{}, errors.New("machine cache is not initialized")
+	}
+	return resourceskus.SKUToVMCapabilities(m.cache.VMSKU, m.Location())
+}
+
 // VMSpec returns the VM spec.
 func (m *MachineScope) VMSpec() azure.ResourceSpecGetter {
 	spec := &virtualmachines.VMSpec{
@@ -167,6 +219,8 @@ func (m *MachineScope) VMSpec() azure.ResourceSpecGetter {
 		Role:                   m.Role(),
 		NICIDs:                 m.NICIDs(),
 		SSHKeyData:             m.AzureMachine.Spec.SSHPublicKey,
+		AdditionalSSHKeys:      m.AzureMachine.Spec.AdditionalSSHPublicKeys,
+		WindowsConfiguration:   m.AzureMachine.Spec.WindowsConfiguration,
 		Size:                   m.AzureMachine.Spec.VMSize,
 		OSDisk:                 m.AzureMachine.Spec.OSDisk,
 		DataDisks:              m.AzureMachine.Spec.DataDisks,
@@ -180,11 +234,14 @@ func (m *MachineScope) VMSpec() azure.ResourceSpecGetter {
 		AdditionalTags:         m.AdditionalTags(),
 		AdditionalCapabilities: m.AzureMachine.Spec.AdditionalCapabilities,
 		ProviderID:             m.ProviderID(),
+		BootstrapDataSource:    ptr.Deref(m.AzureMachine.Spec.BootstrapDataSource, infrav1.BootstrapDataSourceCustomData),
 	}
 	if m.cache != nil {
 		spec.SKU = m.cache.VMSKU
 		spec.Image = m.cache.VMImage
 		spec.BootstrapData = m.cache.BootstrapData
+		spec.BootstrapDataFormat = m.cache.BootstrapDataFormat
+		spec.AdminPassword = m.cache.AdminPassword
 	}
 	return spec
 }
@@ -219,19 +276,27 @@ func (m *MachineScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	return specs
 }
 
+// SetPublicIPFQDN is a no-op for machines as the public IPs of machines don't have a DNS name.
+func (m *MachineScope) SetPublicIPFQDN(name, fqdn string) {}
+
 // InboundNatSpecs returns the inbound NAT specs.
 func (m *MachineScope) InboundNatSpecs() []azure.ResourceSpecGetter {
 	// The existing inbound NAT rules are needed in order to find an available SSH port for each new inbound NAT rule.
 	if m.Role() == infrav1.ControlPlane {
 		spec := &inboundnatrules.InboundNatSpec{
 			Name:                      m.Name(),
-			ResourceGroup:             m.NodeResourceGroup(),
+			ResourceGroup:             m.ResourceGroup(),
 			LoadBalancerName:          m.APIServerLBName(),
 			FrontendIPConfigurationID: nil,
 		}
+		if settings := m.APIServerLB().InboundNATRules; settings != nil {
+			spec.Protocol = converters.InboundNATPoolProtocolToSDK(settings.Protocol)
+			spec.EnableFloatingIP = settings.EnableFloatingIP
+			spec.EnableTCPReset = settings.EnableTCPReset
+		}
 		if frontEndIPs := m.APIServerLB().FrontendIPs; len(frontEndIPs) > 0 {
 			ipConfig := frontEndIPs[0].Name
-			id := azure.FrontendIPConfigID(m.SubscriptionID(), m.NodeResourceGroup(), m.APIServerLBName(), ipConfig)
+			id := azure.FrontendIPConfigID(m.SubscriptionID(), m.ResourceGroup(), m.APIServerLBName(), ipConfig)
 			spec.FrontendIPConfigurationID = ptr.To(id)
 		}
 
@@ -267,6 +332,7 @@ func (m *MachineScope) BuildNICSpec(nicName string, infrav1NetworkInterface infr
 		MachineName:           m.Name(),
 		VNetName:              m.Vnet().Name,
 		VNetResourceGroup:     m.Vnet().ResourceGroup,
+		LBResourceGroup:       m.ResourceGroup(),
 		AcceleratedNetworking: infrav1NetworkInterface.AcceleratedNetworking,
 		IPv6Enabled:           m.IsIPv6Enabled(),
 		EnableIPForwarding:    m.AzureMachine.Spec.EnableIPForwarding,
@@ -387,6 +453,14 @@ func (m *MachineScope) VMExtensionSpecs() []azure.ResourceSpecGetter {
 		})
 	}
 
+	if ptr.Deref(m.AzureMachine.Spec.BootstrapDataSource, infrav1.BootstrapDataSourceCustomData) == infrav1.BootstrapDataSourceCustomScriptExtension {
+		extensionSpecs = append(extensionSpecs, &vmextensions.VMExtensionSpec{
+			ExtensionSpec: *azure.GetCustomScriptBootstrapVMExtension(m.Name(), m.cache.BootstrapData),
+			ResourceGroup: m.NodeResourceGroup(),
+			Location:      m.Location(),
+		})
+	}
+
 	cpuArchitectureType, _ := m.cache.VMSKU.GetCapability(resourceskus.CPUArchitectureType)
 	bootstrapExtensionSpec := azure.GetBootstrappingVMExtension(m.AzureMachine.Spec.OSDisk.OSType, m.CloudEnvironment(), m.Name(), cpuArchitectureType)
 
@@ -441,6 +515,14 @@ func (m *MachineScope) Name() string {
 	return m.AzureMachine.Name
 }
 
+// IsPaused returns true if reconciliation of the AzureMachine or its cluster is paused.
+func (m *MachineScope) IsPaused() bool {
+	if p, ok := m.ClusterScoper.(azure.PauseDescriber); ok && p.IsPaused() {
+		return true
+	}
+	return annotations.HasPaused(m.AzureMachine)
+}
+
 // Namespace returns the namespace name.
 func (m *MachineScope) Namespace() string {
 	return m.AzureMachine.Namespace
@@ -473,8 +555,13 @@ func (m *MachineScope) ProviderID() string {
 	return ptr.Deref(m.AzureMachine.Spec.ProviderID, "")
 }
 
-// AvailabilitySetSpec returns the availability set spec for this machine if available.
+// AvailabilitySetSpec returns the availability set spec for this machine if available. It returns nil if the machine
+// references an existing availability set, which CAPZ doesn't create.
 func (m *MachineScope) AvailabilitySetSpec() azure.ResourceSpecGetter {
+	if m.AvailabilitySetReference() != "" {
+		return nil
+	}
+
 	availabilitySetName, ok := m.AvailabilitySet()
 	if !ok {
 		return nil
@@ -489,6 +576,11 @@ func (m *MachineScope) AvailabilitySetSpec() azure.ResourceSpecGetter {
 		AdditionalTags: m.AdditionalTags(),
 	}
 
+	if settings := m.AzureMachine.Spec.AvailabilitySet; settings != nil {
+		spec.PlatformFaultDomainCount = settings.PlatformFaultDomainCount
+		spec.PlatformUpdateDomainCount = settings.PlatformUpdateDomainCount
+	}
+
 	if m.cache != nil {
 		spec.SKU = &m.cache.availabilitySetSKU
 	}
@@ -521,8 +613,18 @@ func (m *MachineScope) AvailabilitySet() (string, bool) {
 	return "", false
 }
 
+// AvailabilitySetReference returns the resource ID of the existing availability set the machine references, or ""
+// if it doesn't reference one.
+func (m *MachineScope) AvailabilitySetReference() string {
+	return ptr.Deref(m.AzureMachine.Spec.AvailabilitySetID, "")
+}
+
 // AvailabilitySetID returns the availability set for this machine, or "" if there is no availability set.
 func (m *MachineScope) AvailabilitySetID() string {
+	if ref := m.AvailabilitySetReference(); ref != "" {
+		return ref
+	}
+
 	var asID string
 	if asName, ok := m.AvailabilitySet(); ok {
 		asID = azure.AvailabilitySetID(m.SubscriptionID(), m.NodeResourceGroup(), asName)
@@ -660,36 +762,88 @@ func (m *MachineScope) Close(ctx context.Context) error {
 // AdditionalTags merges AdditionalTags from the scope's AzureCluster and AzureMachine. If the same key is present in both,
 // the value from AzureMachine takes precedence.
 func (m *MachineScope) AdditionalTags() infrav1.Tags {
-	tags := make(infrav1.Tags)
-	// Start with the cluster-wide tags...
-	tags.Merge(m.ClusterScoper.AdditionalTags())
-	// ... and merge in the Machine's
-	tags.Merge(m.AzureMachine.Spec.AdditionalTags)
+	// Start with the cluster-wide tags and merge in the Machine's
+	tags := mergeAdditionalTags(m.ClusterScoper.AdditionalTags(), m.AzureMachine.Spec.AdditionalTags)
 	// Set the cloud provider tag
 	tags[infrav1.ClusterAzureCloudProviderTagKey(m.ClusterName())] = string(infrav1.ResourceLifecycleOwned)
 
 	return tags
 }
 
-// GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
+// mergeAdditionalTags merges the cluster-wide AdditionalTags with the tags of a single resource, e.g. the
+// AdditionalTags of a machine or machine pool or the tags of a subnet's security group. If the same key is present
+// in both, the value from the resource takes precedence.
+func mergeAdditionalTags(clusterTags, resourceTags infrav1.Tags) infrav1.Tags {
+	return maps.MergeWithResolver(clusterTags, resourceTags, func(_ string, _, resourceVal string) string {
+		return resourceVal
+	})
+}
+
+// GetBootstrapData returns the encoded bootstrap data from the secret in the Machine's bootstrap.dataSecretName, with
+// the AzureMachine's registry mirrors merged into it.
 func (m *MachineScope) GetBootstrapData(ctx context.Context) (string, error) {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachineScope.GetBootstrapData")
 	defer done()
 
+	secret, err := m.getBootstrapDataSecret(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := encodeBootstrapData(secret, m.AzureMachine.Spec.RegistryMirrors)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid bootstrap data for AzureMachine %s/%s", m.Namespace(), m.Name())
+	}
+	return data, nil
+}
+
+// GetBootstrapDataFormat returns the format of the bootstrap data, e.g. "cloud-config" or "ignition", from the
+// secret in the Machine's bootstrap.dataSecretName. It is empty if the bootstrap provider doesn't set the format.
+func (m *MachineScope) GetBootstrapDataFormat(ctx context.Context) (string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachineScope.GetBootstrapDataFormat")
+	defer done()
+
+	secret, err := m.getBootstrapDataSecret(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data["format"]), nil
+}
+
+func (m *MachineScope) getBootstrapDataSecret(ctx context.Context) (*corev1.Secret, error) {
 	if m.Machine.Spec.Bootstrap.DataSecretName == nil {
-		return "", errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
+		return nil, errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
 	}
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: m.Namespace(), Name: *m.Machine.Spec.Bootstrap.DataSecretName}
 	if err := m.client.Get(ctx, key, secret); err != nil {
-		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for AzureMachine %s/%s", m.Namespace(), m.Name())
+		return nil, errors.Wrapf(err, "failed to retrieve bootstrap data secret for AzureMachine %s/%s", m.Namespace(), m.Name())
+	}
+	return secret, nil
+}
+
+// GetAdminPassword returns the Windows admin password from the secret referenced in the AzureMachine's
+// windowsConfiguration.adminPasswordSecretRef, or an empty string if no secret is referenced.
+func (m *MachineScope) GetAdminPassword(ctx context.Context) (string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachineScope.GetAdminPassword")
+	defer done()
+
+	config := m.AzureMachine.Spec.WindowsConfiguration
+	if config == nil || config.AdminPasswordSecretRef == nil {
+		return "", nil
+	}
+	ref := config.AdminPasswordSecretRef
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: m.Namespace(), Name: ref.Name}
+	if err := m.client.Get(ctx, key, secret); err != nil {
+		return "", errors.Wrapf(err, "failed to retrieve admin password secret for AzureMachine %s/%s", m.Namespace(), m.Name())
 	}
 
-	value, ok := secret.Data["value"]
+	value, ok := secret.Data[ref.Key]
 	if !ok {
-		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+		return "", errors.Errorf("error retrieving admin password: secret key %s is missing", ref.Key)
 	}
-	return base64.StdEncoding.EncodeToString(value), nil
+	return string(value), nil
 }
 
 // GetVMImage returns the image from the machine configuration, or a default one.
@@ -765,6 +919,8 @@ func (m *MachineScope) UpdateDeleteStatus(condition clusterv1.ConditionType, ser
 		conditions.MarkFalse(m.AzureMachine, condition, infrav1.DeletedReason, clusterv1.ConditionSeverityInfo, "%s successfully deleted", service)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(m.AzureMachine, condition, infrav1.DeletingReason, clusterv1.ConditionSeverityInfo, "%s deleting", service)
+	case azure.IsTransientError(err):
+		markReconciling(m.AzureMachine, condition, service, err)
 	default:
 		conditions.MarkFalse(m.AzureMachine, condition, infrav1.DeletionFailedReason, clusterv1.ConditionSeverityError, "%s failed to delete. err: %s", service, err.Error())
 	}
@@ -777,6 +933,8 @@ func (m *MachineScope) UpdatePutStatus(condition clusterv1.ConditionType, servic
 		conditions.MarkTrue(m.AzureMachine, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(m.AzureMachine, condition, infrav1.CreatingReason, clusterv1.ConditionSeverityInfo, "%s creating or updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(m.AzureMachine, condition, service, err)
 	default:
 		conditions.MarkFalse(m.AzureMachine, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to create or update. err: %s", service, err.Error())
 	}
@@ -789,6 +947,8 @@ func (m *MachineScope) UpdatePatchStatus(condition clusterv1.ConditionType, serv
 		conditions.MarkTrue(m.AzureMachine, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(m.AzureMachine, condition, infrav1.UpdatingReason, clusterv1.ConditionSeverityInfo, "%s updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(m.AzureMachine, condition, service, err)
 	default:
 		conditions.MarkFalse(m.AzureMachine, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to update. err: %s", service, err.Error())
 	}
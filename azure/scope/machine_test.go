@@ -22,10 +22,13 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	azureautorest "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +36,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/availabilitysets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/disks"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/inboundnatrules"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/networkinterfaces"
@@ -408,6 +412,67 @@ func TestMachineScope_InboundNatSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "returns InboundNatSpec with the inbound NAT rule settings of the API server load balancer",
+			machineScope: MachineScope{
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							clusterv1.MachineControlPlaneLabel: "",
+						},
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine-name",
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Values: map[string]string{
+								auth.SubscriptionID: "123",
+							},
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								SubscriptionID: "123",
+							},
+							NetworkSpec: infrav1.NetworkSpec{
+								APIServerLB: infrav1.LoadBalancerSpec{
+									Name: "foo-loadbalancer",
+									LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{
+										InboundNATRules: &infrav1.InboundNATRuleSettings{
+											EnableFloatingIP: true,
+											EnableTCPReset:   true,
+										},
+									},
+									FrontendIPs: []infrav1.FrontendIP{
+										{
+											Name: "foo-frontend-ip",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&inboundnatrules.InboundNatSpec{
+					Name:                      "machine-name",
+					LoadBalancerName:          "foo-loadbalancer",
+					ResourceGroup:             "my-rg",
+					FrontendIPConfigurationID: ptr.To(azure.FrontendIPConfigID("123", "my-rg", "foo-loadbalancer", "foo-frontend-ip")),
+					Protocol:                  armnetwork.TransportProtocolTCP,
+					EnableFloatingIP:          true,
+					EnableTCPReset:            true,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -595,6 +660,72 @@ func TestMachineScope_VMExtensionSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "If bootstrap data source is CustomScriptExtension, it returns the Custom Script ExtensionSpec running the bootstrap data",
+			machineScope: MachineScope{
+				Machine: &clusterv1.Machine{},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine-name",
+					},
+					Spec: infrav1.AzureMachineSpec{
+						OSDisk: infrav1.OSDisk{
+							OSType: "Linux",
+						},
+						BootstrapDataSource: ptr.To(infrav1.BootstrapDataSourceCustomScriptExtension),
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Environment: azureautorest.Environment{
+								Name: azureautorest.PublicCloud.Name,
+							},
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location: "westus",
+							},
+						},
+					},
+				},
+				cache: &MachineCache{
+					VMSKU:         resourceskus.SKU{},
+					BootstrapData: "ZmFrZS1ib290c3RyYXAtZGF0YQ==",
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&vmextensions.VMExtensionSpec{
+					ExtensionSpec: azure.ExtensionSpec{
+						Name:      "CustomScript",
+						VMName:    "machine-name",
+						Publisher: "Microsoft.Azure.Extensions",
+						Version:   "2.1",
+						ProtectedSettings: map[string]string{
+							"script": "ZmFrZS1ib290c3RyYXAtZGF0YQ==",
+						},
+					},
+					ResourceGroup: "my-rg",
+					Location:      "westus",
+				},
+				&vmextensions.VMExtensionSpec{
+					ExtensionSpec: azure.ExtensionSpec{
+						Name:      "CAPZ.Linux.Bootstrapping",
+						VMName:    "machine-name",
+						Publisher: "Microsoft.Azure.ContainerUpstream",
+						Version:   "1.0",
+						ProtectedSettings: map[string]string{
+							"commandToExecute": azure.LinuxBootstrapExtensionCommand,
+						},
+					},
+					ResourceGroup: "my-rg",
+					Location:      "westus",
+				},
+			},
+		},
 		{
 			name: "If OS type is Linux and cloud is not AzurePublicCloud, it returns empty",
 			machineScope: MachineScope{
@@ -1384,6 +1515,195 @@ func TestMachineScope_AvailabilitySet(t *testing.T) {
 	}
 }
 
+func TestMachineScope_AvailabilitySetSpec(t *testing.T) {
+	g := NewWithT(t)
+	machineScope := MachineScope{
+		ClusterScoper: &ClusterScope{
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster",
+				},
+			},
+			AzureCluster: &infrav1.AzureCluster{
+				Spec: infrav1.AzureClusterSpec{
+					ResourceGroup: "my-rg",
+					AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+						Location: "westus2",
+					},
+				},
+			},
+		},
+		Machine: &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					clusterv1.MachineDeploymentNameLabel: "md-0",
+				},
+			},
+		},
+		AzureMachine: &infrav1.AzureMachine{
+			Spec: infrav1.AzureMachineSpec{
+				AvailabilitySet: &infrav1.AvailabilitySetSettings{
+					PlatformFaultDomainCount:  ptr.To[int32](2),
+					PlatformUpdateDomainCount: ptr.To[int32](10),
+				},
+			},
+		},
+	}
+
+	g.Expect(machineScope.AvailabilitySetSpec()).To(Equal(&availabilitysets.AvailabilitySetSpec{
+		Name:                      "cluster_md-0-as",
+		ResourceGroup:             "my-rg",
+		ClusterName:               "cluster",
+		Location:                  "westus2",
+		AdditionalTags:            infrav1.Tags{"kubernetes.io_cluster_cluster": "owned"},
+		PlatformFaultDomainCount:  ptr.To[int32](2),
+		PlatformUpdateDomainCount: ptr.To[int32](10),
+	}))
+}
+
+func TestMachineScope_AvailabilitySetReference(t *testing.T) {
+	tests := []struct {
+		name                  string
+		availabilitySetID     *string
+		wantSpec              bool
+		wantAvailabilitySetID string
+	}{
+		{
+			name:                  "creates the availability set of the control plane",
+			availabilitySetID:     nil,
+			wantSpec:              true,
+			wantAvailabilitySetID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/availabilitySets/cluster_control-plane-as",
+		},
+		{
+			name:                  "references an existing availability set",
+			availabilitySetID:     ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/availabilitySets/existing-as"),
+			wantSpec:              false,
+			wantAvailabilitySetID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/availabilitySets/existing-as",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machineScope := MachineScope{
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Values: map[string]string{
+								auth.SubscriptionID: "123",
+							},
+						},
+					},
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster",
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+						},
+					},
+				},
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							clusterv1.MachineControlPlaneLabel: "",
+						},
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					Spec: infrav1.AzureMachineSpec{
+						AvailabilitySetID: tt.availabilitySetID,
+					},
+				},
+			}
+
+			if tt.wantSpec {
+				g.Expect(machineScope.AvailabilitySetSpec()).NotTo(BeNil())
+			} else {
+				g.Expect(machineScope.AvailabilitySetSpec()).To(BeNil())
+			}
+			g.Expect(machineScope.AvailabilitySetID()).To(Equal(tt.wantAvailabilitySetID))
+		})
+	}
+}
+
+func TestMachineScope_ValidateZoneRedundantDisks(t *testing.T) {
+	skuCache := resourceskus.NewStaticCache([]armcompute.ResourceSKU{
+		{
+			Name:         ptr.To("Premium_ZRS"),
+			ResourceType: ptr.To(string(resourceskus.Disks)),
+		},
+	}, "westeurope")
+
+	tests := []struct {
+		name          string
+		osDisk        infrav1.OSDisk
+		dataDisks     []infrav1.DataDisk
+		expectedError string
+	}{
+		{
+			name:   "locally redundant disks",
+			osDisk: infrav1.OSDisk{ManagedDisk: &infrav1.ManagedDiskParameters{StorageAccountType: "Premium_LRS"}},
+			dataDisks: []infrav1.DataDisk{
+				{NameSuffix: "etcddisk", ManagedDisk: &infrav1.ManagedDiskParameters{StorageAccountType: "StandardSSD_LRS"}},
+			},
+		},
+		{
+			name:   "zone-redundant data disk available in the location",
+			osDisk: infrav1.OSDisk{ManagedDisk: &infrav1.ManagedDiskParameters{StorageAccountType: "Premium_LRS"}},
+			dataDisks: []infrav1.DataDisk{
+				{NameSuffix: "etcddisk", ManagedDisk: &infrav1.ManagedDiskParameters{StorageAccountType: "Premium_ZRS"}},
+			},
+		},
+		{
+			name:   "zone-redundant data disk not available in the location",
+			osDisk: infrav1.OSDisk{ManagedDisk: &infrav1.ManagedDiskParameters{StorageAccountType: "Premium_LRS"}},
+			dataDisks: []infrav1.DataDisk{
+				{NameSuffix: "etcddisk", ManagedDisk: &infrav1.ManagedDiskParameters{StorageAccountType: "StandardSSD_ZRS"}},
+			},
+			expectedError: "storage account type StandardSSD_ZRS is not available in location westeurope",
+		},
+		{
+			name:          "zone-redundant OS disk not available in the location",
+			osDisk:        infrav1.OSDisk{ManagedDisk: &infrav1.ManagedDiskParameters{StorageAccountType: "StandardSSD_ZRS"}},
+			expectedError: "storage account type StandardSSD_ZRS is not available in location westeurope",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machineScope := MachineScope{
+				ClusterScoper: &ClusterScope{
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location: "westeurope",
+							},
+						},
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					Spec: infrav1.AzureMachineSpec{
+						OSDisk:    tt.osDisk,
+						DataDisks: tt.dataDisks,
+					},
+				},
+			}
+
+			err := machineScope.validateZoneRedundantDisks(context.Background(), skuCache)
+			if tt.expectedError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.expectedError)))
+				var reconcileError azure.ReconcileError
+				g.Expect(errors.As(err, &reconcileError)).To(BeTrue())
+				g.Expect(reconcileError.IsTerminal()).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestMachineScope_VMState(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1813,6 +2133,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "outbound-lb",
 					PublicLBAddressPoolName:   "outbound-lb-outboundBackendPool",
 					PublicLBNATRuleName:       "",
@@ -1925,6 +2246,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "outbound-lb",
 					PublicLBAddressPoolName:   "outbound-lb-outboundBackendPool",
 					PublicLBNATRuleName:       "",
@@ -2036,6 +2358,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "",
 					PublicLBAddressPoolName:   "",
 					PublicLBNATRuleName:       "",
@@ -2141,6 +2464,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "",
 					PublicLBAddressPoolName:   "",
 					PublicLBNATRuleName:       "",
@@ -2254,6 +2578,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "",
 					PublicLBAddressPoolName:   "",
 					PublicLBNATRuleName:       "",
@@ -2364,6 +2689,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "api-lb",
 					PublicLBAddressPoolName:   "api-lb-backendPool",
 					PublicLBNATRuleName:       "machine-name",
@@ -2475,6 +2801,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "api-lb",
 					PublicLBAddressPoolName:   "api-lb-backendPool",
 					PublicLBNATRuleName:       "machine-name",
@@ -2591,6 +2918,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "outbound-lb",
 					PublicLBAddressPoolName:   "outbound-lb-outboundBackendPool",
 					PublicLBNATRuleName:       "",
@@ -2616,6 +2944,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}, {}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "",
 					PublicLBAddressPoolName:   "",
 					PublicLBNATRuleName:       "",
@@ -2729,6 +3058,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "",
 					PublicLBAddressPoolName:   "",
 					PublicLBNATRuleName:       "",
@@ -2754,6 +3084,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}, {}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "",
 					PublicLBAddressPoolName:   "",
 					PublicLBNATRuleName:       "",
@@ -2864,6 +3195,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}, {}, {}, {}, {}, {}, {}, {}, {}, {}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
+					LBResourceGroup:           "my-rg",
 					PublicLBName:              "outbound-lb",
 					PublicLBAddressPoolName:   "outbound-lb-outboundBackendPool",
 					PublicLBNATRuleName:       "",
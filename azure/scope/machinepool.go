@@ -19,11 +19,11 @@ package scope
 import (
 	"context"
 	"crypto/sha256"
-	"encoding/base64"
 	"fmt"
 	"io"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -78,6 +78,7 @@ type (
 		capiMachinePoolPatchHelper *patch.Helper
 		vmssState                  *azure.VMSS
 		cache                      *MachinePoolCache
+		scaleDownBlockedMessage    string
 	}
 
 	// NodeStatus represents the status of a Kubernetes node.
@@ -92,6 +93,7 @@ type (
 		HasBootstrapDataChanges bool
 		VMImage                 *infrav1.Image
 		VMSKU                   resourceskus.SKU
+		AvailabilitySetSKU      *resourceskus.SKU
 		MaxSurge                int
 	}
 )
@@ -170,6 +172,15 @@ func (m *MachinePoolScope) InitMachinePoolCache(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrapf(err, "failed to get VM SKU %s in compute api", m.AzureMachinePool.Spec.Template.VMSize)
 		}
+
+		// The maximum fault domain count of the region is only known from the availability set SKU.
+		if m.AzureMachinePool.Spec.PlatformFaultDomainCount != nil {
+			availabilitySetSKU, err := skuCache.Get(ctx, string(armcompute.AvailabilitySetSKUTypesAligned), resourceskus.AvailabilitySets)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get availability set SKU %s in compute api", string(armcompute.AvailabilitySetSKUTypesAligned))
+			}
+			m.cache.AvailabilitySetSKU = &availabilitySetSKU
+		}
 	}
 
 	return nil
@@ -191,6 +202,7 @@ func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecG
 		SubnetName:                   m.AzureMachinePool.Spec.Template.NetworkInterfaces[0].SubnetName,
 		VNetName:                     m.Vnet().Name,
 		VNetResourceGroup:            m.Vnet().ResourceGroup,
+		LBResourceGroup:              m.ResourceGroup(),
 		PublicLBName:                 m.OutboundLBName(infrav1.Node),
 		PublicLBAddressPoolName:      m.OutboundPoolName(infrav1.Node),
 		AcceleratedNetworking:        m.AzureMachinePool.Spec.Template.NetworkInterfaces[0].AcceleratedNetworking,
@@ -209,6 +221,12 @@ func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecG
 		HasReplicasExternallyManaged: m.HasReplicasExternallyManaged(ctx),
 		ClusterName:                  m.ClusterName(),
 		AdditionalTags:               m.AzureMachinePool.Spec.AdditionalTags,
+		ModelUpdatesPaused:           m.ModelUpdatesPaused(),
+		PlatformFaultDomainCount:     m.AzureMachinePool.Spec.PlatformFaultDomainCount,
+		HostGroupID:                  ptr.Deref(m.AzureMachinePool.Spec.HostGroupID, ""),
+		InboundNATPoolNames:          m.AzureMachinePool.Spec.InboundNATPools,
+		LBInboundNATPools:            m.OutboundLBInboundNATPools(infrav1.Node),
+		ForceDeleteInstances:         m.AzureMachinePool.Spec.ForceDeleteInstances,
 	}
 
 	if m.cache != nil {
@@ -218,6 +236,7 @@ func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecG
 		}
 		spec.VMSSExtensionSpecs = m.VMSSExtensionSpecs()
 		spec.SKU = m.cache.VMSKU
+		spec.AvailabilitySetSKU = m.cache.AvailabilitySetSKU
 		spec.VMImage = m.cache.VMImage
 		spec.BootstrapData = m.cache.BootstrapData
 		spec.MaxSurge = m.cache.MaxSurge
@@ -228,6 +247,19 @@ func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecG
 	return spec
 }
 
+// IsPaused returns true if reconciliation of the AzureMachinePool or its cluster is paused.
+func (m *MachinePoolScope) IsPaused() bool {
+	if p, ok := m.ClusterScoper.(azure.PauseDescriber); ok && p.IsPaused() {
+		return true
+	}
+	return annotations.HasPaused(m.AzureMachinePool)
+}
+
+// ModelUpdatesPaused returns true if the AzureMachinePool has the PauseModelUpdatesAnnotation set to "true".
+func (m *MachinePoolScope) ModelUpdatesPaused() bool {
+	return m.AzureMachinePool.GetAnnotations()[infrav1.PauseModelUpdatesAnnotation] == "true"
+}
+
 // Name returns the Azure Machine Pool Name.
 func (m *MachinePoolScope) Name() string {
 	// Windows Machine pools names cannot be longer than 9 chars
@@ -459,6 +491,18 @@ func (m *MachinePoolScope) applyAzureMachinePoolMachines(ctx context.Context) er
 		return errors.Wrap(err, "failed selecting AzureMachinePoolMachine(s) to delete")
 	}
 
+	// Machines protected from scale-in are never selected, so the pool can't scale down below their count.
+	protected := 0
+	for _, ampm := range existingMachinesByProviderID {
+		if ampm.Annotations[infrav1.ProtectFromScaleInAnnotation] == "true" {
+			protected++
+		}
+	}
+	if desired := m.DesiredReplicas(); int32(protected) > desired {
+		m.scaleDownBlockedMessage = fmt.Sprintf("%d AzureMachinePoolMachines are protected from scale-in, more than the %d desired replicas", protected, desired)
+		log.Info("scale down blocked by machines protected from scale-in", "desiredReplicaCount", desired, "protectedCount", protected)
+	}
+
 	// Delete MachinePool Machines as a part of scaling down
 	for i := range toDelete {
 		ampm := toDelete[i]
@@ -573,9 +617,12 @@ func (m *MachinePoolScope) setProvisioningStateAndConditions(v infrav1.Provision
 		// not enough ready or too many ready replicas we must still be scaling up or down
 		updatingState := infrav1.Updating
 		m.AzureMachinePool.Status.ProvisioningState = &updatingState
-		if *m.MachinePool.Spec.Replicas > m.AzureMachinePool.Status.Replicas {
+		switch {
+		case *m.MachinePool.Spec.Replicas > m.AzureMachinePool.Status.Replicas:
 			conditions.MarkFalse(m.AzureMachinePool, infrav1.ScaleSetDesiredReplicasCondition, infrav1.ScaleSetScaleUpReason, clusterv1.ConditionSeverityInfo, "")
-		} else {
+		case m.scaleDownBlockedMessage != "":
+			conditions.MarkFalse(m.AzureMachinePool, infrav1.ScaleSetDesiredReplicasCondition, infrav1.ScaleSetScaleDownBlockedReason, clusterv1.ConditionSeverityWarning, m.scaleDownBlockedMessage)
+		default:
 			conditions.MarkFalse(m.AzureMachinePool, infrav1.ScaleSetDesiredReplicasCondition, infrav1.ScaleSetScaleDownReason, clusterv1.ConditionSeverityInfo, "")
 		}
 		m.SetNotReady()
@@ -617,11 +664,8 @@ func (m *MachinePoolScope) SetFailureReason(v capierrors.MachineStatusError) {
 // AdditionalTags merges AdditionalTags from the scope's AzureCluster and AzureMachinePool. If the same key is present in both,
 // the value from AzureMachinePool takes precedence.
 func (m *MachinePoolScope) AdditionalTags() infrav1.Tags {
-	tags := make(infrav1.Tags)
-	// Start with the cluster-wide tags...
-	tags.Merge(m.ClusterScoper.AdditionalTags())
-	// ... and merge in the Machine Pool's
-	tags.Merge(m.AzureMachinePool.Spec.AdditionalTags)
+	// Start with the cluster-wide tags and merge in the Machine Pool's
+	tags := mergeAdditionalTags(m.ClusterScoper.AdditionalTags(), m.AzureMachinePool.Spec.AdditionalTags)
 	// Set the cloud provider tag
 	tags[infrav1.ClusterAzureCloudProviderTagKey(m.ClusterName())] = string(infrav1.ResourceLifecycleOwned)
 
@@ -686,7 +730,8 @@ func (m *MachinePoolScope) Close(ctx context.Context) error {
 	return nil
 }
 
-// GetBootstrapData returns the bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName.
+// GetBootstrapData returns the encoded bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName,
+// with the AzureMachinePool's registry mirrors merged into it.
 func (m *MachinePoolScope) GetBootstrapData(ctx context.Context) (string, error) {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachinePoolScope.GetBootstrapData")
 	defer done()
@@ -701,11 +746,11 @@ func (m *MachinePoolScope) GetBootstrapData(ctx context.Context) (string, error)
 		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for AzureMachinePool %s/%s", m.AzureMachinePool.Namespace, m.Name())
 	}
 
-	value, ok := secret.Data["value"]
-	if !ok {
-		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+	data, err := encodeBootstrapData(secret, m.AzureMachinePool.Spec.Template.RegistryMirrors)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid bootstrap data for AzureMachinePool %s/%s", m.AzureMachinePool.Namespace, m.Name())
 	}
-	return base64.StdEncoding.EncodeToString(value), nil
+	return data, nil
 }
 
 // calculateBootstrapDataHash calculates the sha256 hash of the bootstrap data.
@@ -836,6 +881,17 @@ func (m *MachinePoolScope) VMSSExtensionSpecs() []azure.ResourceSpecGetter {
 		})
 	}
 
+	if health := m.AzureMachinePool.Spec.Template.ApplicationHealth; health != nil {
+		extensionSpecs = append(extensionSpecs, &scalesets.ApplicationHealthExtensionSpec{
+			VMSSName:      m.Name(),
+			ResourceGroup: m.NodeResourceGroup(),
+			OSType:        m.AzureMachinePool.Spec.Template.OSDisk.OSType,
+			Protocol:      health.Protocol,
+			Port:          health.Port,
+			RequestPath:   health.RequestPath,
+		})
+	}
+
 	return extensionSpecs
 }
 
@@ -866,6 +922,26 @@ func (m *MachinePoolScope) SetSubnetName() error {
 	return nil
 }
 
+// ValidateSubnetNames returns an error if a network interface of the AzureMachinePool references a subnet which is not
+// one of the subnets of the cluster's virtual network, as its NICs can't be placed in it.
+func (m *MachinePoolScope) ValidateSubnetNames() error {
+	subnets := m.Subnets()
+	for i, nic := range m.AzureMachinePool.Spec.Template.NetworkInterfaces {
+		found := false
+		for _, subnet := range subnets {
+			if subnet.Name == nic.SubnetName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("subnet %q of network interface %d is not a subnet of virtual network %s", nic.SubnetName, i, m.Vnet().Name)
+		}
+	}
+
+	return nil
+}
+
 // UpdateDeleteStatus updates a condition on the AzureMachinePool status after a DELETE operation.
 func (m *MachinePoolScope) UpdateDeleteStatus(condition clusterv1.ConditionType, service string, err error) {
 	switch {
@@ -873,6 +949,8 @@ func (m *MachinePoolScope) UpdateDeleteStatus(condition clusterv1.ConditionType,
 		conditions.MarkFalse(m.AzureMachinePool, condition, infrav1.DeletedReason, clusterv1.ConditionSeverityInfo, "%s successfully deleted", service)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(m.AzureMachinePool, condition, infrav1.DeletingReason, clusterv1.ConditionSeverityInfo, "%s deleting", service)
+	case azure.IsTransientError(err):
+		markReconciling(m.AzureMachinePool, condition, service, err)
 	default:
 		conditions.MarkFalse(m.AzureMachinePool, condition, infrav1.DeletionFailedReason, clusterv1.ConditionSeverityError, "%s failed to delete. err: %s", service, err.Error())
 	}
@@ -885,6 +963,8 @@ func (m *MachinePoolScope) UpdatePutStatus(condition clusterv1.ConditionType, se
 		conditions.MarkTrue(m.AzureMachinePool, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(m.AzureMachinePool, condition, infrav1.CreatingReason, clusterv1.ConditionSeverityInfo, "%s creating or updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(m.AzureMachinePool, condition, service, err)
 	default:
 		conditions.MarkFalse(m.AzureMachinePool, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to create or update. err: %s", service, err.Error())
 	}
@@ -897,6 +977,8 @@ func (m *MachinePoolScope) UpdatePatchStatus(condition clusterv1.ConditionType,
 		conditions.MarkTrue(m.AzureMachinePool, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(m.AzureMachinePool, condition, infrav1.UpdatingReason, clusterv1.ConditionSeverityInfo, "%s updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(m.AzureMachinePool, condition, service, err)
 	default:
 		conditions.MarkFalse(m.AzureMachinePool, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to update. err: %s", service, err.Error())
 	}
@@ -41,6 +41,7 @@ import (
 	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -101,6 +102,43 @@ func TestMachinePoolScope_Name(t *testing.T) {
 	}
 }
 
+func TestMachinePoolScope_ModelUpdatesPaused(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name: "without annotation",
+			want: false,
+		},
+		{
+			name:        "with annotation set to true",
+			annotations: map[string]string{infrav1.PauseModelUpdatesAnnotation: "true"},
+			want:        true,
+		},
+		{
+			name:        "with annotation set to false",
+			annotations: map[string]string{infrav1.PauseModelUpdatesAnnotation: "false"},
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machinePoolScope := MachinePoolScope{
+				AzureMachinePool: &infrav1exp.AzureMachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tt.annotations,
+					},
+				},
+			}
+			g.Expect(machinePoolScope.ModelUpdatesPaused()).To(Equal(tt.want))
+		})
+	}
+}
+
 func TestMachinePoolScope_ProviderID(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -258,6 +296,83 @@ func TestMachinePoolScope_NetworkInterfaces(t *testing.T) {
 	}
 }
 
+func TestMachinePoolScope_ValidateSubnetNames(t *testing.T) {
+	clusterScope := &ClusterScope{
+		AzureCluster: &infrav1.AzureCluster{
+			Spec: infrav1.AzureClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					Vnet: infrav1.VnetSpec{
+						Name: "my-vnet",
+					},
+					Subnets: infrav1.Subnets{
+						{SubnetClassSpec: infrav1.SubnetClassSpec{Name: "control-plane-subnet", Role: infrav1.SubnetControlPlane}},
+						{SubnetClassSpec: infrav1.SubnetClassSpec{Name: "node-subnet-1", Role: infrav1.SubnetNode}},
+						{SubnetClassSpec: infrav1.SubnetClassSpec{Name: "node-subnet-2", Role: infrav1.SubnetNode}},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		pools   map[string][]string
+		wantErr string
+	}{
+		{
+			name: "two pools in two node subnets",
+			pools: map[string][]string{
+				"pool-1": {"node-subnet-1"},
+				"pool-2": {"node-subnet-2"},
+			},
+		},
+		{
+			name: "pool with network interfaces in two subnets",
+			pools: map[string][]string{
+				"pool-1": {"node-subnet-1", "control-plane-subnet"},
+			},
+		},
+		{
+			name: "pool in a subnet missing from the cluster's virtual network",
+			pools: map[string][]string{
+				"pool-1": {"node-subnet-1", "missing-subnet"},
+			},
+			wantErr: `subnet "missing-subnet" of network interface 1 is not a subnet of virtual network my-vnet`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			for name, subnetNames := range tt.pools {
+				var nics []infrav1.NetworkInterface
+				for _, subnetName := range subnetNames {
+					nics = append(nics, infrav1.NetworkInterface{SubnetName: subnetName})
+				}
+				machinePoolScope := MachinePoolScope{
+					ClusterScoper: clusterScope,
+					AzureMachinePool: &infrav1exp.AzureMachinePool{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: name,
+						},
+						Spec: infrav1exp.AzureMachinePoolSpec{
+							Template: infrav1exp.AzureMachinePoolMachineTemplate{
+								NetworkInterfaces: nics,
+							},
+						},
+					},
+				}
+				err := machinePoolScope.ValidateSubnetNames()
+				if tt.wantErr != "" {
+					g.Expect(err).To(MatchError(tt.wantErr))
+				} else {
+					g.Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	}
+}
+
 func TestMachinePoolScope_MaxSurge(t *testing.T) {
 	cases := []struct {
 		Name   string
@@ -1190,6 +1305,56 @@ func TestMachinePoolScope_VMSSExtensionSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "If application health is configured, it returns the application health extension",
+			machinePoolScope: MachinePoolScope{
+				MachinePool: &expv1.MachinePool{},
+				AzureMachinePool: &infrav1exp.AzureMachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machinepool-name",
+					},
+					Spec: infrav1exp.AzureMachinePoolSpec{
+						Template: infrav1exp.AzureMachinePoolMachineTemplate{
+							OSDisk: infrav1.OSDisk{
+								OSType: "Linux",
+							},
+							ApplicationHealth: &infrav1exp.ApplicationHealth{
+								Protocol:    infrav1exp.ApplicationHealthProtocolHTTP,
+								Port:        10256,
+								RequestPath: "/healthz",
+							},
+						},
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Environment: azureautorest.Environment{
+								Name: azureautorest.USGovernmentCloud.Name,
+							},
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+						},
+					},
+				},
+				cache: &MachinePoolCache{
+					VMSKU: resourceskus.SKU{},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&scalesets.ApplicationHealthExtensionSpec{
+					VMSSName:      "machinepool-name",
+					ResourceGroup: "my-rg",
+					OSType:        "Linux",
+					Protocol:      infrav1exp.ApplicationHealthProtocolHTTP,
+					Port:          10256,
+					RequestPath:   "/healthz",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1511,3 +1676,79 @@ func TestMachinePoolScope_applyAzureMachinePoolMachines(t *testing.T) {
 		})
 	}
 }
+
+func TestMachinePoolScope_applyAzureMachinePoolMachinesScaleDownBlocked(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = infrav1exp.AddToScheme(scheme)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: "default",
+		},
+	}
+	mp := &expv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mp1",
+			Namespace: "default",
+		},
+		Spec: expv1.MachinePoolSpec{
+			Replicas: ptr.To[int32](1),
+		},
+	}
+	amp := &infrav1exp.AzureMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "amp1",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					Name:       "mp1",
+					Kind:       "MachinePool",
+					APIVersion: expv1.GroupVersion.String(),
+				},
+			},
+		},
+		Status: infrav1exp.AzureMachinePoolStatus{
+			Replicas: 2,
+		},
+	}
+	mpm1, ampm1 := getAzureMachinePoolMachineWithOwnerMachine(1)
+	mpm2, ampm2 := getAzureMachinePoolMachineWithOwnerMachine(2)
+	ampm1.Annotations = map[string]string{infrav1.ProtectFromScaleInAnnotation: "true"}
+	ampm2.Annotations = map[string]string{infrav1.ProtectFromScaleInAnnotation: "true"}
+
+	s := &MachinePoolScope{
+		client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(amp, cluster, &mpm1, &ampm1, &mpm2, &ampm2).Build(),
+		ClusterScoper: &ClusterScope{
+			Cluster: cluster,
+		},
+		MachinePool:      mp,
+		AzureMachinePool: amp,
+		vmssState: &azure.VMSS{
+			Instances: []azure.VMSSVM{
+				{
+					ID:   "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/my-vmss/virtualMachines/1",
+					Name: "ampm1",
+				},
+				{
+					ID:   "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/my-vmss/virtualMachines/2",
+					Name: "ampm2",
+				},
+			},
+		},
+	}
+	g.Expect(s.applyAzureMachinePoolMachines(ctx)).To(Succeed())
+
+	list := clusterv1.MachineList{}
+	g.Expect(s.client.List(ctx, &list)).To(Succeed())
+	g.Expect(list.Items).To(HaveLen(2))
+
+	s.setProvisioningStateAndConditions(infrav1.Succeeded)
+	condition := conditions.Get(amp, infrav1.ScaleSetDesiredReplicasCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Reason).To(Equal(infrav1.ScaleSetScaleDownBlockedReason))
+	g.Expect(condition.Severity).To(Equal(clusterv1.ConditionSeverityWarning))
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -38,6 +39,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	capierrors "sigs.k8s.io/cluster-api/errors"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -163,7 +165,9 @@ func (s *MachinePoolMachineScope) ScaleSetVMSpec() azure.ResourceSpecGetter {
 		ScaleSetName:  s.ScaleSetName(),
 		ProviderID:    s.ProviderID(),
 		IsFlex:        s.OrchestrationMode() == infrav1.FlexibleOrchestrationMode,
+		ForceDelete:   s.AzureMachinePool.Spec.ForceDeleteInstances,
 	}
+	spec.ProtectFromScaleIn = !spec.IsFlex && s.AzureMachinePoolMachine.GetAnnotations()[infrav1.ProtectFromScaleInAnnotation] == "true"
 
 	if spec.IsFlex {
 		spec.ResourceID = strings.TrimPrefix(spec.ProviderID, azureutil.ProviderIDPrefix)
@@ -177,6 +181,14 @@ func (s *MachinePoolMachineScope) Name() string {
 	return s.AzureMachinePoolMachine.Name
 }
 
+// IsPaused returns true if reconciliation of the AzureMachinePoolMachine or its cluster is paused.
+func (s *MachinePoolMachineScope) IsPaused() bool {
+	if p, ok := s.ClusterScoper.(azure.PauseDescriber); ok && p.IsPaused() {
+		return true
+	}
+	return annotations.HasPaused(s.AzureMachinePoolMachine)
+}
+
 // InstanceID is the unique ID of the machine within the Machine Pool.
 func (s *MachinePoolMachineScope) InstanceID() string {
 	return s.AzureMachinePoolMachine.Spec.InstanceID
@@ -215,6 +227,8 @@ func (s *MachinePoolMachineScope) UpdateDeleteStatus(condition clusterv1.Conditi
 		conditions.MarkFalse(s.AzureMachinePoolMachine, condition, infrav1.DeletedReason, clusterv1.ConditionSeverityInfo, "%s successfully deleted", service)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.AzureMachinePoolMachine, condition, infrav1.DeletingReason, clusterv1.ConditionSeverityInfo, "%s deleting", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.AzureMachinePoolMachine, condition, service, err)
 	default:
 		conditions.MarkFalse(s.AzureMachinePoolMachine, condition, infrav1.DeletionFailedReason, clusterv1.ConditionSeverityError, "%s failed to delete. err: %s", service, err.Error())
 	}
@@ -227,6 +241,8 @@ func (s *MachinePoolMachineScope) UpdatePutStatus(condition clusterv1.ConditionT
 		conditions.MarkTrue(s.AzureMachinePoolMachine, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.AzureMachinePoolMachine, condition, infrav1.CreatingReason, clusterv1.ConditionSeverityInfo, "%s creating or updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.AzureMachinePoolMachine, condition, service, err)
 	default:
 		conditions.MarkFalse(s.AzureMachinePoolMachine, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to create or update. err: %s", service, err.Error())
 	}
@@ -239,6 +255,8 @@ func (s *MachinePoolMachineScope) UpdatePatchStatus(condition clusterv1.Conditio
 		conditions.MarkTrue(s.AzureMachinePoolMachine, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.AzureMachinePoolMachine, condition, infrav1.UpdatingReason, clusterv1.ConditionSeverityInfo, "%s updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.AzureMachinePoolMachine, condition, service, err)
 	default:
 		conditions.MarkFalse(s.AzureMachinePoolMachine, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to update. err: %s", service, err.Error())
 	}
@@ -359,6 +377,10 @@ func (s *MachinePoolMachineScope) UpdateNodeStatus(ctx context.Context) error {
 	case err != nil:
 		// Failed due to an unexpected error
 		return err
+	case !found && s.nodeStartupTimeoutExceeded():
+		// Node has not joined the workload cluster in time
+		conditions.MarkFalse(s.AzureMachinePoolMachine, clusterv1.MachineNodeHealthyCondition, infrav1.NodeStartupTimeoutReason, clusterv1.ConditionSeverityError,
+			"node has not joined the workload cluster within %s", s.AzureMachinePool.Spec.NodeStartupTimeout.Duration)
 	case !found && s.ProviderID() == "":
 		// Node was not found due to not having a providerID set
 		conditions.MarkFalse(s.AzureMachinePoolMachine, clusterv1.MachineNodeHealthyCondition, clusterv1.WaitingForNodeRefReason, clusterv1.ConditionSeverityInfo, "")
@@ -447,6 +469,17 @@ func (s *MachinePoolMachineScope) hasLatestModelApplied(ctx context.Context) (bo
 	return reflect.DeepEqual(s.instance.Image, *image), nil
 }
 
+// nodeStartupTimeoutExceeded returns true if the AzureMachinePool's NodeStartupTimeout has passed since the
+// AzureMachinePoolMachine was created.
+func (s *MachinePoolMachineScope) nodeStartupTimeoutExceeded() bool {
+	timeout := s.AzureMachinePool.Spec.NodeStartupTimeout
+	if timeout == nil || timeout.Duration <= 0 {
+		return false
+	}
+
+	return time.Since(s.AzureMachinePoolMachine.CreationTimestamp.Time) >= timeout.Duration
+}
+
 func newWorkloadClusterProxy(c client.Client, cluster client.ObjectKey) *workloadClusterProxy {
 	return &workloadClusterProxy{
 		Client:  c,
@@ -20,6 +20,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
@@ -212,6 +213,114 @@ func TestMachinePoolMachineScope_ScaleSetVMSpecs(t *testing.T) {
 				ResourceID:    "",
 			},
 		},
+		{
+			name: "return vmss vm spec for uniform vmss with force deletion",
+			machinePoolMachineScope: MachinePoolMachineScope{
+				MachinePool: &expv1.MachinePool{},
+				AzureMachinePool: &infrav1exp.AzureMachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machinepool-name",
+					},
+					Spec: infrav1exp.AzureMachinePoolSpec{
+						Template: infrav1exp.AzureMachinePoolMachineTemplate{
+							OSDisk: infrav1.OSDisk{
+								OSType: "Linux",
+							},
+						},
+						OrchestrationMode:    infrav1.UniformOrchestrationMode,
+						ForceDeleteInstances: true,
+					},
+				},
+				AzureMachinePoolMachine: &infrav1exp.AzureMachinePoolMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machinepoolmachine-name",
+					},
+					Spec: infrav1exp.AzureMachinePoolMachineSpec{
+						ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/machinepool-name/virtualMachines/0",
+						InstanceID: "0",
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+						},
+					},
+				},
+				MachinePoolScope: &MachinePoolScope{
+					AzureMachinePool: &infrav1exp.AzureMachinePool{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "machinepool-name",
+						},
+					},
+				},
+			},
+			want: &scalesetvms.ScaleSetVMSpec{
+				Name:          "machinepoolmachine-name",
+				InstanceID:    "0",
+				ResourceGroup: "my-rg",
+				ScaleSetName:  "machinepool-name",
+				ProviderID:    "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/machinepool-name/virtualMachines/0",
+				IsFlex:        false,
+				ResourceID:    "",
+				ForceDelete:   true,
+			},
+		},
+		{
+			name: "return vmss vm spec for uniform vmss with scale-in protection",
+			machinePoolMachineScope: MachinePoolMachineScope{
+				MachinePool: &expv1.MachinePool{},
+				AzureMachinePool: &infrav1exp.AzureMachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machinepool-name",
+					},
+					Spec: infrav1exp.AzureMachinePoolSpec{
+						Template: infrav1exp.AzureMachinePoolMachineTemplate{
+							OSDisk: infrav1.OSDisk{
+								OSType: "Linux",
+							},
+						},
+						OrchestrationMode: infrav1.UniformOrchestrationMode,
+					},
+				},
+				AzureMachinePoolMachine: &infrav1exp.AzureMachinePoolMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machinepoolmachine-name",
+						Annotations: map[string]string{
+							infrav1.ProtectFromScaleInAnnotation: "true",
+						},
+					},
+					Spec: infrav1exp.AzureMachinePoolMachineSpec{
+						ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/machinepool-name/virtualMachines/0",
+						InstanceID: "0",
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+						},
+					},
+				},
+				MachinePoolScope: &MachinePoolScope{
+					AzureMachinePool: &infrav1exp.AzureMachinePool{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "machinepool-name",
+						},
+					},
+				},
+			},
+			want: &scalesetvms.ScaleSetVMSpec{
+				Name:               "machinepoolmachine-name",
+				InstanceID:         "0",
+				ResourceGroup:      "my-rg",
+				ScaleSetName:       "machinepool-name",
+				ProviderID:         "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/machinepool-name/virtualMachines/0",
+				IsFlex:             false,
+				ResourceID:         "",
+				ProtectFromScaleIn: true,
+			},
+		},
 		{
 			name: "return vmss vm spec for vmss flex",
 			machinePoolMachineScope: MachinePoolMachineScope{
@@ -337,10 +446,11 @@ func TestMachineScope_UpdateNodeStatus(t *testing.T) {
 	clusterScope.EXPECT().ClusterName().Return("cluster-foo").AnyTimes()
 
 	cases := []struct {
-		Name   string
-		Setup  func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine)
-		Verify func(g *WithT, scope *MachinePoolMachineScope)
-		Err    string
+		Name               string
+		NodeStartupTimeout *metav1.Duration
+		Setup              func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine)
+		Verify             func(g *WithT, scope *MachinePoolMachineScope)
+		Err                string
 	}{
 		{
 			Name: "should set kubernetes version, ready, and node reference upon finding the node",
@@ -390,6 +500,44 @@ func TestMachineScope_UpdateNodeStatus(t *testing.T) {
 				assertCondition(t, scope.AzureMachinePoolMachine, conditions.FalseCondition(clusterv1.MachineNodeHealthyCondition, clusterv1.NodeProvisioningReason, clusterv1.ConditionSeverityInfo, ""))
 			},
 		},
+		{
+			Name:               "node has not joined within the node startup timeout",
+			NodeStartupTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			Setup: func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine) {
+				ampm.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+				mockNodeGetter.EXPECT().GetNodeByProviderID(gomock2.AContext(), FakeProviderID).Return(nil, nil)
+				return nil, ampm
+			},
+			Verify: func(g *WithT, scope *MachinePoolMachineScope) {
+				g.Expect(scope.AzureMachinePoolMachine.Status.Ready).To(BeFalse())
+				assertCondition(t, scope.AzureMachinePoolMachine, conditions.FalseCondition(clusterv1.MachineNodeHealthyCondition, infrav1.NodeStartupTimeoutReason, clusterv1.ConditionSeverityError, "node has not joined the workload cluster within 10m0s"))
+			},
+		},
+		{
+			Name:               "node has not joined before the node startup timeout",
+			NodeStartupTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			Setup: func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine) {
+				ampm.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+				mockNodeGetter.EXPECT().GetNodeByProviderID(gomock2.AContext(), FakeProviderID).Return(nil, nil)
+				return nil, ampm
+			},
+			Verify: func(g *WithT, scope *MachinePoolMachineScope) {
+				assertCondition(t, scope.AzureMachinePoolMachine, conditions.FalseCondition(clusterv1.MachineNodeHealthyCondition, clusterv1.NodeProvisioningReason, clusterv1.ConditionSeverityInfo, ""))
+			},
+		},
+		{
+			Name:               "should mark AMPM ready if the node joined after the node startup timeout",
+			NodeStartupTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			Setup: func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine) {
+				ampm.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+				mockNodeGetter.EXPECT().GetNodeByProviderID(gomock2.AContext(), FakeProviderID).Return(getReadyNode(), nil)
+				return nil, ampm
+			},
+			Verify: func(g *WithT, scope *MachinePoolMachineScope) {
+				g.Expect(scope.AzureMachinePoolMachine.Status.Ready).To(BeTrue())
+				assertCondition(t, scope.AzureMachinePoolMachine, conditions.TrueCondition(clusterv1.MachineNodeHealthyCondition))
+			},
+		},
 		{
 			Name: "node is found by ObjectReference",
 			Setup: func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine) {
@@ -435,6 +583,7 @@ func TestMachineScope_UpdateNodeStatus(t *testing.T) {
 			)
 
 			defer controller.Finish()
+			params.AzureMachinePool.Spec.NodeStartupTimeout = c.NodeStartupTimeout
 
 			instance, ampm := c.Setup(mockClient, &infrav1exp.AzureMachinePoolMachine{
 				Spec: infrav1exp.AzureMachinePoolMachineSpec{
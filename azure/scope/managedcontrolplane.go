@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	asocontainerservicev1preview "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20230315preview"
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	asokubernetesconfigurationv1 "github.com/Azure/azure-service-operator/v2/api/kubernetesconfiguration/v1api20230501"
@@ -40,10 +41,13 @@ import (
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/acrattachments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aksextensions"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/datacollectionrules"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/fleetsmembers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/managedclusters"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/monitorworkspaces"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualnetworks"
@@ -51,6 +55,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/secret"
@@ -62,6 +67,9 @@ const (
 	resourceHealthWarningInitialGracePeriod = 1 * time.Hour
 	// managedControlPlaneScopeName is the sourceName, or more specifically the UserAgent, of client used to store the Cluster Info configmap.
 	managedControlPlaneScopeName = "azuremanagedcontrolplane-scope"
+	// prometheusDataCollectionRuleAssociationName is the name of the association of the data collection rule which
+	// sends the Prometheus metrics of the cluster to its Azure Monitor workspace.
+	prometheusDataCollectionRuleAssociationName = "ContainerInsightsMetricsExtension"
 )
 
 // ManagedControlPlaneScopeParams defines the input parameters used to create a new managed
@@ -128,6 +136,11 @@ type ManagedControlPlaneScope struct {
 	userKubeConfigData  []byte
 	cache               *ManagedControlPlaneCache
 
+	// effectiveOutboundIPs are the IDs of the outbound public IPs of the AKS load balancer reported by the managed cluster.
+	effectiveOutboundIPs []string
+	// kubeletIdentityObjectID is the object ID of the kubelet identity reported by the managed cluster.
+	kubeletIdentityObjectID string
+
 	AzureClients
 	Cluster             *clusterv1.Cluster
 	ControlPlane        *infrav1.AzureManagedControlPlane
@@ -155,6 +168,11 @@ func (s *ManagedControlPlaneScope) GetDeletionTimestamp() *metav1.Time {
 	return s.Cluster.DeletionTimestamp
 }
 
+// IsPaused returns true if reconciliation of the AzureManagedControlPlane is paused.
+func (s *ManagedControlPlaneScope) IsPaused() bool {
+	return annotations.IsPaused(s.Cluster, s.ControlPlane)
+}
+
 // ResourceGroup returns the managed control plane's resource group.
 func (s *ManagedControlPlaneScope) ResourceGroup() string {
 	if s.ControlPlane == nil {
@@ -213,6 +231,150 @@ func (s *ManagedControlPlaneScope) AdditionalTags() infrav1.Tags {
 	return tags
 }
 
+// TagsSpecs returns the tags for the node resource group of the managed cluster and for the outbound resources AKS
+// created in it. Their tags are tracked separately from the AdditionalTags of the managed cluster, which are
+// reconciled with the managed cluster itself.
+func (s *ManagedControlPlaneScope) TagsSpecs() []azure.TagsSpec {
+	var specs []azure.TagsSpec
+	// Keep reconciling once tags were applied so that removed tags are deleted from the node resource group.
+	if len(s.ControlPlane.Spec.NodeResourceGroupTags) != 0 || s.ControlPlane.GetAnnotations()[azure.NodeResourceGroupTagsLastAppliedAnnotation] != "" {
+		specs = append(specs, azure.TagsSpec{
+			Scope:      azure.ResourceGroupID(s.SubscriptionID(), s.NodeResourceGroup()),
+			Tags:       s.ControlPlane.Spec.NodeResourceGroupTags,
+			Annotation: azure.NodeResourceGroupTagsLastAppliedAnnotation,
+		})
+	}
+	if len(s.ControlPlane.Spec.AdditionalTags) != 0 || s.ControlPlane.GetAnnotations()[azure.AKSOutboundTagsLastAppliedAnnotation] != "" {
+		for _, id := range s.aksOutboundResourceIDs() {
+			specs = append(specs, azure.TagsSpec{
+				Scope:      id,
+				Tags:       s.ControlPlane.Spec.AdditionalTags,
+				Annotation: azure.AKSOutboundTagsLastAppliedAnnotation,
+			})
+		}
+	}
+	return specs
+}
+
+// SetEffectiveOutboundIPs sets the IDs of the outbound public IPs of the AKS load balancer.
+func (s *ManagedControlPlaneScope) SetEffectiveOutboundIPs(ids []string) {
+	s.effectiveOutboundIPs = ids
+}
+
+// SetKubeletIdentityObjectID sets the object ID of the kubelet identity of the managed cluster.
+func (s *ManagedControlPlaneScope) SetKubeletIdentityObjectID(objectID string) {
+	s.kubeletIdentityObjectID = objectID
+}
+
+// KubeletIdentityObjectID returns the object ID of the kubelet identity of the managed cluster, or an empty string if
+// the managed cluster didn't report it yet.
+func (s *ManagedControlPlaneScope) KubeletIdentityObjectID() string {
+	return s.kubeletIdentityObjectID
+}
+
+// ACRAttachmentSpecs returns the specs of the AcrPull role assignments of the given kubelet identity on the
+// referenced Azure Container Registries.
+func (s *ManagedControlPlaneScope) ACRAttachmentSpecs(principalID string) []azure.ResourceSpecGetter {
+	var specs []azure.ResourceSpecGetter
+	for _, reference := range s.ControlPlane.Spec.ACRReferences {
+		specs = append(specs, acrattachments.NewACRAttachmentSpec(reference.ID, principalID))
+	}
+	return specs
+}
+
+// azureMonitorMetrics returns the Prometheus metrics configuration of the cluster, or nil if it isn't configured.
+func (s *ManagedControlPlaneScope) azureMonitorMetrics() *infrav1.AzureMonitorMetrics {
+	profile := s.ControlPlane.Spec.AzureMonitorProfile
+	if profile == nil {
+		return nil
+	}
+	return profile.Metrics
+}
+
+// AzureMonitorMetricsEnabled returns true if the collection of the Prometheus metrics of the cluster is enabled.
+func (s *ManagedControlPlaneScope) AzureMonitorMetricsEnabled() bool {
+	metrics := s.azureMonitorMetrics()
+	return metrics != nil && metrics.Enabled
+}
+
+// AzureMonitorResource refers to the AzureManagedControlPlane, whose conditions record whether the Azure Monitor
+// resources of the cluster were created.
+func (s *ManagedControlPlaneScope) AzureMonitorResource() conditions.Setter {
+	return s.ControlPlane
+}
+
+// MonitorWorkspaceSpec returns the spec of the Azure Monitor workspace which stores the Prometheus metrics of the
+// cluster, or nil if their collection isn't configured. The spec is also returned if the collection is disabled, so
+// that a workspace created before can be deleted.
+func (s *ManagedControlPlaneScope) MonitorWorkspaceSpec() azure.ResourceSpecGetter {
+	metrics := s.azureMonitorMetrics()
+	if metrics == nil {
+		return nil
+	}
+	return &monitorworkspaces.WorkspaceSpec{
+		Name:           metrics.WorkspaceName,
+		ResourceGroup:  s.ResourceGroup(),
+		Location:       s.Location(),
+		ClusterName:    s.ClusterName(),
+		AdditionalTags: s.AdditionalTags(),
+	}
+}
+
+// DataCollectionRuleSpecs returns the specs of the data collection rule which sends the Prometheus metrics of the
+// cluster to its Azure Monitor workspace and of its association with the cluster, or nil if the collection of the
+// metrics isn't configured. The specs are also returned if the collection is disabled, so that a rule created before
+// can be deleted.
+func (s *ManagedControlPlaneScope) DataCollectionRuleSpecs() (ruleSpec azure.ResourceSpecGetter, associationSpec azure.ResourceSpecGetter) {
+	metrics := s.azureMonitorMetrics()
+	if metrics == nil {
+		return nil, nil
+	}
+	name := fmt.Sprintf("MSProm-%s-%s", s.Location(), s.ControlPlane.Name)
+	ruleSpec = &datacollectionrules.DataCollectionRuleSpec{
+		Name:           name,
+		ResourceGroup:  s.ResourceGroup(),
+		Location:       s.Location(),
+		WorkspaceID:    azure.AzureMonitorWorkspaceID(s.SubscriptionID(), s.ResourceGroup(), metrics.WorkspaceName),
+		Streams:        metrics.Streams,
+		ClusterName:    s.ClusterName(),
+		AdditionalTags: s.AdditionalTags(),
+	}
+	associationSpec = &datacollectionrules.AssociationSpec{
+		Name:                 prometheusDataCollectionRuleAssociationName,
+		ResourceGroup:        s.ResourceGroup(),
+		ClusterID:            azure.ManagedClusterID(s.SubscriptionID(), s.ResourceGroup(), s.ControlPlane.Name),
+		DataCollectionRuleID: azure.DataCollectionRuleID(s.SubscriptionID(), s.ResourceGroup(), name),
+	}
+	return ruleSpec, associationSpec
+}
+
+// aksOutboundResourceIDs returns the IDs of the outbound load balancer and public IPs AKS created in the node
+// resource group. They change when AKS recreates them, so they are looked up from the managed cluster on every
+// reconcile. Public IPs brought by the user, or outside of the node resource group, are not included.
+func (s *ManagedControlPlaneScope) aksOutboundResourceIDs() []string {
+	if len(s.effectiveOutboundIPs) == 0 {
+		return nil
+	}
+	userIPs := make(map[string]struct{})
+	if lbProfile := s.ControlPlane.Spec.LoadBalancerProfile; lbProfile != nil {
+		for _, id := range lbProfile.OutboundIPs {
+			userIPs[strings.ToLower(id)] = struct{}{}
+		}
+	}
+	ids := []string{azure.LoadBalancerID(s.SubscriptionID(), s.NodeResourceGroup(), s.OutboundLBName(""))}
+	for _, id := range s.effectiveOutboundIPs {
+		if _, ok := userIPs[strings.ToLower(id)]; ok {
+			continue
+		}
+		resourceID, err := arm.ParseResourceID(id)
+		if err != nil || !strings.EqualFold(resourceID.ResourceGroupName, s.NodeResourceGroup()) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 // AzureFleetMembership returns the cluster AzureFleetMembership.
 func (s *ManagedControlPlaneScope) AzureFleetMembership() *infrav1.FleetsMember {
 	return s.ControlPlane.Spec.FleetsMember
@@ -246,6 +408,7 @@ func (s *ManagedControlPlaneScope) PatchObject(ctx context.Context) error {
 			infrav1.ManagedClusterRunningCondition,
 			infrav1.AgentPoolsReadyCondition,
 			infrav1.AzureResourceAvailableCondition,
+			infrav1.ACRReferencesReadyCondition,
 		}})
 }
 
@@ -268,6 +431,12 @@ func (s *ManagedControlPlaneScope) Vnet() *infrav1.VnetSpec {
 	}
 }
 
+// ResourceGroupMode returns whether CAPZ manages the lifecycle of the cluster's resource groups.
+// The resource groups of managed clusters are always managed.
+func (s *ManagedControlPlaneScope) ResourceGroupMode() infrav1.ResourceGroupMode {
+	return infrav1.ResourceGroupModeManaged
+}
+
 // GroupSpecs returns the resource group spec.
 func (s *ManagedControlPlaneScope) GroupSpecs() []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup] {
 	return []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]{
@@ -468,6 +637,12 @@ func (s *ManagedControlPlaneScope) OutboundPoolName(_ string) string {
 	return "aksOutboundBackendPool" // hard-coded in aks
 }
 
+// OutboundLBInboundNATPools returns the inbound NAT pools of the outbound LB.
+// Currently always empty as the outbound LB of managed clusters is not managed.
+func (s *ManagedControlPlaneScope) OutboundLBInboundNATPools(_ string) []infrav1.InboundNATPool {
+	return nil
+}
+
 // GetPrivateDNSZoneName returns the Private DNS Zone from the spec or generate it from cluster name.
 // Currently always empty as managed control planes do not currently implement private clusters.
 func (s *ManagedControlPlaneScope) GetPrivateDNSZoneName() string {
@@ -502,6 +677,29 @@ func (s *ManagedControlPlaneScope) IsAADEnabled() bool {
 	return false
 }
 
+// DesiredPowerState returns the power state the managed cluster should be in, Running by default.
+func (s *ManagedControlPlaneScope) DesiredPowerState() infrav1.ManagedControlPlanePowerState {
+	return ptr.Deref(s.ControlPlane.Spec.PowerState, infrav1.ManagedControlPlanePowerStateRunning)
+}
+
+// PendingCertificateRotation returns the requested rotation of the managed cluster certificates if the certificates
+// haven't been rotated for it yet, or nil.
+func (s *ManagedControlPlaneScope) PendingCertificateRotation() *metav1.Time {
+	requested := s.ControlPlane.Spec.CertificateRotation
+	if requested == nil {
+		return nil
+	}
+	if last := s.ControlPlane.Status.LastCertificateRotation; last != nil && !requested.After(last.Time) {
+		return nil
+	}
+	return requested
+}
+
+// SetLastCertificateRotation records the rotation of the managed cluster certificates which was requested.
+func (s *ManagedControlPlaneScope) SetLastCertificateRotation(rotation metav1.Time) {
+	s.ControlPlane.Status.LastCertificateRotation = &rotation
+}
+
 // ManagedClusterSpec returns the managed cluster spec.
 func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedCluster] {
 	managedClusterSpec := managedclusters.ManagedClusterSpec{
@@ -530,6 +728,9 @@ func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGet
 	if s.ControlPlane.Spec.SSHPublicKey != nil {
 		managedClusterSpec.SSHPublicKey = *s.ControlPlane.Spec.SSHPublicKey
 	}
+	if s.ControlPlane.Spec.AdminUsername != nil {
+		managedClusterSpec.AdminUsername = *s.ControlPlane.Spec.AdminUsername
+	}
 	if s.ControlPlane.Spec.NetworkPlugin != nil {
 		managedClusterSpec.NetworkPlugin = *s.ControlPlane.Spec.NetworkPlugin
 	}
@@ -545,9 +746,17 @@ func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGet
 		if clusterNetwork.Services != nil && len(clusterNetwork.Services.CIDRBlocks) == 1 {
 			managedClusterSpec.ServiceCIDR = clusterNetwork.Services.CIDRBlocks[0]
 		}
+		if clusterNetwork.Services != nil && len(clusterNetwork.Services.CIDRBlocks) == 2 {
+			managedClusterSpec.ServiceCIDR = infrav1.IPv4CIDRBlock(clusterNetwork.Services.CIDRBlocks)
+			managedClusterSpec.ServiceCIDRs = clusterNetwork.Services.CIDRBlocks
+		}
 		if clusterNetwork.Pods != nil && len(clusterNetwork.Pods.CIDRBlocks) == 1 {
 			managedClusterSpec.PodCIDR = clusterNetwork.Pods.CIDRBlocks[0]
 		}
+		if clusterNetwork.Pods != nil && len(clusterNetwork.Pods.CIDRBlocks) == 2 {
+			managedClusterSpec.PodCIDR = infrav1.IPv4CIDRBlock(clusterNetwork.Pods.CIDRBlocks)
+			managedClusterSpec.PodCIDRs = clusterNetwork.Pods.CIDRBlocks
+		}
 	}
 
 	if s.ControlPlane.Spec.AADProfile != nil {
@@ -633,6 +842,10 @@ func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGet
 		}
 	}
 
+	if profile := s.ControlPlane.Spec.AzureMonitorProfile; profile != nil && profile.Metrics != nil {
+		managedClusterSpec.AzureMonitorMetricsEnabled = ptr.To(profile.Metrics.Enabled)
+	}
+
 	return &managedClusterSpec
 }
 
@@ -787,6 +1000,8 @@ func (s *ManagedControlPlaneScope) UpdateDeleteStatus(condition clusterv1.Condit
 		conditions.MarkFalse(s.ControlPlane, condition, infrav1.DeletedReason, clusterv1.ConditionSeverityInfo, "%s successfully deleted", service)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.ControlPlane, condition, infrav1.DeletingReason, clusterv1.ConditionSeverityInfo, "%s deleting", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.ControlPlane, condition, service, err)
 	default:
 		conditions.MarkFalse(s.ControlPlane, condition, infrav1.DeletionFailedReason, clusterv1.ConditionSeverityError, "%s failed to delete. err: %s", service, err.Error())
 	}
@@ -799,6 +1014,8 @@ func (s *ManagedControlPlaneScope) UpdatePutStatus(condition clusterv1.Condition
 		conditions.MarkTrue(s.ControlPlane, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.ControlPlane, condition, infrav1.CreatingReason, clusterv1.ConditionSeverityInfo, "%s creating or updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.ControlPlane, condition, service, err)
 	default:
 		conditions.MarkFalse(s.ControlPlane, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to create or update. err: %s", service, err.Error())
 	}
@@ -811,6 +1028,8 @@ func (s *ManagedControlPlaneScope) UpdatePatchStatus(condition clusterv1.Conditi
 		conditions.MarkTrue(s.ControlPlane, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.ControlPlane, condition, infrav1.UpdatingReason, clusterv1.ConditionSeverityInfo, "%s updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.ControlPlane, condition, service, err)
 	default:
 		conditions.MarkFalse(s.ControlPlane, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to update. err: %s", service, err.Error())
 	}
@@ -939,6 +1158,9 @@ func (s *ManagedControlPlaneScope) AKSExtensionSpecs() []azure.ASOResourceSpecGe
 			AKSAssignedIdentityType: extension.AKSAssignedIdentityType,
 			ExtensionIdentity:       extension.Identity,
 		}
+		if extension.Scope != nil {
+			extensionSpec.Scope = *extension.Scope
+		}
 
 		extensionSpecs = append(extensionSpecs, extensionSpec)
 	}
@@ -20,10 +20,12 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	asokubernetesconfigurationv1 "github.com/Azure/azure-service-operator/v2/api/kubernetesconfiguration/v1api20230501"
 	asonetworkv1 "github.com/Azure/azure-service-operator/v2/api/network/v1api20220701"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,7 +35,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpools"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aksextensions"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/datacollectionrules"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/managedclusters"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/monitorworkspaces"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
@@ -221,14 +225,15 @@ func TestManagedControlPlaneScope_PoolVersion(t *testing.T) {
 			},
 			Expected: []azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedClustersAgentPool]{
 				&agentpools.AgentPoolSpec{
-					Name:         "pool0",
-					AzureName:    "pool0",
-					SKU:          "Standard_D2s_v3",
-					Mode:         "System",
-					Replicas:     1,
-					Version:      ptr.To("1.21.1"),
-					Cluster:      "cluster1",
-					VnetSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
+					Name:                "pool0",
+					AzureName:           "pool0",
+					SKU:                 "Standard_D2s_v3",
+					Mode:                "System",
+					Replicas:            1,
+					Version:             ptr.To("1.21.1"),
+					ControlPlaneVersion: ptr.To("1.22.0"),
+					Cluster:             "cluster1",
+					VnetSubnetID:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
 				},
 			},
 		},
@@ -472,33 +477,36 @@ func TestManagedControlPlaneScope_OSType(t *testing.T) {
 			},
 			Expected: []azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedClustersAgentPool]{
 				&agentpools.AgentPoolSpec{
-					Name:         "pool0",
-					AzureName:    "pool0",
-					SKU:          "Standard_D2s_v3",
-					Mode:         "System",
-					Replicas:     1,
-					Cluster:      "cluster1",
-					VnetSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
+					Name:                "pool0",
+					AzureName:           "pool0",
+					SKU:                 "Standard_D2s_v3",
+					Mode:                "System",
+					Replicas:            1,
+					ControlPlaneVersion: ptr.To("1.20.1"),
+					Cluster:             "cluster1",
+					VnetSubnetID:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
 				},
 				&agentpools.AgentPoolSpec{
-					Name:         "pool1",
-					AzureName:    "pool1",
-					SKU:          "Standard_D2s_v3",
-					Mode:         "User",
-					Replicas:     1,
-					Cluster:      "cluster1",
-					VnetSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
-					OSType:       ptr.To(azure.LinuxOS),
+					Name:                "pool1",
+					AzureName:           "pool1",
+					SKU:                 "Standard_D2s_v3",
+					Mode:                "User",
+					Replicas:            1,
+					ControlPlaneVersion: ptr.To("1.20.1"),
+					Cluster:             "cluster1",
+					VnetSubnetID:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
+					OSType:              ptr.To(azure.LinuxOS),
 				},
 				&agentpools.AgentPoolSpec{
-					Name:         "pool2",
-					AzureName:    "pool2",
-					SKU:          "Standard_D2s_v3",
-					Mode:         "User",
-					Replicas:     1,
-					Cluster:      "cluster1",
-					VnetSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
-					OSType:       ptr.To(azure.WindowsOS),
+					Name:                "pool2",
+					AzureName:           "pool2",
+					SKU:                 "Standard_D2s_v3",
+					Mode:                "User",
+					Replicas:            1,
+					ControlPlaneVersion: ptr.To("1.20.1"),
+					Cluster:             "cluster1",
+					VnetSubnetID:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
+					OSType:              ptr.To(azure.WindowsOS),
 				},
 			},
 		},
@@ -1488,6 +1496,10 @@ func TestManagedControlPlaneScope_AKSExtensionSpecs(t *testing.T) {
 										Product:   "my-product",
 										Publisher: "my-publisher",
 									},
+									Scope: &infrav1.ExtensionScope{
+										ScopeType:        infrav1.ExtensionScopeCluster,
+										ReleaseNamespace: "my-namespace",
+									},
 									AKSAssignedIdentityType: infrav1.AKSAssignedIdentitySystemAssigned,
 									Identity:                infrav1.ExtensionIdentitySystemAssigned,
 								},
@@ -1513,6 +1525,10 @@ func TestManagedControlPlaneScope_AKSExtensionSpecs(t *testing.T) {
 						Product:   "my-product",
 						Publisher: "my-publisher",
 					},
+					Scope: infrav1.ExtensionScope{
+						ScopeType:        infrav1.ExtensionScopeCluster,
+						ReleaseNamespace: "my-namespace",
+					},
 					AKSAssignedIdentityType: infrav1.AKSAssignedIdentitySystemAssigned,
 					ExtensionIdentity:       infrav1.ExtensionIdentitySystemAssigned,
 				},
@@ -1533,3 +1549,322 @@ func TestManagedControlPlaneScope_AKSExtensionSpecs(t *testing.T) {
 		})
 	}
 }
+
+func TestManagedControlPlaneScope_PendingCertificateRotation(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(time.Date(2024, 10, 14, 0, 0, 0, 0, time.UTC))
+
+	cases := []struct {
+		Name     string
+		Spec     *metav1.Time
+		Status   *metav1.Time
+		Expected *metav1.Time
+	}{
+		{
+			Name: "no rotation requested",
+		},
+		{
+			Name:     "first rotation requested",
+			Spec:     &later,
+			Expected: &later,
+		},
+		{
+			Name:     "rotation advanced past the last one",
+			Spec:     &later,
+			Status:   &earlier,
+			Expected: &later,
+		},
+		{
+			Name:   "rotation already done",
+			Spec:   &later,
+			Status: &later,
+		},
+		{
+			Name:   "rotation moved back",
+			Spec:   &earlier,
+			Status: &later,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedControlPlaneScope{
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						CertificateRotation: c.Spec,
+					},
+					Status: infrav1.AzureManagedControlPlaneStatus{
+						LastCertificateRotation: c.Status,
+					},
+				},
+			}
+			g.Expect(s.PendingCertificateRotation()).To(Equal(c.Expected))
+
+			if pending := s.PendingCertificateRotation(); pending != nil {
+				s.SetLastCertificateRotation(*pending)
+				g.Expect(s.PendingCertificateRotation()).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestManagedControlPlaneScope_TagsSpecs(t *testing.T) {
+	cases := []struct {
+		Name                 string
+		ControlPlane         *infrav1.AzureManagedControlPlane
+		EffectiveOutboundIPs []string
+		Expected             []azure.TagsSpec
+	}{
+		{
+			Name: "returns nil if no node resource group tags are specified",
+			ControlPlane: &infrav1.AzureManagedControlPlane{
+				Spec: infrav1.AzureManagedControlPlaneSpec{
+					NodeResourceGroupName: "MC_rg_cluster_westus",
+					AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+						AdditionalTags: infrav1.Tags{"foo": "bar"},
+					},
+				},
+			},
+		},
+		{
+			Name: "returns the node resource group tags",
+			ControlPlane: &infrav1.AzureManagedControlPlane{
+				Spec: infrav1.AzureManagedControlPlaneSpec{
+					NodeResourceGroupName: "MC_rg_cluster_westus",
+					NodeResourceGroupTags: infrav1.Tags{"team": "a"},
+					AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+						AdditionalTags: infrav1.Tags{"foo": "bar"},
+					},
+				},
+			},
+			Expected: []azure.TagsSpec{
+				{
+					Scope:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MC_rg_cluster_westus",
+					Tags:       infrav1.Tags{"team": "a"},
+					Annotation: azure.NodeResourceGroupTagsLastAppliedAnnotation,
+				},
+			},
+		},
+		{
+			Name: "returns a spec without tags if all previously applied tags were removed",
+			ControlPlane: &infrav1.AzureManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						azure.NodeResourceGroupTagsLastAppliedAnnotation: `{"team":"a"}`,
+					},
+				},
+				Spec: infrav1.AzureManagedControlPlaneSpec{
+					NodeResourceGroupName: "MC_rg_cluster_westus",
+				},
+			},
+			Expected: []azure.TagsSpec{
+				{
+					Scope:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MC_rg_cluster_westus",
+					Annotation: azure.NodeResourceGroupTagsLastAppliedAnnotation,
+				},
+			},
+		},
+		{
+			Name: "returns the additional tags for the outbound resources AKS created",
+			ControlPlane: &infrav1.AzureManagedControlPlane{
+				Spec: infrav1.AzureManagedControlPlaneSpec{
+					NodeResourceGroupName: "MC_rg_cluster_westus",
+					AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+						AdditionalTags: infrav1.Tags{"foo": "bar"},
+						LoadBalancerProfile: &infrav1.LoadBalancerProfile{
+							OutboundIPs: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/user-ip"},
+						},
+					},
+				},
+			},
+			EffectiveOutboundIPs: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mc_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/aks-ip",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/user-ip",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/other-rg/providers/Microsoft.Network/publicIPAddresses/other-ip",
+			},
+			Expected: []azure.TagsSpec{
+				{
+					Scope:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/loadBalancers/kubernetes",
+					Tags:       infrav1.Tags{"foo": "bar"},
+					Annotation: azure.AKSOutboundTagsLastAppliedAnnotation,
+				},
+				{
+					Scope:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mc_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/aks-ip",
+					Tags:       infrav1.Tags{"foo": "bar"},
+					Annotation: azure.AKSOutboundTagsLastAppliedAnnotation,
+				},
+			},
+		},
+		{
+			Name: "returns specs without tags for the outbound resources if all previously applied tags were removed",
+			ControlPlane: &infrav1.AzureManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						azure.AKSOutboundTagsLastAppliedAnnotation: `{"foo":"bar"}`,
+					},
+				},
+				Spec: infrav1.AzureManagedControlPlaneSpec{
+					NodeResourceGroupName: "MC_rg_cluster_westus",
+				},
+			},
+			EffectiveOutboundIPs: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/aks-ip",
+			},
+			Expected: []azure.TagsSpec{
+				{
+					Scope:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/loadBalancers/kubernetes",
+					Annotation: azure.AKSOutboundTagsLastAppliedAnnotation,
+				},
+				{
+					Scope:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/aks-ip",
+					Annotation: azure.AKSOutboundTagsLastAppliedAnnotation,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedControlPlaneScope{
+				AzureClients: AzureClients{
+					EnvironmentSettings: auth.EnvironmentSettings{
+						Values: map[string]string{
+							auth.SubscriptionID: "00000000-0000-0000-0000-000000000000",
+						},
+					},
+				},
+				ControlPlane: c.ControlPlane,
+			}
+			s.SetEffectiveOutboundIPs(c.EffectiveOutboundIPs)
+			g.Expect(s.TagsSpecs()).To(Equal(c.Expected))
+		})
+	}
+}
+
+func TestManagedControlPlaneScope_AzureMonitorSpecs(t *testing.T) {
+	cases := []struct {
+		Name                string
+		AzureMonitorProfile *infrav1.AzureMonitorProfile
+		ExpectedWorkspace   azure.ResourceSpecGetter
+		ExpectedRule        azure.ResourceSpecGetter
+		ExpectedAssociation azure.ResourceSpecGetter
+		ExpectedEnabled     bool
+	}{
+		{
+			Name: "returns nil without azure monitor profile",
+		},
+		{
+			Name: "returns the specs if metrics are disabled so that their resources can be deleted",
+			AzureMonitorProfile: &infrav1.AzureMonitorProfile{
+				Metrics: &infrav1.AzureMonitorMetrics{
+					Enabled:       false,
+					WorkspaceName: "workspace",
+					Streams:       []string{infrav1.DefaultAzureMonitorMetricsStream},
+				},
+			},
+			ExpectedWorkspace: &monitorworkspaces.WorkspaceSpec{
+				Name:           "workspace",
+				ResourceGroup:  "rg",
+				Location:       "westus",
+				ClusterName:    "cluster",
+				AdditionalTags: infrav1.Tags{"foo": "bar"},
+			},
+			ExpectedRule: &datacollectionrules.DataCollectionRuleSpec{
+				Name:           "MSProm-westus-cluster",
+				ResourceGroup:  "rg",
+				Location:       "westus",
+				WorkspaceID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Monitor/accounts/workspace",
+				Streams:        []string{infrav1.DefaultAzureMonitorMetricsStream},
+				ClusterName:    "cluster",
+				AdditionalTags: infrav1.Tags{"foo": "bar"},
+			},
+			ExpectedAssociation: &datacollectionrules.AssociationSpec{
+				Name:                 "ContainerInsightsMetricsExtension",
+				ResourceGroup:        "rg",
+				ClusterID:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/cluster",
+				DataCollectionRuleID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Insights/dataCollectionRules/MSProm-westus-cluster",
+			},
+		},
+		{
+			Name: "returns the workspace, data collection rule and association specs if metrics are enabled",
+			AzureMonitorProfile: &infrav1.AzureMonitorProfile{
+				Metrics: &infrav1.AzureMonitorMetrics{
+					Enabled:       true,
+					WorkspaceName: "workspace",
+					Streams:       []string{infrav1.DefaultAzureMonitorMetricsStream},
+				},
+			},
+			ExpectedWorkspace: &monitorworkspaces.WorkspaceSpec{
+				Name:           "workspace",
+				ResourceGroup:  "rg",
+				Location:       "westus",
+				ClusterName:    "cluster",
+				AdditionalTags: infrav1.Tags{"foo": "bar"},
+			},
+			ExpectedRule: &datacollectionrules.DataCollectionRuleSpec{
+				Name:           "MSProm-westus-cluster",
+				ResourceGroup:  "rg",
+				Location:       "westus",
+				WorkspaceID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Monitor/accounts/workspace",
+				Streams:        []string{infrav1.DefaultAzureMonitorMetricsStream},
+				ClusterName:    "cluster",
+				AdditionalTags: infrav1.Tags{"foo": "bar"},
+			},
+			ExpectedAssociation: &datacollectionrules.AssociationSpec{
+				Name:                 "ContainerInsightsMetricsExtension",
+				ResourceGroup:        "rg",
+				ClusterID:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/cluster",
+				DataCollectionRuleID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Insights/dataCollectionRules/MSProm-westus-cluster",
+			},
+			ExpectedEnabled: true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedControlPlaneScope{
+				AzureClients: AzureClients{
+					EnvironmentSettings: auth.EnvironmentSettings{
+						Values: map[string]string{
+							auth.SubscriptionID: "00000000-0000-0000-0000-000000000000",
+						},
+					},
+				},
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				},
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						ResourceGroupName: "rg",
+						AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+							Location:            "westus",
+							AdditionalTags:      infrav1.Tags{"foo": "bar"},
+							AzureMonitorProfile: c.AzureMonitorProfile,
+						},
+					},
+				},
+			}
+			g.Expect(s.AzureMonitorMetricsEnabled()).To(Equal(c.ExpectedEnabled))
+			if c.ExpectedWorkspace == nil {
+				g.Expect(s.MonitorWorkspaceSpec()).To(BeNil())
+			} else {
+				g.Expect(s.MonitorWorkspaceSpec()).To(Equal(c.ExpectedWorkspace))
+			}
+			rule, association := s.DataCollectionRuleSpecs()
+			if c.ExpectedRule == nil {
+				g.Expect(rule).To(BeNil())
+				g.Expect(association).To(BeNil())
+			} else {
+				g.Expect(rule).To(Equal(c.ExpectedRule))
+				g.Expect(association).To(Equal(c.ExpectedAssociation))
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -139,6 +140,11 @@ func (s *ManagedMachinePoolScope) Name() string {
 	return s.InfraMachinePool.Name
 }
 
+// IsPaused returns true if reconciliation of the AzureManagedMachinePool is paused.
+func (s *ManagedMachinePoolScope) IsPaused() bool {
+	return annotations.IsPaused(s.Cluster, s.InfraMachinePool)
+}
+
 // SetSubnetName updates AzureManagedMachinePool.SubnetName if AzureManagedMachinePool.SubnetName is empty with s.ControlPlane.Spec.VirtualNetwork.Subnet.Name.
 func (s *ManagedMachinePoolScope) SetSubnetName() {
 	s.InfraMachinePool.Spec.SubnetName = getAgentPoolSubnet(s.ControlPlane, s.InfraMachinePool)
@@ -164,6 +170,10 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 		v := strings.TrimPrefix(*machinePool.Spec.Template.Spec.Version, "v")
 		normalizedVersion = &v
 	}
+	var controlPlaneVersion *string
+	if managedControlPlane.Spec.Version != "" {
+		controlPlaneVersion = ptr.To(strings.TrimPrefix(managedControlPlane.Spec.Version, "v"))
+	}
 
 	replicas := int32(1)
 	if machinePool.Spec.Replicas != nil {
@@ -171,14 +181,15 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 	}
 
 	agentPoolSpec := &agentpools.AgentPoolSpec{
-		Name:          managedMachinePool.Name,
-		AzureName:     ptr.Deref(managedMachinePool.Spec.Name, ""),
-		ResourceGroup: managedControlPlane.Spec.ResourceGroupName,
-		Cluster:       managedControlPlane.Name,
-		SKU:           managedMachinePool.Spec.SKU,
-		Replicas:      int(replicas),
-		Version:       normalizedVersion,
-		OSType:        managedMachinePool.Spec.OSType,
+		Name:                managedMachinePool.Name,
+		AzureName:           ptr.Deref(managedMachinePool.Spec.Name, ""),
+		ResourceGroup:       managedControlPlane.Spec.ResourceGroupName,
+		Cluster:             managedControlPlane.Name,
+		SKU:                 managedMachinePool.Spec.SKU,
+		Replicas:            int(replicas),
+		Version:             normalizedVersion,
+		ControlPlaneVersion: controlPlaneVersion,
+		OSType:              managedMachinePool.Spec.OSType,
 		VnetSubnetID: azure.SubnetID(
 			managedControlPlane.Spec.SubscriptionID,
 			managedControlPlane.Spec.VirtualNetwork.ResourceGroup,
@@ -192,6 +203,7 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 		EnableUltraSSD:         managedMachinePool.Spec.EnableUltraSSD,
 		EnableNodePublicIP:     managedMachinePool.Spec.EnableNodePublicIP,
 		NodePublicIPPrefixID:   ptr.Deref(managedMachinePool.Spec.NodePublicIPPrefixID, ""),
+		HostGroupID:            ptr.Deref(managedMachinePool.Spec.HostGroupID, ""),
 		ScaleSetPriority:       managedMachinePool.Spec.ScaleSetPriority,
 		ScaleDownMode:          managedMachinePool.Spec.ScaleDownMode,
 		SpotMaxPrice:           managedMachinePool.Spec.SpotMaxPrice,
@@ -283,6 +295,8 @@ func (s *ManagedMachinePoolScope) UpdateDeleteStatus(condition clusterv1.Conditi
 		conditions.MarkFalse(s.InfraMachinePool, condition, infrav1.DeletedReason, clusterv1.ConditionSeverityInfo, "%s successfully deleted", service)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.InfraMachinePool, condition, infrav1.DeletingReason, clusterv1.ConditionSeverityInfo, "%s deleting", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.InfraMachinePool, condition, service, err)
 	default:
 		conditions.MarkFalse(s.InfraMachinePool, condition, infrav1.DeletionFailedReason, clusterv1.ConditionSeverityError, "%s failed to delete. err: %s", service, err.Error())
 	}
@@ -295,6 +309,8 @@ func (s *ManagedMachinePoolScope) UpdatePutStatus(condition clusterv1.ConditionT
 		conditions.MarkTrue(s.InfraMachinePool, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.InfraMachinePool, condition, infrav1.CreatingReason, clusterv1.ConditionSeverityInfo, "%s creating or updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.InfraMachinePool, condition, service, err)
 	default:
 		conditions.MarkFalse(s.InfraMachinePool, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to create or update. err: %s", service, err.Error())
 	}
@@ -307,6 +323,8 @@ func (s *ManagedMachinePoolScope) UpdatePatchStatus(condition clusterv1.Conditio
 		conditions.MarkTrue(s.InfraMachinePool, condition)
 	case azure.IsOperationNotDoneError(err):
 		conditions.MarkFalse(s.InfraMachinePool, condition, infrav1.UpdatingReason, clusterv1.ConditionSeverityInfo, "%s updating", service)
+	case azure.IsTransientError(err):
+		markReconciling(s.InfraMachinePool, condition, service, err)
 	default:
 		conditions.MarkFalse(s.InfraMachinePool, condition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s failed to update. err: %s", service, err.Error())
 	}
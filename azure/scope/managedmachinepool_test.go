@@ -119,6 +119,49 @@ func TestManagedMachinePoolScope_Autoscaling(t *testing.T) {
 				VnetSubnetID:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
 			},
 		},
+		{
+			Name: "With Autoscaling at the size set by the autoscaler",
+			Input: ManagedMachinePoolScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+				},
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+							SubscriptionID: "00000000-0000-0000-0000-000000000000",
+						},
+					},
+				},
+				ManagedMachinePool: ManagedMachinePool{
+					MachinePool: func() *expv1.MachinePool {
+						mp := getMachinePool("pool1")
+						// the replicas follow the node count of the agent pool
+						mp.Spec.Replicas = ptr.To[int32](5)
+						return mp
+					}(),
+					InfraMachinePool: getAzureMachinePoolWithScaling("pool1", 2, 10),
+				},
+			},
+			Expected: &agentpools.AgentPoolSpec{
+				Name:              "pool1",
+				AzureName:         "pool1",
+				SKU:               "Standard_D2s_v3",
+				Mode:              "User",
+				Cluster:           "cluster1",
+				Replicas:          5,
+				EnableAutoScaling: true,
+				MinCount:          ptr.To(2),
+				MaxCount:          ptr.To(10),
+				VnetSubnetID:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
+			},
+		},
 	}
 
 	for _, c := range cases {
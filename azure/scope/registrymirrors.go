@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+const (
+	// cloudConfigHeader is the first line of cloud-init cloud-config bootstrap data.
+	cloudConfigHeader = "#cloud-config"
+	// containerdCertsDir is the directory containerd reads the host configuration of each registry from.
+	containerdCertsDir = "/etc/containerd/certs.d"
+)
+
+// writeFile is a file written by cloud-init.
+type writeFile struct {
+	Path        string `yaml:"path"`
+	Owner       string `yaml:"owner"`
+	Permissions string `yaml:"permissions"`
+	Content     string `yaml:"content"`
+}
+
+// mergeRegistryMirrors adds a containerd hosts.toml file for each registry mirror to the write_files of cloud-config
+// bootstrap data. Files of the bootstrap data with the same path are replaced. The leading comments of the bootstrap
+// data, e.g. the cloud-config and jinja template headers, are kept as is.
+func mergeRegistryMirrors(data []byte, format string, mirrors []infrav1.RegistryMirror) ([]byte, error) {
+	if len(mirrors) == 0 {
+		return data, nil
+	}
+	if format != "" && format != "cloud-config" {
+		return nil, errors.Errorf("registry mirrors are only supported for cloud-config bootstrap data, got format %q", format)
+	}
+
+	header, body := splitCloudConfigHeader(data)
+	if !isCloudConfig(header) {
+		return nil, errors.New("registry mirrors are only supported for cloud-config bootstrap data")
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(body, &doc); err != nil {
+		return nil, errors.Wrap(err, "failed to parse cloud-config bootstrap data")
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, errors.New("cloud-config bootstrap data must be a mapping")
+	}
+
+	files, err := writeFiles(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, mirror := range mirrors {
+		file := &yaml.Node{}
+		if err := file.Encode(writeFile{
+			Path:        path.Join(containerdCertsDir, mirror.Registry, "hosts.toml"),
+			Owner:       "root:root",
+			Permissions: "0644",
+			Content:     containerdHostsConfig(mirror.Endpoints),
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to encode the configuration of the mirrors of registry %s", mirror.Registry)
+		}
+		files.Content = append(removeWriteFile(files.Content, path.Join(containerdCertsDir, mirror.Registry, "hosts.toml")), file)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(header)
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, errors.Wrap(err, "failed to encode cloud-config bootstrap data")
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to encode cloud-config bootstrap data")
+	}
+	return buf.Bytes(), nil
+}
+
+// splitCloudConfigHeader splits the leading comment lines of cloud-config bootstrap data from its body.
+func splitCloudConfigHeader(data []byte) (header, body []byte) {
+	rest := data
+	for len(rest) > 0 && rest[0] == '#' {
+		i := bytes.IndexByte(rest, '\n')
+		if i < 0 {
+			return data, nil
+		}
+		rest = rest[i+1:]
+	}
+	return data[:len(data)-len(rest)], rest
+}
+
+// isCloudConfig returns true if the header of bootstrap data declares it as cloud-config.
+func isCloudConfig(header []byte) bool {
+	for _, line := range strings.Split(string(header), "\n") {
+		if strings.TrimSpace(line) == cloudConfigHeader {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFiles returns the write_files sequence of a cloud-config mapping, adding it if it is missing.
+func writeFiles(root *yaml.Node) (*yaml.Node, error) {
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "write_files" {
+			files := root.Content[i+1]
+			if files.Kind != yaml.SequenceNode {
+				return nil, errors.New("write_files of cloud-config bootstrap data must be a sequence")
+			}
+			return files, nil
+		}
+	}
+	files := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "write_files"}, files)
+	return files, nil
+}
+
+// removeWriteFile returns the write_files entries without the ones writing the given path.
+func removeWriteFile(files []*yaml.Node, filePath string) []*yaml.Node {
+	result := files[:0]
+	for _, file := range files {
+		var f writeFile
+		if err := file.Decode(&f); err == nil && f.Path == filePath {
+			continue
+		}
+		result = append(result, file)
+	}
+	return result
+}
+
+// containerdHostsConfig returns the containerd hosts.toml configuration which pulls images from the given mirrors.
+func containerdHostsConfig(endpoints []string) string {
+	var b strings.Builder
+	b.WriteString("# Configured by Cluster API Provider Azure.\n")
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(&b, "\n[host.%q]\n  capabilities = [\"pull\", \"resolve\"]\n", endpoint)
+	}
+	return b.String()
+}
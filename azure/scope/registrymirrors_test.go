@@ -0,0 +1,160 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+func TestMergeRegistryMirrors(t *testing.T) {
+	mirrors := []infrav1.RegistryMirror{
+		{Registry: "docker.io", Endpoints: []string{"https://mirror.example.com", "https://backup-mirror.example.com"}},
+	}
+
+	tests := []struct {
+		name          string
+		data          string
+		format        string
+		mirrors       []infrav1.RegistryMirror
+		want          string
+		expectedError string
+	}{
+		{
+			name:    "bootstrap data is unchanged without mirrors",
+			data:    "#!/bin/bash\necho hello\n",
+			mirrors: nil,
+			want:    "#!/bin/bash\necho hello\n",
+		},
+		{
+			name: "mirrors are appended to the files of the bootstrap data",
+			data: `## template: jinja
+#cloud-config
+
+write_files:
+- path: /etc/kubernetes/pki/ca.crt
+  owner: root:root
+  permissions: '0640'
+  content: |
+    ca
+runcmd:
+  - 'kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml'
+`,
+			format:  "cloud-config",
+			mirrors: mirrors,
+			want: `## template: jinja
+#cloud-config
+write_files:
+  - path: /etc/kubernetes/pki/ca.crt
+    owner: root:root
+    permissions: '0640'
+    content: |
+      ca
+  - path: /etc/containerd/certs.d/docker.io/hosts.toml
+    owner: root:root
+    permissions: "0644"
+    content: |
+      # Configured by Cluster API Provider Azure.
+
+      [host."https://mirror.example.com"]
+        capabilities = ["pull", "resolve"]
+
+      [host."https://backup-mirror.example.com"]
+        capabilities = ["pull", "resolve"]
+runcmd:
+  - 'kubeadm join --config /run/kubeadm/kubeadm-join-config.yaml'
+`,
+		},
+		{
+			name: "files are added to bootstrap data without files",
+			data: `#cloud-config
+runcmd:
+- kubeadm init
+`,
+			mirrors: []infrav1.RegistryMirror{{Registry: "_default", Endpoints: []string{"https://mirror.example.com"}}},
+			want: `#cloud-config
+runcmd:
+  - kubeadm init
+write_files:
+  - path: /etc/containerd/certs.d/_default/hosts.toml
+    owner: root:root
+    permissions: "0644"
+    content: |
+      # Configured by Cluster API Provider Azure.
+
+      [host."https://mirror.example.com"]
+        capabilities = ["pull", "resolve"]
+`,
+		},
+		{
+			name: "files with the same path are replaced",
+			data: `#cloud-config
+write_files:
+- path: /etc/containerd/certs.d/docker.io/hosts.toml
+  content: server = "https://registry-1.docker.io"
+`,
+			mirrors: []infrav1.RegistryMirror{{Registry: "docker.io", Endpoints: []string{"https://mirror.example.com"}}},
+			want: `#cloud-config
+write_files:
+  - path: /etc/containerd/certs.d/docker.io/hosts.toml
+    owner: root:root
+    permissions: "0644"
+    content: |
+      # Configured by Cluster API Provider Azure.
+
+      [host."https://mirror.example.com"]
+        capabilities = ["pull", "resolve"]
+`,
+		},
+		{
+			name:          "ignition bootstrap data is not supported",
+			data:          `{"ignition":{"version":"3.3.0"}}`,
+			format:        "ignition",
+			mirrors:       mirrors,
+			expectedError: `registry mirrors are only supported for cloud-config bootstrap data, got format "ignition"`,
+		},
+		{
+			name:          "bootstrap data must be cloud-config",
+			data:          "#!/bin/bash\necho hello\n",
+			mirrors:       mirrors,
+			expectedError: "registry mirrors are only supported for cloud-config bootstrap data",
+		},
+		{
+			name:          "write_files must be a sequence",
+			data:          "#cloud-config\nwrite_files: {}\n",
+			mirrors:       mirrors,
+			expectedError: "write_files of cloud-config bootstrap data must be a sequence",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			got, err := mergeRegistryMirrors([]byte(tc.data), tc.format, tc.mirrors)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(string(got)).To(Equal(tc.want))
+			}
+		})
+	}
+}
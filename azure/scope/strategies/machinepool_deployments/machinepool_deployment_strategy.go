@@ -120,12 +120,13 @@ func (rollingUpdateStrategy rollingUpdateStrategy) SelectMachinesToDelete(ctx co
 	var (
 		order = func() func(machines []infrav1exp.AzureMachinePoolMachine) []infrav1exp.AzureMachinePoolMachine {
 			switch rollingUpdateStrategy.DeletePolicy {
-			case infrav1exp.OldestDeletePolicyType:
-				return orderByOldest
 			case infrav1exp.NewestDeletePolicyType:
 				return orderByNewest
-			default:
+			case infrav1exp.RandomDeletePolicyType:
 				return orderRandom
+			default:
+				// match the API default of Oldest when no DeletePolicy is set.
+				return orderByOldest
 			}
 		}()
 		log                        = ctrl.LoggerFrom(ctx).V(4)
@@ -182,6 +183,11 @@ func (rollingUpdateStrategy rollingUpdateStrategy) SelectMachinesToDelete(ctx co
 				return toDelete, nil
 			}
 
+			// machines protected from scale-in are never removed to reduce the replica count
+			if isProtectedFromScaleIn(v) {
+				continue
+			}
+
 			toDelete = append(toDelete, v)
 		}
 
@@ -192,6 +198,10 @@ func (rollingUpdateStrategy rollingUpdateStrategy) SelectMachinesToDelete(ctx co
 				return toDelete, nil
 			}
 
+			if isProtectedFromScaleIn(v) {
+				continue
+			}
+
 			toDelete = append(toDelete, v)
 		}
 
@@ -316,6 +326,11 @@ func orderByDeleteMachineAnnotation(machines []infrav1exp.AzureMachinePoolMachin
 	return machines
 }
 
+// isProtectedFromScaleIn returns true if the AzureMachinePoolMachine has the ProtectFromScaleInAnnotation set to "true".
+func isProtectedFromScaleIn(machine infrav1exp.AzureMachinePoolMachine) bool {
+	return machine.Annotations[infrav1.ProtectFromScaleInAnnotation] == "true"
+}
+
 func getProviderIDs(machines []infrav1exp.AzureMachinePoolMachine) []string {
 	ids := make([]string, len(machines))
 	for i, machine := range machines {
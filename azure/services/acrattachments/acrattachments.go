@@ -0,0 +1,197 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acrattachments
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const (
+	// ServiceName is the name of this service.
+	ServiceName = "acrattachments"
+
+	// kubeletIdentityRequeueInterval is how long to wait before checking again on a managed cluster whose kubelet
+	// identity is not known yet.
+	kubeletIdentityRequeueInterval = 30 * time.Second
+)
+
+// ACRAttachmentScope defines the scope interface for an ACR attachments service.
+type ACRAttachmentScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	KubeletIdentityObjectID() string
+	ACRAttachmentSpecs(principalID string) []azure.ResourceSpecGetter
+	AnnotationJSON(string) (map[string]interface{}, error)
+	UpdateAnnotationJSON(string, map[string]interface{}) error
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	Scope ACRAttachmentScope
+	async.Reconciler
+}
+
+// New creates a new service.
+func New(scope ACRAttachmentScope) (*Service, error) {
+	client, err := newClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope: scope,
+		Reconciler: async.New[armauthorization.RoleAssignmentsClientCreateResponse,
+			armauthorization.RoleAssignmentsClientDeleteResponse](scope, client, client),
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return ServiceName
+}
+
+// Reconcile idempotently assigns the AcrPull role to the kubelet identity on the referenced registries, and removes
+// the role assignments on registries which were removed from the spec since they were last applied.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "acrattachments.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	principalID := s.Scope.KubeletIdentityObjectID()
+	specs := s.Scope.ACRAttachmentSpecs(principalID)
+	lastApplied, err := s.Scope.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation)
+	if err != nil {
+		return err
+	}
+	if len(specs) == 0 && len(lastApplied) == 0 {
+		return nil
+	}
+	if len(specs) > 0 && principalID == "" {
+		err := azure.WithTransientError(errors.New("waiting for the kubelet identity of the managed cluster"), kubeletIdentityRequeueInterval)
+		s.Scope.UpdatePutStatus(infrav1.ACRReferencesReadyCondition, ServiceName, err)
+		return err
+	}
+
+	newAnnotation := make(map[string]interface{})
+
+	// We go through the list of ACRAttachmentSpecs to reconcile each one, independently of the result of the previous one.
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
+	var resultErr error
+	for _, spec := range specs {
+		attachmentSpec := spec.(*ACRAttachmentSpec)
+		if _, err := s.CreateOrUpdateResource(ctx, attachmentSpec, ServiceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || resultErr == nil {
+				resultErr = err
+			}
+		}
+		newAnnotation[strings.ToLower(attachmentSpec.roleAssignmentID())] = attachmentSpec.RegistryID
+	}
+
+	// Role assignments on registries which were removed from the spec, or of a previous kubelet identity, are
+	// deleted. They are kept in the annotation until they are, so that a failed deletion is retried.
+	var removed []string
+	for id := range lastApplied {
+		if _, ok := newAnnotation[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	if err := s.deleteRoleAssignments(ctx, removed, lastApplied, newAnnotation); err != nil {
+		if !azure.IsOperationNotDoneError(err) || resultErr == nil {
+			resultErr = err
+		}
+	}
+
+	if err := s.Scope.UpdateAnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation, newAnnotation); err != nil {
+		return err
+	}
+
+	s.Scope.UpdatePutStatus(infrav1.ACRReferencesReadyCondition, ServiceName, resultErr)
+	return resultErr
+}
+
+// Delete removes all role assignments on registries which were last applied. They are looked up from the annotation,
+// as the kubelet identity is not known while the managed cluster is deleted.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "acrattachments.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	lastApplied, err := s.Scope.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation)
+	if err != nil {
+		return err
+	}
+	if len(lastApplied) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(lastApplied))
+	for id := range lastApplied {
+		ids = append(ids, id)
+	}
+	newAnnotation := make(map[string]interface{})
+	resultErr := s.deleteRoleAssignments(ctx, ids, lastApplied, newAnnotation)
+
+	if err := s.Scope.UpdateAnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation, newAnnotation); err != nil {
+		return err
+	}
+
+	s.Scope.UpdateDeleteStatus(infrav1.ACRReferencesReadyCondition, ServiceName, resultErr)
+	return resultErr
+}
+
+// deleteRoleAssignments deletes the role assignments with the given IDs in sorted order. Role assignments which
+// fail to be deleted are added back to the annotation, and the most pressing error is returned.
+func (s *Service) deleteRoleAssignments(ctx context.Context, ids []string, lastApplied, annotation map[string]interface{}) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "acrattachments.Service.deleteRoleAssignments")
+	defer done()
+
+	sort.Strings(ids)
+	var resultErr error
+	for _, id := range ids {
+		spec, err := specFromRoleAssignmentID(id)
+		if err != nil {
+			log.Error(err, "dropping invalid role assignment ID from the last applied ACR attachments")
+			continue
+		}
+		if err := s.DeleteResource(ctx, spec, ServiceName); err != nil {
+			annotation[id] = lastApplied[id]
+			if !azure.IsOperationNotDoneError(err) || resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+	return resultErr
+}
+
+// IsManaged returns always returns true as CAPZ does not support BYO role assignments.
+func (s *Service) IsManaged(ctx context.Context) (bool, error) {
+	return true, nil
+}
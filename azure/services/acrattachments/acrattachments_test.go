@@ -0,0 +1,242 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acrattachments
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/acrattachments/mock_acrattachments"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+const (
+	fakeRegistryID      = "/subscriptions/123/resourceGroups/acr-rg/providers/Microsoft.ContainerRegistry/registries/myregistry"
+	fakeOtherRegistryID = "/subscriptions/456/resourceGroups/other-rg/providers/Microsoft.ContainerRegistry/registries/other"
+	fakePrincipalID     = "kubelet-object-id"
+)
+
+var (
+	fakeAttachment      = NewACRAttachmentSpec(fakeRegistryID, fakePrincipalID)
+	fakeOtherAttachment = NewACRAttachmentSpec(fakeOtherRegistryID, fakePrincipalID)
+	errFake             = errors.New("this is an error")
+)
+
+// lastApplied returns the annotation content of the given last applied role assignments.
+func lastApplied(specs ...*ACRAttachmentSpec) map[string]interface{} {
+	annotation := make(map[string]interface{}, len(specs))
+	for _, spec := range specs {
+		annotation[strings.ToLower(spec.roleAssignmentID())] = spec.RegistryID
+	}
+	return annotation
+}
+
+// removedSpec returns the spec a last applied role assignment is deleted with.
+func removedSpec(spec *ACRAttachmentSpec) *ACRAttachmentSpec {
+	removed, err := specFromRoleAssignmentID(strings.ToLower(spec.roleAssignmentID()))
+	if err != nil {
+		panic(err)
+	}
+	return removed
+}
+
+func TestReconcileACRAttachments(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no registries are referenced or were attached",
+			expectedError: "",
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.KubeletIdentityObjectID().Return(fakePrincipalID)
+				s.ACRAttachmentSpecs(fakePrincipalID).Return(nil)
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(map[string]interface{}{}, nil)
+			},
+		},
+		{
+			name:          "role assignments are created on the referenced registries",
+			expectedError: "",
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.KubeletIdentityObjectID().Return(fakePrincipalID)
+				s.ACRAttachmentSpecs(fakePrincipalID).Return([]azure.ResourceSpecGetter{fakeAttachment, fakeOtherAttachment})
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(map[string]interface{}{}, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), fakeAttachment, ServiceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), fakeOtherAttachment, ServiceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation, lastApplied(fakeAttachment, fakeOtherAttachment)).Return(nil)
+				s.UpdatePutStatus(infrav1.ACRReferencesReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "role assignment on a registry removed from the spec is deleted",
+			expectedError: "",
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.KubeletIdentityObjectID().Return(fakePrincipalID)
+				s.ACRAttachmentSpecs(fakePrincipalID).Return([]azure.ResourceSpecGetter{fakeAttachment})
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(lastApplied(fakeAttachment, fakeOtherAttachment), nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), fakeAttachment, ServiceName).Return(nil, nil)
+				r.DeleteResource(gomockinternal.AContext(), removedSpec(fakeOtherAttachment), ServiceName).Return(nil)
+				s.UpdateAnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation, lastApplied(fakeAttachment)).Return(nil)
+				s.UpdatePutStatus(infrav1.ACRReferencesReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "role assignment which fails to be deleted is kept in the annotation",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.KubeletIdentityObjectID().Return("")
+				s.ACRAttachmentSpecs("").Return(nil)
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(lastApplied(fakeOtherAttachment), nil)
+				r.DeleteResource(gomockinternal.AContext(), removedSpec(fakeOtherAttachment), ServiceName).Return(errFake)
+				s.UpdateAnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation, lastApplied(fakeOtherAttachment)).Return(nil)
+				s.UpdatePutStatus(infrav1.ACRReferencesReadyCondition, ServiceName, errFake)
+			},
+		},
+		{
+			name:          "waits for the kubelet identity of the managed cluster",
+			expectedError: "waiting for the kubelet identity of the managed cluster. Object will be requeued after 30s",
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.KubeletIdentityObjectID().Return("")
+				s.ACRAttachmentSpecs("").Return([]azure.ResourceSpecGetter{NewACRAttachmentSpec(fakeRegistryID, "")})
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(lastApplied(fakeAttachment), nil)
+				s.UpdatePutStatus(infrav1.ACRReferencesReadyCondition, ServiceName, gomock.Any())
+			},
+		},
+		{
+			name:          "role assignment create fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.KubeletIdentityObjectID().Return(fakePrincipalID)
+				s.ACRAttachmentSpecs(fakePrincipalID).Return([]azure.ResourceSpecGetter{fakeAttachment})
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(map[string]interface{}{}, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), fakeAttachment, ServiceName).Return(nil, errFake)
+				s.UpdateAnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation, lastApplied(fakeAttachment)).Return(nil)
+				s.UpdatePutStatus(infrav1.ACRReferencesReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_acrattachments.NewMockACRAttachmentScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteACRAttachments(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no registries were attached",
+			expectedError: "",
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(map[string]interface{}{}, nil)
+			},
+		},
+		{
+			name:          "last applied role assignments are deleted",
+			expectedError: "",
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(lastApplied(fakeAttachment, fakeOtherAttachment), nil)
+				r.DeleteResource(gomockinternal.AContext(), removedSpec(fakeAttachment), ServiceName).Return(nil)
+				r.DeleteResource(gomockinternal.AContext(), removedSpec(fakeOtherAttachment), ServiceName).Return(nil)
+				s.UpdateAnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
+				s.UpdateDeleteStatus(infrav1.ACRReferencesReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "role assignment delete fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_acrattachments.MockACRAttachmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation).Return(lastApplied(fakeAttachment, fakeOtherAttachment), nil)
+				r.DeleteResource(gomockinternal.AContext(), removedSpec(fakeAttachment), ServiceName).Return(errFake)
+				r.DeleteResource(gomockinternal.AContext(), removedSpec(fakeOtherAttachment), ServiceName).Return(nil)
+				s.UpdateAnnotationJSON(azure.ACRAttachmentLastAppliedAnnotation, lastApplied(fakeAttachment)).Return(nil)
+				s.UpdateDeleteStatus(infrav1.ACRReferencesReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_acrattachments.NewMockACRAttachmentScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acrattachments
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// azureClient contains the Azure go-sdk Client.
+type azureClient struct {
+	roleassignments *armauthorization.RoleAssignmentsClient
+}
+
+// newClient creates a new role assignments client from an authorizer.
+func newClient(auth azure.Authorizer) (*azureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create acrattachments client options")
+	}
+	factory, err := armauthorization.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armauthorization client factory")
+	}
+	return &azureClient{factory.NewRoleAssignmentsClient()}, nil
+}
+
+// Get gets the specified role assignment on a registry.
+func (ac *azureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (interface{}, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "acrattachments.azureClient.Get")
+	defer done()
+
+	resp, err := ac.roleassignments.Get(ctx, spec.OwnerResourceName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.RoleAssignment, nil
+}
+
+// CreateOrUpdateAsync creates a role assignment on a registry.
+// Creating a role assignment is not a long running operation, so we don't ever return a poller.
+func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armauthorization.RoleAssignmentsClientCreateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "acrattachments.azureClient.CreateOrUpdateAsync")
+	defer done()
+
+	createParams, ok := parameters.(armauthorization.RoleAssignmentCreateParameters)
+	if !ok {
+		return nil, nil, errors.Errorf("%T is not an armauthorization.RoleAssignmentCreateParameters", parameters)
+	}
+	resp, err := ac.roleassignments.Create(ctx, spec.OwnerResourceName(), spec.ResourceName(), createParams, nil)
+	return resp.RoleAssignment, nil, err
+}
+
+// DeleteAsync deletes a role assignment on a registry.
+// Deleting a role assignment is not a long running operation, so we don't ever return a poller.
+func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armauthorization.RoleAssignmentsClientDeleteResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "acrattachments.azureClient.DeleteAsync")
+	defer done()
+
+	_, err = ac.roleassignments.Delete(ctx, spec.OwnerResourceName(), spec.ResourceName(), nil)
+	return nil, err
+}
@@ -0,0 +1,371 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../acrattachments.go
+//
+// Generated by this command:
+//
+//	mockgen -destination acrattachments_mock.go -package mock_acrattachments -source ../acrattachments.go ACRAttachmentScope
+//
+
+// Package mock_acrattachments is a generated GoMock package.
+package mock_acrattachments
+
+import (
+	reflect "reflect"
+	time "time"
+
+	azcore "github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	gomock "go.uber.org/mock/gomock"
+	v1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	azure "sigs.k8s.io/cluster-api-provider-azure/azure"
+	v1beta10 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// MockACRAttachmentScope is a mock of ACRAttachmentScope interface.
+type MockACRAttachmentScope struct {
+	ctrl     *gomock.Controller
+	recorder *MockACRAttachmentScopeMockRecorder
+}
+
+// MockACRAttachmentScopeMockRecorder is the mock recorder for MockACRAttachmentScope.
+type MockACRAttachmentScopeMockRecorder struct {
+	mock *MockACRAttachmentScope
+}
+
+// NewMockACRAttachmentScope creates a new mock instance.
+func NewMockACRAttachmentScope(ctrl *gomock.Controller) *MockACRAttachmentScope {
+	mock := &MockACRAttachmentScope{ctrl: ctrl}
+	mock.recorder = &MockACRAttachmentScopeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockACRAttachmentScope) EXPECT() *MockACRAttachmentScopeMockRecorder {
+	return m.recorder
+}
+
+// ACRAttachmentSpecs mocks base method.
+func (m *MockACRAttachmentScope) ACRAttachmentSpecs(principalID string) []azure.ResourceSpecGetter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ACRAttachmentSpecs", principalID)
+	ret0, _ := ret[0].([]azure.ResourceSpecGetter)
+	return ret0
+}
+
+// ACRAttachmentSpecs indicates an expected call of ACRAttachmentSpecs.
+func (mr *MockACRAttachmentScopeMockRecorder) ACRAttachmentSpecs(principalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ACRAttachmentSpecs", reflect.TypeOf((*MockACRAttachmentScope)(nil).ACRAttachmentSpecs), principalID)
+}
+
+// AnnotationJSON mocks base method.
+func (m *MockACRAttachmentScope) AnnotationJSON(arg0 string) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnotationJSON", arg0)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotationJSON indicates an expected call of AnnotationJSON.
+func (mr *MockACRAttachmentScopeMockRecorder) AnnotationJSON(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotationJSON", reflect.TypeOf((*MockACRAttachmentScope)(nil).AnnotationJSON), arg0)
+}
+
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockACRAttachmentScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockACRAttachmentScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockACRAttachmentScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockACRAttachmentScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockACRAttachmentScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockACRAttachmentScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
+// BaseURI mocks base method.
+func (m *MockACRAttachmentScope) BaseURI() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseURI")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BaseURI indicates an expected call of BaseURI.
+func (mr *MockACRAttachmentScopeMockRecorder) BaseURI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseURI", reflect.TypeOf((*MockACRAttachmentScope)(nil).BaseURI))
+}
+
+// ClientID mocks base method.
+func (m *MockACRAttachmentScope) ClientID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClientID indicates an expected call of ClientID.
+func (mr *MockACRAttachmentScopeMockRecorder) ClientID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientID", reflect.TypeOf((*MockACRAttachmentScope)(nil).ClientID))
+}
+
+// ClientSecret mocks base method.
+func (m *MockACRAttachmentScope) ClientSecret() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientSecret")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClientSecret indicates an expected call of ClientSecret.
+func (mr *MockACRAttachmentScopeMockRecorder) ClientSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientSecret", reflect.TypeOf((*MockACRAttachmentScope)(nil).ClientSecret))
+}
+
+// CloudEnvironment mocks base method.
+func (m *MockACRAttachmentScope) CloudEnvironment() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudEnvironment")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CloudEnvironment indicates an expected call of CloudEnvironment.
+func (mr *MockACRAttachmentScopeMockRecorder) CloudEnvironment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudEnvironment", reflect.TypeOf((*MockACRAttachmentScope)(nil).CloudEnvironment))
+}
+
+// DefaultedAzureCallTimeout mocks base method.
+func (m *MockACRAttachmentScope) DefaultedAzureCallTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
+func (mr *MockACRAttachmentScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockACRAttachmentScope)(nil).DefaultedAzureCallTimeout))
+}
+
+// DefaultedReconcilerRequeue mocks base method.
+func (m *MockACRAttachmentScope) DefaultedReconcilerRequeue() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedReconcilerRequeue")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedReconcilerRequeue indicates an expected call of DefaultedReconcilerRequeue.
+func (mr *MockACRAttachmentScopeMockRecorder) DefaultedReconcilerRequeue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedReconcilerRequeue", reflect.TypeOf((*MockACRAttachmentScope)(nil).DefaultedReconcilerRequeue))
+}
+
+// DeleteLongRunningOperationState mocks base method.
+func (m *MockACRAttachmentScope) DeleteLongRunningOperationState(arg0, arg1, arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteLongRunningOperationState", arg0, arg1, arg2)
+}
+
+// DeleteLongRunningOperationState indicates an expected call of DeleteLongRunningOperationState.
+func (mr *MockACRAttachmentScopeMockRecorder) DeleteLongRunningOperationState(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLongRunningOperationState", reflect.TypeOf((*MockACRAttachmentScope)(nil).DeleteLongRunningOperationState), arg0, arg1, arg2)
+}
+
+// GetLongRunningOperationState mocks base method.
+func (m *MockACRAttachmentScope) GetLongRunningOperationState(arg0, arg1, arg2 string) *v1beta1.Future {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLongRunningOperationState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.Future)
+	return ret0
+}
+
+// GetLongRunningOperationState indicates an expected call of GetLongRunningOperationState.
+func (mr *MockACRAttachmentScopeMockRecorder) GetLongRunningOperationState(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongRunningOperationState", reflect.TypeOf((*MockACRAttachmentScope)(nil).GetLongRunningOperationState), arg0, arg1, arg2)
+}
+
+// HashKey mocks base method.
+func (m *MockACRAttachmentScope) HashKey() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashKey")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashKey indicates an expected call of HashKey.
+func (mr *MockACRAttachmentScopeMockRecorder) HashKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockACRAttachmentScope)(nil).HashKey))
+}
+
+// KubeletIdentityObjectID mocks base method.
+func (m *MockACRAttachmentScope) KubeletIdentityObjectID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KubeletIdentityObjectID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// KubeletIdentityObjectID indicates an expected call of KubeletIdentityObjectID.
+func (mr *MockACRAttachmentScopeMockRecorder) KubeletIdentityObjectID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KubeletIdentityObjectID", reflect.TypeOf((*MockACRAttachmentScope)(nil).KubeletIdentityObjectID))
+}
+
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockACRAttachmentScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockACRAttachmentScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockACRAttachmentScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
+// SetLongRunningOperationState mocks base method.
+func (m *MockACRAttachmentScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLongRunningOperationState", arg0)
+}
+
+// SetLongRunningOperationState indicates an expected call of SetLongRunningOperationState.
+func (mr *MockACRAttachmentScopeMockRecorder) SetLongRunningOperationState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLongRunningOperationState", reflect.TypeOf((*MockACRAttachmentScope)(nil).SetLongRunningOperationState), arg0)
+}
+
+// SubscriptionID mocks base method.
+func (m *MockACRAttachmentScope) SubscriptionID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscriptionID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SubscriptionID indicates an expected call of SubscriptionID.
+func (mr *MockACRAttachmentScopeMockRecorder) SubscriptionID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionID", reflect.TypeOf((*MockACRAttachmentScope)(nil).SubscriptionID))
+}
+
+// TenantID mocks base method.
+func (m *MockACRAttachmentScope) TenantID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TenantID indicates an expected call of TenantID.
+func (mr *MockACRAttachmentScopeMockRecorder) TenantID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantID", reflect.TypeOf((*MockACRAttachmentScope)(nil).TenantID))
+}
+
+// Token mocks base method.
+func (m *MockACRAttachmentScope) Token() azcore.TokenCredential {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(azcore.TokenCredential)
+	return ret0
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockACRAttachmentScopeMockRecorder) Token() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockACRAttachmentScope)(nil).Token))
+}
+
+// UpdateAnnotationJSON mocks base method.
+func (m *MockACRAttachmentScope) UpdateAnnotationJSON(arg0 string, arg1 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnnotationJSON", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAnnotationJSON indicates an expected call of UpdateAnnotationJSON.
+func (mr *MockACRAttachmentScopeMockRecorder) UpdateAnnotationJSON(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotationJSON", reflect.TypeOf((*MockACRAttachmentScope)(nil).UpdateAnnotationJSON), arg0, arg1)
+}
+
+// UpdateDeleteStatus mocks base method.
+func (m *MockACRAttachmentScope) UpdateDeleteStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateDeleteStatus", arg0, arg1, arg2)
+}
+
+// UpdateDeleteStatus indicates an expected call of UpdateDeleteStatus.
+func (mr *MockACRAttachmentScopeMockRecorder) UpdateDeleteStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeleteStatus", reflect.TypeOf((*MockACRAttachmentScope)(nil).UpdateDeleteStatus), arg0, arg1, arg2)
+}
+
+// UpdatePatchStatus mocks base method.
+func (m *MockACRAttachmentScope) UpdatePatchStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePatchStatus", arg0, arg1, arg2)
+}
+
+// UpdatePatchStatus indicates an expected call of UpdatePatchStatus.
+func (mr *MockACRAttachmentScopeMockRecorder) UpdatePatchStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatchStatus", reflect.TypeOf((*MockACRAttachmentScope)(nil).UpdatePatchStatus), arg0, arg1, arg2)
+}
+
+// UpdatePutStatus mocks base method.
+func (m *MockACRAttachmentScope) UpdatePutStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePutStatus", arg0, arg1, arg2)
+}
+
+// UpdatePutStatus indicates an expected call of UpdatePutStatus.
+func (mr *MockACRAttachmentScopeMockRecorder) UpdatePutStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePutStatus", reflect.TypeOf((*MockACRAttachmentScope)(nil).UpdatePutStatus), arg0, arg1, arg2)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acrattachments
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+)
+
+const (
+	// acrPullRoleID is the ID of the built-in "AcrPull" role.
+	acrPullRoleID = "7f951dfc-4ca3-4e1c-a5b2-a3b9e1123cef"
+	// roleAssignmentsProvider is the provider segment of role assignment resource IDs.
+	roleAssignmentsProvider = "/providers/Microsoft.Authorization/roleAssignments/"
+)
+
+// ACRAttachmentSpec defines the specification for the AcrPull role assignment of a kubelet identity on an Azure
+// Container Registry.
+type ACRAttachmentSpec struct {
+	Name string
+	// ResourceGroup is the resource group of the registry.
+	ResourceGroup string
+	// RegistryID is the resource ID of the registry the role is assigned on.
+	RegistryID  string
+	PrincipalID string
+}
+
+// NewACRAttachmentSpec returns the spec of the AcrPull role assignment of the principal on the registry. The name of
+// the role assignment is derived from both, so that it is the same on every reconcile.
+func NewACRAttachmentSpec(registryID, principalID string) *ACRAttachmentSpec {
+	spec := &ACRAttachmentSpec{
+		Name:        uuid.NewSHA1(uuid.NameSpaceURL, []byte(strings.ToLower(registryID)+"/"+principalID+"/"+acrPullRoleID)).String(),
+		RegistryID:  registryID,
+		PrincipalID: principalID,
+	}
+	if id, err := azureutil.ParseResourceID(registryID); err == nil {
+		spec.ResourceGroup = id.ResourceGroupName
+	}
+	return spec
+}
+
+// ResourceName returns the name of the role assignment.
+func (s *ACRAttachmentSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the registry.
+func (s *ACRAttachmentSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName returns the resource ID of the registry, which is the scope of the role assignment.
+func (s *ACRAttachmentSpec) OwnerResourceName() string {
+	return s.RegistryID
+}
+
+// Parameters returns the parameters for the role assignment.
+func (s *ACRAttachmentSpec) Parameters(ctx context.Context, existing interface{}) (interface{}, error) {
+	if existing != nil {
+		if _, ok := existing.(armauthorization.RoleAssignment); !ok {
+			return nil, errors.Errorf("%T is not an armauthorization.RoleAssignment", existing)
+		}
+		// Role assignments can't be updated, and the name of the role assignment changes with the principal.
+		return nil, nil
+	}
+
+	id, err := azureutil.ParseResourceID(s.RegistryID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse registry ID %s", s.RegistryID)
+	}
+	return armauthorization.RoleAssignmentCreateParameters{
+		Properties: &armauthorization.RoleAssignmentProperties{
+			PrincipalID:      ptr.To(s.PrincipalID),
+			RoleDefinitionID: ptr.To(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s", id.SubscriptionID, acrPullRoleID)),
+			// The kubelet identity may not have been replicated yet when the cluster was just created.
+			PrincipalType: ptr.To(armauthorization.PrincipalTypeServicePrincipal),
+		},
+	}, nil
+}
+
+// roleAssignmentID returns the Azure resource ID of the role assignment.
+func (s *ACRAttachmentSpec) roleAssignmentID() string {
+	return s.RegistryID + roleAssignmentsProvider + s.Name
+}
+
+// specFromRoleAssignmentID returns the spec of the role assignment with the given resource ID, which is sufficient
+// to delete it.
+func specFromRoleAssignmentID(id string) (*ACRAttachmentSpec, error) {
+	i := strings.LastIndex(strings.ToLower(id), strings.ToLower(roleAssignmentsProvider))
+	if i < 0 {
+		return nil, errors.Errorf("%s is not the resource ID of a role assignment", id)
+	}
+	spec := &ACRAttachmentSpec{
+		Name:       id[i+len(roleAssignmentsProvider):],
+		RegistryID: id[:i],
+	}
+	if registryID, err := azureutil.ParseResourceID(spec.RegistryID); err == nil {
+		spec.ResourceGroup = registryID.ResourceGroupName
+	}
+	return spec, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acrattachments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestNewACRAttachmentSpec(t *testing.T) {
+	g := NewWithT(t)
+
+	spec := NewACRAttachmentSpec(fakeRegistryID, fakePrincipalID)
+	g.Expect(spec.ResourceGroupName()).To(Equal("acr-rg"))
+	g.Expect(spec.OwnerResourceName()).To(Equal(fakeRegistryID))
+	g.Expect(spec.ResourceName()).To(Equal(NewACRAttachmentSpec(fakeRegistryID, fakePrincipalID).ResourceName()))
+	g.Expect(spec.ResourceName()).NotTo(Equal(NewACRAttachmentSpec(fakeRegistryID, "other-object-id").ResourceName()))
+	g.Expect(spec.ResourceName()).NotTo(Equal(NewACRAttachmentSpec(fakeOtherRegistryID, fakePrincipalID).ResourceName()))
+
+	removed, err := specFromRoleAssignmentID(spec.roleAssignmentID())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(removed).To(Equal(&ACRAttachmentSpec{
+		Name:          spec.Name,
+		ResourceGroup: "acr-rg",
+		RegistryID:    fakeRegistryID,
+	}))
+
+	_, err = specFromRoleAssignmentID(fakeRegistryID)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestParameters(t *testing.T) {
+	testcases := []struct {
+		name          string
+		spec          *ACRAttachmentSpec
+		existing      interface{}
+		expect        func(g *WithT, result interface{})
+		expectedError string
+	}{
+		{
+			name:     "new role assignment",
+			spec:     fakeOtherAttachment,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armauthorization.RoleAssignmentCreateParameters{
+					Properties: &armauthorization.RoleAssignmentProperties{
+						PrincipalID:      ptr.To(fakePrincipalID),
+						RoleDefinitionID: ptr.To("/subscriptions/456/providers/Microsoft.Authorization/roleDefinitions/7f951dfc-4ca3-4e1c-a5b2-a3b9e1123cef"),
+						PrincipalType:    ptr.To(armauthorization.PrincipalTypeServicePrincipal),
+					},
+				}))
+			},
+		},
+		{
+			name:     "existing role assignment",
+			spec:     fakeAttachment,
+			existing: armauthorization.RoleAssignment{},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name:          "existing is not a role assignment",
+			spec:          fakeAttachment,
+			existing:      "wrong type",
+			expectedError: "string is not an armauthorization.RoleAssignment",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				tc.expect(g, result)
+			}
+		})
+	}
+}
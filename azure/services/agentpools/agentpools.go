@@ -20,11 +20,16 @@ import (
 	"context"
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/hostgroups"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const serviceName = "agentpools"
@@ -32,8 +37,10 @@ const serviceName = "agentpools"
 // AgentPoolScope defines the scope interface for an agent pool.
 type AgentPoolScope interface {
 	aso.Scope
+	azure.Authorizer
 
 	Name() string
+	Location() string
 	NodeResourceGroup() string
 	AgentPoolSpec() azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedClustersAgentPool]
 	SetAgentPoolProviderIDList([]string)
@@ -46,12 +53,42 @@ type AgentPoolScope interface {
 }
 
 // New creates a new service.
-func New(scope AgentPoolScope) *aso.Service[*asocontainerservicev1.ManagedClustersAgentPool, AgentPoolScope] {
+func New(scope AgentPoolScope) (*aso.Service[*asocontainerservicev1.ManagedClustersAgentPool, AgentPoolScope], error) {
+	hostGroupsClient, err := hostgroups.NewClient(scope)
+	if err != nil {
+		return nil, err
+	}
 	svc := aso.NewService[*asocontainerservicev1.ManagedClustersAgentPool](serviceName, scope)
 	svc.Specs = []azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedClustersAgentPool]{scope.AgentPoolSpec()}
 	svc.ConditionType = infrav1.AgentPoolsReadyCondition
+	svc.PreReconcileHook = func(ctx context.Context, scope AgentPoolScope) error {
+		return validateHostGroup(ctx, scope, hostGroupsClient)
+	}
 	svc.PostCreateOrUpdateResourceHook = postCreateOrUpdateResourceHook
-	return svc
+	return svc, nil
+}
+
+// validateHostGroup checks that the dedicated host group of the agent pool, if any, can host its nodes.
+func validateHostGroup(ctx context.Context, scope AgentPoolScope, hostGroupsClient hostgroups.Client) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "agentpools.validateHostGroup")
+	defer done()
+
+	spec, ok := scope.AgentPoolSpec().(*AgentPoolSpec)
+	if !ok || spec.HostGroupID == "" {
+		return nil
+	}
+
+	// The host group of an existing agent pool can't change, so it's only checked before the agent pool is created.
+	existing := spec.ResourceRef()
+	existing.Namespace = scope.ASOOwner().GetNamespace()
+	err := scope.GetClient().Get(ctx, client.ObjectKeyFromObject(existing), existing)
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to get existing agent pool %s", existing.Name)
+	}
+	return hostgroups.ValidatePlacement(ctx, hostGroupsClient, spec.HostGroupID, scope.Location(), spec.AvailabilityZones)
 }
 
 func postCreateOrUpdateResourceHook(ctx context.Context, scope AgentPoolScope, agentPool *asocontainerservicev1.ManagedClustersAgentPool, err error) error {
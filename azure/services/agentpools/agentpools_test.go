@@ -20,13 +20,19 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpools/mock_agentpools"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/hostgroups/mock_hostgroups"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestPostCreateOrUpdateResourceHook(t *testing.T) {
@@ -75,3 +81,55 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 		g.Expect(err).NotTo(HaveOccurred())
 	})
 }
+
+func TestValidateHostGroup(t *testing.T) {
+	const hostGroupID = "/subscriptions/other-sub/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-host-group"
+	spec := &AgentPoolSpec{
+		Name:              "pool0",
+		HostGroupID:       hostGroupID,
+		AvailabilityZones: []string{"1"},
+	}
+	owner := &infrav1.AzureManagedMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool0",
+			Namespace: "ns",
+		},
+	}
+	scheme := runtime.NewScheme()
+	_ = asocontainerservicev1.AddToScheme(scheme)
+
+	t.Run("validates the host group in its subscription before the agent pool is created", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_agentpools.NewMockAgentPoolScope(mockCtrl)
+		hostGroupsClient := mock_hostgroups.NewMockClient(mockCtrl)
+
+		scope.EXPECT().AgentPoolSpec().Return(spec)
+		scope.EXPECT().ASOOwner().Return(owner)
+		scope.EXPECT().GetClient().Return(fake.NewClientBuilder().WithScheme(scheme).Build())
+		scope.EXPECT().Location().Return("eastus")
+		hostGroupsClient.EXPECT().Get(gomock.Any(), "other-sub", "my-rg", "my-host-group").
+			Return(armcompute.DedicatedHostGroup{Location: ptr.To("eastus"), Zones: []*string{ptr.To("1")}}, nil)
+
+		g.Expect(validateHostGroup(context.Background(), scope, hostGroupsClient)).To(Succeed())
+	})
+
+	t.Run("skips the host group of an existing agent pool", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_agentpools.NewMockAgentPoolScope(mockCtrl)
+		hostGroupsClient := mock_hostgroups.NewMockClient(mockCtrl)
+
+		existing := &asocontainerservicev1.ManagedClustersAgentPool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pool0",
+				Namespace: "ns",
+			},
+		}
+		scope.EXPECT().AgentPoolSpec().Return(spec)
+		scope.EXPECT().ASOOwner().Return(owner)
+		scope.EXPECT().GetClient().Return(fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build())
+
+		g.Expect(validateHostGroup(context.Background(), scope, hostGroupsClient)).To(Succeed())
+	})
+}
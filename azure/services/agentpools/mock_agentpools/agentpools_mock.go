@@ -29,6 +29,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	azcore "github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	v1api20231001 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	gomock "go.uber.org/mock/gomock"
 	v1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -88,6 +89,90 @@ func (mr *MockAgentPoolScopeMockRecorder) AgentPoolSpec() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AgentPoolSpec", reflect.TypeOf((*MockAgentPoolScope)(nil).AgentPoolSpec))
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockAgentPoolScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockAgentPoolScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockAgentPoolScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockAgentPoolScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockAgentPoolScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockAgentPoolScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
+// BaseURI mocks base method.
+func (m *MockAgentPoolScope) BaseURI() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseURI")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BaseURI indicates an expected call of BaseURI.
+func (mr *MockAgentPoolScopeMockRecorder) BaseURI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseURI", reflect.TypeOf((*MockAgentPoolScope)(nil).BaseURI))
+}
+
+// ClientID mocks base method.
+func (m *MockAgentPoolScope) ClientID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClientID indicates an expected call of ClientID.
+func (mr *MockAgentPoolScopeMockRecorder) ClientID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientID", reflect.TypeOf((*MockAgentPoolScope)(nil).ClientID))
+}
+
+// ClientSecret mocks base method.
+func (m *MockAgentPoolScope) ClientSecret() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientSecret")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClientSecret indicates an expected call of ClientSecret.
+func (mr *MockAgentPoolScopeMockRecorder) ClientSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientSecret", reflect.TypeOf((*MockAgentPoolScope)(nil).ClientSecret))
+}
+
+// CloudEnvironment mocks base method.
+func (m *MockAgentPoolScope) CloudEnvironment() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudEnvironment")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CloudEnvironment indicates an expected call of CloudEnvironment.
+func (mr *MockAgentPoolScopeMockRecorder) CloudEnvironment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudEnvironment", reflect.TypeOf((*MockAgentPoolScope)(nil).CloudEnvironment))
+}
+
 // ClusterName mocks base method.
 func (m *MockAgentPoolScope) ClusterName() string {
 	m.ctrl.T.Helper()
@@ -116,20 +201,6 @@ func (mr *MockAgentPoolScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockAgentPoolScope)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockAgentPoolScope) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockAgentPoolScopeMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockAgentPoolScope)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockAgentPoolScope) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -184,6 +255,34 @@ func (mr *MockAgentPoolScopeMockRecorder) GetLongRunningOperationState(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongRunningOperationState", reflect.TypeOf((*MockAgentPoolScope)(nil).GetLongRunningOperationState), arg0, arg1, arg2)
 }
 
+// HashKey mocks base method.
+func (m *MockAgentPoolScope) HashKey() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashKey")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashKey indicates an expected call of HashKey.
+func (mr *MockAgentPoolScopeMockRecorder) HashKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockAgentPoolScope)(nil).HashKey))
+}
+
+// Location mocks base method.
+func (m *MockAgentPoolScope) Location() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Location")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Location indicates an expected call of Location.
+func (mr *MockAgentPoolScopeMockRecorder) Location() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Location", reflect.TypeOf((*MockAgentPoolScope)(nil).Location))
+}
+
 // Name mocks base method.
 func (m *MockAgentPoolScope) Name() string {
 	m.ctrl.T.Helper()
@@ -224,6 +323,18 @@ func (mr *MockAgentPoolScopeMockRecorder) RemoveCAPIMachinePoolAnnotation(key an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCAPIMachinePoolAnnotation", reflect.TypeOf((*MockAgentPoolScope)(nil).RemoveCAPIMachinePoolAnnotation), key)
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockAgentPoolScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockAgentPoolScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockAgentPoolScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // SetAgentPoolProviderIDList mocks base method.
 func (m *MockAgentPoolScope) SetAgentPoolProviderIDList(arg0 []string) {
 	m.ctrl.T.Helper()
@@ -308,6 +419,48 @@ func (mr *MockAgentPoolScopeMockRecorder) SetSubnetName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetName", reflect.TypeOf((*MockAgentPoolScope)(nil).SetSubnetName))
 }
 
+// SubscriptionID mocks base method.
+func (m *MockAgentPoolScope) SubscriptionID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscriptionID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SubscriptionID indicates an expected call of SubscriptionID.
+func (mr *MockAgentPoolScopeMockRecorder) SubscriptionID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionID", reflect.TypeOf((*MockAgentPoolScope)(nil).SubscriptionID))
+}
+
+// TenantID mocks base method.
+func (m *MockAgentPoolScope) TenantID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TenantID indicates an expected call of TenantID.
+func (mr *MockAgentPoolScopeMockRecorder) TenantID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantID", reflect.TypeOf((*MockAgentPoolScope)(nil).TenantID))
+}
+
+// Token mocks base method.
+func (m *MockAgentPoolScope) Token() azcore.TokenCredential {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(azcore.TokenCredential)
+	return ret0
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockAgentPoolScopeMockRecorder) Token() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockAgentPoolScope)(nil).Token))
+}
+
 // UpdateDeleteStatus mocks base method.
 func (m *MockAgentPoolScope) UpdateDeleteStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
 	m.ctrl.T.Helper()
@@ -21,6 +21,8 @@ import (
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -29,6 +31,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
+// maxVersionSkew is the number of minor versions an agent pool may be behind the control plane of its managed cluster.
+const maxVersionSkew = 2
+
 // KubeletConfig defines the set of kubelet configurations for nodes in pools.
 type KubeletConfig struct {
 	// CPUManagerPolicy - CPU Manager policy to use.
@@ -72,6 +77,9 @@ type AgentPoolSpec struct {
 	// Version defines the desired Kubernetes version.
 	Version *string
 
+	// ControlPlaneVersion is the desired Kubernetes version of the AKS cluster's control plane.
+	ControlPlaneVersion *string
+
 	// SKU defines the Azure VM size for the agent pool VMs.
 	SKU string
 
@@ -149,6 +157,9 @@ type AgentPoolSpec struct {
 
 	// EnableEncryptionAtHost indicates whether host encryption is enabled on the node pool
 	EnableEncryptionAtHost *bool
+
+	// HostGroupID is the resource ID of the dedicated host group the nodes are placed in.
+	HostGroupID string
 }
 
 // ResourceRef implements azure.ASOResourceSpecGetter.
@@ -165,6 +176,10 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existing *asocontainerse
 	_, _, done := tele.StartSpanWithLogger(ctx, "agentpools.Service.Parameters")
 	defer done()
 
+	if err := validateVersionSkew(s.Version, s.ControlPlaneVersion); err != nil {
+		return nil, azure.WithTerminalError(err)
+	}
+
 	agentPool := existing
 	if agentPool == nil {
 		agentPool = &asocontainerservicev1.ManagedClustersAgentPool{}
@@ -233,6 +248,12 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existing *asocontainerse
 		}
 	}
 
+	if s.HostGroupID != "" {
+		agentPool.Spec.HostGroupReference = &genruntime.ResourceReference{
+			ARMID: s.HostGroupID,
+		}
+	}
+
 	if s.LinuxOSConfig != nil {
 		agentPool.Spec.LinuxOSConfig = &asocontainerservicev1.LinuxOSConfig{
 			SwapFileSizeMB:             s.LinuxOSConfig.SwapFileSizeMB,
@@ -283,6 +304,31 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existing *asocontainerse
 	return agentPool, nil
 }
 
+// validateVersionSkew returns an error if the agent pool's Kubernetes version is newer than the control plane's or
+// more than maxVersionSkew minor versions behind it. Versions which aren't set or can't be parsed are left for AKS to
+// validate.
+func validateVersionSkew(version, controlPlaneVersion *string) error {
+	if version == nil || controlPlaneVersion == nil {
+		return nil
+	}
+	nodeVersion, err := semver.ParseTolerant(*version)
+	if err != nil {
+		return nil //nolint:nilerr // AKS reports invalid versions.
+	}
+	cpVersion, err := semver.ParseTolerant(*controlPlaneVersion)
+	if err != nil {
+		return nil //nolint:nilerr // AKS reports invalid versions.
+	}
+
+	switch {
+	case nodeVersion.GT(cpVersion):
+		return errors.Errorf("the Kubernetes version %s of the agent pool must not be newer than the version %s of the control plane", *version, *controlPlaneVersion)
+	case nodeVersion.Major != cpVersion.Major || nodeVersion.Minor+maxVersionSkew < cpVersion.Minor:
+		return errors.Errorf("the Kubernetes version %s of the agent pool must not be more than %d minor versions behind the version %s of the control plane", *version, maxVersionSkew, *controlPlaneVersion)
+	}
+	return nil
+}
+
 // WasManaged implements azure.ASOResourceSpecGetter.
 func (s *AgentPoolSpec) WasManaged(resource *asocontainerservicev1.ManagedClustersAgentPool) bool {
 	// CAPZ has never supported BYO agent pools.
@@ -18,6 +18,7 @@ package agentpools
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
 )
 
 func TestParameters(t *testing.T) {
@@ -71,6 +73,7 @@ func TestParameters(t *testing.T) {
 			},
 			EnableFIPS:             ptr.To(true),
 			EnableEncryptionAtHost: ptr.To(false),
+			HostGroupID:            "host group ID",
 		}
 		expected := &asocontainerservicev1.ManagedClustersAgentPool{
 			Spec: asocontainerservicev1.ManagedClusters_AgentPool_Spec{
@@ -111,6 +114,9 @@ func TestParameters(t *testing.T) {
 				NodePublicIPPrefixReference: &genruntime.ResourceReference{
 					ARMID: "public IP prefix ID",
 				},
+				HostGroupReference: &genruntime.ResourceReference{
+					ARMID: "host group ID",
+				},
 				LinuxOSConfig: &asocontainerservicev1.LinuxOSConfig{
 					Sysctls: &asocontainerservicev1.SysctlConfig{
 						FsNrOpen: ptr.To(6),
@@ -152,4 +158,121 @@ func TestParameters(t *testing.T) {
 		g.Expect(actual.Spec.Count).To(Equal(ptr.To(1212)))
 		g.Expect(actual.Spec.PowerState.Code).To(Equal(ptr.To(asocontainerservicev1.PowerState_Code("set by the user"))))
 	})
+
+	t.Run("autoscaled agent pool can scale up after its replicas follow its node count", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &AgentPoolSpec{
+			AzureName:         "pool0",
+			Replicas:          5,
+			EnableAutoScaling: true,
+			MinCount:          ptr.To(2),
+			MaxCount:          ptr.To(10),
+		}
+		existing := &asocontainerservicev1.ManagedClustersAgentPool{
+			Spec: asocontainerservicev1.ManagedClusters_AgentPool_Spec{
+				AzureName: "pool0",
+			},
+			Status: asocontainerservicev1.ManagedClusters_AgentPool_STATUS{
+				Count: ptr.To(5),
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), existing)
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(actual.Spec.Count).To(Equal(ptr.To(5)))
+		g.Expect(actual.Spec.MaxCount).To(Equal(ptr.To(10)))
+	})
+
+	t.Run("scale down mode of existing agent pool is updated in place", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &AgentPoolSpec{
+			AzureName:     "pool0",
+			ScaleDownMode: ptr.To(infrav1.ScaleDownModeDeallocate),
+		}
+		existing := &asocontainerservicev1.ManagedClustersAgentPool{
+			Spec: asocontainerservicev1.ManagedClusters_AgentPool_Spec{
+				AzureName:     "pool0",
+				ScaleDownMode: ptr.To(asocontainerservicev1.ScaleDownMode_Delete),
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), existing)
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(actual).To(BeIdenticalTo(existing))
+		g.Expect(actual.Spec.ScaleDownMode).To(Equal(ptr.To(asocontainerservicev1.ScaleDownMode_Deallocate)))
+	})
+
+	t.Run("with an agent pool outside of the supported version skew", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &AgentPoolSpec{
+			Version:             ptr.To("1.26.6"),
+			ControlPlaneVersion: ptr.To("1.29.2"),
+		}
+
+		_, err := spec.Parameters(context.Background(), nil)
+
+		g.Expect(err).To(MatchError(ContainSubstring("must not be more than 2 minor versions behind")))
+		var recerr azure.ReconcileError
+		g.Expect(errors.As(err, &recerr)).To(BeTrue())
+		g.Expect(recerr.IsTerminal()).To(BeTrue())
+	})
+}
+
+func TestValidateVersionSkew(t *testing.T) {
+	tests := []struct {
+		name                string
+		version             *string
+		controlPlaneVersion *string
+		wantErr             string
+	}{
+		{
+			name:                "version not set",
+			version:             nil,
+			controlPlaneVersion: ptr.To("1.29.2"),
+		},
+		{
+			name:                "same version as the control plane",
+			version:             ptr.To("1.29.2"),
+			controlPlaneVersion: ptr.To("1.29.2"),
+		},
+		{
+			name:                "within the supported skew",
+			version:             ptr.To("1.27.9"),
+			controlPlaneVersion: ptr.To("1.29.2"),
+		},
+		{
+			name:                "outside of the supported skew",
+			version:             ptr.To("1.26.6"),
+			controlPlaneVersion: ptr.To("1.29.2"),
+			wantErr:             "the Kubernetes version 1.26.6 of the agent pool must not be more than 2 minor versions behind the version 1.29.2 of the control plane",
+		},
+		{
+			name:                "newer than the control plane",
+			version:             ptr.To("1.29.2"),
+			controlPlaneVersion: ptr.To("1.28.5"),
+			wantErr:             "the Kubernetes version 1.29.2 of the agent pool must not be newer than the version 1.28.5 of the control plane",
+		},
+		{
+			name:                "unparsable version",
+			version:             ptr.To("version"),
+			controlPlaneVersion: ptr.To("1.29.2"),
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateVersionSkew(tc.version, tc.controlPlaneVersion)
+			if tc.wantErr != "" {
+				g.Expect(err).To(MatchError(tc.wantErr))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
 }
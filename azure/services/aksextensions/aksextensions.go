@@ -17,10 +17,15 @@ limitations under the License.
 package aksextensions
 
 import (
+	"context"
+
 	asokubernetesconfigurationv1 "github.com/Azure/azure-service-operator/v2/api/kubernetesconfiguration/v1api20230501"
+	"github.com/pkg/errors"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const serviceName = "extension"
@@ -48,3 +53,50 @@ func New(scope AKSExtensionScope) *Service {
 		Service: svc,
 	}
 }
+
+// Reconcile idempotently creates or updates the extensions and deletes the extensions which were removed from the
+// spec.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "aksextensions.Service.Reconcile")
+	defer done()
+
+	resultErr := s.Service.Reconcile(ctx)
+
+	err := s.deleteRemovedExtensions(ctx)
+	if err != nil && (!azure.IsOperationNotDoneError(err) || resultErr == nil) {
+		resultErr = err
+		s.Scope.UpdatePutStatus(s.ConditionType, serviceName, resultErr)
+	}
+	return resultErr
+}
+
+// deleteRemovedExtensions deletes the extensions owned by the control plane which no longer have a spec. Extensions
+// not managed by CAPZ are left alone.
+func (s *Service) deleteRemovedExtensions(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
+	defer cancel()
+
+	extensions := &asokubernetesconfigurationv1.ExtensionList{}
+	err := s.Scope.GetClient().List(ctx, extensions, client.InNamespace(s.Scope.ASOOwner().GetNamespace()))
+	if err != nil {
+		return errors.Wrap(err, "failed to list extensions")
+	}
+
+	specified := make(map[string]struct{}, len(s.Specs))
+	for _, spec := range s.Specs {
+		specified[spec.ResourceRef().GetName()] = struct{}{}
+	}
+
+	var resultErr error
+	for i := range extensions.Items {
+		extension := &extensions.Items[i]
+		if _, ok := specified[extension.GetName()]; ok {
+			continue
+		}
+		err := s.DeleteResource(ctx, extension, serviceName)
+		if err != nil && (!azure.IsOperationNotDoneError(err) || resultErr == nil) {
+			resultErr = err
+		}
+	}
+	return resultErr
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aksextensions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	asokubernetesconfigurationv1 "github.com/Azure/azure-service-operator/v2/api/kubernetesconfiguration/v1api20230501"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aksextensions/mock_aksextensions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcile(t *testing.T) {
+	owner := &infrav1.AzureManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "namespace",
+		},
+	}
+
+	fluxSpec := func(settings map[string]string) *AKSExtensionSpec {
+		return &AKSExtensionSpec{
+			Name:                  "flux",
+			Namespace:             "namespace",
+			ConfigurationSettings: settings,
+			ExtensionType:         ptr.To("microsoft.flux"),
+			Version:               ptr.To("1.8.2"),
+			Owner:                 "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ContainerService/managedClusters/my-cluster",
+			Scope: infrav1.ExtensionScope{
+				ScopeType:        infrav1.ExtensionScopeCluster,
+				ReleaseNamespace: "flux-system",
+			},
+		}
+	}
+
+	newExtension := func(name string, owned bool, settings map[string]string) *asokubernetesconfigurationv1.Extension {
+		extension := &asokubernetesconfigurationv1.Extension{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "namespace",
+			},
+			Spec: asokubernetesconfigurationv1.Extension_Spec{
+				AzureName:             name,
+				ConfigurationSettings: settings,
+			},
+			Status: asokubernetesconfigurationv1.Extension_STATUS{
+				Conditions: []conditions.Condition{
+					{
+						Type:   conditions.ConditionTypeReady,
+						Status: metav1.ConditionTrue,
+						Reason: conditions.ReasonSucceeded,
+					},
+				},
+			},
+		}
+		if owned {
+			extension.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: infrav1.GroupVersion.String(),
+					Kind:       "AzureManagedControlPlane",
+					Name:       owner.Name,
+					Controller: ptr.To(true),
+				},
+			}
+		}
+		return extension
+	}
+
+	tests := []struct {
+		name           string
+		specs          []azure.ASOResourceSpecGetter[*asokubernetesconfigurationv1.Extension]
+		objects        []client.Object
+		wantExtensions map[string]map[string]string
+		wantDeleted    []string
+	}{
+		{
+			name:  "flux extension is installed",
+			specs: []azure.ASOResourceSpecGetter[*asokubernetesconfigurationv1.Extension]{fluxSpec(map[string]string{"multiTenancy.enforce": "false"})},
+			wantExtensions: map[string]map[string]string{
+				"flux": {"multiTenancy.enforce": "false"},
+			},
+		},
+		{
+			name:    "flux extension configuration settings are updated",
+			specs:   []azure.ASOResourceSpecGetter[*asokubernetesconfigurationv1.Extension]{fluxSpec(map[string]string{"multiTenancy.enforce": "true"})},
+			objects: []client.Object{newExtension("flux", true, map[string]string{"multiTenancy.enforce": "false"})},
+			wantExtensions: map[string]map[string]string{
+				"flux": {"multiTenancy.enforce": "true"},
+			},
+		},
+		{
+			name:  "removed extension is deleted and unmanaged extension is kept",
+			specs: []azure.ASOResourceSpecGetter[*asokubernetesconfigurationv1.Extension]{fluxSpec(nil)},
+			objects: []client.Object{
+				newExtension("flux", true, nil),
+				newExtension("dapr", true, nil),
+				newExtension("unmanaged", false, nil),
+			},
+			wantExtensions: map[string]map[string]string{
+				"flux":      nil,
+				"unmanaged": nil,
+			},
+			wantDeleted: []string{"dapr"},
+		},
+		{
+			name: "all extensions are removed",
+			objects: []client.Object{
+				newExtension("flux", true, nil),
+			},
+			wantDeleted: []string{"flux"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_aksextensions.NewMockAKSExtensionScope(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			g.Expect(asokubernetesconfigurationv1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			ctrlClient := fakeclient.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(test.objects...).
+				Build()
+			scopeMock.EXPECT().GetClient().Return(ctrlClient).AnyTimes()
+			scopeMock.EXPECT().ASOOwner().Return(owner).AnyTimes()
+			scopeMock.EXPECT().ClusterName().Return("my-cluster").AnyTimes()
+			scopeMock.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(time.Minute).AnyTimes()
+			scopeMock.EXPECT().AKSExtensionSpecs().Return(test.specs)
+			scopeMock.EXPECT().UpdatePutStatus(infrav1.AKSExtensionsReadyCondition, serviceName, gomock.Any()).AnyTimes()
+
+			err := New(scopeMock).Reconcile(context.Background())
+			if err != nil {
+				g.Expect(azure.IsOperationNotDoneError(err)).To(BeTrue(), "unexpected error: %v", err)
+			}
+
+			for name, settings := range test.wantExtensions {
+				extension := &asokubernetesconfigurationv1.Extension{}
+				g.Expect(ctrlClient.Get(context.Background(), client.ObjectKey{Namespace: "namespace", Name: name}, extension)).To(Succeed())
+				g.Expect(extension.Spec.ConfigurationSettings).To(Equal(settings))
+			}
+			for _, name := range test.wantDeleted {
+				err := ctrlClient.Get(context.Background(), client.ObjectKey{Namespace: "namespace", Name: name}, &asokubernetesconfigurationv1.Extension{})
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			}
+		})
+	}
+}
@@ -0,0 +1,763 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../aksextensions.go
+//
+// Generated by this command:
+//
+//	mockgen -destination aksextensions_mock.go -package mock_aksextensions -source ../aksextensions.go AKSExtensionScope
+//
+
+// Package mock_aksextensions is a generated GoMock package.
+package mock_aksextensions
+
+import (
+	reflect "reflect"
+	time "time"
+
+	azcore "github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1api20230501 "github.com/Azure/azure-service-operator/v2/api/kubernetesconfiguration/v1api20230501"
+	gomock "go.uber.org/mock/gomock"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	azure "sigs.k8s.io/cluster-api-provider-azure/azure"
+	v1beta10 "sigs.k8s.io/cluster-api/api/v1beta1"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MockAKSExtensionScope is a mock of AKSExtensionScope interface.
+type MockAKSExtensionScope struct {
+	ctrl     *gomock.Controller
+	recorder *MockAKSExtensionScopeMockRecorder
+}
+
+// MockAKSExtensionScopeMockRecorder is the mock recorder for MockAKSExtensionScope.
+type MockAKSExtensionScopeMockRecorder struct {
+	mock *MockAKSExtensionScope
+}
+
+// NewMockAKSExtensionScope creates a new mock instance.
+func NewMockAKSExtensionScope(ctrl *gomock.Controller) *MockAKSExtensionScope {
+	mock := &MockAKSExtensionScope{ctrl: ctrl}
+	mock.recorder = &MockAKSExtensionScopeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAKSExtensionScope) EXPECT() *MockAKSExtensionScopeMockRecorder {
+	return m.recorder
+}
+
+// AKSExtensionSpecs mocks base method.
+func (m *MockAKSExtensionScope) AKSExtensionSpecs() []azure.ASOResourceSpecGetter[*v1api20230501.Extension] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AKSExtensionSpecs")
+	ret0, _ := ret[0].([]azure.ASOResourceSpecGetter[*v1api20230501.Extension])
+	return ret0
+}
+
+// AKSExtensionSpecs indicates an expected call of AKSExtensionSpecs.
+func (mr *MockAKSExtensionScopeMockRecorder) AKSExtensionSpecs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AKSExtensionSpecs", reflect.TypeOf((*MockAKSExtensionScope)(nil).AKSExtensionSpecs))
+}
+
+// APIServerLB mocks base method.
+func (m *MockAKSExtensionScope) APIServerLB() *v1beta1.LoadBalancerSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIServerLB")
+	ret0, _ := ret[0].(*v1beta1.LoadBalancerSpec)
+	return ret0
+}
+
+// APIServerLB indicates an expected call of APIServerLB.
+func (mr *MockAKSExtensionScopeMockRecorder) APIServerLB() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIServerLB", reflect.TypeOf((*MockAKSExtensionScope)(nil).APIServerLB))
+}
+
+// APIServerLBName mocks base method.
+func (m *MockAKSExtensionScope) APIServerLBName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIServerLBName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// APIServerLBName indicates an expected call of APIServerLBName.
+func (mr *MockAKSExtensionScopeMockRecorder) APIServerLBName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIServerLBName", reflect.TypeOf((*MockAKSExtensionScope)(nil).APIServerLBName))
+}
+
+// APIServerLBPoolName mocks base method.
+func (m *MockAKSExtensionScope) APIServerLBPoolName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIServerLBPoolName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// APIServerLBPoolName indicates an expected call of APIServerLBPoolName.
+func (mr *MockAKSExtensionScopeMockRecorder) APIServerLBPoolName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIServerLBPoolName", reflect.TypeOf((*MockAKSExtensionScope)(nil).APIServerLBPoolName))
+}
+
+// ASOOwner mocks base method.
+func (m *MockAKSExtensionScope) ASOOwner() client.Object {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ASOOwner")
+	ret0, _ := ret[0].(client.Object)
+	return ret0
+}
+
+// ASOOwner indicates an expected call of ASOOwner.
+func (mr *MockAKSExtensionScopeMockRecorder) ASOOwner() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ASOOwner", reflect.TypeOf((*MockAKSExtensionScope)(nil).ASOOwner))
+}
+
+// AdditionalTags mocks base method.
+func (m *MockAKSExtensionScope) AdditionalTags() v1beta1.Tags {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdditionalTags")
+	ret0, _ := ret[0].(v1beta1.Tags)
+	return ret0
+}
+
+// AdditionalTags indicates an expected call of AdditionalTags.
+func (mr *MockAKSExtensionScopeMockRecorder) AdditionalTags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdditionalTags", reflect.TypeOf((*MockAKSExtensionScope)(nil).AdditionalTags))
+}
+
+// AvailabilitySetEnabled mocks base method.
+func (m *MockAKSExtensionScope) AvailabilitySetEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailabilitySetEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AvailabilitySetEnabled indicates an expected call of AvailabilitySetEnabled.
+func (mr *MockAKSExtensionScopeMockRecorder) AvailabilitySetEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySetEnabled", reflect.TypeOf((*MockAKSExtensionScope)(nil).AvailabilitySetEnabled))
+}
+
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockAKSExtensionScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockAKSExtensionScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockAKSExtensionScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockAKSExtensionScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockAKSExtensionScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockAKSExtensionScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
+// BaseURI mocks base method.
+func (m *MockAKSExtensionScope) BaseURI() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseURI")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BaseURI indicates an expected call of BaseURI.
+func (mr *MockAKSExtensionScopeMockRecorder) BaseURI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseURI", reflect.TypeOf((*MockAKSExtensionScope)(nil).BaseURI))
+}
+
+// ClientID mocks base method.
+func (m *MockAKSExtensionScope) ClientID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClientID indicates an expected call of ClientID.
+func (mr *MockAKSExtensionScopeMockRecorder) ClientID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientID", reflect.TypeOf((*MockAKSExtensionScope)(nil).ClientID))
+}
+
+// ClientSecret mocks base method.
+func (m *MockAKSExtensionScope) ClientSecret() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientSecret")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClientSecret indicates an expected call of ClientSecret.
+func (mr *MockAKSExtensionScopeMockRecorder) ClientSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientSecret", reflect.TypeOf((*MockAKSExtensionScope)(nil).ClientSecret))
+}
+
+// CloudEnvironment mocks base method.
+func (m *MockAKSExtensionScope) CloudEnvironment() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudEnvironment")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CloudEnvironment indicates an expected call of CloudEnvironment.
+func (mr *MockAKSExtensionScopeMockRecorder) CloudEnvironment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudEnvironment", reflect.TypeOf((*MockAKSExtensionScope)(nil).CloudEnvironment))
+}
+
+// CloudProviderConfigOverrides mocks base method.
+func (m *MockAKSExtensionScope) CloudProviderConfigOverrides() *v1beta1.CloudProviderConfigOverrides {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudProviderConfigOverrides")
+	ret0, _ := ret[0].(*v1beta1.CloudProviderConfigOverrides)
+	return ret0
+}
+
+// CloudProviderConfigOverrides indicates an expected call of CloudProviderConfigOverrides.
+func (mr *MockAKSExtensionScopeMockRecorder) CloudProviderConfigOverrides() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudProviderConfigOverrides", reflect.TypeOf((*MockAKSExtensionScope)(nil).CloudProviderConfigOverrides))
+}
+
+// ClusterName mocks base method.
+func (m *MockAKSExtensionScope) ClusterName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClusterName indicates an expected call of ClusterName.
+func (mr *MockAKSExtensionScopeMockRecorder) ClusterName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterName", reflect.TypeOf((*MockAKSExtensionScope)(nil).ClusterName))
+}
+
+// ControlPlaneRouteTable mocks base method.
+func (m *MockAKSExtensionScope) ControlPlaneRouteTable() v1beta1.RouteTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControlPlaneRouteTable")
+	ret0, _ := ret[0].(v1beta1.RouteTable)
+	return ret0
+}
+
+// ControlPlaneRouteTable indicates an expected call of ControlPlaneRouteTable.
+func (mr *MockAKSExtensionScopeMockRecorder) ControlPlaneRouteTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControlPlaneRouteTable", reflect.TypeOf((*MockAKSExtensionScope)(nil).ControlPlaneRouteTable))
+}
+
+// ControlPlaneSubnet mocks base method.
+func (m *MockAKSExtensionScope) ControlPlaneSubnet() v1beta1.SubnetSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControlPlaneSubnet")
+	ret0, _ := ret[0].(v1beta1.SubnetSpec)
+	return ret0
+}
+
+// ControlPlaneSubnet indicates an expected call of ControlPlaneSubnet.
+func (mr *MockAKSExtensionScopeMockRecorder) ControlPlaneSubnet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControlPlaneSubnet", reflect.TypeOf((*MockAKSExtensionScope)(nil).ControlPlaneSubnet))
+}
+
+// DefaultedAzureCallTimeout mocks base method.
+func (m *MockAKSExtensionScope) DefaultedAzureCallTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
+func (mr *MockAKSExtensionScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockAKSExtensionScope)(nil).DefaultedAzureCallTimeout))
+}
+
+// DefaultedReconcilerRequeue mocks base method.
+func (m *MockAKSExtensionScope) DefaultedReconcilerRequeue() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedReconcilerRequeue")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedReconcilerRequeue indicates an expected call of DefaultedReconcilerRequeue.
+func (mr *MockAKSExtensionScopeMockRecorder) DefaultedReconcilerRequeue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedReconcilerRequeue", reflect.TypeOf((*MockAKSExtensionScope)(nil).DefaultedReconcilerRequeue))
+}
+
+// DeleteLongRunningOperationState mocks base method.
+func (m *MockAKSExtensionScope) DeleteLongRunningOperationState(arg0, arg1, arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteLongRunningOperationState", arg0, arg1, arg2)
+}
+
+// DeleteLongRunningOperationState indicates an expected call of DeleteLongRunningOperationState.
+func (mr *MockAKSExtensionScopeMockRecorder) DeleteLongRunningOperationState(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLongRunningOperationState", reflect.TypeOf((*MockAKSExtensionScope)(nil).DeleteLongRunningOperationState), arg0, arg1, arg2)
+}
+
+// ExtendedLocation mocks base method.
+func (m *MockAKSExtensionScope) ExtendedLocation() *v1beta1.ExtendedLocationSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendedLocation")
+	ret0, _ := ret[0].(*v1beta1.ExtendedLocationSpec)
+	return ret0
+}
+
+// ExtendedLocation indicates an expected call of ExtendedLocation.
+func (mr *MockAKSExtensionScopeMockRecorder) ExtendedLocation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendedLocation", reflect.TypeOf((*MockAKSExtensionScope)(nil).ExtendedLocation))
+}
+
+// ExtendedLocationName mocks base method.
+func (m *MockAKSExtensionScope) ExtendedLocationName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendedLocationName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ExtendedLocationName indicates an expected call of ExtendedLocationName.
+func (mr *MockAKSExtensionScopeMockRecorder) ExtendedLocationName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendedLocationName", reflect.TypeOf((*MockAKSExtensionScope)(nil).ExtendedLocationName))
+}
+
+// ExtendedLocationType mocks base method.
+func (m *MockAKSExtensionScope) ExtendedLocationType() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendedLocationType")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ExtendedLocationType indicates an expected call of ExtendedLocationType.
+func (mr *MockAKSExtensionScopeMockRecorder) ExtendedLocationType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendedLocationType", reflect.TypeOf((*MockAKSExtensionScope)(nil).ExtendedLocationType))
+}
+
+// FailureDomains mocks base method.
+func (m *MockAKSExtensionScope) FailureDomains() []*string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailureDomains")
+	ret0, _ := ret[0].([]*string)
+	return ret0
+}
+
+// FailureDomains indicates an expected call of FailureDomains.
+func (mr *MockAKSExtensionScopeMockRecorder) FailureDomains() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailureDomains", reflect.TypeOf((*MockAKSExtensionScope)(nil).FailureDomains))
+}
+
+// GetClient mocks base method.
+func (m *MockAKSExtensionScope) GetClient() client.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient")
+	ret0, _ := ret[0].(client.Client)
+	return ret0
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockAKSExtensionScopeMockRecorder) GetClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockAKSExtensionScope)(nil).GetClient))
+}
+
+// GetDeletionTimestamp mocks base method.
+func (m *MockAKSExtensionScope) GetDeletionTimestamp() *v1.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletionTimestamp")
+	ret0, _ := ret[0].(*v1.Time)
+	return ret0
+}
+
+// GetDeletionTimestamp indicates an expected call of GetDeletionTimestamp.
+func (mr *MockAKSExtensionScopeMockRecorder) GetDeletionTimestamp() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletionTimestamp", reflect.TypeOf((*MockAKSExtensionScope)(nil).GetDeletionTimestamp))
+}
+
+// GetLongRunningOperationState mocks base method.
+func (m *MockAKSExtensionScope) GetLongRunningOperationState(arg0, arg1, arg2 string) *v1beta1.Future {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLongRunningOperationState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.Future)
+	return ret0
+}
+
+// GetLongRunningOperationState indicates an expected call of GetLongRunningOperationState.
+func (mr *MockAKSExtensionScopeMockRecorder) GetLongRunningOperationState(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongRunningOperationState", reflect.TypeOf((*MockAKSExtensionScope)(nil).GetLongRunningOperationState), arg0, arg1, arg2)
+}
+
+// GetPrivateDNSZoneName mocks base method.
+func (m *MockAKSExtensionScope) GetPrivateDNSZoneName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivateDNSZoneName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPrivateDNSZoneName indicates an expected call of GetPrivateDNSZoneName.
+func (mr *MockAKSExtensionScopeMockRecorder) GetPrivateDNSZoneName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateDNSZoneName", reflect.TypeOf((*MockAKSExtensionScope)(nil).GetPrivateDNSZoneName))
+}
+
+// HashKey mocks base method.
+func (m *MockAKSExtensionScope) HashKey() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashKey")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashKey indicates an expected call of HashKey.
+func (mr *MockAKSExtensionScopeMockRecorder) HashKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockAKSExtensionScope)(nil).HashKey))
+}
+
+// IsAPIServerPrivate mocks base method.
+func (m *MockAKSExtensionScope) IsAPIServerPrivate() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAPIServerPrivate")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAPIServerPrivate indicates an expected call of IsAPIServerPrivate.
+func (mr *MockAKSExtensionScopeMockRecorder) IsAPIServerPrivate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAPIServerPrivate", reflect.TypeOf((*MockAKSExtensionScope)(nil).IsAPIServerPrivate))
+}
+
+// IsIPv6Enabled mocks base method.
+func (m *MockAKSExtensionScope) IsIPv6Enabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIPv6Enabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsIPv6Enabled indicates an expected call of IsIPv6Enabled.
+func (mr *MockAKSExtensionScopeMockRecorder) IsIPv6Enabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIPv6Enabled", reflect.TypeOf((*MockAKSExtensionScope)(nil).IsIPv6Enabled))
+}
+
+// IsVnetManaged mocks base method.
+func (m *MockAKSExtensionScope) IsVnetManaged() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVnetManaged")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsVnetManaged indicates an expected call of IsVnetManaged.
+func (mr *MockAKSExtensionScopeMockRecorder) IsVnetManaged() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVnetManaged", reflect.TypeOf((*MockAKSExtensionScope)(nil).IsVnetManaged))
+}
+
+// Location mocks base method.
+func (m *MockAKSExtensionScope) Location() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Location")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Location indicates an expected call of Location.
+func (mr *MockAKSExtensionScopeMockRecorder) Location() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Location", reflect.TypeOf((*MockAKSExtensionScope)(nil).Location))
+}
+
+// NodeResourceGroup mocks base method.
+func (m *MockAKSExtensionScope) NodeResourceGroup() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeResourceGroup")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NodeResourceGroup indicates an expected call of NodeResourceGroup.
+func (mr *MockAKSExtensionScopeMockRecorder) NodeResourceGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeResourceGroup", reflect.TypeOf((*MockAKSExtensionScope)(nil).NodeResourceGroup))
+}
+
+// NodeSubnets mocks base method.
+func (m *MockAKSExtensionScope) NodeSubnets() []v1beta1.SubnetSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeSubnets")
+	ret0, _ := ret[0].([]v1beta1.SubnetSpec)
+	return ret0
+}
+
+// NodeSubnets indicates an expected call of NodeSubnets.
+func (mr *MockAKSExtensionScopeMockRecorder) NodeSubnets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeSubnets", reflect.TypeOf((*MockAKSExtensionScope)(nil).NodeSubnets))
+}
+
+// OutboundLBInboundNATPools mocks base method.
+func (m *MockAKSExtensionScope) OutboundLBInboundNATPools(arg0 string) []v1beta1.InboundNATPool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboundLBInboundNATPools", arg0)
+	ret0, _ := ret[0].([]v1beta1.InboundNATPool)
+	return ret0
+}
+
+// OutboundLBInboundNATPools indicates an expected call of OutboundLBInboundNATPools.
+func (mr *MockAKSExtensionScopeMockRecorder) OutboundLBInboundNATPools(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboundLBInboundNATPools", reflect.TypeOf((*MockAKSExtensionScope)(nil).OutboundLBInboundNATPools), arg0)
+}
+
+// OutboundLBName mocks base method.
+func (m *MockAKSExtensionScope) OutboundLBName(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboundLBName", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// OutboundLBName indicates an expected call of OutboundLBName.
+func (mr *MockAKSExtensionScopeMockRecorder) OutboundLBName(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboundLBName", reflect.TypeOf((*MockAKSExtensionScope)(nil).OutboundLBName), arg0)
+}
+
+// OutboundPoolName mocks base method.
+func (m *MockAKSExtensionScope) OutboundPoolName(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OutboundPoolName", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// OutboundPoolName indicates an expected call of OutboundPoolName.
+func (mr *MockAKSExtensionScopeMockRecorder) OutboundPoolName(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OutboundPoolName", reflect.TypeOf((*MockAKSExtensionScope)(nil).OutboundPoolName), arg0)
+}
+
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockAKSExtensionScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockAKSExtensionScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockAKSExtensionScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
+// ResourceGroup mocks base method.
+func (m *MockAKSExtensionScope) ResourceGroup() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceGroup")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ResourceGroup indicates an expected call of ResourceGroup.
+func (mr *MockAKSExtensionScopeMockRecorder) ResourceGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceGroup", reflect.TypeOf((*MockAKSExtensionScope)(nil).ResourceGroup))
+}
+
+// SetLongRunningOperationState mocks base method.
+func (m *MockAKSExtensionScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLongRunningOperationState", arg0)
+}
+
+// SetLongRunningOperationState indicates an expected call of SetLongRunningOperationState.
+func (mr *MockAKSExtensionScopeMockRecorder) SetLongRunningOperationState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLongRunningOperationState", reflect.TypeOf((*MockAKSExtensionScope)(nil).SetLongRunningOperationState), arg0)
+}
+
+// SetSubnet mocks base method.
+func (m *MockAKSExtensionScope) SetSubnet(arg0 v1beta1.SubnetSpec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnet", arg0)
+}
+
+// SetSubnet indicates an expected call of SetSubnet.
+func (mr *MockAKSExtensionScopeMockRecorder) SetSubnet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnet", reflect.TypeOf((*MockAKSExtensionScope)(nil).SetSubnet), arg0)
+}
+
+// Subnet mocks base method.
+func (m *MockAKSExtensionScope) Subnet(arg0 string) v1beta1.SubnetSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subnet", arg0)
+	ret0, _ := ret[0].(v1beta1.SubnetSpec)
+	return ret0
+}
+
+// Subnet indicates an expected call of Subnet.
+func (mr *MockAKSExtensionScopeMockRecorder) Subnet(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subnet", reflect.TypeOf((*MockAKSExtensionScope)(nil).Subnet), arg0)
+}
+
+// Subnets mocks base method.
+func (m *MockAKSExtensionScope) Subnets() v1beta1.Subnets {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subnets")
+	ret0, _ := ret[0].(v1beta1.Subnets)
+	return ret0
+}
+
+// Subnets indicates an expected call of Subnets.
+func (mr *MockAKSExtensionScopeMockRecorder) Subnets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subnets", reflect.TypeOf((*MockAKSExtensionScope)(nil).Subnets))
+}
+
+// SubscriptionID mocks base method.
+func (m *MockAKSExtensionScope) SubscriptionID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscriptionID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SubscriptionID indicates an expected call of SubscriptionID.
+func (mr *MockAKSExtensionScopeMockRecorder) SubscriptionID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionID", reflect.TypeOf((*MockAKSExtensionScope)(nil).SubscriptionID))
+}
+
+// TenantID mocks base method.
+func (m *MockAKSExtensionScope) TenantID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TenantID indicates an expected call of TenantID.
+func (mr *MockAKSExtensionScopeMockRecorder) TenantID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantID", reflect.TypeOf((*MockAKSExtensionScope)(nil).TenantID))
+}
+
+// Token mocks base method.
+func (m *MockAKSExtensionScope) Token() azcore.TokenCredential {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(azcore.TokenCredential)
+	return ret0
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockAKSExtensionScopeMockRecorder) Token() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockAKSExtensionScope)(nil).Token))
+}
+
+// UpdateDeleteStatus mocks base method.
+func (m *MockAKSExtensionScope) UpdateDeleteStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateDeleteStatus", arg0, arg1, arg2)
+}
+
+// UpdateDeleteStatus indicates an expected call of UpdateDeleteStatus.
+func (mr *MockAKSExtensionScopeMockRecorder) UpdateDeleteStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeleteStatus", reflect.TypeOf((*MockAKSExtensionScope)(nil).UpdateDeleteStatus), arg0, arg1, arg2)
+}
+
+// UpdatePatchStatus mocks base method.
+func (m *MockAKSExtensionScope) UpdatePatchStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePatchStatus", arg0, arg1, arg2)
+}
+
+// UpdatePatchStatus indicates an expected call of UpdatePatchStatus.
+func (mr *MockAKSExtensionScopeMockRecorder) UpdatePatchStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatchStatus", reflect.TypeOf((*MockAKSExtensionScope)(nil).UpdatePatchStatus), arg0, arg1, arg2)
+}
+
+// UpdatePutStatus mocks base method.
+func (m *MockAKSExtensionScope) UpdatePutStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePutStatus", arg0, arg1, arg2)
+}
+
+// UpdatePutStatus indicates an expected call of UpdatePutStatus.
+func (mr *MockAKSExtensionScopeMockRecorder) UpdatePutStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePutStatus", reflect.TypeOf((*MockAKSExtensionScope)(nil).UpdatePutStatus), arg0, arg1, arg2)
+}
+
+// Vnet mocks base method.
+func (m *MockAKSExtensionScope) Vnet() *v1beta1.VnetSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Vnet")
+	ret0, _ := ret[0].(*v1beta1.VnetSpec)
+	return ret0
+}
+
+// Vnet indicates an expected call of Vnet.
+func (mr *MockAKSExtensionScopeMockRecorder) Vnet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Vnet", reflect.TypeOf((*MockAKSExtensionScope)(nil).Vnet))
+}
@@ -19,6 +19,7 @@ package aso
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
@@ -33,6 +34,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/aso"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+	capiannotations "sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -43,12 +45,37 @@ const (
 	// asoannotations.ReconcilePolicy that was set before pausing.
 	prePauseReconcilePolicyAnnotation = "sigs.k8s.io/cluster-api-provider-azure-pre-pause-reconcile-policy"
 
+	// adoptedAnnotation is the annotation key which records that a pre-existing resource listed in the owner's
+	// infrav1.AdoptAnnotation was adopted and is managed by CAPZ.
+	adoptedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-adopted"
+
 	requeueInterval = 20 * time.Second
 
 	createOrUpdateFutureType = "ASOCreateOrUpdate"
 	deleteFutureType         = "ASODelete"
 )
 
+// terminalReadyReasons are the reasons of a Ready condition, i.e. the Azure error codes reported by ASO, which
+// won't resolve without user intervention even though ASO keeps retrying them.
+var terminalReadyReasons = map[string]struct{}{
+	"QuotaExceeded":             {},
+	"ResourceQuotaExceeded":     {},
+	"RequestDisallowedByPolicy": {},
+}
+
+// isTerminalReadyCondition returns true if the Ready condition reports a failure which won't resolve on its own.
+// The configured error code overrides take precedence over the default classification.
+func isTerminalReadyCondition(cond conditions.Condition) bool {
+	if errorType, ok := azure.ErrorCodeOverride(cond.Reason); ok {
+		return errorType == azure.TerminalErrorType
+	}
+	if cond.Severity == conditions.ConditionSeverityError {
+		return true
+	}
+	_, ok := terminalReadyReasons[cond.Reason]
+	return ok
+}
+
 // deepCopier is a genruntime.MetaObject with a typed DeepCopy method, usually generated by kubebuilder.
 type deepCopier[T any] interface {
 	genruntime.MetaObject
@@ -62,10 +89,18 @@ type reconciler[T deepCopier[T]] struct {
 
 	clusterName string
 	owner       client.Object
+	// dryRunner, when set and in dry-run mode, records changes instead of applying them.
+	dryRunner azure.DryRunner
+	// pauseDescriber, when set, reports whether the owner or its Cluster is paused.
+	pauseDescriber azure.PauseDescriber
 }
 
 // New creates a new ASO reconciler.
 func New[T deepCopier[T]](ctrlClient client.Client, clusterName string, owner client.Object) Reconciler[T] {
+	return newReconciler[T](ctrlClient, clusterName, owner)
+}
+
+func newReconciler[T deepCopier[T]](ctrlClient client.Client, clusterName string, owner client.Object) *reconciler[T] {
 	return &reconciler[T]{
 		Client:      ctrlClient,
 		clusterName: clusterName,
@@ -136,11 +171,11 @@ func (r *reconciler[T]) CreateOrUpdateResource(ctx context.Context, spec azure.A
 					Name:          existing.GetName(),
 				})
 			default:
-				readyErr = fmt.Errorf("resource is not Ready: %s", conds[i].Message)
+				readyErr = fmt.Errorf("resource is not Ready: %s: %s", cond.Reason, cond.Message)
 			}
 
 			if readyErr != nil {
-				if conds[i].Severity == conditions.ConditionSeverityError {
+				if isTerminalReadyCondition(cond) {
 					readyErr = azure.WithTerminalError(readyErr)
 				} else {
 					readyErr = azure.WithTransientError(readyErr, requeueInterval)
@@ -194,7 +229,7 @@ func (r *reconciler[T]) CreateOrUpdateResource(ctx context.Context, spec azure.A
 		// annotation to "manage".
 		annotations[asoannotations.ReconcilePolicy] = string(asoannotations.ReconcilePolicySkip)
 	} else {
-		adopt = adopt || spec.WasManaged(existing)
+		adopt = adopt || spec.WasManaged(existing) || existing.GetAnnotations()[adoptedAnnotation] == "true"
 	}
 	if adopt {
 		annotations[asoannotations.ReconcilePolicy] = string(asoannotations.ReconcilePolicyManage)
@@ -213,6 +248,13 @@ func (r *reconciler[T]) CreateOrUpdateResource(ctx context.Context, spec azure.A
 	}
 	parameters.SetAnnotations(annotations)
 
+	if resourceExists && !adopt && r.isAdoptionRequested(existing) {
+		if readyErr != nil {
+			return zero, readyErr
+		}
+		return r.adoptResource(ctx, existing, parameters, serviceName)
+	}
+
 	diff := cmp.Diff(existing, parameters)
 	if diff == "" {
 		if readyErr != nil {
@@ -223,10 +265,87 @@ func (r *reconciler[T]) CreateOrUpdateResource(ctx context.Context, spec azure.A
 		log.V(2).Info("resource up to date")
 		return existing, nil
 	}
-	log.V(2).Info("creating or updating resource", "diff", cmp.Diff(existing, parameters))
+	if r.isPaused() {
+		// Leave the ASO resource as-is so ASO doesn't make any changes in Azure while the owner is paused.
+		log.V(2).Info("owner is paused, skipping create or update")
+		if !resourceExists {
+			return zero, azure.WithTransientError(errors.Errorf("owner is paused, not creating resource %s/%s (service: %s)", resourceNamespace, resourceName, serviceName), requeueInterval)
+		}
+		return existing, nil
+	}
+	if r.isDryRun() {
+		// Leave the ASO resource as-is and only record the change ASO would be asked to make in Azure.
+		log.V(2).Info("dry-run mode, skipping create or update", "diff", diff)
+		if !resourceExists {
+			r.dryRunner.RecordPlannedChange(serviceName, fmt.Sprintf("create resource %s/%s", resourceNamespace, resourceName))
+			// Return the desired resource so dependent resources can still be planned.
+			return parameters, nil
+		}
+		r.dryRunner.RecordPlannedChange(serviceName, fmt.Sprintf("update resource %s/%s: %s", resourceNamespace, resourceName, diff))
+		return existing, nil
+	}
+	log.V(2).Info("creating or updating resource", "diff", diff)
 	return r.createOrUpdateResource(ctx, existing, parameters, resourceExists, serviceName)
 }
 
+// adoptResource records an unmanaged resource as adopted without changing its spec. ASO keeps skipping the
+// resource until the next reconcile, which manages it like a resource created by CAPZ.
+func (r *reconciler[T]) adoptResource(ctx context.Context, existing T, parameters T, serviceName string) (T, error) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "services.aso.adoptResource")
+	defer done()
+
+	var zero T
+	if r.isPaused() {
+		log.V(2).Info("owner is paused, skipping adoption")
+		return existing, nil
+	}
+	if r.isDryRun() {
+		log.V(2).Info("dry-run mode, skipping adoption")
+		r.dryRunner.RecordPlannedChange(serviceName, fmt.Sprintf("adopt resource %s/%s", existing.GetNamespace(), existing.GetName()))
+		return existing, nil
+	}
+
+	adopted := existing.DeepCopy()
+	annotations := adopted.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[adoptedAnnotation] = "true"
+	if lastAppliedTags, ok := parameters.GetAnnotations()[tagsLastAppliedAnnotation]; ok {
+		annotations[tagsLastAppliedAnnotation] = lastAppliedTags
+	}
+	adopted.SetAnnotations(annotations)
+
+	log.V(2).Info("adopting resource")
+	if err := r.Client.Patch(ctx, adopted, client.MergeFrom(existing)); err != nil {
+		return zero, errors.Wrapf(err, "failed to adopt resource %s/%s (service: %s)", existing.GetNamespace(), existing.GetName(), serviceName)
+	}
+	return adopted, nil
+}
+
+// isPaused returns true if the owner or its Cluster is paused. Without a pause describer, only the owner's paused
+// annotation is considered.
+func (r *reconciler[T]) isPaused() bool {
+	if r.pauseDescriber != nil {
+		return r.pauseDescriber.IsPaused()
+	}
+	return capiannotations.HasPaused(r.owner)
+}
+
+// isAdoptionRequested returns true if the owner's infrav1.AdoptAnnotation lists the Azure resource ID of the resource.
+func (r *reconciler[T]) isAdoptionRequested(resource T) bool {
+	resourceID := resource.GetAnnotations()[genruntime.ResourceIDAnnotation]
+	if resourceID == "" {
+		return false
+	}
+	for _, id := range strings.Split(r.owner.GetAnnotations()[infrav1.AdoptAnnotation], ",") {
+		if strings.EqualFold(strings.TrimSpace(id), resourceID) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *reconciler[T]) createOrUpdateResource(ctx context.Context, existing T, parameters T, resourceExists bool, serviceName string) (T, error) {
 	var zero T
 	var err error
@@ -274,6 +393,12 @@ func (r *reconciler[T]) DeleteResource(ctx context.Context, resource T, serviceN
 		return nil
 	}
 
+	if r.isDryRun() {
+		log.V(2).Info("dry-run mode, skipping delete")
+		r.dryRunner.RecordPlannedChange(serviceName, fmt.Sprintf("delete resource %s/%s", resourceNamespace, resourceName))
+		return nil
+	}
+
 	log.V(2).Info("deleting resource")
 	err = r.Client.Delete(ctx, resource)
 	if err != nil {
@@ -292,6 +417,11 @@ func (r *reconciler[T]) DeleteResource(ctx context.Context, resource T, serviceN
 	}), requeueInterval)
 }
 
+// isDryRun returns true if changes should only be recorded instead of applied.
+func (r *reconciler[T]) isDryRun() bool {
+	return r.dryRunner != nil && r.dryRunner.IsDryRun()
+}
+
 // IsManaged returns whether the ASO resource referred to by spec was created by
 // CAPZ and therefore whether CAPZ should manage its lifecycle.
 func IsManaged[T genruntime.MetaObject](ctx context.Context, ctrlClient client.Client, resource T, owner client.Object) (bool, error) {
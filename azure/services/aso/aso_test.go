@@ -23,9 +23,11 @@ import (
 
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
 	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -35,6 +37,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso/mock_aso"
 	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -69,6 +72,19 @@ func (e ErroringDeleteClient) Delete(_ context.Context, _ client.Object, _ ...cl
 	return e.err
 }
 
+// fakeDryRunner is an azure.DryRunner in dry-run mode which records planned changes.
+type fakeDryRunner struct {
+	changes []string
+}
+
+func (*fakeDryRunner) IsDryRun() bool {
+	return true
+}
+
+func (d *fakeDryRunner) RecordPlannedChange(serviceName, change string) {
+	d.changes = append(d.changes, serviceName+": "+change)
+}
+
 func newOwner() *asoresourcesv1.ResourceGroup {
 	return &asoresourcesv1.ResourceGroup{
 		ObjectMeta: metav1.ObjectMeta{
@@ -333,6 +349,61 @@ func TestCreateOrUpdateResource(t *testing.T) {
 		g.Expect(recerr.IsTransient()).To(BeFalse())
 	})
 
+	t.Run("resource is not ready because a quota was exceeded", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		s := New[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+
+		mockCtrl := gomock.NewController(t)
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Not(gomock.Nil())).DoAndReturn(func(_ context.Context, group *asoresourcesv1.ResourceGroup) (*asoresourcesv1.ResourceGroup, error) {
+			return group, nil
+		})
+		specMock.EXPECT().WasManaged(gomock.Any()).Return(false)
+
+		ctx := context.Background()
+		g.Expect(c.Create(ctx, &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+				Annotations: map[string]string{
+					asoannotations.PerResourceSecret: "cluster-aso-secret",
+				},
+			},
+			Status: asoresourcesv1.ResourceGroup_STATUS{
+				Conditions: []conditions.Condition{
+					{
+						Type:     conditions.ConditionTypeReady,
+						Status:   metav1.ConditionFalse,
+						Severity: conditions.ConditionSeverityWarning,
+						Reason:   "QuotaExceeded",
+						Message:  "Operation results in exceeding quota limits of Core.",
+					},
+				},
+			},
+		})).To(Succeed())
+
+		result, err := s.CreateOrUpdateResource(ctx, specMock, "service")
+		g.Expect(result).To(BeNil())
+		g.Expect(err).NotTo(BeNil())
+		g.Expect(err.Error()).To(ContainSubstring("resource is not Ready: QuotaExceeded: Operation results in exceeding quota limits of Core."))
+		var recerr azure.ReconcileError
+		g.Expect(errors.As(err, &recerr)).To(BeTrue())
+		g.Expect(recerr.IsTerminal()).To(BeTrue())
+		g.Expect(recerr.IsTransient()).To(BeFalse())
+	})
+
 	t.Run("error getting existing resource", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 
@@ -403,6 +474,244 @@ func TestCreateOrUpdateResource(t *testing.T) {
 		g.Expect(err).NotTo(BeNil())
 	})
 
+	t.Run("skip create when owner is paused", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		owner := newOwner()
+		owner.Annotations = map[string]string{clusterv1.PausedAnnotation: "true"}
+		s := New[*asoresourcesv1.ResourceGroup](c, clusterName, owner)
+
+		mockCtrl := gomock.NewController(t)
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Nil()).Return(&asoresourcesv1.ResourceGroup{
+			Spec: asoresourcesv1.ResourceGroup_Spec{
+				Location: ptr.To("location"),
+			},
+		}, nil)
+
+		ctx := context.Background()
+		result, err := s.CreateOrUpdateResource(ctx, specMock, "service")
+		g.Expect(result).To(BeNil())
+		g.Expect(err).To(MatchError(ContainSubstring("owner is paused")))
+		var recerr azure.ReconcileError
+		g.Expect(errors.As(err, &recerr)).To(BeTrue())
+		g.Expect(recerr.IsTransient()).To(BeTrue())
+
+		err = c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, &asoresourcesv1.ResourceGroup{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	t.Run("skip update when owner is paused", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		owner := newOwner()
+		owner.Annotations = map[string]string{clusterv1.PausedAnnotation: "true"}
+		s := New[*asoresourcesv1.ResourceGroup](c, clusterName, owner)
+
+		mockCtrl := gomock.NewController(t)
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Not(gomock.Nil())).DoAndReturn(func(_ context.Context, group *asoresourcesv1.ResourceGroup) (*asoresourcesv1.ResourceGroup, error) {
+			group.Spec.Location = ptr.To("location")
+			return group, nil
+		})
+		specMock.EXPECT().WasManaged(gomock.Any()).Return(false)
+
+		ctx := context.Background()
+		g.Expect(c.Create(ctx, &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+			},
+			Status: asoresourcesv1.ResourceGroup_STATUS{
+				Conditions: []conditions.Condition{
+					{
+						Type:   conditions.ConditionTypeReady,
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		})).To(Succeed())
+
+		result, err := s.CreateOrUpdateResource(ctx, specMock, "service")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(result).NotTo(BeNil())
+		g.Expect(result.Spec.Location).To(BeNil())
+
+		existing := &asoresourcesv1.ResourceGroup{}
+		g.Expect(c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, existing)).To(Succeed())
+		g.Expect(existing.Spec.Location).To(BeNil())
+		g.Expect(existing.Annotations).To(BeNil())
+	})
+
+	t.Run("skip update when the cluster is paused", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		s := newReconciler[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+
+		mockCtrl := gomock.NewController(t)
+		pauseMock := mock_azure.NewMockPauseDescriber(mockCtrl)
+		pauseMock.EXPECT().IsPaused().Return(true)
+		s.pauseDescriber = pauseMock
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Not(gomock.Nil())).DoAndReturn(func(_ context.Context, group *asoresourcesv1.ResourceGroup) (*asoresourcesv1.ResourceGroup, error) {
+			group.Spec.Location = ptr.To("location")
+			return group, nil
+		})
+		specMock.EXPECT().WasManaged(gomock.Any()).Return(false)
+
+		ctx := context.Background()
+		g.Expect(c.Create(ctx, &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+			},
+			Status: asoresourcesv1.ResourceGroup_STATUS{
+				Conditions: []conditions.Condition{
+					{
+						Type:   conditions.ConditionTypeReady,
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		})).To(Succeed())
+
+		result, err := s.CreateOrUpdateResource(ctx, specMock, "service")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(result).NotTo(BeNil())
+		g.Expect(result.Spec.Location).To(BeNil())
+
+		existing := &asoresourcesv1.ResourceGroup{}
+		g.Expect(c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, existing)).To(Succeed())
+		g.Expect(existing.Spec.Location).To(BeNil())
+	})
+
+	t.Run("skip adoption when the cluster is paused", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		resourceID := "/subscriptions/123/resourceGroups/name"
+		owner := newOwner()
+		owner.Annotations = map[string]string{infrav1.AdoptAnnotation: resourceID}
+		s := newReconciler[*asoresourcesv1.ResourceGroup](c, clusterName, owner)
+
+		mockCtrl := gomock.NewController(t)
+		pauseMock := mock_azure.NewMockPauseDescriber(mockCtrl)
+		pauseMock.EXPECT().IsPaused().Return(true)
+		s.pauseDescriber = pauseMock
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Not(gomock.Nil())).DoAndReturn(func(_ context.Context, group *asoresourcesv1.ResourceGroup) (*asoresourcesv1.ResourceGroup, error) {
+			return group, nil
+		})
+		specMock.EXPECT().WasManaged(gomock.Any()).Return(false)
+
+		ctx := context.Background()
+		g.Expect(c.Create(ctx, &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+				Annotations: map[string]string{
+					asoannotations.ReconcilePolicy:  string(asoannotations.ReconcilePolicySkip),
+					genruntime.ResourceIDAnnotation: resourceID,
+				},
+			},
+			Status: asoresourcesv1.ResourceGroup_STATUS{
+				Conditions: []conditions.Condition{
+					{
+						Type:   conditions.ConditionTypeReady,
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		})).To(Succeed())
+
+		result, err := s.CreateOrUpdateResource(ctx, specMock, "service")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(result).NotTo(BeNil())
+
+		existing := &asoresourcesv1.ResourceGroup{}
+		g.Expect(c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, existing)).To(Succeed())
+		g.Expect(existing.Annotations).NotTo(HaveKey(adoptedAnnotation))
+		g.Expect(existing.Annotations).To(HaveKeyWithValue(asoannotations.ReconcilePolicy, string(asoannotations.ReconcilePolicySkip)))
+	})
+
+	t.Run("skip create in dry-run mode", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		dryRunner := &fakeDryRunner{}
+		s := newReconciler[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+		s.dryRunner = dryRunner
+
+		mockCtrl := gomock.NewController(t)
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Nil()).Return(&asoresourcesv1.ResourceGroup{
+			Spec: asoresourcesv1.ResourceGroup_Spec{
+				Location: ptr.To("location"),
+			},
+		}, nil)
+
+		ctx := context.Background()
+		result, err := s.CreateOrUpdateResource(ctx, specMock, "service")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(result).NotTo(BeNil())
+		g.Expect(result.Spec.Location).To(Equal(ptr.To("location")))
+		g.Expect(dryRunner.changes).To(ConsistOf("service: create resource namespace/name"))
+
+		err = c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, &asoresourcesv1.ResourceGroup{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
 	t.Run("adopt managed resource in not found state", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 
@@ -1040,6 +1349,34 @@ func TestDeleteResource(t *testing.T) {
 		g.Expect(recerr.IsTransient()).To(BeTrue())
 	})
 
+	t.Run("skip delete in dry-run mode", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		dryRunner := &fakeDryRunner{}
+		s := newReconciler[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+		s.dryRunner = dryRunner
+
+		ctx := context.Background()
+		resource := &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+			},
+		}
+		g.Expect(c.Create(ctx, resource)).To(Succeed())
+
+		err := s.DeleteResource(ctx, resource, "service")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(dryRunner.changes).To(ConsistOf("service: delete resource namespace/name"))
+		g.Expect(c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, &asoresourcesv1.ResourceGroup{})).To(Succeed())
+	})
+
 	t.Run("skip delete for unmanaged resource", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 
@@ -1315,3 +1652,24 @@ func TestPauseResource(t *testing.T) {
 		})
 	}
 }
+
+// TestIsTerminalReadyCondition doesn't run in parallel since the error code overrides are configured globally.
+func TestIsTerminalReadyCondition(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	quotaExceeded := conditions.Condition{Reason: "QuotaExceeded", Severity: conditions.ConditionSeverityWarning}
+	internalError := conditions.Condition{Reason: "InternalServerError", Severity: conditions.ConditionSeverityWarning}
+	g.Expect(isTerminalReadyCondition(quotaExceeded)).To(BeTrue())
+	g.Expect(isTerminalReadyCondition(internalError)).To(BeFalse())
+
+	overrides, err := azure.ParseErrorCodeOverrides(map[string]string{
+		"QuotaExceeded":       "Transient",
+		"InternalServerError": "Terminal",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	azure.SetErrorCodeOverrides(overrides)
+	defer azure.SetErrorCodeOverrides(nil)
+
+	g.Expect(isTerminalReadyCondition(quotaExceeded)).To(BeFalse())
+	g.Expect(isTerminalReadyCondition(internalError)).To(BeTrue())
+}
@@ -218,6 +218,34 @@ func (mr *MockScopeMockRecorder) ASOOwner() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ASOOwner", reflect.TypeOf((*MockScope)(nil).ASOOwner))
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
 // ClusterName mocks base method.
 func (m *MockScope) ClusterName() string {
 	m.ctrl.T.Helper()
@@ -246,20 +274,6 @@ func (mr *MockScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockScope)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockScope) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockScopeMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockScope)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockScope) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -314,6 +328,18 @@ func (mr *MockScopeMockRecorder) GetLongRunningOperationState(arg0, arg1, arg2 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongRunningOperationState", reflect.TypeOf((*MockScope)(nil).GetLongRunningOperationState), arg0, arg1, arg2)
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
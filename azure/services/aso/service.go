@@ -33,6 +33,7 @@ type Service[T deepCopier[T], S Scope] struct {
 	Specs []azure.ASOResourceSpecGetter[T]
 
 	ConditionType                  clusterv1.ConditionType
+	PreReconcileHook               func(ctx context.Context, scope S) error
 	PostCreateOrUpdateResourceHook func(ctx context.Context, scope S, result T, err error) error
 	PostReconcileHook              func(ctx context.Context, scope S, err error) error
 	PostDeleteHook                 func(ctx context.Context, scope S, err error) error
@@ -42,8 +43,15 @@ type Service[T deepCopier[T], S Scope] struct {
 
 // NewService creates a new Service.
 func NewService[T deepCopier[T], S Scope](name string, scope S) *Service[T, S] {
+	reconciler := newReconciler[T](scope.GetClient(), scope.ClusterName(), scope.ASOOwner())
+	if d, ok := any(scope).(azure.DryRunner); ok {
+		reconciler.dryRunner = d
+	}
+	if p, ok := any(scope).(azure.PauseDescriber); ok {
+		reconciler.pauseDescriber = p
+	}
 	return &Service[T, S]{
-		Reconciler: New[T](scope.GetClient(), scope.ClusterName(), scope.ASOOwner()),
+		Reconciler: reconciler,
 		Scope:      scope,
 		name:       name,
 	}
@@ -59,13 +67,20 @@ func (s *Service[T, S]) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "aso.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(s.Name()))
 	defer cancel()
 
 	if len(s.Specs) == 0 {
 		return nil
 	}
 
+	if s.PreReconcileHook != nil {
+		if err := s.PreReconcileHook(ctx, s.Scope); err != nil {
+			s.Scope.UpdatePutStatus(s.ConditionType, s.Name(), err)
+			return err
+		}
+	}
+
 	// We go through the list of Specs to reconcile each one, independently of the result of the previous one.
 	// If multiple errors occur, we return the most pressing one.
 	// Order of precedence (highest -> lowest) is:
@@ -95,7 +110,7 @@ func (s *Service[T, S]) Delete(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "aso.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(s.Name()))
 	defer cancel()
 
 	if len(s.Specs) == 0 {
@@ -46,7 +46,7 @@ func TestServiceReconcile(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		scope := mock_aso.NewMockScope(mockCtrl)
 		reconciler := mock_aso.NewMockReconciler[*asoresourcesv1.ResourceGroup](mockCtrl)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -77,7 +77,7 @@ func TestServiceReconcile(t *testing.T) {
 		reconciler := mock_aso.NewMockReconciler[*asoresourcesv1.ResourceGroup](mockCtrl)
 		reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), specs[0], serviceName).Return(nil, reconcileErr)
 		scope.EXPECT().UpdatePutStatus(conditionType, serviceName, reconcileErr)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -108,7 +108,7 @@ func TestServiceReconcile(t *testing.T) {
 		reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), specs[1], serviceName).Return(nil, nil)
 		reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), specs[2], serviceName).Return(nil, nil)
 		scope.EXPECT().UpdatePutStatus(conditionType, serviceName, nil)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -140,7 +140,7 @@ func TestServiceReconcile(t *testing.T) {
 		reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), specs[1], serviceName).Return(nil, reconcileErr)
 		reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), specs[2], serviceName).Return(nil, nil)
 		scope.EXPECT().UpdatePutStatus(conditionType, serviceName, reconcileErr)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -172,7 +172,7 @@ func TestServiceReconcile(t *testing.T) {
 		reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), specs[1], serviceName).Return(nil, reconcileErr)
 		reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), specs[2], serviceName).Return(nil, azure.NewOperationNotDoneError(&infrav1.Future{}))
 		scope.EXPECT().UpdatePutStatus(conditionType, serviceName, reconcileErr)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -186,6 +186,40 @@ func TestServiceReconcile(t *testing.T) {
 		g.Expect(err).To(MatchError(reconcileErr))
 	})
 
+	t.Run("PreReconcileHook returns error", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		mockCtrl := gomock.NewController(t)
+
+		scope := mock_aso.NewMockScope(mockCtrl)
+		specs := []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]{
+			mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl),
+		}
+
+		preReconcileErr := errors.New("PreReconcile error")
+		reconciler := mock_aso.NewMockReconciler[*asoresourcesv1.ResourceGroup](mockCtrl)
+		scope.EXPECT().UpdatePutStatus(conditionType, serviceName, preReconcileErr)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+
+		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
+			Reconciler:    reconciler,
+			Scope:         scope,
+			Specs:         specs,
+			name:          serviceName,
+			ConditionType: conditionType,
+			PreReconcileHook: func(_ context.Context, scopeParam *mock_aso.MockScope) error {
+				g.Expect(scopeParam).To(BeIdenticalTo(scope))
+				return preReconcileErr
+			},
+			PostReconcileHook: func(_ context.Context, _ *mock_aso.MockScope, _ error) error {
+				return errors.New("hook should not be called")
+			},
+		}
+
+		err := s.Reconcile(context.Background())
+		g.Expect(err).To(MatchError(preReconcileErr))
+	})
+
 	t.Run("CreateOrUpdateResource returns error and runs PostCreateOrUpdateResourceHook and PostReconcileHook", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 
@@ -206,7 +240,7 @@ func TestServiceReconcile(t *testing.T) {
 			},
 		}, reconcileErr)
 		scope.EXPECT().UpdatePutStatus(conditionType, serviceName, postReconcileErr)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -239,7 +273,7 @@ func TestServiceDelete(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		scope := mock_aso.NewMockScope(mockCtrl)
 		reconciler := mock_aso.NewMockReconciler[*asoresourcesv1.ResourceGroup](mockCtrl)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -270,7 +304,7 @@ func TestServiceDelete(t *testing.T) {
 		reconciler := mock_aso.NewMockReconciler[*asoresourcesv1.ResourceGroup](mockCtrl)
 		reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[0].ResourceRef(), serviceName).Return(deleteErr)
 		scope.EXPECT().UpdateDeleteStatus(conditionType, serviceName, deleteErr)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -301,7 +335,7 @@ func TestServiceDelete(t *testing.T) {
 		reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[1].ResourceRef(), serviceName).Return(nil)
 		reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[2].ResourceRef(), serviceName).Return(nil)
 		scope.EXPECT().UpdateDeleteStatus(conditionType, serviceName, nil)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -333,7 +367,7 @@ func TestServiceDelete(t *testing.T) {
 		reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[1].ResourceRef(), serviceName).Return(deleteErr)
 		reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[2].ResourceRef(), serviceName).Return(nil)
 		scope.EXPECT().UpdateDeleteStatus(conditionType, serviceName, deleteErr)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -365,7 +399,7 @@ func TestServiceDelete(t *testing.T) {
 		reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[1].ResourceRef(), serviceName).Return(deleteErr)
 		reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[2].ResourceRef(), serviceName).Return(azure.NewOperationNotDoneError(&infrav1.Future{}))
 		scope.EXPECT().UpdateDeleteStatus(conditionType, serviceName, deleteErr)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -394,7 +428,7 @@ func TestServiceDelete(t *testing.T) {
 		reconciler := mock_aso.NewMockReconciler[*asoresourcesv1.ResourceGroup](mockCtrl)
 		reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[0].ResourceRef(), serviceName).Return(deleteErr)
 		scope.EXPECT().UpdateDeleteStatus(conditionType, serviceName, postErr)
-		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+		scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
 
 		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
 			Reconciler:    reconciler,
@@ -18,11 +18,9 @@ package async
 
 import (
 	"context"
-	"net/http"
-	"strconv"
+	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/pkg/errors"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -93,6 +91,27 @@ func (s *Service[C, D]) CreateOrUpdateResource(ctx context.Context, spec azure.R
 		} else if parameters == nil {
 			// Nothing to do, don't create or update the resource and return the existing resource.
 			log.V(2).Info("resource up to date", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+			s.Scope.ResetReconcilerRequeueBackoff(serviceName, backoffKey(rgName, resourceName))
+			return existingResource, nil
+		}
+
+		// Don't make any changes in Azure while reconciliation of the owner is paused.
+		if isPaused(s.Scope) {
+			log.V(2).Info("reconciliation is paused, skipping create or update", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+			if existingResource == nil {
+				return nil, azure.WithTransientError(errors.Errorf("reconciliation is paused, not creating resource %s/%s (service: %s)", rgName, resourceName, serviceName), requeueTime(s.Scope))
+			}
+			return existingResource, nil
+		}
+
+		// Only record the change without applying it when the owner is reconciled in dry-run mode.
+		if d, ok := dryRunner(s.Scope); ok {
+			log.V(2).Info("dry-run mode, skipping create or update", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+			if existingResource == nil {
+				d.RecordPlannedChange(serviceName, fmt.Sprintf("create resource %s/%s", rgName, resourceName))
+			} else {
+				d.RecordPlannedChange(serviceName, fmt.Sprintf("update resource %s/%s", rgName, resourceName))
+			}
 			return existingResource, nil
 		}
 
@@ -112,7 +131,7 @@ func (s *Service[C, D]) CreateOrUpdateResource(ctx context.Context, spec azure.R
 			return nil, errWrapped
 		}
 		s.Scope.SetLongRunningOperationState(future)
-		return nil, azure.WithTransientError(azure.NewOperationNotDoneError(future), requeueTime(s.Scope))
+		return nil, azure.WithTransientError(azure.NewOperationNotDoneError(future), s.Scope.BackoffReconcilerRequeue(serviceName, backoffKey(rgName, resourceName)))
 	}
 
 	// Once the operation is done, delete the long-running operation state. Even if the operation ended with
@@ -120,10 +139,11 @@ func (s *Service[C, D]) CreateOrUpdateResource(ctx context.Context, spec azure.R
 	s.Scope.DeleteLongRunningOperationState(resourceName, serviceName, futureType)
 
 	if err != nil {
-		return nil, errWrapped
+		return nil, s.classifyError(err, errWrapped, serviceName, rgName, resourceName)
 	}
 
 	log.V(2).Info("successfully created or updated resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+	s.Scope.ResetReconcilerRequeueBackoff(serviceName, backoffKey(rgName, resourceName))
 	return result, nil
 }
 
@@ -147,6 +167,13 @@ func (s *Service[C, D]) DeleteResource(ctx context.Context, spec azure.ResourceS
 		resumeToken = t
 	}
 
+	// Only record the deletion without executing it when the owner is reconciled in dry-run mode.
+	if d, ok := dryRunner(s.Scope); ok {
+		log.V(2).Info("dry-run mode, skipping delete", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+		d.RecordPlannedChange(serviceName, fmt.Sprintf("delete resource %s/%s", rgName, resourceName))
+		return nil
+	}
+
 	// Delete the resource.
 	log.V(2).Info("deleting resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
 	poller, err := s.Deleter.DeleteAsync(ctx, spec, resumeToken)
@@ -156,7 +183,7 @@ func (s *Service[C, D]) DeleteResource(ctx context.Context, spec azure.ResourceS
 			return errors.Wrap(err, "failed to convert poller to future")
 		}
 		s.Scope.SetLongRunningOperationState(future)
-		return azure.WithTransientError(azure.NewOperationNotDoneError(future), requeueTime(s.Scope))
+		return azure.WithTransientError(azure.NewOperationNotDoneError(future), s.Scope.BackoffReconcilerRequeue(serviceName, backoffKey(rgName, resourceName)))
 	}
 
 	// Once the operation is done, delete the long-running operation state. Even if the operation ended with
@@ -164,13 +191,53 @@ func (s *Service[C, D]) DeleteResource(ctx context.Context, spec azure.ResourceS
 	s.Scope.DeleteLongRunningOperationState(resourceName, serviceName, futureType)
 
 	if err != nil && !azure.ResourceNotFound(err) {
-		return errors.Wrapf(err, "failed to delete resource %s/%s (service: %s)", rgName, resourceName, serviceName)
+		errWrapped := errors.Wrapf(err, "failed to delete resource %s/%s (service: %s)", rgName, resourceName, serviceName)
+		return s.classifyError(err, errWrapped, serviceName, rgName, resourceName)
 	}
 
 	log.V(2).Info("successfully deleted resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+	s.Scope.ResetReconcilerRequeueBackoff(serviceName, backoffKey(rgName, resourceName))
 	return nil
 }
 
+// classifyError classifies the error of a failed create, update or delete operation as transient or terminal.
+// An error whose Azure error code matches one of the configured error code overrides is classified accordingly.
+// Otherwise, throttling and timed out operations are transient, and any other error is left unclassified.
+// The requeue backoff of the resource only keeps growing while its operations keep failing transiently.
+func (s *Service[C, D]) classifyError(err, errWrapped error, serviceName, rgName, resourceName string) error {
+	if errorType, ok := azure.ErrorCodeOverride(azure.ErrorCode(err)); ok {
+		if errorType == azure.TerminalErrorType {
+			s.Scope.ResetReconcilerRequeueBackoff(serviceName, backoffKey(rgName, resourceName))
+			return azure.WithTerminalError(errWrapped)
+		}
+		return azure.WithTransientError(errWrapped, retryRequeueTime(s.Scope, err, serviceName, rgName, resourceName))
+	}
+	if azure.IsThrottled(err) {
+		return azure.WithTransientError(errWrapped, retryRequeueTime(s.Scope, err, serviceName, rgName, resourceName))
+	}
+	if azure.IsContextDeadlineExceededOrCanceledError(err) {
+		// The service reconcile timed out before the operation was started, retry it with the next reconcile.
+		return azure.WithTransientError(errWrapped, s.Scope.BackoffReconcilerRequeue(serviceName, backoffKey(rgName, resourceName)))
+	}
+	s.Scope.ResetReconcilerRequeueBackoff(serviceName, backoffKey(rgName, resourceName))
+	return errWrapped
+}
+
+// isPaused returns true if the scope reports that reconciliation of its owner is paused.
+func isPaused(scope FutureScope) bool {
+	p, ok := scope.(azure.PauseDescriber)
+	return ok && p.IsPaused()
+}
+
+// dryRunner returns the scope as an azure.DryRunner if it reports that its owner is reconciled in dry-run mode.
+func dryRunner(scope FutureScope) (azure.DryRunner, bool) {
+	d, ok := scope.(azure.DryRunner)
+	if !ok || !d.IsDryRun() {
+		return nil, false
+	}
+	return d, true
+}
+
 // requeueTime returns the time to wait before requeuing a reconciliation.
 // It would be ideal to use the "retry-after" header from the API response, but
 // that is not readily accessible in the SDK v2 Poller framework.
@@ -178,28 +245,32 @@ func requeueTime(timeouts azure.AsyncReconciler) time.Duration {
 	return timeouts.DefaultedReconcilerRequeue()
 }
 
+// backoffKey identifies a resource when tracking its requeue backoff.
+func backoffKey(rgName, resourceName string) string {
+	return rgName + "/" + resourceName
+}
+
 // getRetryAfterFromError returns the time.Duration from the http.Response in the azcore.ResponseError.
 // If there is no Response object, or if there is no meaningful Retry-After header data, it returns a default.
 func getRetryAfterFromError(err error) time.Duration {
+	// If we have Retry-After HTTP header data for any reason, prefer it
+	if retryAfter, ok := azure.RetryAfter(err); ok {
+		return retryAfter
+	}
+	// If we didn't find Retry-After HTTP header data but the response type is 429,
+	// we'll have to come up with our sane default.
+	if azure.IsThrottled(err) {
+		return reconciler.DefaultHTTP429RetryAfter
+	}
 	// In case we aren't able to introspect Retry-After from the error type, we'll return this default
-	ret := reconciler.DefaultReconcilerRequeue
-	var responseError *azcore.ResponseError
-	// if we have a strongly typed azcore.ResponseError then we can introspect the HTTP response data
-	if errors.As(err, &responseError) && responseError.RawResponse != nil {
-		// If we have Retry-After HTTP header data for any reason, prefer it
-		if retryAfter := responseError.RawResponse.Header.Get("Retry-After"); retryAfter != "" {
-			// This handles the case where Retry-After data is in the form of units of seconds
-			if rai, err := strconv.Atoi(retryAfter); err == nil {
-				ret = time.Duration(rai) * time.Second
-				// This handles the case where Retry-After data is in the form of absolute time
-			} else if t, err := time.Parse(time.RFC1123, retryAfter); err == nil {
-				ret = time.Until(t)
-			}
-			// If we didn't find Retry-After HTTP header data but the response type is 429,
-			// we'll have to come up with our sane default.
-		} else if responseError.RawResponse.StatusCode == http.StatusTooManyRequests {
-			ret = reconciler.DefaultHTTP429RetryAfter
-		}
+	return reconciler.DefaultReconcilerRequeue
+}
+
+// retryRequeueTime returns the time to wait before retrying a request for a resource which failed transiently,
+// honoring the Retry-After header of the response and falling back to the requeue backoff of the resource.
+func retryRequeueTime(timeouts azure.AsyncReconciler, err error, serviceName, rgName, resourceName string) time.Duration {
+	if retryAfter, ok := azure.RetryAfter(err); ok {
+		return retryAfter
 	}
-	return ret
+	return timeouts.BackoffReconcilerRequeue(serviceName, backoffKey(rgName, resourceName))
 }
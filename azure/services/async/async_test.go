@@ -26,6 +26,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
@@ -40,13 +41,59 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 )
 
+// throttledError returns a 429 response error with the given Retry-After header, if any.
+func throttledError(retryAfter string) error {
+	header := http.Header{}
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	return &azcore.ResponseError{
+		StatusCode: http.StatusTooManyRequests,
+		RawResponse: &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     header,
+			Request:    &http.Request{Method: http.MethodPut, URL: &url.URL{}},
+			Body:       io.NopCloser(strings.NewReader("")),
+		},
+	}
+}
+
+// pausedFutureScope is a FutureScope whose owner is paused.
+type pausedFutureScope struct {
+	FutureScope
+}
+
+func (pausedFutureScope) IsPaused() bool {
+	return true
+}
+
+// dryRunFutureScope is a FutureScope whose owner is reconciled in dry-run mode.
+type dryRunFutureScope struct {
+	FutureScope
+	changes *[]string
+}
+
+func (dryRunFutureScope) IsDryRun() bool {
+	return true
+}
+
+func (s dryRunFutureScope) RecordPlannedChange(serviceName, change string) {
+	*s.changes = append(*s.changes, serviceName+": "+change)
+}
+
 func TestServiceCreateOrUpdateResource(t *testing.T) {
 	testcases := []struct {
 		name           string
 		serviceName    string
+		paused         bool
+		dryRun         bool
 		expectedError  string
 		expectedResult interface{}
-		expect         func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder)
+		// expectedPlannedChanges is checked when dryRun is set.
+		expectedPlannedChanges []string
+		// expectedRequeue is checked when set and the error is a transient ReconcileError.
+		expectedRequeue time.Duration
+		expect          func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder)
 	}{
 		{
 			name:          "invalid future",
@@ -73,6 +120,7 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(validPutFuture),
 					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), resumeToken, gomock.Any()).Return(fakeResource, nil, nil),
 					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture),
+					s.ResetReconcilerRequeueBackoff(serviceName, resourceGroupName+"/"+resourceName),
 				)
 			},
 		},
@@ -87,7 +135,7 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(validPutFuture),
 					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), resumeToken, gomock.Any()).Return(nil, fakePoller[MockCreator](g, http.StatusAccepted), context.DeadlineExceeded),
 					s.SetLongRunningOperationState(gomock.AssignableToTypeOf(&infrav1.Future{})),
-					s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue),
+					s.BackoffReconcilerRequeue(serviceName, resourceGroupName+"/"+resourceName).Return(reconciler.DefaultReconcilerRequeue),
 				)
 			},
 		},
@@ -102,6 +150,54 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(validPutFuture),
 					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), resumeToken, gomock.Any()).Return(nil, fakePoller[MockCreator](g, http.StatusAccepted), errors.New("foo")),
 					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture),
+					s.ResetReconcilerRequeueBackoff(serviceName, resourceGroupName+"/"+resourceName),
+				)
+			},
+		},
+		{
+			name:            "operation throttled with Retry-After",
+			serviceName:     serviceName,
+			expectedError:   "failed to create or update resource mock-resourcegroup/mock-resource (service: mock-service)",
+			expectedRequeue: 30 * time.Second,
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(validPutFuture),
+					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), resumeToken, gomock.Any()).Return(nil, nil, throttledError("30")),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture),
+				)
+			},
+		},
+		{
+			name:            "operation throttled without Retry-After",
+			serviceName:     serviceName,
+			expectedError:   "failed to create or update resource mock-resourcegroup/mock-resource (service: mock-service)",
+			expectedRequeue: 42 * time.Second,
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(validPutFuture),
+					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), resumeToken, gomock.Any()).Return(nil, nil, throttledError("")),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture),
+					s.BackoffReconcilerRequeue(serviceName, resourceGroupName+"/"+resourceName).Return(42*time.Second),
+				)
+			},
+		},
+		{
+			name:            "operation timed out before it was started",
+			serviceName:     serviceName,
+			expectedError:   "failed to create or update resource mock-resourcegroup/mock-resource (service: mock-service): context deadline exceeded",
+			expectedRequeue: 42 * time.Second,
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(validPutFuture),
+					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), resumeToken, gomock.Any()).Return(nil, nil, context.DeadlineExceeded),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture),
+					s.BackoffReconcilerRequeue(serviceName, resourceGroupName+"/"+resourceName).Return(42*time.Second),
 				)
 			},
 		},
@@ -118,7 +214,7 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 					r.Parameters(gomockinternal.AContext(), nil).Return(fakeParameters, nil),
 					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), "", gomock.Any()).Return(nil, fakePoller[MockCreator](g, http.StatusAccepted), context.DeadlineExceeded),
 					s.SetLongRunningOperationState(gomock.AssignableToTypeOf(&infrav1.Future{})),
-					s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue),
+					s.BackoffReconcilerRequeue(serviceName, resourceGroupName+"/"+resourceName).Return(reconciler.DefaultReconcilerRequeue),
 				)
 			},
 		},
@@ -147,6 +243,7 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
 					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(fakeResource, nil),
 					r.Parameters(gomockinternal.AContext(), fakeResource).Return(nil, nil),
+					s.ResetReconcilerRequeueBackoff(serviceName, resourceGroupName+"/"+resourceName),
 				)
 			},
 		},
@@ -165,6 +262,71 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:           "paused: existing resource is not updated",
+			serviceName:    serviceName,
+			paused:         true,
+			expectedError:  "",
+			expectedResult: fakeResource,
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(fakeResource, nil),
+					r.Parameters(gomockinternal.AContext(), fakeResource).Return(fakeParameters, nil),
+				)
+			},
+		},
+		{
+			name:                   "dry run: resource is not created",
+			serviceName:            serviceName,
+			dryRun:                 true,
+			expectedError:          "",
+			expectedPlannedChanges: []string{"mock-service: create resource mock-resourcegroup/mock-resource"},
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}),
+					r.Parameters(gomockinternal.AContext(), nil).Return(fakeParameters, nil),
+				)
+			},
+		},
+		{
+			name:                   "dry run: existing resource is not updated",
+			serviceName:            serviceName,
+			dryRun:                 true,
+			expectedError:          "",
+			expectedResult:         fakeResource,
+			expectedPlannedChanges: []string{"mock-service: update resource mock-resourcegroup/mock-resource"},
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(fakeResource, nil),
+					r.Parameters(gomockinternal.AContext(), fakeResource).Return(fakeParameters, nil),
+				)
+			},
+		},
+		{
+			name:          "paused: resource is not created",
+			serviceName:   serviceName,
+			paused:        true,
+			expectedError: "reconciliation is paused, not creating resource mock-resourcegroup/mock-resource (service: mock-service). Object will be requeued after 15s",
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}),
+					r.Parameters(gomockinternal.AContext(), nil).Return(fakeParameters, nil),
+					s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue),
+				)
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -177,7 +339,15 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 			defer mockCtrl.Finish()
 			scopeMock := mock_async.NewMockFutureScope(mockCtrl)
 			creatorMock := mock_async.NewMockCreator[MockCreator](mockCtrl)
-			svc := New[MockCreator, MockDeleter](scopeMock, creatorMock, nil)
+			var scope FutureScope = scopeMock
+			if tc.paused {
+				scope = pausedFutureScope{FutureScope: scopeMock}
+			}
+			var plannedChanges []string
+			if tc.dryRun {
+				scope = dryRunFutureScope{FutureScope: scopeMock, changes: &plannedChanges}
+			}
+			svc := New[MockCreator, MockDeleter](scope, creatorMock, nil)
 			specMock := mock_azure.NewMockResourceSpecGetter(mockCtrl)
 
 			tc.expect(g, scopeMock.EXPECT(), creatorMock.EXPECT(), specMock.EXPECT())
@@ -186,6 +356,12 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 			if tc.expectedError != "" {
 				g.Expect(err).To(HaveOccurred())
 				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				if tc.expectedRequeue != 0 {
+					var recerr azure.ReconcileError
+					g.Expect(errors.As(err, &recerr)).To(BeTrue())
+					g.Expect(recerr.IsTransient()).To(BeTrue())
+					g.Expect(recerr.RequeueAfter()).To(Equal(tc.expectedRequeue))
+				}
 			} else {
 				g.Expect(err).NotTo(HaveOccurred())
 				if tc.expectedResult != nil {
@@ -194,6 +370,9 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 					g.Expect(result).To(BeNil())
 				}
 			}
+			if tc.dryRun {
+				g.Expect(plannedChanges).To(Equal(tc.expectedPlannedChanges))
+			}
 		})
 	}
 }
@@ -202,9 +381,15 @@ func TestServiceDeleteResource(t *testing.T) {
 	testcases := []struct {
 		name           string
 		serviceName    string
+		paused         bool
+		dryRun         bool
 		expectedError  string
 		expectedResult interface{}
-		expect         func(g *GomegaWithT, s *mock_async.MockFutureScopeMockRecorder, d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetterMockRecorder)
+		// expectedPlannedChanges is checked when dryRun is set.
+		expectedPlannedChanges []string
+		// expectedRequeue is checked when set and the error is a transient ReconcileError.
+		expectedRequeue time.Duration
+		expect          func(g *GomegaWithT, s *mock_async.MockFutureScopeMockRecorder, d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetterMockRecorder)
 	}{
 		{
 			name:          "invalid future",
@@ -230,7 +415,7 @@ func TestServiceDeleteResource(t *testing.T) {
 					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(validDeleteFuture),
 					d.DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), gomock.Any()).Return(fakePoller[MockDeleter](g, http.StatusAccepted), context.DeadlineExceeded),
 					s.SetLongRunningOperationState(gomock.AssignableToTypeOf(&infrav1.Future{})),
-					s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue),
+					s.BackoffReconcilerRequeue(serviceName, resourceGroupName+"/"+resourceName).Return(reconciler.DefaultReconcilerRequeue),
 				)
 			},
 		},
@@ -245,6 +430,68 @@ func TestServiceDeleteResource(t *testing.T) {
 					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(validDeleteFuture),
 					d.DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), gomock.Any()).Return(nil, nil),
 					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture),
+					s.ResetReconcilerRequeueBackoff(serviceName, resourceGroupName+"/"+resourceName),
+				)
+			},
+		},
+		{
+			name:          "paused: delete is not blocked",
+			serviceName:   serviceName,
+			paused:        true,
+			expectedError: "",
+			expect: func(_ *GomegaWithT, s *mock_async.MockFutureScopeMockRecorder, d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(nil),
+					d.DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), "").Return(nil, nil),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture),
+					s.ResetReconcilerRequeueBackoff(serviceName, resourceGroupName+"/"+resourceName),
+				)
+			},
+		},
+		{
+			name:                   "dry run: resource is not deleted",
+			serviceName:            serviceName,
+			dryRun:                 true,
+			expectedError:          "",
+			expectedPlannedChanges: []string{"mock-service: delete resource mock-resourcegroup/mock-resource"},
+			expect: func(_ *GomegaWithT, s *mock_async.MockFutureScopeMockRecorder, _ *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(nil),
+				)
+			},
+		},
+		{
+			name:            "operation throttled with Retry-After",
+			serviceName:     serviceName,
+			expectedError:   "failed to delete resource mock-resourcegroup/mock-resource (service: mock-service)",
+			expectedRequeue: time.Minute,
+			expect: func(_ *GomegaWithT, s *mock_async.MockFutureScopeMockRecorder, d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(nil),
+					d.DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), "").Return(nil, throttledError("60")),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture),
+				)
+			},
+		},
+		{
+			name:            "operation timed out before it was started",
+			serviceName:     serviceName,
+			expectedError:   "failed to delete resource mock-resourcegroup/mock-resource (service: mock-service): context deadline exceeded",
+			expectedRequeue: 42 * time.Second,
+			expect: func(_ *GomegaWithT, s *mock_async.MockFutureScopeMockRecorder, d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(nil),
+					d.DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), "").Return(nil, context.DeadlineExceeded),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture),
+					s.BackoffReconcilerRequeue(serviceName, resourceGroupName+"/"+resourceName).Return(42*time.Second),
 				)
 			},
 		},
@@ -259,6 +506,7 @@ func TestServiceDeleteResource(t *testing.T) {
 					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(validDeleteFuture),
 					d.DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), gomock.Any()).Return(fakePoller[MockDeleter](g, http.StatusAccepted), errors.New("foo")),
 					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture),
+					s.ResetReconcilerRequeueBackoff(serviceName, resourceGroupName+"/"+resourceName),
 				)
 			},
 		},
@@ -274,7 +522,15 @@ func TestServiceDeleteResource(t *testing.T) {
 			defer mockCtrl.Finish()
 			scopeMock := mock_async.NewMockFutureScope(mockCtrl)
 			deleterMock := mock_async.NewMockDeleter[MockDeleter](mockCtrl)
-			svc := New[MockCreator, MockDeleter](scopeMock, nil, deleterMock)
+			var scope FutureScope = scopeMock
+			if tc.paused {
+				scope = pausedFutureScope{FutureScope: scopeMock}
+			}
+			var plannedChanges []string
+			if tc.dryRun {
+				scope = dryRunFutureScope{FutureScope: scopeMock, changes: &plannedChanges}
+			}
+			svc := New[MockCreator, MockDeleter](scope, nil, deleterMock)
 			specMock := mock_azure.NewMockResourceSpecGetter(mockCtrl)
 
 			tc.expect(g, scopeMock.EXPECT(), deleterMock.EXPECT(), specMock.EXPECT())
@@ -283,13 +539,82 @@ func TestServiceDeleteResource(t *testing.T) {
 			if tc.expectedError != "" {
 				g.Expect(err).To(HaveOccurred())
 				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				if tc.expectedRequeue != 0 {
+					var recerr azure.ReconcileError
+					g.Expect(errors.As(err, &recerr)).To(BeTrue())
+					g.Expect(recerr.IsTransient()).To(BeTrue())
+					g.Expect(recerr.RequeueAfter()).To(Equal(tc.expectedRequeue))
+				}
 			} else {
 				g.Expect(err).NotTo(HaveOccurred())
 			}
+			if tc.dryRun {
+				g.Expect(plannedChanges).To(Equal(tc.expectedPlannedChanges))
+			}
 		})
 	}
 }
 
+// TestServiceErrorCodeOverrides doesn't run in parallel since the error code overrides are configured globally.
+func TestServiceErrorCodeOverrides(t *testing.T) {
+	overrides, err := azure.ParseErrorCodeOverrides(map[string]string{
+		"InternalServerError": "Transient",
+		"NotAllowed*":         "Terminal",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	azure.SetErrorCodeOverrides(overrides)
+	defer azure.SetErrorCodeOverrides(nil)
+
+	t.Run("create error overridden to transient", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scopeMock := mock_async.NewMockFutureScope(mockCtrl)
+		creatorMock := mock_async.NewMockCreator[MockCreator](mockCtrl)
+		specMock := mock_azure.NewMockResourceSpecGetter(mockCtrl)
+		gomock.InOrder(
+			specMock.EXPECT().ResourceName().Return(resourceName),
+			specMock.EXPECT().ResourceGroupName().Return(resourceGroupName),
+			scopeMock.EXPECT().GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(validPutFuture),
+			creatorMock.EXPECT().CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), resumeToken, gomock.Any()).
+				Return(nil, nil, &azcore.ResponseError{StatusCode: http.StatusInternalServerError, ErrorCode: "InternalServerError"}),
+			scopeMock.EXPECT().DeleteLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture),
+			scopeMock.EXPECT().BackoffReconcilerRequeue(serviceName, resourceGroupName+"/"+resourceName).Return(42*time.Second),
+		)
+
+		svc := New[MockCreator, MockDeleter](scopeMock, creatorMock, nil)
+		_, err := svc.CreateOrUpdateResource(context.TODO(), specMock, serviceName)
+		var recerr azure.ReconcileError
+		g.Expect(errors.As(err, &recerr)).To(BeTrue())
+		g.Expect(recerr.IsTransient()).To(BeTrue())
+		g.Expect(recerr.RequeueAfter()).To(Equal(42 * time.Second))
+	})
+
+	t.Run("delete error overridden to terminal", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scopeMock := mock_async.NewMockFutureScope(mockCtrl)
+		deleterMock := mock_async.NewMockDeleter[MockDeleter](mockCtrl)
+		specMock := mock_azure.NewMockResourceSpecGetter(mockCtrl)
+		gomock.InOrder(
+			specMock.EXPECT().ResourceName().Return(resourceName),
+			specMock.EXPECT().ResourceGroupName().Return(resourceGroupName),
+			scopeMock.EXPECT().GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(validDeleteFuture),
+			deleterMock.EXPECT().DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), gomock.Any()).
+				Return(nil, &azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: "NotAllowedByLock"}),
+			scopeMock.EXPECT().DeleteLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture),
+			scopeMock.EXPECT().ResetReconcilerRequeueBackoff(serviceName, resourceGroupName+"/"+resourceName),
+		)
+
+		svc := New[MockCreator, MockDeleter](scopeMock, nil, deleterMock)
+		err := svc.DeleteResource(context.TODO(), specMock, serviceName)
+		var recerr azure.ReconcileError
+		g.Expect(errors.As(err, &recerr)).To(BeTrue())
+		g.Expect(recerr.IsTerminal()).To(BeTrue())
+	})
+}
+
 const (
 	resourceGroupName  = "mock-resourcegroup"
 	resourceName       = "mock-resource"
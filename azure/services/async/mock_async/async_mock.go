@@ -61,32 +61,46 @@ func (m *MockFutureScope) EXPECT() *MockFutureScopeMockRecorder {
 	return m.recorder
 }
 
-// DefaultedAzureCallTimeout mocks base method.
-func (m *MockFutureScope) DefaultedAzureCallTimeout() time.Duration {
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockFutureScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
-func (mr *MockFutureScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockFutureScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockFutureScope)(nil).DefaultedAzureCallTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockFutureScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockFutureScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockFutureScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockFutureScope)(nil).BackoffReconcilerRequeue), serviceName, key)
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockFutureScope) DefaultedAzureServiceReconcileTimeout() time.Duration {
+// DefaultedAzureCallTimeout mocks base method.
+func (m *MockFutureScope) DefaultedAzureCallTimeout() time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
+	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockFutureScopeMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
+// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
+func (mr *MockFutureScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockFutureScope)(nil).DefaultedAzureServiceReconcileTimeout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockFutureScope)(nil).DefaultedAzureCallTimeout))
 }
 
 // DefaultedReconcilerRequeue mocks base method.
@@ -129,6 +143,18 @@ func (mr *MockFutureScopeMockRecorder) GetLongRunningOperationState(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongRunningOperationState", reflect.TypeOf((*MockFutureScope)(nil).GetLongRunningOperationState), arg0, arg1, arg2)
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockFutureScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockFutureScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockFutureScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockFutureScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
@@ -18,13 +18,16 @@ package availabilitysets
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
@@ -35,6 +38,7 @@ type AvailabilitySetScope interface {
 	azure.ClusterDescriber
 	azure.AsyncStatusUpdater
 	AvailabilitySetSpec() azure.ResourceSpecGetter
+	AvailabilitySetReference() string
 }
 
 // Service provides operations on Azure resources.
@@ -70,11 +74,13 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "availabilitysets.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	var err error
-	if setSpec := s.Scope.AvailabilitySetSpec(); setSpec != nil {
+	if ref := s.Scope.AvailabilitySetReference(); ref != "" {
+		err = s.validateReference(ctx, ref)
+	} else if setSpec := s.Scope.AvailabilitySetSpec(); setSpec != nil {
 		_, err = s.CreateOrUpdateResource(ctx, setSpec, serviceName)
 	} else {
 		log.V(2).Info("skip creation when no availability set spec is found")
@@ -90,7 +96,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "availabilitysets.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	var resultingErr error
@@ -123,7 +129,34 @@ func (s *Service) Delete(ctx context.Context) error {
 	return resultingErr
 }
 
-// IsManaged returns always returns true as CAPZ does not support BYO availability set.
+// validateReference checks that the referenced availability set exists in the resource group and location of the VM.
+func (s *Service) validateReference(ctx context.Context, id string) error {
+	resourceID, err := azureutil.ParseResourceID(id)
+	if err != nil {
+		return azure.WithTerminalError(errors.Wrapf(err, "failed to parse availability set ID %s", id))
+	}
+	if !strings.EqualFold(resourceID.SubscriptionID, s.Scope.SubscriptionID()) || !strings.EqualFold(resourceID.ResourceGroupName, s.Scope.NodeResourceGroup()) {
+		return azure.WithTerminalError(errors.Errorf("availability set %s must be in resource group %s of subscription %s", id, s.Scope.NodeResourceGroup(), s.Scope.SubscriptionID()))
+	}
+
+	existing, err := s.Get(ctx, &AvailabilitySetSpec{Name: resourceID.Name, ResourceGroup: resourceID.ResourceGroupName})
+	if err != nil {
+		if azure.ResourceNotFound(err) {
+			return errors.Errorf("availability set %s does not exist", id)
+		}
+		return errors.Wrapf(err, "failed to get availability set %s", id)
+	}
+	availabilitySet, ok := existing.(armcompute.AvailabilitySet)
+	if !ok {
+		return errors.Errorf("%T is not an armcompute.AvailabilitySet", existing)
+	}
+	if location := ptr.Deref(availabilitySet.Location, ""); !strings.EqualFold(location, s.Scope.Location()) {
+		return azure.WithTerminalError(errors.Errorf("availability set %s is in location %s, not in the location %s of the VM", id, location, s.Scope.Location()))
+	}
+	return nil
+}
+
+// IsManaged returns true unless the machine references an existing availability set, which CAPZ doesn't create or delete.
 func (s *Service) IsManaged(ctx context.Context) (bool, error) {
-	return true, nil
+	return s.Scope.AvailabilitySetReference() == "", nil
 }
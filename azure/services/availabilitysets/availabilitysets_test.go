@@ -94,7 +94,8 @@ func TestReconcileAvailabilitySets(t *testing.T) {
 			name:          "create or update availability set",
 			expectedError: "",
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AvailabilitySetReference().Return("")
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeSetSpec, serviceName).Return(nil, nil)
 				s.UpdatePutStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil)
@@ -104,7 +105,8 @@ func TestReconcileAvailabilitySets(t *testing.T) {
 			name:          "noop if no availability set spec returns nil",
 			expectedError: "",
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AvailabilitySetReference().Return("")
 				s.AvailabilitySetSpec().Return(nil)
 			},
 		},
@@ -112,7 +114,8 @@ func TestReconcileAvailabilitySets(t *testing.T) {
 			name:          "missing required value in availability set spec",
 			expectedError: "some error with parameters",
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AvailabilitySetReference().Return("")
 				s.AvailabilitySetSpec().Return(&fakeSetSpecMissing)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeSetSpecMissing, serviceName).Return(nil, parameterError)
 				s.UpdatePutStatus(infrav1.AvailabilitySetReadyCondition, serviceName, parameterError)
@@ -122,7 +125,8 @@ func TestReconcileAvailabilitySets(t *testing.T) {
 			name:          "error in creating availability set",
 			expectedError: "#: Internal Server Error: StatusCode=500",
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AvailabilitySetReference().Return("")
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeSetSpec, serviceName).Return(nil, internalError())
 				s.UpdatePutStatus(infrav1.AvailabilitySetReadyCondition, serviceName, internalError())
@@ -158,6 +162,86 @@ func TestReconcileAvailabilitySets(t *testing.T) {
 	}
 }
 
+func TestReconcileAvailabilitySetReference(t *testing.T) {
+	const referenceID = "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Compute/availabilitySets/existing-as"
+	referenceSpec := &AvailabilitySetSpec{Name: "existing-as", ResourceGroup: "test-rg"}
+
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder)
+	}{
+		{
+			name:          "referenced availability set exists in the location of the VM",
+			expectedError: "",
+			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder) {
+				s.AvailabilitySetReference().Return(referenceID)
+				m.Get(gomockinternal.AContext(), referenceSpec).Return(armcompute.AvailabilitySet{Location: ptr.To("westus2")}, nil)
+				s.UpdatePutStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "referenced availability set does not exist",
+			expectedError: "availability set " + referenceID + " does not exist",
+			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder) {
+				s.AvailabilitySetReference().Return(referenceID)
+				m.Get(gomockinternal.AContext(), referenceSpec).Return(nil, notFoundError)
+				s.UpdatePutStatus(infrav1.AvailabilitySetReadyCondition, serviceName, gomock.Any())
+			},
+		},
+		{
+			name:          "referenced availability set is in another location",
+			expectedError: "availability set " + referenceID + " is in location eastus, not in the location westus2 of the VM",
+			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder) {
+				s.AvailabilitySetReference().Return(referenceID)
+				m.Get(gomockinternal.AContext(), referenceSpec).Return(armcompute.AvailabilitySet{Location: ptr.To("eastus")}, nil)
+				s.UpdatePutStatus(infrav1.AvailabilitySetReadyCondition, serviceName, gomock.Any())
+			},
+		},
+		{
+			name:          "referenced availability set is in another resource group",
+			expectedError: "must be in resource group test-rg of subscription 123",
+			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder) {
+				s.AvailabilitySetReference().Return("/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.Compute/availabilitySets/existing-as")
+				s.UpdatePutStatus(infrav1.AvailabilitySetReadyCondition, serviceName, gomock.Any())
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_availabilitysets.NewMockAvailabilitySetScope(mockCtrl)
+			getterMock := mock_async.NewMockGetter(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			scopeMock.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+			scopeMock.EXPECT().SubscriptionID().Return("123").AnyTimes()
+			scopeMock.EXPECT().NodeResourceGroup().Return("test-rg").AnyTimes()
+			scopeMock.EXPECT().Location().Return("westus2").AnyTimes()
+			tc.expect(scopeMock.EXPECT(), getterMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Getter:     getterMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestDeleteAvailabilitySets(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -170,7 +254,7 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(armcompute.AvailabilitySet{}, nil),
 					r.DeleteResource(gomockinternal.AContext(), &fakeSetSpec, serviceName).Return(nil),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil),
@@ -181,7 +265,7 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			name:          "noop if AvailabilitySetSpec returns nil",
 			expectedError: "",
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.AvailabilitySetSpec().Return(nil)
 			},
 		},
@@ -191,7 +275,7 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpecMissing)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					m.Get(gomockinternal.AContext(), &fakeSetSpecMissing).Return(armcompute.AvailabilitySet{}, nil),
 					r.DeleteResource(gomockinternal.AContext(), &fakeSetSpecMissing, serviceName).Return(nil),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil),
@@ -204,7 +288,7 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(fakeSetWithVMs, nil),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil),
 				)
@@ -216,7 +300,7 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(nil, notFoundError),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil),
 				)
@@ -228,7 +312,7 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(nil, internalError()),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, gomockinternal.ErrStrEq("failed to get availability set test-as in resource group test-rg: "+internalError().Error())),
 				)
@@ -240,7 +324,7 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return("not an availability set", nil),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, gomockinternal.ErrStrEq("string is not an armcompute.AvailabilitySet")),
 				)
@@ -252,7 +336,7 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(armcompute.AvailabilitySet{}, nil),
 					r.DeleteResource(gomockinternal.AContext(), &fakeSetSpec, serviceName).Return(internalError()),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, internalError()),
@@ -87,6 +87,20 @@ func (mr *MockAvailabilitySetScopeMockRecorder) AvailabilitySetEnabled() *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySetEnabled", reflect.TypeOf((*MockAvailabilitySetScope)(nil).AvailabilitySetEnabled))
 }
 
+// AvailabilitySetReference mocks base method.
+func (m *MockAvailabilitySetScope) AvailabilitySetReference() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailabilitySetReference")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// AvailabilitySetReference indicates an expected call of AvailabilitySetReference.
+func (mr *MockAvailabilitySetScopeMockRecorder) AvailabilitySetReference() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySetReference", reflect.TypeOf((*MockAvailabilitySetScope)(nil).AvailabilitySetReference))
+}
+
 // AvailabilitySetSpec mocks base method.
 func (m *MockAvailabilitySetScope) AvailabilitySetSpec() azure.ResourceSpecGetter {
 	m.ctrl.T.Helper()
@@ -101,6 +115,34 @@ func (mr *MockAvailabilitySetScopeMockRecorder) AvailabilitySetSpec() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySetSpec", reflect.TypeOf((*MockAvailabilitySetScope)(nil).AvailabilitySetSpec))
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockAvailabilitySetScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockAvailabilitySetScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockAvailabilitySetScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockAvailabilitySetScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockAvailabilitySetScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockAvailabilitySetScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
 // BaseURI mocks base method.
 func (m *MockAvailabilitySetScope) BaseURI() string {
 	m.ctrl.T.Helper()
@@ -199,20 +241,6 @@ func (mr *MockAvailabilitySetScopeMockRecorder) DefaultedAzureCallTimeout() *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockAvailabilitySetScope)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockAvailabilitySetScope) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockAvailabilitySetScopeMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockAvailabilitySetScope)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockAvailabilitySetScope) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -351,6 +379,18 @@ func (mr *MockAvailabilitySetScopeMockRecorder) NodeResourceGroup() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeResourceGroup", reflect.TypeOf((*MockAvailabilitySetScope)(nil).NodeResourceGroup))
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockAvailabilitySetScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockAvailabilitySetScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockAvailabilitySetScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // ResourceGroup mocks base method.
 func (m *MockAvailabilitySetScope) ResourceGroup() string {
 	m.ctrl.T.Helper()
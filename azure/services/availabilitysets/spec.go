@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 )
@@ -36,6 +37,9 @@ type AvailabilitySetSpec struct {
 	Location       string
 	SKU            *resourceskus.SKU
 	AdditionalTags infrav1.Tags
+
+	PlatformFaultDomainCount  *int32
+	PlatformUpdateDomainCount *int32
 }
 
 // ResourceName returns the name of the availability set.
@@ -77,13 +81,20 @@ func (s *AvailabilitySetSpec) Parameters(ctx context.Context, existing interface
 		return nil, errors.Wrapf(err, "unable to parse availability set fault domain count")
 	}
 	faultDomainCount = ptr.To[int32](int32(count))
+	if s.PlatformFaultDomainCount != nil {
+		if int64(*s.PlatformFaultDomainCount) > count {
+			return nil, azure.WithTerminalError(errors.Errorf("platform fault domain count %d exceeds the maximum fault domain count %d of location %s", *s.PlatformFaultDomainCount, count, s.Location))
+		}
+		faultDomainCount = s.PlatformFaultDomainCount
+	}
 
 	asParams := armcompute.AvailabilitySet{
 		SKU: &armcompute.SKU{
 			Name: ptr.To(string(armcompute.AvailabilitySetSKUTypesAligned)),
 		},
 		Properties: &armcompute.AvailabilitySetProperties{
-			PlatformFaultDomainCount: faultDomainCount,
+			PlatformFaultDomainCount:  faultDomainCount,
+			PlatformUpdateDomainCount: s.PlatformUpdateDomainCount,
 		},
 		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
 			ClusterName: s.ClusterName,
@@ -63,6 +63,43 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "unable to get required availability set SKU capability MaximumPlatformFaultDomainCount",
 		},
+		{
+			name: "get parameters with fault domain and update domain counts",
+			spec: &AvailabilitySetSpec{
+				Name:                      "test-as",
+				ResourceGroup:             "test-rg",
+				ClusterName:               "test-cluster",
+				Location:                  "test-location",
+				SKU:                       &fakeSku,
+				AdditionalTags:            map[string]string{},
+				PlatformFaultDomainCount:  ptr.To[int32](2),
+				PlatformUpdateDomainCount: ptr.To[int32](10),
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.AvailabilitySet{}))
+				g.Expect(result.(armcompute.AvailabilitySet).Properties.PlatformFaultDomainCount).To(Equal(ptr.To[int32](2)))
+				g.Expect(result.(armcompute.AvailabilitySet).Properties.PlatformUpdateDomainCount).To(Equal(ptr.To[int32](10)))
+			},
+			expectedError: "",
+		},
+		{
+			name: "error when fault domain count exceeds the maximum of the location",
+			spec: &AvailabilitySetSpec{
+				Name:                     "test-as",
+				ResourceGroup:            "test-rg",
+				ClusterName:              "test-cluster",
+				Location:                 "test-location",
+				SKU:                      &fakeSku,
+				AdditionalTags:           map[string]string{},
+				PlatformFaultDomainCount: ptr.To[int32](int32(fakeFaultDomainCount + 1)),
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: platform fault domain count 4 exceeds the maximum fault domain count 3 of location test-location. Object will not be requeued",
+		},
 		{
 			name:     "get parameters when all values are present",
 			spec:     &fakeSetSpec,
@@ -70,6 +107,7 @@ func TestParameters(t *testing.T) {
 			expect: func(g *WithT, result interface{}) {
 				g.Expect(result).To(BeAssignableToTypeOf(armcompute.AvailabilitySet{}))
 				g.Expect(result.(armcompute.AvailabilitySet).Properties.PlatformFaultDomainCount).To(Equal(ptr.To[int32](int32(fakeFaultDomainCount))))
+				g.Expect(result.(armcompute.AvailabilitySet).Properties.PlatformUpdateDomainCount).To(BeNil())
 			},
 			expectedError: "",
 		},
@@ -0,0 +1,199 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurefirewalls
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/azurefirewalls/mock_azurefirewalls"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var (
+	fakeFirewallSpec = AzureFirewallSpec{
+		Name:          "my-firewall",
+		ResourceGroup: "my-rg",
+		Location:      "westus",
+		ClusterName:   "my-cluster",
+		SKU:           infrav1.StandardAzureFirewallSKUTier,
+		SubnetID:      "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/AzureFirewallSubnet",
+		PublicIPIDs:   []string{"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-pip-1"},
+	}
+	fakeFirewall = armnetwork.AzureFirewall{
+		Name: ptr.To("my-firewall"),
+		Properties: &armnetwork.AzureFirewallPropertiesFormat{
+			IPConfigurations: []*armnetwork.AzureFirewallIPConfiguration{
+				{
+					Properties: &armnetwork.AzureFirewallIPConfigurationPropertiesFormat{
+						PrivateIPAddress: ptr.To("10.255.255.132"),
+					},
+				},
+			},
+		},
+	}
+	internalError = errors.New("internal error")
+	notDoneError  = azure.NewOperationNotDoneError(&infrav1.Future{})
+)
+
+func TestReconcileAzureFirewall(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no azure firewall spec is found",
+			expectedError: "",
+			expect: func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureFirewallSpec().Return(nil)
+			},
+		},
+		{
+			name:          "create azure firewall and record its private IP",
+			expectedError: "",
+			expect: func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureFirewallSpec().Return(&fakeFirewallSpec)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFirewallSpec, serviceName).Return(fakeFirewall, nil)
+				s.SetAzureFirewallPrivateIP("10.255.255.132")
+				s.UpdatePutStatus(infrav1.AzureFirewallReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "azure firewall creation in progress",
+			expectedError: notDoneError.Error(),
+			expect: func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureFirewallSpec().Return(&fakeFirewallSpec)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFirewallSpec, serviceName).Return(nil, notDoneError)
+				s.UpdatePutStatus(infrav1.AzureFirewallReadyCondition, serviceName, notDoneError)
+			},
+		},
+		{
+			name:          "fail to create azure firewall",
+			expectedError: internalError.Error(),
+			expect: func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureFirewallSpec().Return(&fakeFirewallSpec)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFirewallSpec, serviceName).Return(nil, internalError)
+				s.UpdatePutStatus(infrav1.AzureFirewallReadyCondition, serviceName, internalError)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_azurefirewalls.NewMockAzureFirewallScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteAzureFirewall(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no azure firewall spec is found",
+			expectedError: "",
+			expect: func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureFirewallSpec().Return(nil)
+			},
+		},
+		{
+			name:          "delete azure firewall",
+			expectedError: "",
+			expect: func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureFirewallSpec().Return(&fakeFirewallSpec)
+				r.DeleteResource(gomockinternal.AContext(), &fakeFirewallSpec, serviceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.AzureFirewallReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "fail to delete azure firewall",
+			expectedError: internalError.Error(),
+			expect: func(s *mock_azurefirewalls.MockAzureFirewallScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureFirewallSpec().Return(&fakeFirewallSpec)
+				r.DeleteResource(gomockinternal.AContext(), &fakeFirewallSpec, serviceName).Return(internalError)
+				s.UpdateDeleteStatus(infrav1.AzureFirewallReadyCondition, serviceName, internalError)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_azurefirewalls.NewMockAzureFirewallScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
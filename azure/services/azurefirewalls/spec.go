@@ -0,0 +1,171 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurefirewalls
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+)
+
+// AzureFirewallSpec defines the specification for an Azure Firewall.
+type AzureFirewallSpec struct {
+	Name             string
+	ResourceGroup    string
+	Location         string
+	ClusterName      string
+	SKU              infrav1.AzureFirewallSKUTier
+	SubnetID         string
+	PublicIPIDs      []string
+	FirewallPolicyID string
+	AdditionalTags   infrav1.Tags
+}
+
+// ResourceName returns the name of the Azure Firewall.
+func (s *AzureFirewallSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *AzureFirewallSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for Azure Firewalls.
+func (s *AzureFirewallSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters returns the parameters for the Azure Firewall.
+func (s *AzureFirewallSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		existingFirewall, ok := existing.(armnetwork.AzureFirewall)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armnetwork.AzureFirewall", existing)
+		}
+		if s.isUpToDate(existingFirewall) {
+			return nil, nil
+		}
+	}
+
+	firewall := armnetwork.AzureFirewall{
+		Location: ptr.To(s.Location),
+		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.ClusterName,
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        ptr.To(s.Name),
+			Role:        ptr.To(infrav1.CommonRole),
+			Additional:  s.AdditionalTags,
+		})),
+		Properties: &armnetwork.AzureFirewallPropertiesFormat{
+			SKU: &armnetwork.AzureFirewallSKU{
+				Name: ptr.To(armnetwork.AzureFirewallSKUNameAZFWVnet),
+				Tier: ptr.To(armnetwork.AzureFirewallSKUTier(s.SKU)),
+			},
+			IPConfigurations: s.ipConfigurations(),
+		},
+	}
+	if s.FirewallPolicyID != "" {
+		firewall.Properties.FirewallPolicy = &armnetwork.SubResource{
+			ID: ptr.To(s.FirewallPolicyID),
+		}
+	}
+
+	return firewall, nil
+}
+
+// ipConfigurations returns one IP configuration per public IP.
+// Only the first IP configuration references the firewall subnet, as required by Azure.
+func (s *AzureFirewallSpec) ipConfigurations() []*armnetwork.AzureFirewallIPConfiguration {
+	ipConfigs := make([]*armnetwork.AzureFirewallIPConfiguration, len(s.PublicIPIDs))
+	for i, publicIPID := range s.PublicIPIDs {
+		ipConfig := &armnetwork.AzureFirewallIPConfiguration{
+			Name: ptr.To(fmt.Sprintf("%s-ipconfig-%d", s.Name, i+1)),
+			Properties: &armnetwork.AzureFirewallIPConfigurationPropertiesFormat{
+				PublicIPAddress: &armnetwork.SubResource{
+					ID: ptr.To(publicIPID),
+				},
+			},
+		}
+		if i == 0 {
+			ipConfig.Properties.Subnet = &armnetwork.SubResource{
+				ID: ptr.To(s.SubnetID),
+			}
+		}
+		ipConfigs[i] = ipConfig
+	}
+	return ipConfigs
+}
+
+// isUpToDate returns true if the existing Azure Firewall matches the SKU, firewall policy and public IPs of the spec.
+func (s *AzureFirewallSpec) isUpToDate(existing armnetwork.AzureFirewall) bool {
+	if existing.Properties == nil {
+		return false
+	}
+
+	var existingTier armnetwork.AzureFirewallSKUTier
+	if existing.Properties.SKU != nil {
+		existingTier = ptr.Deref(existing.Properties.SKU.Tier, "")
+	}
+	if existingTier != armnetwork.AzureFirewallSKUTier(s.SKU) {
+		return false
+	}
+
+	var existingPolicyID string
+	if existing.Properties.FirewallPolicy != nil {
+		existingPolicyID = ptr.Deref(existing.Properties.FirewallPolicy.ID, "")
+	}
+	if !strings.EqualFold(existingPolicyID, s.FirewallPolicyID) {
+		return false
+	}
+
+	existingPublicIPIDs := make(map[string]struct{}, len(existing.Properties.IPConfigurations))
+	for _, ipConfig := range existing.Properties.IPConfigurations {
+		if ipConfig != nil && ipConfig.Properties != nil && ipConfig.Properties.PublicIPAddress != nil {
+			existingPublicIPIDs[strings.ToLower(ptr.Deref(ipConfig.Properties.PublicIPAddress.ID, ""))] = struct{}{}
+		}
+	}
+	if len(existingPublicIPIDs) != len(s.PublicIPIDs) {
+		return false
+	}
+	for _, publicIPID := range s.PublicIPIDs {
+		if _, ok := existingPublicIPIDs[strings.ToLower(publicIPID)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PrivateIPAddress returns the private IP address of the Azure Firewall, if one is assigned.
+func PrivateIPAddress(firewall armnetwork.AzureFirewall) string {
+	if firewall.Properties == nil {
+		return ""
+	}
+	for _, ipConfig := range firewall.Properties.IPConfigurations {
+		if ipConfig != nil && ipConfig.Properties != nil && ipConfig.Properties.PrivateIPAddress != nil {
+			return *ipConfig.Properties.PrivateIPAddress
+		}
+	}
+	return ""
+}
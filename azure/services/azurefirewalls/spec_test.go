@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurefirewalls
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+func TestAzureFirewallSpec_Parameters(t *testing.T) {
+	twoPublicIPsSpec := fakeFirewallSpec
+	twoPublicIPsSpec.PublicIPIDs = append([]string{}, fakeFirewallSpec.PublicIPIDs[0], "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-pip-2")
+	policySpec := fakeFirewallSpec
+	policySpec.FirewallPolicyID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/firewallPolicies/my-policy"
+
+	upToDateFirewall := armnetwork.AzureFirewall{
+		Properties: &armnetwork.AzureFirewallPropertiesFormat{
+			SKU: &armnetwork.AzureFirewallSKU{
+				Name: ptr.To(armnetwork.AzureFirewallSKUNameAZFWVnet),
+				Tier: ptr.To(armnetwork.AzureFirewallSKUTierStandard),
+			},
+			IPConfigurations: []*armnetwork.AzureFirewallIPConfiguration{
+				{
+					Properties: &armnetwork.AzureFirewallIPConfigurationPropertiesFormat{
+						PublicIPAddress: &armnetwork.SubResource{ID: ptr.To(fakeFirewallSpec.PublicIPIDs[0])},
+						Subnet:          &armnetwork.SubResource{ID: ptr.To(fakeFirewallSpec.SubnetID)},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		spec          *AzureFirewallSpec
+		existing      interface{}
+		expect        func(g *WithT, result interface{})
+		expectedError string
+	}{
+		{
+			name:     "error when existing is not of AzureFirewall type",
+			spec:     &fakeFirewallSpec,
+			existing: struct{}{},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "struct {} is not an armnetwork.AzureFirewall",
+		},
+		{
+			name:     "new azure firewall",
+			spec:     &fakeFirewallSpec,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.AzureFirewall{}))
+				firewall := result.(armnetwork.AzureFirewall)
+				g.Expect(firewall.Location).To(Equal(ptr.To("westus")))
+				g.Expect(firewall.Properties.SKU.Tier).To(Equal(ptr.To(armnetwork.AzureFirewallSKUTierStandard)))
+				g.Expect(firewall.Properties.FirewallPolicy).To(BeNil())
+				g.Expect(firewall.Properties.IPConfigurations).To(HaveLen(1))
+				g.Expect(firewall.Properties.IPConfigurations[0].Properties.Subnet.ID).To(Equal(ptr.To(fakeFirewallSpec.SubnetID)))
+				g.Expect(firewall.Tags).To(HaveKeyWithValue("sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster", ptr.To(string(infrav1.ResourceLifecycleOwned))))
+			},
+		},
+		{
+			name:     "new azure firewall with a firewall policy",
+			spec:     &policySpec,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.AzureFirewall{}))
+				g.Expect(result.(armnetwork.AzureFirewall).Properties.FirewallPolicy.ID).To(Equal(ptr.To(policySpec.FirewallPolicyID)))
+			},
+		},
+		{
+			name:     "existing azure firewall is up to date",
+			spec:     &fakeFirewallSpec,
+			existing: upToDateFirewall,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name:     "update azure firewall when the public IP count changes",
+			spec:     &twoPublicIPsSpec,
+			existing: upToDateFirewall,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.AzureFirewall{}))
+				ipConfigs := result.(armnetwork.AzureFirewall).Properties.IPConfigurations
+				g.Expect(ipConfigs).To(HaveLen(2))
+				g.Expect(ipConfigs[0].Properties.Subnet).NotTo(BeNil())
+				g.Expect(ipConfigs[1].Properties.Subnet).To(BeNil())
+				g.Expect(ipConfigs[1].Properties.PublicIPAddress.ID).To(Equal(ptr.To(twoPublicIPsSpec.PublicIPIDs[1])))
+			},
+		},
+		{
+			name:     "update azure firewall when the firewall policy changes",
+			spec:     &policySpec,
+			existing: upToDateFirewall,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.AzureFirewall{}))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			tc.expect(g, result)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacollectionrules
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// azureAssociationsClient contains the Azure go-sdk Client for data collection rule associations.
+type azureAssociationsClient struct {
+	associations *armmonitor.DataCollectionRuleAssociationsClient
+}
+
+// newAssociationsClient creates a data collection rule associations client from an authorizer.
+func newAssociationsClient(auth azure.Authorizer) (*azureAssociationsClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create datacollectionruleassociations client options")
+	}
+	factory, err := armmonitor.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armmonitor client factory")
+	}
+	return &azureAssociationsClient{factory.NewDataCollectionRuleAssociationsClient()}, nil
+}
+
+// Get gets the specified data collection rule association of the resource identified by the spec's owner.
+func (ac *azureAssociationsClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.azureAssociationsClient.Get")
+	defer done()
+
+	resp, err := ac.associations.Get(ctx, spec.OwnerResourceName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.DataCollectionRuleAssociationProxyOnlyResource, nil
+}
+
+// CreateOrUpdateAsync creates or updates a data collection rule association.
+// Creating a data collection rule association is not a long running operation, so we don't ever return a poller.
+func (ac *azureAssociationsClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armmonitor.DataCollectionRuleAssociationsClientCreateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.azureAssociationsClient.CreateOrUpdateAsync")
+	defer done()
+
+	association, ok := parameters.(armmonitor.DataCollectionRuleAssociationProxyOnlyResource)
+	if !ok {
+		return nil, nil, errors.Errorf("%T is not an armmonitor.DataCollectionRuleAssociationProxyOnlyResource", parameters)
+	}
+	resp, err := ac.associations.Create(ctx, spec.OwnerResourceName(), spec.ResourceName(), &armmonitor.DataCollectionRuleAssociationsClientCreateOptions{Body: &association})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.DataCollectionRuleAssociationProxyOnlyResource, nil, nil
+}
+
+// DeleteAsync deletes a data collection rule association.
+// Deleting a data collection rule association is not a long running operation, so we don't ever return a poller.
+func (ac *azureAssociationsClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armmonitor.DataCollectionRuleAssociationsClientDeleteResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.azureAssociationsClient.DeleteAsync")
+	defer done()
+
+	_, err = ac.associations.Delete(ctx, spec.OwnerResourceName(), spec.ResourceName(), nil)
+	return nil, err
+}
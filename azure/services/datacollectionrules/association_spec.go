@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacollectionrules
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+)
+
+// AssociationSpec defines the specification for the association of a data collection rule with a cluster.
+type AssociationSpec struct {
+	Name string
+	// ResourceGroup is the resource group of the cluster.
+	ResourceGroup string
+	// ClusterID is the Azure resource ID of the cluster the data collection rule is associated with.
+	ClusterID            string
+	DataCollectionRuleID string
+}
+
+// ResourceName returns the name of the data collection rule association.
+func (s *AssociationSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the cluster.
+func (s *AssociationSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName returns the Azure resource ID of the cluster.
+func (s *AssociationSpec) OwnerResourceName() string {
+	return s.ClusterID
+}
+
+// Parameters returns the parameters for the data collection rule association.
+func (s *AssociationSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		existingAssociation, ok := existing.(armmonitor.DataCollectionRuleAssociationProxyOnlyResource)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armmonitor.DataCollectionRuleAssociationProxyOnlyResource", existing)
+		}
+		if existingAssociation.Properties != nil &&
+			strings.EqualFold(ptr.Deref(existingAssociation.Properties.DataCollectionRuleID, ""), s.DataCollectionRuleID) {
+			return nil, nil
+		}
+	}
+
+	return armmonitor.DataCollectionRuleAssociationProxyOnlyResource{
+		Properties: &armmonitor.DataCollectionRuleAssociationProxyOnlyResourceProperties{
+			DataCollectionRuleID: ptr.To(s.DataCollectionRuleID),
+		},
+	}, nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacollectionrules
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// ServiceName is the name of this service.
+	ServiceName = "datacollectionrules"
+	// dataCollectionEndpointRequeueTime is the time after which a workspace without a data collection endpoint is
+	// checked again.
+	dataCollectionEndpointRequeueTime = 30 * time.Second
+)
+
+// DataCollectionRuleScope defines the scope interface for a data collection rules service.
+type DataCollectionRuleScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	DataCollectionRuleSpecs() (ruleSpec azure.ResourceSpecGetter, associationSpec azure.ResourceSpecGetter)
+	AzureMonitorMetricsEnabled() bool
+	AzureMonitorResource() conditions.Setter
+}
+
+// endpointGetter gets the data collection endpoints of Azure Monitor workspaces.
+type endpointGetter interface {
+	GetDataCollectionEndpointID(ctx context.Context, workspaceID string) (string, error)
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	Scope                 DataCollectionRuleScope
+	ruleReconciler        async.Reconciler
+	associationReconciler async.Reconciler
+	endpoints             endpointGetter
+}
+
+// New creates a new service.
+func New(scope DataCollectionRuleScope) (*Service, error) {
+	rulesClient, err := newRulesClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	associationsClient, err := newAssociationsClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope: scope,
+		ruleReconciler: async.New[armmonitor.DataCollectionRulesClientCreateResponse,
+			armmonitor.DataCollectionRulesClientDeleteResponse](scope, rulesClient, rulesClient),
+		associationReconciler: async.New[armmonitor.DataCollectionRuleAssociationsClientCreateResponse,
+			armmonitor.DataCollectionRuleAssociationsClientDeleteResponse](scope, associationsClient, associationsClient),
+		endpoints: rulesClient,
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return ServiceName
+}
+
+// Reconcile idempotently creates or updates the data collection rule which sends the Prometheus metrics of the
+// cluster to its Azure Monitor workspace, and associates it with the cluster. The rule and association are deleted
+// once the collection of the metrics is disabled.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	ruleSpec, associationSpec := s.Scope.DataCollectionRuleSpecs()
+	if ruleSpec == nil {
+		return nil
+	}
+	if !s.Scope.AzureMonitorMetricsEnabled() {
+		if !s.created() {
+			return nil
+		}
+		if err := s.delete(ctx, ruleSpec, associationSpec); err != nil {
+			s.Scope.UpdateDeleteStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, err)
+			return err
+		}
+		conditions.Delete(s.Scope.AzureMonitorResource(), infrav1.DataCollectionRulesReadyCondition)
+		return nil
+	}
+
+	err := s.setDataCollectionEndpoint(ctx, ruleSpec)
+	if err == nil {
+		_, err = s.ruleReconciler.CreateOrUpdateResource(ctx, ruleSpec, ServiceName)
+	}
+	if err == nil && associationSpec != nil {
+		_, err = s.associationReconciler.CreateOrUpdateResource(ctx, associationSpec, ServiceName)
+	}
+
+	s.Scope.UpdatePutStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, err)
+	return err
+}
+
+// Delete deletes the association of the data collection rule with the cluster, then the data collection rule.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	ruleSpec, associationSpec := s.Scope.DataCollectionRuleSpecs()
+	if ruleSpec == nil || !s.created() {
+		return nil
+	}
+
+	err := s.delete(ctx, ruleSpec, associationSpec)
+	s.Scope.UpdateDeleteStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, err)
+	return err
+}
+
+// delete deletes the association of the data collection rule with the cluster, then the data collection rule.
+func (s *Service) delete(ctx context.Context, ruleSpec azure.ResourceSpecGetter, associationSpec azure.ResourceSpecGetter) error {
+	if associationSpec != nil {
+		if err := s.associationReconciler.DeleteResource(ctx, associationSpec, ServiceName); err != nil {
+			return err
+		}
+	}
+	return s.ruleReconciler.DeleteResource(ctx, ruleSpec, ServiceName)
+}
+
+// created returns true if the data collection rule may exist, i.e. if CAPZ reconciled it while the collection of the
+// metrics was enabled and didn't delete it since.
+func (s *Service) created() bool {
+	return s.Scope.AzureMonitorMetricsEnabled() || conditions.Has(s.Scope.AzureMonitorResource(), infrav1.DataCollectionRulesReadyCondition)
+}
+
+// IsManaged returns always returns true as CAPZ does not support BYO data collection rules.
+func (s *Service) IsManaged(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+// setDataCollectionEndpoint sets the data collection endpoint of the rule to the default endpoint of its workspace,
+// which Azure creates along with the workspace, unless the spec already specifies one.
+func (s *Service) setDataCollectionEndpoint(ctx context.Context, spec azure.ResourceSpecGetter) error {
+	ruleSpec, ok := spec.(*DataCollectionRuleSpec)
+	if !ok || ruleSpec.DataCollectionEndpointID != "" {
+		return nil
+	}
+	endpointID, err := s.endpoints.GetDataCollectionEndpointID(ctx, ruleSpec.WorkspaceID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get data collection endpoint of Azure Monitor workspace %s", ruleSpec.WorkspaceID)
+	}
+	if endpointID == "" {
+		return azure.WithTransientError(errors.Errorf("Azure Monitor workspace %s has no data collection endpoint yet", ruleSpec.WorkspaceID), dataCollectionEndpointRequeueTime)
+	}
+	ruleSpec.DataCollectionEndpointID = endpointID
+	return nil
+}
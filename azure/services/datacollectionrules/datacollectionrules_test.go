@@ -0,0 +1,276 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacollectionrules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/datacollectionrules/mock_datacollectionrules"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	fakeWorkspaceID = "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Monitor/accounts/test-workspace"
+	fakeEndpointID  = "/subscriptions/123/resourceGroups/MA_test-workspace_eastus_managed/providers/Microsoft.Insights/dataCollectionEndpoints/test-workspace"
+	fakeRuleID      = "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Insights/dataCollectionRules/MSProm-eastus-test-cluster"
+)
+
+var errFake = errors.New("this is an error")
+
+// conditionTypes returns the types of the conditions of the AzureManagedControlPlane.
+func conditionTypes(controlPlane *infrav1.AzureManagedControlPlane) []clusterv1.ConditionType {
+	var types []clusterv1.ConditionType
+	for _, condition := range controlPlane.GetConditions() {
+		types = append(types, condition.Type)
+	}
+	return types
+}
+
+func fakeRule(endpointID string) *DataCollectionRuleSpec {
+	return &DataCollectionRuleSpec{
+		Name:                     "MSProm-eastus-test-cluster",
+		ResourceGroup:            "test-rg",
+		Location:                 "eastus",
+		WorkspaceID:              fakeWorkspaceID,
+		DataCollectionEndpointID: endpointID,
+		Streams:                  []string{infrav1.DefaultAzureMonitorMetricsStream},
+		ClusterName:              "test-cluster",
+	}
+}
+
+func fakeAssociation() *AssociationSpec {
+	return &AssociationSpec{
+		Name:                 "ContainerInsightsMetricsExtension",
+		ResourceGroup:        "test-rg",
+		ClusterID:            "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.ContainerService/managedClusters/test-cluster",
+		DataCollectionRuleID: fakeRuleID,
+	}
+}
+
+func TestReconcileDataCollectionRules(t *testing.T) {
+	testcases := []struct {
+		name               string
+		disabled           bool
+		conditions         []clusterv1.ConditionType
+		expectedConditions []clusterv1.ConditionType
+		expectedError      string
+		expect             func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder, e *mock_datacollectionrules.MockendpointGetterMockRecorder)
+	}{
+		{
+			name:          "noop if no data collection rule is specified",
+			expectedError: "",
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder, e *mock_datacollectionrules.MockendpointGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(nil, nil)
+			},
+		},
+		{
+			name:          "create data collection rule with the endpoint of the workspace and associate it with the cluster",
+			expectedError: "",
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder, e *mock_datacollectionrules.MockendpointGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(""), fakeAssociation())
+				e.GetDataCollectionEndpointID(gomockinternal.AContext(), fakeWorkspaceID).Return(fakeEndpointID, nil)
+				rule.CreateOrUpdateResource(gomockinternal.AContext(), fakeRule(fakeEndpointID), ServiceName).Return(nil, nil)
+				association.CreateOrUpdateResource(gomockinternal.AContext(), fakeAssociation(), ServiceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "data collection rule is not created until the workspace has an endpoint",
+			expectedError: "has no data collection endpoint yet",
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder, e *mock_datacollectionrules.MockendpointGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(""), fakeAssociation())
+				e.GetDataCollectionEndpointID(gomockinternal.AContext(), fakeWorkspaceID).Return("", nil)
+				s.UpdatePutStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, gomock.Any())
+			},
+		},
+		{
+			name:          "data collection rule is not associated when its creation fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder, e *mock_datacollectionrules.MockendpointGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(fakeEndpointID), fakeAssociation())
+				rule.CreateOrUpdateResource(gomockinternal.AContext(), fakeRule(fakeEndpointID), ServiceName).Return(nil, errFake)
+				s.UpdatePutStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, errFake)
+			},
+		},
+		{
+			name:     "noop if metrics were never enabled",
+			disabled: true,
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder, e *mock_datacollectionrules.MockendpointGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(""), fakeAssociation())
+			},
+		},
+		{
+			name:               "association and data collection rule are deleted and their condition removed once metrics are disabled",
+			disabled:           true,
+			conditions:         []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition, infrav1.DataCollectionRulesReadyCondition},
+			expectedConditions: []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition},
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder, e *mock_datacollectionrules.MockendpointGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(""), fakeAssociation())
+				gomock.InOrder(
+					association.DeleteResource(gomockinternal.AContext(), fakeAssociation(), ServiceName).Return(nil),
+					rule.DeleteResource(gomockinternal.AContext(), fakeRule(""), ServiceName).Return(nil),
+				)
+			},
+		},
+		{
+			name:               "data collection rule condition is kept when its deletion fails once metrics are disabled",
+			disabled:           true,
+			conditions:         []clusterv1.ConditionType{infrav1.DataCollectionRulesReadyCondition},
+			expectedConditions: []clusterv1.ConditionType{infrav1.DataCollectionRulesReadyCondition},
+			expectedError:      errFake.Error(),
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder, e *mock_datacollectionrules.MockendpointGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(""), fakeAssociation())
+				association.DeleteResource(gomockinternal.AContext(), fakeAssociation(), ServiceName).Return(errFake)
+				s.UpdateDeleteStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_datacollectionrules.NewMockDataCollectionRuleScope(mockCtrl)
+			ruleMock := mock_async.NewMockReconciler(mockCtrl)
+			associationMock := mock_async.NewMockReconciler(mockCtrl)
+			endpointsMock := mock_datacollectionrules.NewMockendpointGetter(mockCtrl)
+			controlPlane := &infrav1.AzureManagedControlPlane{}
+			for _, condition := range tc.conditions {
+				conditions.MarkTrue(controlPlane, condition)
+			}
+			scopeMock.EXPECT().AzureMonitorMetricsEnabled().Return(!tc.disabled).AnyTimes()
+			scopeMock.EXPECT().AzureMonitorResource().Return(controlPlane).AnyTimes()
+
+			tc.expect(scopeMock.EXPECT(), ruleMock.EXPECT(), associationMock.EXPECT(), endpointsMock.EXPECT())
+
+			s := &Service{
+				Scope:                 scopeMock,
+				ruleReconciler:        ruleMock,
+				associationReconciler: associationMock,
+				endpoints:             endpointsMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(conditionTypes(controlPlane)).To(ConsistOf(tc.expectedConditions))
+		})
+	}
+}
+
+func TestDeleteDataCollectionRules(t *testing.T) {
+	testcases := []struct {
+		name          string
+		disabled      bool
+		expectedError string
+		expect        func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no data collection rule is specified",
+			expectedError: "",
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(nil, nil)
+			},
+		},
+		{
+			name:          "association is deleted before the data collection rule",
+			expectedError: "",
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(""), fakeAssociation())
+				gomock.InOrder(
+					association.DeleteResource(gomockinternal.AContext(), fakeAssociation(), ServiceName).Return(nil),
+					rule.DeleteResource(gomockinternal.AContext(), fakeRule(""), ServiceName).Return(nil),
+				)
+				s.UpdateDeleteStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "data collection rule is kept when deleting the association fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(""), fakeAssociation())
+				association.DeleteResource(gomockinternal.AContext(), fakeAssociation(), ServiceName).Return(errFake)
+				s.UpdateDeleteStatus(infrav1.DataCollectionRulesReadyCondition, ServiceName, errFake)
+			},
+		},
+		{
+			name:     "noop if metrics were never enabled",
+			disabled: true,
+			expect: func(s *mock_datacollectionrules.MockDataCollectionRuleScopeMockRecorder, rule, association *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DataCollectionRuleSpecs().Return(fakeRule(""), fakeAssociation())
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_datacollectionrules.NewMockDataCollectionRuleScope(mockCtrl)
+			ruleMock := mock_async.NewMockReconciler(mockCtrl)
+			associationMock := mock_async.NewMockReconciler(mockCtrl)
+			scopeMock.EXPECT().AzureMonitorMetricsEnabled().Return(!tc.disabled).AnyTimes()
+			scopeMock.EXPECT().AzureMonitorResource().Return(&infrav1.AzureManagedControlPlane{}).AnyTimes()
+
+			tc.expect(scopeMock.EXPECT(), ruleMock.EXPECT(), associationMock.EXPECT())
+
+			s := &Service{
+				Scope:                 scopeMock,
+				ruleReconciler:        ruleMock,
+				associationReconciler: associationMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
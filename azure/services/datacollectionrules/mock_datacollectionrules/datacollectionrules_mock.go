@@ -0,0 +1,397 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../datacollectionrules.go
+//
+// Generated by this command:
+//
+//	mockgen -destination datacollectionrules_mock.go -package mock_datacollectionrules -source ../datacollectionrules.go DataCollectionRuleScope
+//
+
+// Package mock_datacollectionrules is a generated GoMock package.
+package mock_datacollectionrules
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	azcore "github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	gomock "go.uber.org/mock/gomock"
+	v1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	azure "sigs.k8s.io/cluster-api-provider-azure/azure"
+	v1beta10 "sigs.k8s.io/cluster-api/api/v1beta1"
+	conditions "sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// MockDataCollectionRuleScope is a mock of DataCollectionRuleScope interface.
+type MockDataCollectionRuleScope struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataCollectionRuleScopeMockRecorder
+}
+
+// MockDataCollectionRuleScopeMockRecorder is the mock recorder for MockDataCollectionRuleScope.
+type MockDataCollectionRuleScopeMockRecorder struct {
+	mock *MockDataCollectionRuleScope
+}
+
+// NewMockDataCollectionRuleScope creates a new mock instance.
+func NewMockDataCollectionRuleScope(ctrl *gomock.Controller) *MockDataCollectionRuleScope {
+	mock := &MockDataCollectionRuleScope{ctrl: ctrl}
+	mock.recorder = &MockDataCollectionRuleScopeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataCollectionRuleScope) EXPECT() *MockDataCollectionRuleScopeMockRecorder {
+	return m.recorder
+}
+
+// AzureMonitorMetricsEnabled mocks base method.
+func (m *MockDataCollectionRuleScope) AzureMonitorMetricsEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureMonitorMetricsEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AzureMonitorMetricsEnabled indicates an expected call of AzureMonitorMetricsEnabled.
+func (mr *MockDataCollectionRuleScopeMockRecorder) AzureMonitorMetricsEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureMonitorMetricsEnabled", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).AzureMonitorMetricsEnabled))
+}
+
+// AzureMonitorResource mocks base method.
+func (m *MockDataCollectionRuleScope) AzureMonitorResource() conditions.Setter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureMonitorResource")
+	ret0, _ := ret[0].(conditions.Setter)
+	return ret0
+}
+
+// AzureMonitorResource indicates an expected call of AzureMonitorResource.
+func (mr *MockDataCollectionRuleScopeMockRecorder) AzureMonitorResource() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureMonitorResource", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).AzureMonitorResource))
+}
+
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockDataCollectionRuleScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockDataCollectionRuleScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockDataCollectionRuleScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockDataCollectionRuleScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
+// BaseURI mocks base method.
+func (m *MockDataCollectionRuleScope) BaseURI() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseURI")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// BaseURI indicates an expected call of BaseURI.
+func (mr *MockDataCollectionRuleScopeMockRecorder) BaseURI() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseURI", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).BaseURI))
+}
+
+// ClientID mocks base method.
+func (m *MockDataCollectionRuleScope) ClientID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClientID indicates an expected call of ClientID.
+func (mr *MockDataCollectionRuleScopeMockRecorder) ClientID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientID", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).ClientID))
+}
+
+// ClientSecret mocks base method.
+func (m *MockDataCollectionRuleScope) ClientSecret() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClientSecret")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClientSecret indicates an expected call of ClientSecret.
+func (mr *MockDataCollectionRuleScopeMockRecorder) ClientSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientSecret", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).ClientSecret))
+}
+
+// CloudEnvironment mocks base method.
+func (m *MockDataCollectionRuleScope) CloudEnvironment() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudEnvironment")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CloudEnvironment indicates an expected call of CloudEnvironment.
+func (mr *MockDataCollectionRuleScopeMockRecorder) CloudEnvironment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudEnvironment", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).CloudEnvironment))
+}
+
+// DataCollectionRuleSpecs mocks base method.
+func (m *MockDataCollectionRuleScope) DataCollectionRuleSpecs() (azure.ResourceSpecGetter, azure.ResourceSpecGetter) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataCollectionRuleSpecs")
+	ret0, _ := ret[0].(azure.ResourceSpecGetter)
+	ret1, _ := ret[1].(azure.ResourceSpecGetter)
+	return ret0, ret1
+}
+
+// DataCollectionRuleSpecs indicates an expected call of DataCollectionRuleSpecs.
+func (mr *MockDataCollectionRuleScopeMockRecorder) DataCollectionRuleSpecs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataCollectionRuleSpecs", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).DataCollectionRuleSpecs))
+}
+
+// DefaultedAzureCallTimeout mocks base method.
+func (m *MockDataCollectionRuleScope) DefaultedAzureCallTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
+func (mr *MockDataCollectionRuleScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).DefaultedAzureCallTimeout))
+}
+
+// DefaultedReconcilerRequeue mocks base method.
+func (m *MockDataCollectionRuleScope) DefaultedReconcilerRequeue() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedReconcilerRequeue")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedReconcilerRequeue indicates an expected call of DefaultedReconcilerRequeue.
+func (mr *MockDataCollectionRuleScopeMockRecorder) DefaultedReconcilerRequeue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedReconcilerRequeue", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).DefaultedReconcilerRequeue))
+}
+
+// DeleteLongRunningOperationState mocks base method.
+func (m *MockDataCollectionRuleScope) DeleteLongRunningOperationState(arg0, arg1, arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteLongRunningOperationState", arg0, arg1, arg2)
+}
+
+// DeleteLongRunningOperationState indicates an expected call of DeleteLongRunningOperationState.
+func (mr *MockDataCollectionRuleScopeMockRecorder) DeleteLongRunningOperationState(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLongRunningOperationState", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).DeleteLongRunningOperationState), arg0, arg1, arg2)
+}
+
+// GetLongRunningOperationState mocks base method.
+func (m *MockDataCollectionRuleScope) GetLongRunningOperationState(arg0, arg1, arg2 string) *v1beta1.Future {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLongRunningOperationState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.Future)
+	return ret0
+}
+
+// GetLongRunningOperationState indicates an expected call of GetLongRunningOperationState.
+func (mr *MockDataCollectionRuleScopeMockRecorder) GetLongRunningOperationState(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongRunningOperationState", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).GetLongRunningOperationState), arg0, arg1, arg2)
+}
+
+// HashKey mocks base method.
+func (m *MockDataCollectionRuleScope) HashKey() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashKey")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HashKey indicates an expected call of HashKey.
+func (mr *MockDataCollectionRuleScopeMockRecorder) HashKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).HashKey))
+}
+
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockDataCollectionRuleScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockDataCollectionRuleScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
+// SetLongRunningOperationState mocks base method.
+func (m *MockDataCollectionRuleScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLongRunningOperationState", arg0)
+}
+
+// SetLongRunningOperationState indicates an expected call of SetLongRunningOperationState.
+func (mr *MockDataCollectionRuleScopeMockRecorder) SetLongRunningOperationState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLongRunningOperationState", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).SetLongRunningOperationState), arg0)
+}
+
+// SubscriptionID mocks base method.
+func (m *MockDataCollectionRuleScope) SubscriptionID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscriptionID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// SubscriptionID indicates an expected call of SubscriptionID.
+func (mr *MockDataCollectionRuleScopeMockRecorder) SubscriptionID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionID", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).SubscriptionID))
+}
+
+// TenantID mocks base method.
+func (m *MockDataCollectionRuleScope) TenantID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TenantID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TenantID indicates an expected call of TenantID.
+func (mr *MockDataCollectionRuleScopeMockRecorder) TenantID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantID", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).TenantID))
+}
+
+// Token mocks base method.
+func (m *MockDataCollectionRuleScope) Token() azcore.TokenCredential {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(azcore.TokenCredential)
+	return ret0
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockDataCollectionRuleScopeMockRecorder) Token() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).Token))
+}
+
+// UpdateDeleteStatus mocks base method.
+func (m *MockDataCollectionRuleScope) UpdateDeleteStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateDeleteStatus", arg0, arg1, arg2)
+}
+
+// UpdateDeleteStatus indicates an expected call of UpdateDeleteStatus.
+func (mr *MockDataCollectionRuleScopeMockRecorder) UpdateDeleteStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeleteStatus", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).UpdateDeleteStatus), arg0, arg1, arg2)
+}
+
+// UpdatePatchStatus mocks base method.
+func (m *MockDataCollectionRuleScope) UpdatePatchStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePatchStatus", arg0, arg1, arg2)
+}
+
+// UpdatePatchStatus indicates an expected call of UpdatePatchStatus.
+func (mr *MockDataCollectionRuleScopeMockRecorder) UpdatePatchStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatchStatus", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).UpdatePatchStatus), arg0, arg1, arg2)
+}
+
+// UpdatePutStatus mocks base method.
+func (m *MockDataCollectionRuleScope) UpdatePutStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePutStatus", arg0, arg1, arg2)
+}
+
+// UpdatePutStatus indicates an expected call of UpdatePutStatus.
+func (mr *MockDataCollectionRuleScopeMockRecorder) UpdatePutStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePutStatus", reflect.TypeOf((*MockDataCollectionRuleScope)(nil).UpdatePutStatus), arg0, arg1, arg2)
+}
+
+// MockendpointGetter is a mock of endpointGetter interface.
+type MockendpointGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockendpointGetterMockRecorder
+}
+
+// MockendpointGetterMockRecorder is the mock recorder for MockendpointGetter.
+type MockendpointGetterMockRecorder struct {
+	mock *MockendpointGetter
+}
+
+// NewMockendpointGetter creates a new mock instance.
+func NewMockendpointGetter(ctrl *gomock.Controller) *MockendpointGetter {
+	mock := &MockendpointGetter{ctrl: ctrl}
+	mock.recorder = &MockendpointGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockendpointGetter) EXPECT() *MockendpointGetterMockRecorder {
+	return m.recorder
+}
+
+// GetDataCollectionEndpointID mocks base method.
+func (m *MockendpointGetter) GetDataCollectionEndpointID(ctx context.Context, workspaceID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataCollectionEndpointID", ctx, workspaceID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataCollectionEndpointID indicates an expected call of GetDataCollectionEndpointID.
+func (mr *MockendpointGetterMockRecorder) GetDataCollectionEndpointID(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataCollectionEndpointID", reflect.TypeOf((*MockendpointGetter)(nil).GetDataCollectionEndpointID), ctx, workspaceID)
+}
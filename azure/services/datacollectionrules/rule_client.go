@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacollectionrules
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// azureRulesClient contains the Azure go-sdk Client for data collection rules.
+type azureRulesClient struct {
+	rules      *armmonitor.DataCollectionRulesClient
+	workspaces *armmonitor.AzureMonitorWorkspacesClient
+}
+
+// newRulesClient creates a data collection rules client from an authorizer.
+func newRulesClient(auth azure.Authorizer) (*azureRulesClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create datacollectionrules client options")
+	}
+	factory, err := armmonitor.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armmonitor client factory")
+	}
+	return &azureRulesClient{
+		rules:      factory.NewDataCollectionRulesClient(),
+		workspaces: factory.NewAzureMonitorWorkspacesClient(),
+	}, nil
+}
+
+// Get gets the specified data collection rule.
+func (ac *azureRulesClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.azureRulesClient.Get")
+	defer done()
+
+	resp, err := ac.rules.Get(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.DataCollectionRuleResource, nil
+}
+
+// CreateOrUpdateAsync creates or updates a data collection rule.
+// Creating a data collection rule is not a long running operation, so we don't ever return a poller.
+func (ac *azureRulesClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armmonitor.DataCollectionRulesClientCreateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.azureRulesClient.CreateOrUpdateAsync")
+	defer done()
+
+	rule, ok := parameters.(armmonitor.DataCollectionRuleResource)
+	if !ok {
+		return nil, nil, errors.Errorf("%T is not an armmonitor.DataCollectionRuleResource", parameters)
+	}
+	resp, err := ac.rules.Create(ctx, spec.ResourceGroupName(), spec.ResourceName(), &armmonitor.DataCollectionRulesClientCreateOptions{Body: &rule})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.DataCollectionRuleResource, nil, nil
+}
+
+// DeleteAsync deletes a data collection rule.
+// Deleting a data collection rule is not a long running operation, so we don't ever return a poller.
+func (ac *azureRulesClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armmonitor.DataCollectionRulesClientDeleteResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.azureRulesClient.DeleteAsync")
+	defer done()
+
+	_, err = ac.rules.Delete(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	return nil, err
+}
+
+// GetDataCollectionEndpointID returns the ID of the default data collection endpoint of the Azure Monitor workspace
+// with the given resource ID, or an empty string if the workspace doesn't have one yet.
+func (ac *azureRulesClient) GetDataCollectionEndpointID(ctx context.Context, workspaceID string) (string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "datacollectionrules.azureRulesClient.GetDataCollectionEndpointID")
+	defer done()
+
+	id, err := arm.ParseResourceID(workspaceID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse Azure Monitor workspace ID %s", workspaceID)
+	}
+	resp, err := ac.workspaces.Get(ctx, id.ResourceGroupName, id.Name, nil)
+	if err != nil {
+		return "", err
+	}
+	if resp.Properties == nil || resp.Properties.DefaultIngestionSettings == nil {
+		return "", nil
+	}
+	return ptr.Deref(resp.Properties.DefaultIngestionSettings.DataCollectionEndpointResourceID, ""), nil
+}
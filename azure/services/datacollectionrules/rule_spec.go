@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacollectionrules
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+)
+
+const (
+	// prometheusDataSourceName is the name of the data source which collects the Prometheus metrics of the cluster.
+	prometheusDataSourceName = "PrometheusDataSource"
+	// monitoringAccountDestinationName is the name of the destination which sends the metrics to the workspace.
+	monitoringAccountDestinationName = "MonitoringAccount1"
+)
+
+// DataCollectionRuleSpec defines the specification for a data collection rule which sends the Prometheus metrics of
+// a cluster to an Azure Monitor workspace.
+type DataCollectionRuleSpec struct {
+	Name          string
+	ResourceGroup string
+	Location      string
+	// WorkspaceID is the Azure resource ID of the Azure Monitor workspace the metrics are sent to.
+	WorkspaceID string
+	// DataCollectionEndpointID is the Azure resource ID of the data collection endpoint of the workspace. It is
+	// looked up from the workspace when empty.
+	DataCollectionEndpointID string
+	Streams                  []string
+	ClusterName              string
+	AdditionalTags           infrav1.Tags
+}
+
+// ResourceName returns the name of the data collection rule.
+func (s *DataCollectionRuleSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the data collection rule.
+func (s *DataCollectionRuleSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for data collection rules.
+func (s *DataCollectionRuleSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters returns the parameters for the data collection rule. An existing rule is replaced when its data flows
+// don't send the desired streams to the workspace.
+func (s *DataCollectionRuleSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		existingRule, ok := existing.(armmonitor.DataCollectionRuleResource)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armmonitor.DataCollectionRuleResource", existing)
+		}
+		if s.isUpToDate(existingRule) {
+			return nil, nil
+		}
+	}
+
+	dataFlowStreams := make([]*armmonitor.KnownDataFlowStreams, len(s.Streams))
+	sourceStreams := make([]*armmonitor.KnownPrometheusForwarderDataSourceStreams, len(s.Streams))
+	for i, stream := range s.Streams {
+		dataFlowStreams[i] = ptr.To(armmonitor.KnownDataFlowStreams(stream))
+		sourceStreams[i] = ptr.To(armmonitor.KnownPrometheusForwarderDataSourceStreams(stream))
+	}
+	return armmonitor.DataCollectionRuleResource{
+		Location: ptr.To(s.Location),
+		Kind:     ptr.To(armmonitor.KnownDataCollectionRuleResourceKindLinux),
+		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.ClusterName,
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        ptr.To(s.Name),
+			Additional:  s.AdditionalTags,
+		})),
+		Properties: &armmonitor.DataCollectionRuleResourceProperties{
+			DataCollectionEndpointID: ptr.To(s.DataCollectionEndpointID),
+			DataSources: &armmonitor.DataCollectionRuleDataSources{
+				PrometheusForwarder: []*armmonitor.PrometheusForwarderDataSource{
+					{
+						Name:    ptr.To(prometheusDataSourceName),
+						Streams: sourceStreams,
+					},
+				},
+			},
+			Destinations: &armmonitor.DataCollectionRuleDestinations{
+				MonitoringAccounts: []*armmonitor.MonitoringAccountDestination{
+					{
+						Name:              ptr.To(monitoringAccountDestinationName),
+						AccountResourceID: ptr.To(s.WorkspaceID),
+					},
+				},
+			},
+			DataFlows: []*armmonitor.DataFlow{
+				{
+					Streams:      dataFlowStreams,
+					Destinations: []*string{ptr.To(monitoringAccountDestinationName)},
+				},
+			},
+		},
+	}, nil
+}
+
+// isUpToDate returns true if the existing data collection rule sends exactly the desired streams to the workspace.
+func (s *DataCollectionRuleSpec) isUpToDate(existing armmonitor.DataCollectionRuleResource) bool {
+	if existing.Properties == nil || existing.Properties.Destinations == nil || len(existing.Properties.DataFlows) != 1 {
+		return false
+	}
+	accounts := existing.Properties.Destinations.MonitoringAccounts
+	if len(accounts) != 1 || accounts[0] == nil || !strings.EqualFold(ptr.Deref(accounts[0].AccountResourceID, ""), s.WorkspaceID) {
+		return false
+	}
+	dataFlow := existing.Properties.DataFlows[0]
+	if dataFlow == nil {
+		return false
+	}
+	var streams []string
+	for _, stream := range dataFlow.Streams {
+		if stream != nil {
+			streams = append(streams, string(*stream))
+		}
+	}
+	return sameStreams(streams, s.Streams)
+}
+
+// sameStreams returns true if both lists contain the same streams, regardless of their order.
+func sameStreams(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
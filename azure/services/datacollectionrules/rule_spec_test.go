@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacollectionrules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestDataCollectionRuleSpecParameters(t *testing.T) {
+	existingRule := func(workspaceID string, streams ...string) armmonitor.DataCollectionRuleResource {
+		dataFlowStreams := make([]*armmonitor.KnownDataFlowStreams, len(streams))
+		for i, stream := range streams {
+			dataFlowStreams[i] = ptr.To(armmonitor.KnownDataFlowStreams(stream))
+		}
+		return armmonitor.DataCollectionRuleResource{
+			Properties: &armmonitor.DataCollectionRuleResourceProperties{
+				Destinations: &armmonitor.DataCollectionRuleDestinations{
+					MonitoringAccounts: []*armmonitor.MonitoringAccountDestination{
+						{Name: ptr.To(monitoringAccountDestinationName), AccountResourceID: ptr.To(workspaceID)},
+					},
+				},
+				DataFlows: []*armmonitor.DataFlow{
+					{Streams: dataFlowStreams, Destinations: []*string{ptr.To(monitoringAccountDestinationName)}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		streams     []string
+		existing    interface{}
+		wantStreams []string
+		wantErr     bool
+	}{
+		{
+			name:        "new data collection rule",
+			streams:     []string{"Microsoft-PrometheusMetrics"},
+			existing:    nil,
+			wantStreams: []string{"Microsoft-PrometheusMetrics"},
+		},
+		{
+			name:     "existing data collection rule is up to date",
+			streams:  []string{"Microsoft-PrometheusMetrics"},
+			existing: existingRule(fakeWorkspaceID, "Microsoft-PrometheusMetrics"),
+		},
+		{
+			name:     "workspace ID is compared case insensitively",
+			streams:  []string{"Microsoft-PrometheusMetrics"},
+			existing: existingRule("/subscriptions/123/resourcegroups/TEST-RG/providers/Microsoft.Monitor/accounts/test-workspace", "Microsoft-PrometheusMetrics"),
+		},
+		{
+			name:        "data flows of existing data collection rule are updated with added streams",
+			streams:     []string{"Microsoft-PrometheusMetrics", "Microsoft-InsightsMetrics"},
+			existing:    existingRule(fakeWorkspaceID, "Microsoft-PrometheusMetrics"),
+			wantStreams: []string{"Microsoft-PrometheusMetrics", "Microsoft-InsightsMetrics"},
+		},
+		{
+			name:        "data flows of existing data collection rule are updated with removed streams",
+			streams:     []string{"Microsoft-PrometheusMetrics"},
+			existing:    existingRule(fakeWorkspaceID, "Microsoft-PrometheusMetrics", "Microsoft-InsightsMetrics"),
+			wantStreams: []string{"Microsoft-PrometheusMetrics"},
+		},
+		{
+			name:        "existing data collection rule sending to another workspace is updated",
+			streams:     []string{"Microsoft-PrometheusMetrics"},
+			existing:    existingRule("/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Monitor/accounts/other", "Microsoft-PrometheusMetrics"),
+			wantStreams: []string{"Microsoft-PrometheusMetrics"},
+		},
+		{
+			name:     "existing is not a data collection rule",
+			streams:  []string{"Microsoft-PrometheusMetrics"},
+			existing: armmonitor.DiagnosticSettingsResource{},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := fakeRule(fakeEndpointID)
+			spec.Streams = tc.streams
+
+			got, err := spec.Parameters(context.Background(), tc.existing)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.wantStreams == nil {
+				g.Expect(got).To(BeNil())
+				return
+			}
+
+			rule, ok := got.(armmonitor.DataCollectionRuleResource)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(rule.Location).To(Equal(ptr.To("eastus")))
+			g.Expect(rule.Kind).To(Equal(ptr.To(armmonitor.KnownDataCollectionRuleResourceKindLinux)))
+			g.Expect(rule.Properties.DataCollectionEndpointID).To(Equal(ptr.To(fakeEndpointID)))
+			g.Expect(rule.Properties.Destinations.MonitoringAccounts).To(ConsistOf(&armmonitor.MonitoringAccountDestination{
+				Name:              ptr.To(monitoringAccountDestinationName),
+				AccountResourceID: ptr.To(fakeWorkspaceID),
+			}))
+			g.Expect(rule.Properties.DataSources.PrometheusForwarder).To(HaveLen(1))
+			g.Expect(rule.Properties.DataFlows).To(HaveLen(1))
+			var streams []string
+			for _, stream := range rule.Properties.DataFlows[0].Streams {
+				streams = append(streams, string(*stream))
+			}
+			g.Expect(streams).To(Equal(tc.wantStreams))
+			g.Expect(rule.Properties.DataFlows[0].Destinations).To(ConsistOf(ptr.To(monitoringAccountDestinationName)))
+		})
+	}
+}
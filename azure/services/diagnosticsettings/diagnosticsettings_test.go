@@ -0,0 +1,186 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnosticsettings
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/diagnosticsettings/mock_diagnosticsettings"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var (
+	fakeVNetSetting = DiagnosticSettingSpec{
+		Name:             "logs",
+		ResourceGroup:    "test-rg",
+		TargetResourceID: "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Network/virtualNetworks/test-vnet",
+		WorkspaceID:      fakeWorkspaceID,
+		LogCategories:    []string{"VMProtectionAlerts"},
+		MetricCategories: []string{"AllMetrics"},
+	}
+	fakeNSGSetting = DiagnosticSettingSpec{
+		Name:             "logs",
+		ResourceGroup:    "test-rg",
+		TargetResourceID: "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Network/networkSecurityGroups/test-nsg",
+		WorkspaceID:      fakeWorkspaceID,
+		LogCategories:    []string{"NetworkSecurityGroupEvent", "NetworkSecurityGroupRuleCounter"},
+	}
+	errFake       = errors.New("this is an error")
+	errCreateDone = azure.NewOperationNotDoneError(&infrav1.Future{})
+)
+
+const fakeWorkspaceID = "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/test-workspace"
+
+func TestReconcileDiagnosticSettings(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_diagnosticsettings.MockDiagnosticSettingScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no diagnostic settings are specified",
+			expectedError: "",
+			expect: func(s *mock_diagnosticsettings.MockDiagnosticSettingScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DiagnosticSettingSpecs().Return(nil)
+			},
+		},
+		{
+			name:          "create diagnostic settings succeeds",
+			expectedError: "",
+			expect: func(s *mock_diagnosticsettings.MockDiagnosticSettingScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DiagnosticSettingSpecs().Return([]azure.ResourceSpecGetter{&fakeVNetSetting, &fakeNSGSetting})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeVNetSetting, ServiceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeNSGSetting, ServiceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.DiagnosticSettingsReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "first diagnostic setting create fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_diagnosticsettings.MockDiagnosticSettingScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DiagnosticSettingSpecs().Return([]azure.ResourceSpecGetter{&fakeVNetSetting, &fakeNSGSetting})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeVNetSetting, ServiceName).Return(nil, errFake)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeNSGSetting, ServiceName).Return(nil, errCreateDone)
+				s.UpdatePutStatus(infrav1.DiagnosticSettingsReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_diagnosticsettings.NewMockDiagnosticSettingScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteDiagnosticSettings(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_diagnosticsettings.MockDiagnosticSettingScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no diagnostic settings are specified",
+			expectedError: "",
+			expect: func(s *mock_diagnosticsettings.MockDiagnosticSettingScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DiagnosticSettingSpecs().Return(nil)
+			},
+		},
+		{
+			name:          "delete diagnostic settings succeeds",
+			expectedError: "",
+			expect: func(s *mock_diagnosticsettings.MockDiagnosticSettingScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DiagnosticSettingSpecs().Return([]azure.ResourceSpecGetter{&fakeVNetSetting, &fakeNSGSetting})
+				r.DeleteResource(gomockinternal.AContext(), &fakeVNetSetting, ServiceName).Return(nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeNSGSetting, ServiceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.DiagnosticSettingsReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "diagnostic setting delete fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_diagnosticsettings.MockDiagnosticSettingScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DiagnosticSettingSpecs().Return([]azure.ResourceSpecGetter{&fakeVNetSetting, &fakeNSGSetting})
+				r.DeleteResource(gomockinternal.AContext(), &fakeVNetSetting, ServiceName).Return(errFake)
+				r.DeleteResource(gomockinternal.AContext(), &fakeNSGSetting, ServiceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.DiagnosticSettingsReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_diagnosticsettings.NewMockDiagnosticSettingScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnosticsettings
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+)
+
+// DiagnosticSettingSpec defines the specification for a diagnostic setting of an Azure resource.
+type DiagnosticSettingSpec struct {
+	Name string
+	// ResourceGroup is the resource group of the target resource.
+	ResourceGroup string
+	// TargetResourceID is the Azure resource ID of the resource the diagnostic setting belongs to.
+	TargetResourceID string
+	WorkspaceID      string
+	LogCategories    []string
+	MetricCategories []string
+}
+
+// ResourceName returns the name of the diagnostic setting.
+func (s *DiagnosticSettingSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the target resource.
+func (s *DiagnosticSettingSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName returns the Azure resource ID of the target resource.
+func (s *DiagnosticSettingSpec) OwnerResourceName() string {
+	return s.TargetResourceID
+}
+
+// Parameters returns the parameters for the diagnostic setting.
+func (s *DiagnosticSettingSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		existingSetting, ok := existing.(armmonitor.DiagnosticSettingsResource)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armmonitor.DiagnosticSettingsResource", existing)
+		}
+		if s.isUpToDate(existingSetting) {
+			return nil, nil
+		}
+	}
+
+	// The whole diagnostic setting is replaced, which disables the categories which are no longer listed.
+	logs := make([]*armmonitor.LogSettings, len(s.LogCategories))
+	for i, category := range s.LogCategories {
+		logs[i] = &armmonitor.LogSettings{
+			Category: ptr.To(category),
+			Enabled:  ptr.To(true),
+		}
+	}
+	metrics := make([]*armmonitor.MetricSettings, len(s.MetricCategories))
+	for i, category := range s.MetricCategories {
+		metrics[i] = &armmonitor.MetricSettings{
+			Category: ptr.To(category),
+			Enabled:  ptr.To(true),
+		}
+	}
+	return armmonitor.DiagnosticSettingsResource{
+		Properties: &armmonitor.DiagnosticSettings{
+			WorkspaceID: ptr.To(s.WorkspaceID),
+			Logs:        logs,
+			Metrics:     metrics,
+		},
+	}, nil
+}
+
+// isUpToDate returns true if the existing diagnostic setting sends exactly the desired categories to the desired workspace.
+func (s *DiagnosticSettingSpec) isUpToDate(existing armmonitor.DiagnosticSettingsResource) bool {
+	if existing.Properties == nil || !strings.EqualFold(ptr.Deref(existing.Properties.WorkspaceID, ""), s.WorkspaceID) {
+		return false
+	}
+	var logCategories []string
+	for _, log := range existing.Properties.Logs {
+		if log != nil && ptr.Deref(log.Enabled, false) {
+			logCategories = append(logCategories, ptr.Deref(log.Category, ""))
+		}
+	}
+	var metricCategories []string
+	for _, metric := range existing.Properties.Metrics {
+		if metric != nil && ptr.Deref(metric.Enabled, false) {
+			metricCategories = append(metricCategories, ptr.Deref(metric.Category, ""))
+		}
+	}
+	return sameCategories(logCategories, s.LogCategories) && sameCategories(metricCategories, s.MetricCategories)
+}
+
+// sameCategories returns true if both lists contain the same categories, regardless of their order.
+func sameCategories(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnosticsettings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestParameters(t *testing.T) {
+	testcases := []struct {
+		name          string
+		spec          *DiagnosticSettingSpec
+		existing      interface{}
+		expect        func(g *WithT, result interface{})
+		expectedError string
+	}{
+		{
+			name:     "new diagnostic setting",
+			spec:     &fakeNSGSetting,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armmonitor.DiagnosticSettingsResource{
+					Properties: &armmonitor.DiagnosticSettings{
+						WorkspaceID: ptr.To(fakeWorkspaceID),
+						Logs: []*armmonitor.LogSettings{
+							{Category: ptr.To("NetworkSecurityGroupEvent"), Enabled: ptr.To(true)},
+							{Category: ptr.To("NetworkSecurityGroupRuleCounter"), Enabled: ptr.To(true)},
+						},
+						Metrics: []*armmonitor.MetricSettings{},
+					},
+				}))
+			},
+		},
+		{
+			name: "existing diagnostic setting is up to date",
+			spec: &fakeVNetSetting,
+			existing: armmonitor.DiagnosticSettingsResource{
+				Properties: &armmonitor.DiagnosticSettings{
+					WorkspaceID: ptr.To(fakeWorkspaceID),
+					Logs: []*armmonitor.LogSettings{
+						{Category: ptr.To("VMProtectionAlerts"), Enabled: ptr.To(true)},
+					},
+					Metrics: []*armmonitor.MetricSettings{
+						{Category: ptr.To("AllMetrics"), Enabled: ptr.To(true)},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "existing diagnostic setting with another enabled category",
+			spec: &fakeNSGSetting,
+			existing: armmonitor.DiagnosticSettingsResource{
+				Properties: &armmonitor.DiagnosticSettings{
+					WorkspaceID: ptr.To(fakeWorkspaceID),
+					Logs: []*armmonitor.LogSettings{
+						{Category: ptr.To("NetworkSecurityGroupEvent"), Enabled: ptr.To(true)},
+						{Category: ptr.To("NetworkSecurityGroupRuleCounter"), Enabled: ptr.To(false)},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armmonitor.DiagnosticSettingsResource{}))
+				setting := result.(armmonitor.DiagnosticSettingsResource)
+				g.Expect(setting.Properties.Logs).To(Equal([]*armmonitor.LogSettings{
+					{Category: ptr.To("NetworkSecurityGroupEvent"), Enabled: ptr.To(true)},
+					{Category: ptr.To("NetworkSecurityGroupRuleCounter"), Enabled: ptr.To(true)},
+				}))
+			},
+		},
+		{
+			name: "existing diagnostic setting with a category which is no longer enabled",
+			spec: &fakeVNetSetting,
+			existing: armmonitor.DiagnosticSettingsResource{
+				Properties: &armmonitor.DiagnosticSettings{
+					WorkspaceID: ptr.To(fakeWorkspaceID),
+					Logs: []*armmonitor.LogSettings{
+						{Category: ptr.To("VMProtectionAlerts"), Enabled: ptr.To(true)},
+					},
+					Metrics: []*armmonitor.MetricSettings{
+						{Category: ptr.To("AllMetrics"), Enabled: ptr.To(true)},
+						{Category: ptr.To("OtherMetrics"), Enabled: ptr.To(true)},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armmonitor.DiagnosticSettingsResource{}))
+				setting := result.(armmonitor.DiagnosticSettingsResource)
+				g.Expect(setting.Properties.Metrics).To(Equal([]*armmonitor.MetricSettings{
+					{Category: ptr.To("AllMetrics"), Enabled: ptr.To(true)},
+				}))
+			},
+		},
+		{
+			name: "existing diagnostic setting sends logs to another workspace",
+			spec: &fakeVNetSetting,
+			existing: armmonitor.DiagnosticSettingsResource{
+				Properties: &armmonitor.DiagnosticSettings{
+					WorkspaceID: ptr.To("/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/other"),
+					Logs: []*armmonitor.LogSettings{
+						{Category: ptr.To("VMProtectionAlerts"), Enabled: ptr.To(true)},
+					},
+					Metrics: []*armmonitor.MetricSettings{
+						{Category: ptr.To("AllMetrics"), Enabled: ptr.To(true)},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armmonitor.DiagnosticSettingsResource{}))
+				g.Expect(result.(armmonitor.DiagnosticSettingsResource).Properties.WorkspaceID).To(Equal(ptr.To(fakeWorkspaceID)))
+			},
+		},
+		{
+			name:          "existing is not a diagnostic setting",
+			spec:          &fakeVNetSetting,
+			existing:      "wrong type",
+			expectedError: "string is not an armmonitor.DiagnosticSettingsResource",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				tc.expect(g, result)
+			}
+		})
+	}
+}
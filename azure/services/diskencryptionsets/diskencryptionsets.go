@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diskencryptionsets
+
+import (
+	"context"
+
+	asocomputev1 "github.com/Azure/azure-service-operator/v2/api/compute/v1api20220702"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+)
+
+const serviceName = "diskencryptionsets"
+
+// DiskEncryptionSetScope defines the scope interface for a disk encryption set service.
+type DiskEncryptionSetScope interface {
+	aso.Scope
+	DiskEncryptionSetSpecs() []azure.ASOResourceSpecGetter[*asocomputev1.DiskEncryptionSet]
+	SetDiskEncryptionSetStatus(status infrav1.DiskEncryptionSetStatus)
+}
+
+// New creates a new service.
+func New(scope DiskEncryptionSetScope) *aso.Service[*asocomputev1.DiskEncryptionSet, DiskEncryptionSetScope] {
+	svc := aso.NewService[*asocomputev1.DiskEncryptionSet, DiskEncryptionSetScope](serviceName, scope)
+	svc.Specs = scope.DiskEncryptionSetSpecs()
+	svc.ConditionType = infrav1.DiskEncryptionSetsReadyCondition
+	svc.PostCreateOrUpdateResourceHook = postCreateOrUpdateResourceHook
+	return svc
+}
+
+func postCreateOrUpdateResourceHook(_ context.Context, scope DiskEncryptionSetScope, result *asocomputev1.DiskEncryptionSet, err error) error {
+	if err != nil {
+		return err
+	}
+	// result only gets populated once the disk encryption set is ready or if it already exists.
+	if result != nil && result.Status.Id != nil {
+		status := infrav1.DiskEncryptionSetStatus{
+			Name: result.AzureName(),
+			ID:   ptr.Deref(result.Status.Id, ""),
+		}
+		if result.Status.Identity != nil {
+			status.PrincipalID = ptr.Deref(result.Status.Identity.PrincipalId, "")
+		}
+		if result.Status.ActiveKey != nil {
+			status.ActiveKeyURL = ptr.Deref(result.Status.ActiveKey.KeyUrl, "")
+		}
+		scope.SetDiskEncryptionSetStatus(status)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diskencryptionsets
+
+import (
+	"context"
+	"testing"
+
+	asocomputev1 "github.com/Azure/azure-service-operator/v2/api/compute/v1api20220702"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso/mock_aso"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/diskencryptionsets/mock_diskencryptionsets"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	reconcilerutils "sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+const fakeDiskEncryptionSetID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/diskEncryptionSets/my-des"
+
+func fakeDiskEncryptionSet(activeKeyURL string) *asocomputev1.DiskEncryptionSet {
+	return &asocomputev1.DiskEncryptionSet{
+		Spec: asocomputev1.DiskEncryptionSet_Spec{
+			AzureName: "my-des",
+		},
+		Status: asocomputev1.DiskEncryptionSet_STATUS{
+			Id: ptr.To(fakeDiskEncryptionSetID),
+			ActiveKey: &asocomputev1.KeyForDiskEncryptionSet_STATUS{
+				KeyUrl: ptr.To(activeKeyURL),
+			},
+			Identity: &asocomputev1.EncryptionSetIdentity_STATUS{
+				PrincipalId: ptr.To("principal-id"),
+			},
+		},
+	}
+}
+
+func TestReconcileDiskEncryptionSets(t *testing.T) {
+	rotatedSpec := *fakeDiskEncryptionSetSpec
+	rotatedSpec.KeyURL = fakeRotatedKeyURL
+
+	testcases := []struct {
+		name        string
+		spec        *DiskEncryptionSetSpec
+		result      *asocomputev1.DiskEncryptionSet
+		wantKeyURL  string
+		expectedErr string
+	}{
+		{
+			name:       "create disk encryption set",
+			spec:       fakeDiskEncryptionSetSpec,
+			result:     fakeDiskEncryptionSet(fakeKeyURL),
+			wantKeyURL: fakeKeyURL,
+		},
+		{
+			name:       "rotate disk encryption set to a new key",
+			spec:       &rotatedSpec,
+			result:     fakeDiskEncryptionSet(fakeRotatedKeyURL),
+			wantKeyURL: fakeRotatedKeyURL,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			mockCtrl := gomock.NewController(t)
+			scope := mock_diskencryptionsets.NewMockDiskEncryptionSetScope(mockCtrl)
+			reconciler := mock_aso.NewMockReconciler[*asocomputev1.DiskEncryptionSet](mockCtrl)
+
+			specs := []azure.ASOResourceSpecGetter[*asocomputev1.DiskEncryptionSet]{tc.spec}
+
+			scope.EXPECT().GetClient().Return(nil)
+			scope.EXPECT().ClusterName().Return("my-cluster")
+			scope.EXPECT().ASOOwner().Return(&infrav1.AzureCluster{})
+			scope.EXPECT().DiskEncryptionSetSpecs().Return(specs)
+			scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+			reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), tc.spec, serviceName).Return(tc.result, nil)
+			scope.EXPECT().SetDiskEncryptionSetStatus(infrav1.DiskEncryptionSetStatus{
+				Name:         "my-des",
+				ID:           fakeDiskEncryptionSetID,
+				PrincipalID:  "principal-id",
+				ActiveKeyURL: tc.wantKeyURL,
+			})
+			scope.EXPECT().UpdatePutStatus(infrav1.DiskEncryptionSetsReadyCondition, serviceName, nil)
+
+			s := New(scope)
+			s.Reconciler = reconciler
+
+			err := s.Reconcile(context.Background())
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestPostCreateOrUpdateResourceHook(t *testing.T) {
+	t.Run("error creating or updating", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_diskencryptionsets.NewMockDiskEncryptionSetScope(mockCtrl)
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, nil, errors.New("an error"))
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("disk encryption set not created yet", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_diskencryptionsets.NewMockDiskEncryptionSetScope(mockCtrl)
+
+		encryptionSet := &asocomputev1.DiskEncryptionSet{
+			Spec: asocomputev1.DiskEncryptionSet_Spec{
+				AzureName: "my-des",
+			},
+		}
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, encryptionSet, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("successful create or update", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_diskencryptionsets.NewMockDiskEncryptionSetScope(mockCtrl)
+
+		scope.EXPECT().SetDiskEncryptionSetStatus(infrav1.DiskEncryptionSetStatus{
+			Name:         "my-des",
+			ID:           fakeDiskEncryptionSetID,
+			PrincipalID:  "principal-id",
+			ActiveKeyURL: fakeKeyURL,
+		})
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, fakeDiskEncryptionSet(fakeKeyURL), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+}
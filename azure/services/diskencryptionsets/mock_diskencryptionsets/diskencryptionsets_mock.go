@@ -0,0 +1,271 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../diskencryptionsets.go
+//
+// Generated by this command:
+//
+//	mockgen -destination diskencryptionsets_mock.go -package mock_diskencryptionsets -source ../diskencryptionsets.go DiskEncryptionSetScope
+//
+
+// Package mock_diskencryptionsets is a generated GoMock package.
+package mock_diskencryptionsets
+
+import (
+	reflect "reflect"
+	time "time"
+
+	v1api20220702 "github.com/Azure/azure-service-operator/v2/api/compute/v1api20220702"
+	gomock "go.uber.org/mock/gomock"
+	v1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	azure "sigs.k8s.io/cluster-api-provider-azure/azure"
+	v1beta10 "sigs.k8s.io/cluster-api/api/v1beta1"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MockDiskEncryptionSetScope is a mock of DiskEncryptionSetScope interface.
+type MockDiskEncryptionSetScope struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiskEncryptionSetScopeMockRecorder
+}
+
+// MockDiskEncryptionSetScopeMockRecorder is the mock recorder for MockDiskEncryptionSetScope.
+type MockDiskEncryptionSetScopeMockRecorder struct {
+	mock *MockDiskEncryptionSetScope
+}
+
+// NewMockDiskEncryptionSetScope creates a new mock instance.
+func NewMockDiskEncryptionSetScope(ctrl *gomock.Controller) *MockDiskEncryptionSetScope {
+	mock := &MockDiskEncryptionSetScope{ctrl: ctrl}
+	mock.recorder = &MockDiskEncryptionSetScopeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiskEncryptionSetScope) EXPECT() *MockDiskEncryptionSetScopeMockRecorder {
+	return m.recorder
+}
+
+// ASOOwner mocks base method.
+func (m *MockDiskEncryptionSetScope) ASOOwner() client.Object {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ASOOwner")
+	ret0, _ := ret[0].(client.Object)
+	return ret0
+}
+
+// ASOOwner indicates an expected call of ASOOwner.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) ASOOwner() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ASOOwner", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).ASOOwner))
+}
+
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockDiskEncryptionSetScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockDiskEncryptionSetScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
+// ClusterName mocks base method.
+func (m *MockDiskEncryptionSetScope) ClusterName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ClusterName indicates an expected call of ClusterName.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) ClusterName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterName", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).ClusterName))
+}
+
+// DefaultedAzureCallTimeout mocks base method.
+func (m *MockDiskEncryptionSetScope) DefaultedAzureCallTimeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedAzureCallTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedAzureCallTimeout indicates an expected call of DefaultedAzureCallTimeout.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).DefaultedAzureCallTimeout))
+}
+
+// DefaultedReconcilerRequeue mocks base method.
+func (m *MockDiskEncryptionSetScope) DefaultedReconcilerRequeue() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultedReconcilerRequeue")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// DefaultedReconcilerRequeue indicates an expected call of DefaultedReconcilerRequeue.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) DefaultedReconcilerRequeue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedReconcilerRequeue", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).DefaultedReconcilerRequeue))
+}
+
+// DeleteLongRunningOperationState mocks base method.
+func (m *MockDiskEncryptionSetScope) DeleteLongRunningOperationState(arg0, arg1, arg2 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteLongRunningOperationState", arg0, arg1, arg2)
+}
+
+// DeleteLongRunningOperationState indicates an expected call of DeleteLongRunningOperationState.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) DeleteLongRunningOperationState(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLongRunningOperationState", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).DeleteLongRunningOperationState), arg0, arg1, arg2)
+}
+
+// DiskEncryptionSetSpecs mocks base method.
+func (m *MockDiskEncryptionSetScope) DiskEncryptionSetSpecs() []azure.ASOResourceSpecGetter[*v1api20220702.DiskEncryptionSet] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiskEncryptionSetSpecs")
+	ret0, _ := ret[0].([]azure.ASOResourceSpecGetter[*v1api20220702.DiskEncryptionSet])
+	return ret0
+}
+
+// DiskEncryptionSetSpecs indicates an expected call of DiskEncryptionSetSpecs.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) DiskEncryptionSetSpecs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiskEncryptionSetSpecs", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).DiskEncryptionSetSpecs))
+}
+
+// GetClient mocks base method.
+func (m *MockDiskEncryptionSetScope) GetClient() client.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClient")
+	ret0, _ := ret[0].(client.Client)
+	return ret0
+}
+
+// GetClient indicates an expected call of GetClient.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) GetClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClient", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).GetClient))
+}
+
+// GetLongRunningOperationState mocks base method.
+func (m *MockDiskEncryptionSetScope) GetLongRunningOperationState(arg0, arg1, arg2 string) *v1beta1.Future {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLongRunningOperationState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*v1beta1.Future)
+	return ret0
+}
+
+// GetLongRunningOperationState indicates an expected call of GetLongRunningOperationState.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) GetLongRunningOperationState(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongRunningOperationState", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).GetLongRunningOperationState), arg0, arg1, arg2)
+}
+
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockDiskEncryptionSetScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
+// SetDiskEncryptionSetStatus mocks base method.
+func (m *MockDiskEncryptionSetScope) SetDiskEncryptionSetStatus(status v1beta1.DiskEncryptionSetStatus) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDiskEncryptionSetStatus", status)
+}
+
+// SetDiskEncryptionSetStatus indicates an expected call of SetDiskEncryptionSetStatus.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) SetDiskEncryptionSetStatus(status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDiskEncryptionSetStatus", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).SetDiskEncryptionSetStatus), status)
+}
+
+// SetLongRunningOperationState mocks base method.
+func (m *MockDiskEncryptionSetScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLongRunningOperationState", arg0)
+}
+
+// SetLongRunningOperationState indicates an expected call of SetLongRunningOperationState.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) SetLongRunningOperationState(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLongRunningOperationState", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).SetLongRunningOperationState), arg0)
+}
+
+// UpdateDeleteStatus mocks base method.
+func (m *MockDiskEncryptionSetScope) UpdateDeleteStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateDeleteStatus", arg0, arg1, arg2)
+}
+
+// UpdateDeleteStatus indicates an expected call of UpdateDeleteStatus.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) UpdateDeleteStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeleteStatus", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).UpdateDeleteStatus), arg0, arg1, arg2)
+}
+
+// UpdatePatchStatus mocks base method.
+func (m *MockDiskEncryptionSetScope) UpdatePatchStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePatchStatus", arg0, arg1, arg2)
+}
+
+// UpdatePatchStatus indicates an expected call of UpdatePatchStatus.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) UpdatePatchStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePatchStatus", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).UpdatePatchStatus), arg0, arg1, arg2)
+}
+
+// UpdatePutStatus mocks base method.
+func (m *MockDiskEncryptionSetScope) UpdatePutStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdatePutStatus", arg0, arg1, arg2)
+}
+
+// UpdatePutStatus indicates an expected call of UpdatePutStatus.
+func (mr *MockDiskEncryptionSetScopeMockRecorder) UpdatePutStatus(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePutStatus", reflect.TypeOf((*MockDiskEncryptionSetScope)(nil).UpdatePutStatus), arg0, arg1, arg2)
+}
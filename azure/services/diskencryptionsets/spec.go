@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diskencryptionsets
+
+import (
+	"context"
+
+	asocomputev1 "github.com/Azure/azure-service-operator/v2/api/compute/v1api20220702"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+// DiskEncryptionSetSpec defines the specification for a disk encryption set.
+type DiskEncryptionSetSpec struct {
+	Name          string
+	ResourceGroup string
+	Location      string
+	ClusterName   string
+	// KeyURL is the URL of the Key Vault key the disks are encrypted with.
+	KeyURL string
+	// KeyVaultID is the resource ID of the Key Vault containing the key, if known.
+	KeyVaultID string
+	Identity   infrav1.VMIdentity
+	// UserAssignedIdentityID is the resource ID of the user-assigned identity used to access the key when Identity is
+	// UserAssigned.
+	UserAssignedIdentityID            string
+	RotationToLatestKeyVersionEnabled *bool
+	AdditionalTags                    infrav1.Tags
+}
+
+// ResourceRef implements azure.ASOResourceSpecGetter.
+func (s *DiskEncryptionSetSpec) ResourceRef() *asocomputev1.DiskEncryptionSet {
+	return &asocomputev1.DiskEncryptionSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: s.Name,
+		},
+	}
+}
+
+// Parameters implements azure.ASOResourceSpecGetter. The active key is always set from the spec, so that changing
+// the key URL of an existing disk encryption set rotates it to the new key.
+func (s *DiskEncryptionSetSpec) Parameters(ctx context.Context, existing *asocomputev1.DiskEncryptionSet) (params *asocomputev1.DiskEncryptionSet, err error) {
+	encryptionSet := &asocomputev1.DiskEncryptionSet{}
+	if existing != nil {
+		encryptionSet = existing
+	}
+
+	encryptionSet.Spec.AzureName = s.Name
+	encryptionSet.Spec.Owner = &genruntime.KnownResourceReference{
+		Name: s.ResourceGroup,
+	}
+	encryptionSet.Spec.Location = ptr.To(s.Location)
+	encryptionSet.Spec.EncryptionType = ptr.To(asocomputev1.DiskEncryptionSetType_EncryptionAtRestWithCustomerKey)
+
+	activeKey := &asocomputev1.KeyForDiskEncryptionSet{
+		KeyUrl: ptr.To(s.KeyURL),
+	}
+	if s.KeyVaultID != "" {
+		activeKey.SourceVault = &asocomputev1.SourceVault{
+			Reference: &genruntime.ResourceReference{
+				ARMID: s.KeyVaultID,
+			},
+		}
+	}
+	encryptionSet.Spec.ActiveKey = activeKey
+	encryptionSet.Spec.RotationToLatestKeyVersionEnabled = s.RotationToLatestKeyVersionEnabled
+
+	identity := &asocomputev1.EncryptionSetIdentity{
+		Type: ptr.To(asocomputev1.EncryptionSetIdentity_Type_SystemAssigned),
+	}
+	if s.Identity == infrav1.VMIdentityUserAssigned {
+		identity.Type = ptr.To(asocomputev1.EncryptionSetIdentity_Type_UserAssigned)
+		identity.UserAssignedIdentities = []asocomputev1.UserAssignedIdentityDetails{
+			{
+				Reference: genruntime.ResourceReference{
+					ARMID: s.UserAssignedIdentityID,
+				},
+			},
+		}
+	}
+	encryptionSet.Spec.Identity = identity
+
+	encryptionSet.Spec.Tags = infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.ClusterName,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        ptr.To(s.Name),
+		Additional:  s.AdditionalTags,
+	})
+
+	return encryptionSet, nil
+}
+
+// WasManaged implements azure.ASOResourceSpecGetter.
+func (s *DiskEncryptionSetSpec) WasManaged(resource *asocomputev1.DiskEncryptionSet) bool {
+	// CAPZ only creates disk encryption sets it manages.
+	return true
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diskencryptionsets
+
+import (
+	"context"
+	"testing"
+
+	asocomputev1 "github.com/Azure/azure-service-operator/v2/api/compute/v1api20220702"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+const (
+	fakeKeyURL        = "https://my-vault.vault.azure.net/keys/my-key/0123456789abcdef"
+	fakeRotatedKeyURL = "https://my-vault.vault.azure.net/keys/my-key/fedcba9876543210"
+	fakeKeyVaultID    = "/subscriptions/123/resourceGroups/kv-rg/providers/Microsoft.KeyVault/vaults/my-vault"
+	fakeIdentityID    = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity"
+)
+
+var fakeDiskEncryptionSetSpec = &DiskEncryptionSetSpec{
+	Name:           "my-des",
+	ResourceGroup:  "my-rg",
+	Location:       "eastus",
+	ClusterName:    "my-cluster",
+	KeyURL:         fakeKeyURL,
+	Identity:       infrav1.VMIdentitySystemAssigned,
+	AdditionalTags: infrav1.Tags{"foo": "bar"},
+}
+
+func TestParameters(t *testing.T) {
+	expectedTags := map[string]string{
+		"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": "owned",
+		"Name": "my-des",
+		"foo":  "bar",
+	}
+	testcases := []struct {
+		name     string
+		spec     *DiskEncryptionSetSpec
+		existing *asocomputev1.DiskEncryptionSet
+		expected *asocomputev1.DiskEncryptionSet
+	}{
+		{
+			name:     "new disk encryption set with a system-assigned identity",
+			spec:     fakeDiskEncryptionSetSpec,
+			existing: nil,
+			expected: &asocomputev1.DiskEncryptionSet{
+				Spec: asocomputev1.DiskEncryptionSet_Spec{
+					AzureName: "my-des",
+					Location:  ptr.To("eastus"),
+					Owner: &genruntime.KnownResourceReference{
+						Name: "my-rg",
+					},
+					EncryptionType: ptr.To(asocomputev1.DiskEncryptionSetType_EncryptionAtRestWithCustomerKey),
+					ActiveKey: &asocomputev1.KeyForDiskEncryptionSet{
+						KeyUrl: ptr.To(fakeKeyURL),
+					},
+					Identity: &asocomputev1.EncryptionSetIdentity{
+						Type: ptr.To(asocomputev1.EncryptionSetIdentity_Type_SystemAssigned),
+					},
+					Tags: expectedTags,
+				},
+			},
+		},
+		{
+			name: "new disk encryption set with a user-assigned identity and automatic key rotation",
+			spec: &DiskEncryptionSetSpec{
+				Name:                              "my-des",
+				ResourceGroup:                     "my-rg",
+				Location:                          "eastus",
+				ClusterName:                       "my-cluster",
+				KeyURL:                            "https://my-vault.vault.azure.net/keys/my-key",
+				KeyVaultID:                        fakeKeyVaultID,
+				Identity:                          infrav1.VMIdentityUserAssigned,
+				UserAssignedIdentityID:            fakeIdentityID,
+				RotationToLatestKeyVersionEnabled: ptr.To(true),
+				AdditionalTags:                    infrav1.Tags{"foo": "bar"},
+			},
+			existing: nil,
+			expected: &asocomputev1.DiskEncryptionSet{
+				Spec: asocomputev1.DiskEncryptionSet_Spec{
+					AzureName: "my-des",
+					Location:  ptr.To("eastus"),
+					Owner: &genruntime.KnownResourceReference{
+						Name: "my-rg",
+					},
+					EncryptionType: ptr.To(asocomputev1.DiskEncryptionSetType_EncryptionAtRestWithCustomerKey),
+					ActiveKey: &asocomputev1.KeyForDiskEncryptionSet{
+						KeyUrl: ptr.To("https://my-vault.vault.azure.net/keys/my-key"),
+						SourceVault: &asocomputev1.SourceVault{
+							Reference: &genruntime.ResourceReference{
+								ARMID: fakeKeyVaultID,
+							},
+						},
+					},
+					Identity: &asocomputev1.EncryptionSetIdentity{
+						Type: ptr.To(asocomputev1.EncryptionSetIdentity_Type_UserAssigned),
+						UserAssignedIdentities: []asocomputev1.UserAssignedIdentityDetails{
+							{
+								Reference: genruntime.ResourceReference{
+									ARMID: fakeIdentityID,
+								},
+							},
+						},
+					},
+					RotationToLatestKeyVersionEnabled: ptr.To(true),
+					Tags:                              expectedTags,
+				},
+			},
+		},
+		{
+			name: "existing disk encryption set is rotated to the new key and keeps its status",
+			spec: &DiskEncryptionSetSpec{
+				Name:           "my-des",
+				ResourceGroup:  "my-rg",
+				Location:       "eastus",
+				ClusterName:    "my-cluster",
+				KeyURL:         fakeRotatedKeyURL,
+				Identity:       infrav1.VMIdentitySystemAssigned,
+				AdditionalTags: infrav1.Tags{"foo": "bar"},
+			},
+			existing: &asocomputev1.DiskEncryptionSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-des",
+					Namespace: "default",
+				},
+				Spec: asocomputev1.DiskEncryptionSet_Spec{
+					AzureName: "my-des",
+					Location:  ptr.To("eastus"),
+					ActiveKey: &asocomputev1.KeyForDiskEncryptionSet{
+						KeyUrl: ptr.To(fakeKeyURL),
+					},
+				},
+				Status: asocomputev1.DiskEncryptionSet_STATUS{
+					ActiveKey: &asocomputev1.KeyForDiskEncryptionSet_STATUS{
+						KeyUrl: ptr.To(fakeKeyURL),
+					},
+				},
+			},
+			expected: &asocomputev1.DiskEncryptionSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-des",
+					Namespace: "default",
+				},
+				Spec: asocomputev1.DiskEncryptionSet_Spec{
+					AzureName: "my-des",
+					Location:  ptr.To("eastus"),
+					Owner: &genruntime.KnownResourceReference{
+						Name: "my-rg",
+					},
+					EncryptionType: ptr.To(asocomputev1.DiskEncryptionSetType_EncryptionAtRestWithCustomerKey),
+					ActiveKey: &asocomputev1.KeyForDiskEncryptionSet{
+						KeyUrl: ptr.To(fakeRotatedKeyURL),
+					},
+					Identity: &asocomputev1.EncryptionSetIdentity{
+						Type: ptr.To(asocomputev1.EncryptionSetIdentity_Type_SystemAssigned),
+					},
+					Tags: expectedTags,
+				},
+				Status: asocomputev1.DiskEncryptionSet_STATUS{
+					ActiveKey: &asocomputev1.KeyForDiskEncryptionSet_STATUS{
+						KeyUrl: ptr.To(fakeKeyURL),
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).To(Equal(tc.expected))
+		})
+	}
+}
@@ -68,7 +68,7 @@ func TestDeleteDisk(t *testing.T) {
 			name:          "noop if no disk specs are found",
 			expectedError: "",
 			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.DiskSpecs().Return([]azure.ResourceSpecGetter{})
 			},
 		},
@@ -78,7 +78,7 @@ func TestDeleteDisk(t *testing.T) {
 			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.DiskSpecs().Return(fakeDiskSpecs)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					r.DeleteResource(gomockinternal.AContext(), &diskSpec1, serviceName).Return(nil),
 					r.DeleteResource(gomockinternal.AContext(), &diskSpec2, serviceName).Return(nil),
 					s.UpdateDeleteStatus(infrav1.DisksReadyCondition, serviceName, nil),
@@ -91,7 +91,7 @@ func TestDeleteDisk(t *testing.T) {
 			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.DiskSpecs().Return(fakeDiskSpecs)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					r.DeleteResource(gomockinternal.AContext(), &diskSpec1, serviceName).Return(nil),
 					r.DeleteResource(gomockinternal.AContext(), &diskSpec2, serviceName).Return(nil),
 					s.UpdateDeleteStatus(infrav1.DisksReadyCondition, serviceName, nil),
@@ -104,7 +104,7 @@ func TestDeleteDisk(t *testing.T) {
 			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.DiskSpecs().Return(fakeDiskSpecs)
 				gomock.InOrder(
-					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout),
 					r.DeleteResource(gomockinternal.AContext(), &diskSpec1, serviceName).Return(internalError),
 					r.DeleteResource(gomockinternal.AContext(), &diskSpec2, serviceName).Return(nil),
 					s.UpdateDeleteStatus(infrav1.DisksReadyCondition, serviceName, internalError),
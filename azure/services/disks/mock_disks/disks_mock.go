@@ -87,6 +87,34 @@ func (mr *MockDiskScopeMockRecorder) AvailabilitySetEnabled() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySetEnabled", reflect.TypeOf((*MockDiskScope)(nil).AvailabilitySetEnabled))
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockDiskScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockDiskScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockDiskScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockDiskScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockDiskScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockDiskScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
 // BaseURI mocks base method.
 func (m *MockDiskScope) BaseURI() string {
 	m.ctrl.T.Helper()
@@ -185,20 +213,6 @@ func (mr *MockDiskScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockDiskScope)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockDiskScope) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockDiskScopeMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockDiskScope)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockDiskScope) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -351,6 +365,18 @@ func (mr *MockDiskScopeMockRecorder) NodeResourceGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeResourceGroup", reflect.TypeOf((*MockDiskScope)(nil).NodeResourceGroup))
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockDiskScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockDiskScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockDiskScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
 // ResourceGroup mocks base method.
 func (m *MockDiskScope) ResourceGroup() string {
 	m.ctrl.T.Helper()
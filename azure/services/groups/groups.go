@@ -18,18 +18,28 @@ package groups
 
 import (
 	"context"
+	"time"
 
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
 	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // ServiceName is the name of this service.
 const ServiceName = "group"
 
+// groupReadyRequeue is the interval after which the existence of an unmanaged resource group is checked again while
+// ASO hasn't found out yet.
+const groupReadyRequeue = 20 * time.Second
+
 // Service provides operations on Azure resources.
 type Service struct {
 	Scope GroupScope
@@ -40,6 +50,7 @@ type Service struct {
 type GroupScope interface {
 	aso.Scope
 	GroupSpecs() []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]
+	ResourceGroupMode() infrav1.ResourceGroupMode
 }
 
 // New creates a new service.
@@ -57,6 +68,10 @@ func New(scope GroupScope) *Service {
 // managed and reconciled by ASO, meaning that we can rely on a single resource
 // group delete operation as opposed to deleting every individual resource.
 func (s *Service) IsManaged(ctx context.Context) (bool, error) {
+	if s.Scope.ResourceGroupMode() == infrav1.ResourceGroupModeUnmanaged {
+		return false, nil
+	}
+
 	// Unless all resource groups are managed by CAPZ and reconciled by ASO, resources need to be deleted individually.
 	for _, spec := range s.Specs {
 		managed, err := aso.IsManaged(ctx, s.Scope.GetClient(), spec.ResourceRef(), s.Scope.ASOOwner())
@@ -77,3 +92,67 @@ func (s *Service) IsManaged(ctx context.Context) (bool, error) {
 	}
 	return true, nil
 }
+
+// Reconcile idempotently creates or updates the resource groups. Unmanaged resource groups are never created or
+// updated, only their existence is verified.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if s.Scope.ResourceGroupMode() != infrav1.ResourceGroupModeUnmanaged {
+		return s.Service.Reconcile(ctx)
+	}
+
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "groups.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	var resultErr error
+	for _, spec := range s.Specs {
+		err := s.verifyExists(ctx, spec)
+		if err != nil && (!azure.IsOperationNotDoneError(err) || resultErr == nil) {
+			resultErr = err
+		}
+	}
+	s.Scope.UpdatePutStatus(s.ConditionType, ServiceName, resultErr)
+	return resultErr
+}
+
+// Delete deletes the resource groups. Unmanaged resource groups are never deleted.
+func (s *Service) Delete(ctx context.Context) error {
+	if s.Scope.ResourceGroupMode() != infrav1.ResourceGroupModeUnmanaged {
+		return s.Service.Delete(ctx)
+	}
+	return nil
+}
+
+// verifyExists returns an error unless the resource group of spec exists in Azure. The resource group is represented
+// by an ASO resource with the "skip" reconcile-policy, which ASO only uses to get the resource group, and which is
+// never adopted.
+func (s *Service) verifyExists(ctx context.Context, spec azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]) error {
+	group := spec.ResourceRef()
+	group.SetNamespace(s.Scope.ASOOwner().GetNamespace())
+	err := s.Scope.GetClient().Get(ctx, client.ObjectKeyFromObject(group), group)
+	if apierrors.IsNotFound(err) {
+		_, err = s.CreateOrUpdateResource(ctx, &unmanagedGroupSpec{spec: spec}, ServiceName)
+		return err
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get resource group %s", group.Name)
+	}
+
+	// The existing ASO resource is only read: the ASO reconciler would adopt it by setting the "manage"
+	// reconcile-policy once ASO reports that the resource group doesn't exist in Azure.
+	conds := group.GetConditions()
+	i, ok := conds.FindIndexByType(conditions.ConditionTypeReady)
+	if !ok {
+		return azure.WithTransientError(errors.Errorf("ready status of resource group %s unknown", group.Name), groupReadyRequeue)
+	}
+	switch cond := conds[i]; {
+	case cond.Status == metav1.ConditionTrue:
+		return nil
+	case cond.Reason == conditions.ReasonAzureResourceNotFound.Name:
+		return azure.WithTerminalError(errors.Errorf("resource group %s does not exist and is not created since the resource group mode is %s", group.Name, infrav1.ResourceGroupModeUnmanaged))
+	default:
+		return azure.WithTransientError(errors.Errorf("resource group %s is not Ready: %s: %s", group.Name, cond.Reason, cond.Message), groupReadyRequeue)
+	}
+}
@@ -18,17 +18,22 @@ package groups
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
 	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups/mock_groups"
+	"sigs.k8s.io/cluster-api-provider-azure/util/aso"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -61,6 +66,7 @@ func TestIsManaged(t *testing.T) {
 
 	tests := []struct {
 		name          string
+		mode          infrav1.ResourceGroupMode
 		objects       []client.Object
 		expect        func(s *mock_groups.MockGroupScopeMockRecorder)
 		expected      bool
@@ -148,6 +154,31 @@ func TestIsManaged(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "unmanaged group with reconcile policy manage",
+			mode: infrav1.ResourceGroupModeUnmanaged,
+			objects: []client.Object{
+				&asoresourcesv1.ResourceGroup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "name",
+						Namespace:       "namespace",
+						OwnerReferences: newOwnerRefs(),
+						Annotations: map[string]string{
+							asoannotations.ReconcilePolicy: string(asoannotations.ReconcilePolicyManage),
+						},
+					},
+				},
+			},
+			expect: func(s *mock_groups.MockGroupScopeMockRecorder) {
+				s.GroupSpecs().Return([]azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]{
+					&GroupSpec{
+						Name: "name",
+					},
+				}).AnyTimes()
+				s.ClusterName().Return("cluster").AnyTimes()
+			},
+			expected: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -166,6 +197,7 @@ func TestIsManaged(t *testing.T) {
 				Build()
 			scopeMock.EXPECT().GetClient().Return(ctrlClient).AnyTimes()
 			scopeMock.EXPECT().ASOOwner().Return(newOwner()).AnyTimes()
+			scopeMock.EXPECT().ResourceGroupMode().Return(test.mode).AnyTimes()
 			test.expect(scopeMock.EXPECT())
 
 			actual, err := New(scopeMock).IsManaged(context.Background())
@@ -177,3 +209,155 @@ func TestIsManaged(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileUnmanaged(t *testing.T) {
+	newOwner := func() *asoresourcesv1.ResourceGroup {
+		return &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "namespace",
+			},
+		}
+	}
+
+	newGroup := func(reason string, status metav1.ConditionStatus) *asoresourcesv1.ResourceGroup {
+		s := runtime.NewScheme()
+		if err := asoresourcesv1.AddToScheme(s); err != nil {
+			t.Fatal(err.Error())
+		}
+		gvk, _ := apiutil.GVKForObject(&asoresourcesv1.ResourceGroup{}, s)
+		return &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         gvk.GroupVersion().String(),
+						Kind:               gvk.Kind,
+						Controller:         ptr.To(true),
+						BlockOwnerDeletion: ptr.To(true),
+					},
+				},
+				Annotations: map[string]string{
+					asoannotations.ReconcilePolicy:   string(asoannotations.ReconcilePolicySkip),
+					asoannotations.PerResourceSecret: aso.GetASOSecretName("cluster"),
+				},
+			},
+			Spec: asoresourcesv1.ResourceGroup_Spec{
+				Location: ptr.To("westus"),
+			},
+			Status: asoresourcesv1.ResourceGroup_STATUS{
+				// Tags which mark the group as owned by the cluster must not lead to adopting it.
+				Tags: map[string]string{"sigs.k8s.io_cluster-api-provider-azure_cluster_cluster": "owned"},
+				Conditions: []conditions.Condition{
+					{
+						Type:   conditions.ConditionTypeReady,
+						Status: status,
+						Reason: reason,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		objects       []client.Object
+		expectedError string
+		terminal      bool
+	}{
+		{
+			name:          "resource group is looked up without being created",
+			expectedError: "operation type ASOCreateOrUpdate on Azure resource namespace/name is not done",
+		},
+		{
+			name:    "resource group exists",
+			objects: []client.Object{newGroup(conditions.ReasonSucceeded, metav1.ConditionTrue)},
+		},
+		{
+			name:          "resource group doesn't exist",
+			objects:       []client.Object{newGroup(conditions.ReasonAzureResourceNotFound.Name, metav1.ConditionFalse)},
+			expectedError: "resource group name does not exist and is not created since the resource group mode is Unmanaged",
+			terminal:      true,
+		},
+		{
+			name:          "resource group is being looked up",
+			objects:       []client.Object{newGroup(conditions.ReasonReconciling.Name, metav1.ConditionFalse)},
+			expectedError: "resource group name is not Ready: Reconciling",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_groups.NewMockGroupScope(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			g.Expect(asoresourcesv1.AddToScheme(scheme)).To(Succeed())
+			ctrlClient := fakeclient.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(test.objects...).
+				Build()
+			scopeMock.EXPECT().GetClient().Return(ctrlClient).AnyTimes()
+			scopeMock.EXPECT().ASOOwner().Return(newOwner()).AnyTimes()
+			scopeMock.EXPECT().ClusterName().Return("cluster").AnyTimes()
+			scopeMock.EXPECT().ResourceGroupMode().Return(infrav1.ResourceGroupModeUnmanaged).AnyTimes()
+			scopeMock.EXPECT().AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+			scopeMock.EXPECT().GroupSpecs().Return([]azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]{
+				&GroupSpec{
+					Name:        "name",
+					Location:    "westus",
+					ClusterName: "cluster",
+				},
+			})
+			scopeMock.EXPECT().UpdatePutStatus(infrav1.ResourceGroupReadyCondition, ServiceName, gomock.Any())
+
+			err := New(scopeMock).Reconcile(context.Background())
+			if test.expectedError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expectedError)))
+				var reconcileErr azure.ReconcileError
+				g.Expect(errors.As(err, &reconcileErr) && reconcileErr.IsTerminal()).To(Equal(test.terminal))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			group := &asoresourcesv1.ResourceGroup{}
+			g.Expect(ctrlClient.Get(context.Background(), client.ObjectKey{Namespace: "namespace", Name: "name"}, group)).To(Succeed())
+			g.Expect(group.Annotations).To(HaveKeyWithValue(asoannotations.ReconcilePolicy, string(asoannotations.ReconcilePolicySkip)))
+			g.Expect(group.Spec.Tags).To(BeEmpty())
+		})
+	}
+}
+
+func TestDeleteUnmanaged(t *testing.T) {
+	g := NewWithT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scopeMock := mock_groups.NewMockGroupScope(mockCtrl)
+
+	group := &asoresourcesv1.ResourceGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+	}
+	scheme := runtime.NewScheme()
+	g.Expect(asoresourcesv1.AddToScheme(scheme)).To(Succeed())
+	ctrlClient := fakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(group).
+		Build()
+	scopeMock.EXPECT().GetClient().Return(ctrlClient).AnyTimes()
+	scopeMock.EXPECT().ASOOwner().Return(&asoresourcesv1.ResourceGroup{}).AnyTimes()
+	scopeMock.EXPECT().ClusterName().Return("cluster").AnyTimes()
+	scopeMock.EXPECT().ResourceGroupMode().Return(infrav1.ResourceGroupModeUnmanaged).AnyTimes()
+	scopeMock.EXPECT().GroupSpecs().Return([]azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]{
+		&GroupSpec{Name: "name"},
+	})
+
+	g.Expect(New(scopeMock).Delete(context.Background())).To(Succeed())
+	g.Expect(ctrlClient.Get(context.Background(), client.ObjectKeyFromObject(group), &asoresourcesv1.ResourceGroup{})).To(Succeed())
+}
@@ -74,6 +74,34 @@ func (mr *MockGroupScopeMockRecorder) ASOOwner() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ASOOwner", reflect.TypeOf((*MockGroupScope)(nil).ASOOwner))
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockGroupScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockGroupScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockGroupScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockGroupScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockGroupScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockGroupScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
 // ClusterName mocks base method.
 func (m *MockGroupScope) ClusterName() string {
 	m.ctrl.T.Helper()
@@ -102,20 +130,6 @@ func (mr *MockGroupScopeMockRecorder) DefaultedAzureCallTimeout() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockGroupScope)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockGroupScope) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockGroupScopeMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockGroupScope)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockGroupScope) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -184,6 +198,32 @@ func (mr *MockGroupScopeMockRecorder) GroupSpecs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupSpecs", reflect.TypeOf((*MockGroupScope)(nil).GroupSpecs))
 }
 
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockGroupScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockGroupScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockGroupScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
+// ResourceGroupMode mocks base method.
+func (m *MockGroupScope) ResourceGroupMode() v1beta1.ResourceGroupMode {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceGroupMode")
+	ret0, _ := ret[0].(v1beta1.ResourceGroupMode)
+	return ret0
+}
+
+// ResourceGroupMode indicates an expected call of ResourceGroupMode.
+func (mr *MockGroupScopeMockRecorder) ResourceGroupMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceGroupMode", reflect.TypeOf((*MockGroupScope)(nil).ResourceGroupMode))
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockGroupScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
 )
 
@@ -89,3 +90,32 @@ func (*GroupSpec) GetActualTags(resource *asoresourcesv1.ResourceGroup) infrav1.
 func (*GroupSpec) SetTags(resource *asoresourcesv1.ResourceGroup, tags infrav1.Tags) {
 	resource.Spec.Tags = tags
 }
+
+// unmanagedGroupSpec wraps the spec of a resource group which CAPZ doesn't manage. The resource group is never
+// adopted and its tags aren't reconciled, so its ASO resource keeps the "skip" reconcile-policy it is created with.
+type unmanagedGroupSpec struct {
+	spec azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]
+}
+
+// ResourceRef implements aso.ResourceSpecGetter.
+func (s *unmanagedGroupSpec) ResourceRef() *asoresourcesv1.ResourceGroup {
+	return s.spec.ResourceRef()
+}
+
+// Parameters implements aso.ResourceSpecGetter.
+func (s *unmanagedGroupSpec) Parameters(ctx context.Context, existing *asoresourcesv1.ResourceGroup) (*asoresourcesv1.ResourceGroup, error) {
+	if existing != nil {
+		return existing, nil
+	}
+	parameters, err := s.spec.Parameters(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	parameters.Spec.Tags = nil
+	return parameters, nil
+}
+
+// WasManaged implements azure.ASOResourceSpecGetter.
+func (*unmanagedGroupSpec) WasManaged(*asoresourcesv1.ResourceGroup) bool {
+	return false
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostgroups
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// Client wraps go-sdk.
+type Client interface {
+	Get(ctx context.Context, subscriptionID, resourceGroupName, name string) (armcompute.DedicatedHostGroup, error)
+}
+
+// AzureClient contains the credentials and options to create Azure go-sdk clients. Dedicated host groups may be in
+// another subscription than the cluster, so a go-sdk client is created for the subscription of each request.
+type AzureClient struct {
+	credential azcore.TokenCredential
+	opts       *arm.ClientOptions
+}
+
+// NewClient creates a new dedicated host groups client from an authorizer.
+func NewClient(auth azure.Authorizer) (Client, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create dedicated host groups client options")
+	}
+	return &AzureClient{credential: auth.Token(), opts: opts}, nil
+}
+
+// Get returns a dedicated host group.
+func (ac *AzureClient) Get(ctx context.Context, subscriptionID, resourceGroupName, name string) (armcompute.DedicatedHostGroup, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "hostgroups.AzureClient.Get")
+	defer done()
+
+	dedicatedHostGroups, err := armcompute.NewDedicatedHostGroupsClient(subscriptionID, ac.credential, ac.opts)
+	if err != nil {
+		return armcompute.DedicatedHostGroup{}, errors.Wrap(err, "failed to create dedicated host groups client")
+	}
+	resp, err := dedicatedHostGroups.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return armcompute.DedicatedHostGroup{}, err
+	}
+	return resp.DedicatedHostGroup, nil
+}
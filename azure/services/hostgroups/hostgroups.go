@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostgroups
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/slice"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// ValidatePlacement checks that the dedicated host group can host VMs in the location and availability zones. A zonal
+// host group only hosts VMs in its zone, and a regional host group only hosts VMs without a zone.
+func ValidatePlacement(ctx context.Context, client Client, hostGroupID, location string, zones []string) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "hostgroups.ValidatePlacement")
+	defer done()
+
+	resourceID, err := azureutil.ParseResourceID(hostGroupID)
+	if err != nil {
+		return azure.WithTerminalError(errors.Wrapf(err, "failed to parse host group ID %s", hostGroupID))
+	}
+	hostGroup, err := client.Get(ctx, resourceID.SubscriptionID, resourceID.ResourceGroupName, resourceID.Name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get host group %s", hostGroupID)
+	}
+
+	if hostGroupLocation := ptr.Deref(hostGroup.Location, ""); !strings.EqualFold(normalizeLocation(hostGroupLocation), normalizeLocation(location)) {
+		return azure.WithTerminalError(errors.Errorf("host group %s is in location %s, not in location %s", hostGroupID, hostGroupLocation, location))
+	}
+
+	var hostGroupZones []string
+	for _, zone := range hostGroup.Zones {
+		hostGroupZones = append(hostGroupZones, ptr.Deref(zone, ""))
+	}
+	if len(hostGroupZones) == 0 {
+		if len(zones) > 0 {
+			return azure.WithTerminalError(errors.Errorf("host group %s is regional and can't host VMs in availability zones %v", hostGroupID, zones))
+		}
+		return nil
+	}
+	if len(zones) == 0 {
+		return azure.WithTerminalError(errors.Errorf("host group %s is in availability zones %v and can't host VMs without an availability zone", hostGroupID, hostGroupZones))
+	}
+	for _, zone := range zones {
+		if !slice.Contains(hostGroupZones, zone) {
+			return azure.WithTerminalError(errors.Errorf("host group %s is in availability zones %v and can't host VMs in availability zone %s", hostGroupID, hostGroupZones, zone))
+		}
+	}
+	return nil
+}
+
+// normalizeLocation returns the name of a location, which Azure may return as its display name, e.g. "East US".
+func normalizeLocation(location string) string {
+	return strings.ToLower(strings.ReplaceAll(location, " ", ""))
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostgroups
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/hostgroups/mock_hostgroups"
+)
+
+const fakeHostGroupID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-host-group"
+
+func TestValidatePlacement(t *testing.T) {
+	tests := []struct {
+		name          string
+		hostGroup     armcompute.DedicatedHostGroup
+		getErr        error
+		location      string
+		zones         []string
+		expectedError string
+		terminal      bool
+	}{
+		{
+			name:      "regional host group in the same location",
+			hostGroup: armcompute.DedicatedHostGroup{Location: ptr.To("eastus")},
+			location:  "eastus",
+		},
+		{
+			name:      "location display name",
+			hostGroup: armcompute.DedicatedHostGroup{Location: ptr.To("East US")},
+			location:  "eastus",
+		},
+		{
+			name:      "zonal host group in the same zone",
+			hostGroup: armcompute.DedicatedHostGroup{Location: ptr.To("eastus"), Zones: []*string{ptr.To("1")}},
+			location:  "eastus",
+			zones:     []string{"1"},
+		},
+		{
+			name:          "host group in another location",
+			hostGroup:     armcompute.DedicatedHostGroup{Location: ptr.To("westus")},
+			location:      "eastus",
+			expectedError: "host group " + fakeHostGroupID + " is in location westus, not in location eastus",
+			terminal:      true,
+		},
+		{
+			name:          "zonal host group in another zone",
+			hostGroup:     armcompute.DedicatedHostGroup{Location: ptr.To("eastus"), Zones: []*string{ptr.To("1")}},
+			location:      "eastus",
+			zones:         []string{"1", "2"},
+			expectedError: "host group " + fakeHostGroupID + " is in availability zones [1] and can't host VMs in availability zone 2",
+			terminal:      true,
+		},
+		{
+			name:          "zonal host group for VMs without a zone",
+			hostGroup:     armcompute.DedicatedHostGroup{Location: ptr.To("eastus"), Zones: []*string{ptr.To("1")}},
+			location:      "eastus",
+			expectedError: "host group " + fakeHostGroupID + " is in availability zones [1] and can't host VMs without an availability zone",
+			terminal:      true,
+		},
+		{
+			name:          "regional host group for VMs in a zone",
+			hostGroup:     armcompute.DedicatedHostGroup{Location: ptr.To("eastus")},
+			location:      "eastus",
+			zones:         []string{"1"},
+			expectedError: "host group " + fakeHostGroupID + " is regional and can't host VMs in availability zones [1]",
+			terminal:      true,
+		},
+		{
+			name:          "host group can't be fetched",
+			getErr:        errors.New("not found"),
+			location:      "eastus",
+			expectedError: "failed to get host group " + fakeHostGroupID + ": not found",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			clientMock := mock_hostgroups.NewMockClient(mockCtrl)
+			clientMock.EXPECT().Get(gomock.Any(), "123", "my-rg", "my-host-group").Return(tc.hostGroup, tc.getErr)
+
+			err := ValidatePlacement(context.Background(), clientMock, fakeHostGroupID, tc.location, tc.zones)
+			if tc.expectedError == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			var reconcileErr azure.ReconcileError
+			g.Expect(errors.As(err, &reconcileErr) && reconcileErr.IsTerminal()).To(Equal(tc.terminal))
+		})
+	}
+}
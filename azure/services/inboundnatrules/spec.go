@@ -31,6 +31,12 @@ type InboundNatSpec struct {
 	ResourceGroup             string
 	FrontendIPConfigurationID *string
 	SSHFrontendPort           *int32
+	// Protocol is the transport protocol of the rule. Defaults to Tcp.
+	Protocol armnetwork.TransportProtocol
+	// EnableFloatingIP enables floating IP (direct server return), which is not supported with the All protocol.
+	EnableFloatingIP bool
+	// EnableTCPReset sends a TCP reset when a connection idles out, which is only supported with the Tcp protocol.
+	EnableTCPReset bool
 }
 
 // ResourceName returns the name of the inbound NAT rule.
@@ -62,16 +68,28 @@ func (s *InboundNatSpec) Parameters(ctx context.Context, existing interface{}) (
 		return nil, errors.Errorf("FrontendIPConfigurationID is not set")
 	}
 
+	protocol := s.Protocol
+	if protocol == "" {
+		protocol = armnetwork.TransportProtocolTCP
+	}
+	if s.EnableFloatingIP && protocol == armnetwork.TransportProtocolAll {
+		return nil, errors.Errorf("floating IP is not supported for inbound NAT rules with protocol %s", protocol)
+	}
+	if s.EnableTCPReset && protocol != armnetwork.TransportProtocolTCP {
+		return nil, errors.Errorf("TCP reset is not supported for inbound NAT rules with protocol %s", protocol)
+	}
+
 	rule := armnetwork.InboundNatRule{
 		Name: ptr.To(s.ResourceName()),
 		Properties: &armnetwork.InboundNatRulePropertiesFormat{
 			BackendPort:          ptr.To[int32](22),
-			EnableFloatingIP:     ptr.To(false),
+			EnableFloatingIP:     ptr.To(s.EnableFloatingIP),
+			EnableTCPReset:       ptr.To(s.EnableTCPReset),
 			IdleTimeoutInMinutes: ptr.To[int32](4),
 			FrontendIPConfiguration: &armnetwork.SubResource{
 				ID: s.FrontendIPConfigurationID,
 			},
-			Protocol:     ptr.To(armnetwork.TransportProtocolTCP),
+			Protocol:     ptr.To(protocol),
 			FrontendPort: s.SSHFrontendPort,
 		},
 	}
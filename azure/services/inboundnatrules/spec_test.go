@@ -47,6 +47,60 @@ func TestParameters(t *testing.T) {
 			existing: nil,
 			errorMsg: "FrontendIPConfigurationID is not set",
 		},
+		{
+			name: "no existing InboundNatRule with floating IP and TCP reset enabled",
+			spec: func() InboundNatSpec {
+				spec := fakeInboundNatSpec(true)
+				spec.EnableFloatingIP = true
+				spec.EnableTCPReset = true
+				return spec
+			}(),
+			existing: nil,
+			expected: func() armnetwork.InboundNatRule {
+				rule := fakeNatRule()
+				rule.Properties.EnableFloatingIP = ptr.To(true)
+				rule.Properties.EnableTCPReset = ptr.To(true)
+				return rule
+			}(),
+		},
+		{
+			name: "no existing InboundNatRule with floating IP enabled for UDP",
+			spec: func() InboundNatSpec {
+				spec := fakeInboundNatSpec(true)
+				spec.Protocol = armnetwork.TransportProtocolUDP
+				spec.EnableFloatingIP = true
+				return spec
+			}(),
+			existing: nil,
+			expected: func() armnetwork.InboundNatRule {
+				rule := fakeNatRule()
+				rule.Properties.Protocol = ptr.To(armnetwork.TransportProtocolUDP)
+				rule.Properties.EnableFloatingIP = ptr.To(true)
+				return rule
+			}(),
+		},
+		{
+			name: "no existing InboundNatRule with floating IP enabled for all protocols",
+			spec: func() InboundNatSpec {
+				spec := fakeInboundNatSpec(true)
+				spec.Protocol = armnetwork.TransportProtocolAll
+				spec.EnableFloatingIP = true
+				return spec
+			}(),
+			existing: nil,
+			errorMsg: "floating IP is not supported for inbound NAT rules with protocol All",
+		},
+		{
+			name: "no existing InboundNatRule with TCP reset enabled for UDP",
+			spec: func() InboundNatSpec {
+				spec := fakeInboundNatSpec(true)
+				spec.Protocol = armnetwork.TransportProtocolUDP
+				spec.EnableTCPReset = true
+				return spec
+			}(),
+			existing: nil,
+			errorMsg: "TCP reset is not supported for inbound NAT rules with protocol Udp",
+		},
 		{
 			name:     "existing is not an InboundNatRule",
 			spec:     fakeInboundNatSpec(true),
@@ -99,6 +153,7 @@ func fakeNatRule() armnetwork.InboundNatRule {
 		Properties: &armnetwork.InboundNatRulePropertiesFormat{
 			BackendPort:      ptr.To[int32](22),
 			EnableFloatingIP: ptr.To(false),
+			EnableTCPReset:   ptr.To(false),
 			FrontendIPConfiguration: &armnetwork.SubResource{
 				ID: ptr.To("frontend-ip-config-id-1"),
 			},
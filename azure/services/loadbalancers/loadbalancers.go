@@ -31,7 +31,11 @@ const (
 	httpsProbe            = "HTTPSProbe"
 	httpsProbeRequestPath = "/readyz"
 	lbRuleHTTPS           = "LBRuleHTTPS"
+	lbRuleHAPorts         = "LBRuleHAPorts"
 	outboundNAT           = "OutboundNATAllProtocols"
+
+	defaultProbeIntervalInSeconds = 15
+	defaultNumberOfProbes         = 4
 )
 
 // LBScope defines the scope interface for a load balancer service.
@@ -39,6 +43,7 @@ type LBScope interface {
 	azure.ClusterScoper
 	azure.AsyncStatusUpdater
 	LBSpecs() []azure.ResourceSpecGetter
+	UpdateAnnotationJSON(string, map[string]interface{}) error
 }
 
 // Service provides operations on Azure resources.
@@ -70,7 +75,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "loadbalancers.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	specs := s.Scope.LBSpecs()
@@ -82,12 +87,50 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	// If multiple errors occur, we return the most pressing one.
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
 	var result error
-	for _, lbSpec := range specs {
-		if _, err := s.CreateOrUpdateResource(ctx, lbSpec, serviceName); err != nil {
+	newAnnotation := make(map[string]interface{})
+	newNATPoolAnnotation := make(map[string]interface{})
+	for _, resourceSpec := range specs {
+		lbSpec := resourceSpec.(*LBSpec)
+		currentAnnotation := make(map[string]string)
+		currentNATPoolAnnotation := make(map[string]string)
+
+		_, err := s.CreateOrUpdateResource(ctx, lbSpec, serviceName)
+		if err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
 		}
+
+		for _, name := range lbSpec.AdditionalBackendPoolNames {
+			currentAnnotation[name] = azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, name)
+		}
+
+		// Inbound NAT pools which were removed from the spec stay tracked until the load balancer is updated, so that
+		// their removal is retried.
+		if err != nil {
+			for name, id := range lbSpec.LastAppliedInboundNATPools {
+				if id, ok := id.(string); ok {
+					currentNATPoolAnnotation[name] = id
+				}
+			}
+		}
+		for _, pool := range lbSpec.InboundNATPools {
+			currentNATPoolAnnotation[pool.Name] = azure.InboundNATPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, pool.Name)
+		}
+
+		if len(currentAnnotation) > 0 {
+			newAnnotation[lbSpec.Name] = currentAnnotation
+		}
+		if len(currentNATPoolAnnotation) > 0 {
+			newNATPoolAnnotation[lbSpec.Name] = currentNATPoolAnnotation
+		}
+	}
+
+	if err := s.Scope.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, newAnnotation); err != nil {
+		return err
+	}
+	if err := s.Scope.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, newNATPoolAnnotation); err != nil {
+		return err
 	}
 
 	s.Scope.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, result)
@@ -99,7 +142,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "loadbalancers.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	specs := s.Scope.LBSpecs()
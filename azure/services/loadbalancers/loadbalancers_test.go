@@ -122,7 +122,7 @@ func TestReconcileLoadBalancer(t *testing.T) {
 			name:          "noop if no LBSpecs are found",
 			expectedError: "",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{})
 			},
 		},
@@ -130,9 +130,11 @@ func TestReconcileLoadBalancer(t *testing.T) {
 			name:          "fail to create a public LB",
 			expectedError: "#: Internal Server Error: StatusCode=500",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakePublicAPILBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(nil, internalError)
+				s.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, map[string]interface{}{})
+				s.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, map[string]interface{}{})
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, internalError)
 			},
 		},
@@ -140,19 +142,79 @@ func TestReconcileLoadBalancer(t *testing.T) {
 			name:          "create public apiserver LB",
 			expectedError: "",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakePublicAPILBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, map[string]interface{}{})
+				s.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, map[string]interface{}{})
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
+		{
+			name:          "create apiserver LB with additional backend pools",
+			expectedError: "",
+			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				spec := fakePublicAPILBSpec
+				spec.AdditionalBackendPoolNames = []string{"my-publiclb-green"}
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.LBSpecs().Return([]azure.ResourceSpecGetter{&spec})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &spec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, map[string]interface{}{
+					"my-publiclb": map[string]string{
+						"my-publiclb-green": "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/my-publiclb-green",
+					},
+				})
+				s.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, map[string]interface{}{})
+				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "create node outbound LB with inbound NAT pools",
+			expectedError: "",
+			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				spec := fakeNodeOutboundLBSpec
+				spec.InboundNATPools = []infrav1.InboundNATPool{{Name: "ssh", FrontendPortRangeStart: 50000, FrontendPortRangeEnd: 50099, BackendPort: 22}}
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.LBSpecs().Return([]azure.ResourceSpecGetter{&spec})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &spec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, map[string]interface{}{})
+				s.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, map[string]interface{}{
+					"my-cluster": map[string]string{
+						"ssh": "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-cluster/inboundNatPools/ssh",
+					},
+				})
+				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "removed inbound NAT pool stays tracked when the node outbound LB fails to update",
+			expectedError: "#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				spec := fakeNodeOutboundLBSpec
+				spec.LastAppliedInboundNATPools = map[string]interface{}{
+					"ssh": "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-cluster/inboundNatPools/ssh",
+				}
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.LBSpecs().Return([]azure.ResourceSpecGetter{&spec})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &spec, serviceName).Return(nil, internalError)
+				s.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, map[string]interface{}{})
+				s.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, map[string]interface{}{
+					"my-cluster": map[string]string{
+						"ssh": "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-cluster/inboundNatPools/ssh",
+					},
+				})
+				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, internalError)
+			},
+		},
 		{
 			name:          "create internal apiserver LB",
 			expectedError: "",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakeInternalAPILBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeInternalAPILBSpec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, map[string]interface{}{})
+				s.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, map[string]interface{}{})
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
@@ -160,9 +222,11 @@ func TestReconcileLoadBalancer(t *testing.T) {
 			name:          "create node outbound LB",
 			expectedError: "",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakeNodeOutboundLBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeNodeOutboundLBSpec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, map[string]interface{}{})
+				s.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, map[string]interface{}{})
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
@@ -170,11 +234,13 @@ func TestReconcileLoadBalancer(t *testing.T) {
 			name:          "create multiple LBs",
 			expectedError: "",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakePublicAPILBSpec, &fakeInternalAPILBSpec, &fakeNodeOutboundLBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeInternalAPILBSpec, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeNodeOutboundLBSpec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.BackendPoolLastAppliedAnnotation, map[string]interface{}{})
+				s.UpdateAnnotationJSON(azure.InboundNATPoolLastAppliedAnnotation, map[string]interface{}{})
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
@@ -219,7 +285,7 @@ func TestDeleteLoadBalancer(t *testing.T) {
 			name:          "noop if no LBSpecs are found",
 			expectedError: "",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{})
 			},
 		},
@@ -227,7 +293,7 @@ func TestDeleteLoadBalancer(t *testing.T) {
 			name:          "delete a load balancer",
 			expectedError: "",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakePublicAPILBSpec})
 				r.DeleteResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(nil)
 				s.UpdateDeleteStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
@@ -237,7 +303,7 @@ func TestDeleteLoadBalancer(t *testing.T) {
 			name:          "delete multiple load balancers",
 			expectedError: "",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakePublicAPILBSpec, &fakeInternalAPILBSpec, &fakeNodeOutboundLBSpec})
 				r.DeleteResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(nil)
 				r.DeleteResource(gomockinternal.AContext(), &fakeInternalAPILBSpec, serviceName).Return(nil)
@@ -249,7 +315,7 @@ func TestDeleteLoadBalancer(t *testing.T) {
 			name:          "load balancer deletion fails",
 			expectedError: "#: Internal Server Error: StatusCode=500",
 			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakePublicAPILBSpec})
 				r.DeleteResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(internalError)
 				s.UpdateDeleteStatus(infrav1.LoadBalancersReadyCondition, serviceName, internalError)
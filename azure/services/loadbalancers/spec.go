@@ -18,6 +18,7 @@ package loadbalancers
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
@@ -44,8 +45,24 @@ type LBSpec struct {
 	BackendPoolName      string
 	FrontendIPConfigs    []infrav1.FrontendIP
 	APIServerPort        int32
+	FrontendPort         *int32
 	IdleTimeoutInMinutes *int32
+	EnableTCPReset       *bool
+	EnableHAPorts        bool
+	DisableOutboundSNAT  *bool
+	HealthProbe          *infrav1.HealthProbe
 	AdditionalTags       map[string]string
+
+	// AdditionalBackendPoolNames are the names of the backend pools of the load balancer in addition to BackendPoolName.
+	AdditionalBackendPoolNames []string
+	// RuleBackendPoolName is the name of the backend pool referenced by the load balancing rule. Defaults to BackendPoolName.
+	RuleBackendPoolName string
+	// LastAppliedBackendPools are the additional backend pools CAPZ applied to the load balancer in the previous reconcile.
+	LastAppliedBackendPools map[string]interface{}
+	// InboundNATPools are the inbound NAT pools of the load balancer.
+	InboundNATPools []infrav1.InboundNATPool
+	// LastAppliedInboundNATPools are the inbound NAT pools CAPZ applied to the load balancer in the previous reconcile.
+	LastAppliedInboundNATPools map[string]interface{}
 }
 
 // ResourceName returns the name of the load balancer.
@@ -73,6 +90,7 @@ func (s *LBSpec) Parameters(ctx context.Context, existing interface{}) (paramete
 		backendAddressPools []*armnetwork.BackendAddressPool
 		outboundRules       []*armnetwork.OutboundRule
 		probes              []*armnetwork.Probe
+		inboundNATPools     []*armnetwork.InboundNatPool
 	)
 
 	if existing != nil {
@@ -92,6 +110,10 @@ func (s *LBSpec) Parameters(ctx context.Context, existing interface{}) (paramete
 			if !ipExists(frontendIPConfigs, *ip) {
 				update = true
 				frontendIPConfigs = append(frontendIPConfigs, ip)
+			} else if ip.Properties.GatewayLoadBalancer != nil && !gatewayLoadBalancerUpToDate(frontendIPConfigs, *ip) {
+				// only a configured Gateway Load Balancer is kept up to date, otherwise the existing chaining is left as is.
+				update = true
+				frontendIPConfigs = chainGatewayLoadBalancer(frontendIPConfigs, *ip)
 			}
 		}
 
@@ -100,22 +122,34 @@ func (s *LBSpec) Parameters(ctx context.Context, existing interface{}) (paramete
 			if !lbRuleExists(loadBalancingRules, *rule) {
 				update = true
 				loadBalancingRules = append(loadBalancingRules, rule)
+			} else if !lbRuleUpToDate(loadBalancingRules, *rule) {
+				update = true
+				loadBalancingRules = updateLBRule(loadBalancingRules, *rule)
 			}
 		}
 
 		backendAddressPools = existingLB.Properties.BackendAddressPools
-		for _, pool := range getBackendAddressPools(*s) {
+		wantedPools := getBackendAddressPools(*s)
+		for _, pool := range wantedPools {
 			if !poolExists(backendAddressPools, *pool) {
 				update = true
 				backendAddressPools = append(backendAddressPools, pool)
 			}
 		}
+		// Pools previously applied by CAPZ which were removed from the spec are removed, once the load balancing rule
+		// above no longer references them.
+		var removed bool
+		backendAddressPools, removed = s.removeStalePools(backendAddressPools, wantedPools)
+		update = update || removed
 
 		outboundRules = existingLB.Properties.OutboundRules
 		for _, rule := range getOutboundRules(*s, wantedFrontendIDs) {
 			if !outboundRuleExists(outboundRules, *rule) {
 				update = true
 				outboundRules = append(outboundRules, rule)
+			} else if !outboundRuleUpToDate(outboundRules, *rule) {
+				update = true
+				outboundRules = updateOutboundRule(outboundRules, *rule)
 			}
 		}
 
@@ -124,8 +158,28 @@ func (s *LBSpec) Parameters(ctx context.Context, existing interface{}) (paramete
 			if !probeExists(probes, *probe) {
 				update = true
 				probes = append(probes, probe)
+			} else if s.HealthProbe != nil && !probeUpToDate(probes, *probe) {
+				// only a configured health probe is kept up to date, otherwise the existing probe is left as is.
+				update = true
+				probes = replaceProbe(probes, probe)
+			}
+		}
+
+		inboundNATPools = existingLB.Properties.InboundNatPools
+		wantedNATPools := getInboundNATPools(*s, wantedFrontendIDs)
+		for _, pool := range wantedNATPools {
+			if !inboundNATPoolExists(inboundNATPools, *pool) {
+				update = true
+				inboundNATPools = append(inboundNATPools, pool)
+			} else if !inboundNATPoolUpToDate(inboundNATPools, *pool) {
+				update = true
+				inboundNATPools = replaceInboundNATPool(inboundNATPools, pool)
 			}
 		}
+		// Inbound NAT pools previously applied by CAPZ which were removed from the spec are removed. Azure refuses to
+		// remove a pool which is still referenced by a Scale Set.
+		inboundNATPools, removed = s.removeStaleInboundNATPools(inboundNATPools, wantedNATPools)
+		update = update || removed
 
 		if !update {
 			// load balancer already exists with all required defaults
@@ -137,6 +191,7 @@ func (s *LBSpec) Parameters(ctx context.Context, existing interface{}) (paramete
 		backendAddressPools = getBackendAddressPools(*s)
 		outboundRules = getOutboundRules(*s, frontendIDs)
 		probes = getProbes(*s)
+		inboundNATPools = getInboundNATPools(*s, frontendIDs)
 	}
 
 	lb := armnetwork.LoadBalancer{
@@ -156,6 +211,7 @@ func (s *LBSpec) Parameters(ctx context.Context, existing interface{}) (paramete
 			OutboundRules:            outboundRules,
 			Probes:                   probes,
 			LoadBalancingRules:       loadBalancingRules,
+			InboundNatPools:          inboundNATPools,
 		},
 	}
 
@@ -182,6 +238,11 @@ func getFrontendIPConfigs(lbSpec LBSpec) ([]*armnetwork.FrontendIPConfiguration,
 				},
 			}
 		}
+		if ipConfig.GatewayLoadBalancer != nil {
+			properties.GatewayLoadBalancer = &armnetwork.SubResource{
+				ID: ptr.To(ipConfig.GatewayLoadBalancer.ID),
+			}
+		}
 		frontendIPConfigurations = append(frontendIPConfigurations, &armnetwork.FrontendIPConfiguration{
 			Properties: &properties,
 			Name:       ptr.To(ipConfig.Name),
@@ -203,6 +264,7 @@ func getOutboundRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource) []*a
 			Properties: &armnetwork.OutboundRulePropertiesFormat{
 				Protocol:                 ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolAll),
 				IdleTimeoutInMinutes:     lbSpec.IdleTimeoutInMinutes,
+				EnableTCPReset:           lbSpec.EnableTCPReset,
 				FrontendIPConfigurations: frontendIDs,
 				BackendAddressPool: &armnetwork.SubResource{
 					ID: ptr.To(azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, lbSpec.BackendPoolName)),
@@ -214,63 +276,196 @@ func getOutboundRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource) []*a
 
 func getLoadBalancingRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource) []*armnetwork.LoadBalancingRule {
 	if lbSpec.Role == infrav1.APIServerRole {
-		// We disable outbound SNAT explicitly in the HTTPS LB rule and enable TCP and UDP outbound NAT with an outbound rule.
+		// Unless configured otherwise, we disable outbound SNAT explicitly in the HTTPS LB rule and enable TCP and UDP outbound NAT with an outbound rule.
 		// For more information on Standard LB outbound connections see https://learn.microsoft.com/azure/load-balancer/load-balancer-outbound-connections.
 		var frontendIPConfig *armnetwork.SubResource
 		if len(frontendIDs) != 0 {
 			frontendIPConfig = frontendIDs[0]
 		}
-		return []*armnetwork.LoadBalancingRule{
-			{
-				Name: ptr.To(lbRuleHTTPS),
-				Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
-					DisableOutboundSnat:     ptr.To(true),
-					Protocol:                ptr.To(armnetwork.TransportProtocolTCP),
-					FrontendPort:            ptr.To[int32](lbSpec.APIServerPort),
-					BackendPort:             ptr.To[int32](lbSpec.APIServerPort),
-					IdleTimeoutInMinutes:    lbSpec.IdleTimeoutInMinutes,
-					EnableFloatingIP:        ptr.To(false),
-					LoadDistribution:        ptr.To(armnetwork.LoadDistributionDefault),
-					FrontendIPConfiguration: frontendIPConfig,
-					BackendAddressPool: &armnetwork.SubResource{
-						ID: ptr.To(azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, lbSpec.BackendPoolName)),
-					},
-					Probe: &armnetwork.SubResource{
-						ID: ptr.To(azure.ProbeID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, httpsProbe)),
-					},
+		rule := &armnetwork.LoadBalancingRule{
+			Name: ptr.To(lbRuleHTTPS),
+			Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
+				DisableOutboundSnat:     ptr.To(ptr.Deref(lbSpec.DisableOutboundSNAT, true)),
+				Protocol:                ptr.To(armnetwork.TransportProtocolTCP),
+				FrontendPort:            ptr.To(ptr.Deref(lbSpec.FrontendPort, lbSpec.APIServerPort)),
+				BackendPort:             ptr.To[int32](lbSpec.APIServerPort),
+				IdleTimeoutInMinutes:    lbSpec.IdleTimeoutInMinutes,
+				EnableTCPReset:          lbSpec.EnableTCPReset,
+				EnableFloatingIP:        ptr.To(false),
+				LoadDistribution:        ptr.To(armnetwork.LoadDistributionDefault),
+				FrontendIPConfiguration: frontendIPConfig,
+				BackendAddressPool: &armnetwork.SubResource{
+					ID: ptr.To(azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, lbSpec.ruleBackendPoolName())),
+				},
+				Probe: &armnetwork.SubResource{
+					ID: ptr.To(azure.ProbeID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, httpsProbe)),
 				},
 			},
 		}
+		if lbSpec.EnableHAPorts {
+			// An HA ports rule balances the flows of all protocols on all ports.
+			// For more information see https://learn.microsoft.com/azure/load-balancer/load-balancer-ha-ports-overview.
+			rule.Name = ptr.To(lbRuleHAPorts)
+			rule.Properties.Protocol = ptr.To(armnetwork.TransportProtocolAll)
+			rule.Properties.FrontendPort = ptr.To[int32](0)
+			rule.Properties.BackendPort = ptr.To[int32](0)
+		}
+		return []*armnetwork.LoadBalancingRule{rule}
 	}
 	return []*armnetwork.LoadBalancingRule{}
 }
 
 func getBackendAddressPools(lbSpec LBSpec) []*armnetwork.BackendAddressPool {
-	return []*armnetwork.BackendAddressPool{
+	pools := []*armnetwork.BackendAddressPool{
 		{
 			Name: ptr.To(lbSpec.BackendPoolName),
 		},
 	}
+	for _, name := range lbSpec.AdditionalBackendPoolNames {
+		pools = append(pools, &armnetwork.BackendAddressPool{
+			Name: ptr.To(name),
+		})
+	}
+	return pools
+}
+
+// ruleBackendPoolName returns the name of the backend pool referenced by the load balancing rule.
+func (s *LBSpec) ruleBackendPoolName() string {
+	if s.RuleBackendPoolName != "" {
+		return s.RuleBackendPoolName
+	}
+	return s.BackendPoolName
+}
+
+// removeStalePools returns pools without the pools previously applied by CAPZ which are no longer wanted, and whether
+// any pool was removed. Pools added outside of CAPZ are kept.
+func (s *LBSpec) removeStalePools(pools []*armnetwork.BackendAddressPool, wantedPools []*armnetwork.BackendAddressPool) ([]*armnetwork.BackendAddressPool, bool) {
+	kept := make([]*armnetwork.BackendAddressPool, 0, len(pools))
+	removed := false
+	for _, pool := range pools {
+		name := ptr.Deref(pool.Name, "")
+		if _, tracked := s.LastAppliedBackendPools[name]; tracked && !poolExists(wantedPools, *pool) {
+			removed = true
+			continue
+		}
+		kept = append(kept, pool)
+	}
+	return kept, removed
 }
 
 func getProbes(lbSpec LBSpec) []*armnetwork.Probe {
 	if lbSpec.Role == infrav1.APIServerRole {
-		return []*armnetwork.Probe{
-			{
-				Name: ptr.To(httpsProbe),
-				Properties: &armnetwork.ProbePropertiesFormat{
-					Protocol:          ptr.To(armnetwork.ProbeProtocolHTTPS),
-					Port:              ptr.To[int32](lbSpec.APIServerPort),
-					RequestPath:       ptr.To(httpsProbeRequestPath),
-					IntervalInSeconds: ptr.To[int32](15),
-					NumberOfProbes:    ptr.To[int32](4),
-				},
+		probe := &armnetwork.Probe{
+			Name: ptr.To(httpsProbe),
+			Properties: &armnetwork.ProbePropertiesFormat{
+				Protocol:          ptr.To(armnetwork.ProbeProtocolHTTPS),
+				Port:              ptr.To[int32](lbSpec.APIServerPort),
+				RequestPath:       ptr.To(httpsProbeRequestPath),
+				IntervalInSeconds: ptr.To[int32](defaultProbeIntervalInSeconds),
+				NumberOfProbes:    ptr.To[int32](defaultNumberOfProbes),
 			},
 		}
+		if hp := lbSpec.HealthProbe; hp != nil {
+			// the probe keeps its name regardless of the protocol so that the load balancing rule still refers to it.
+			probe.Properties.Protocol = ptr.To(converters.ProbeProtocolToSDK(hp.Protocol))
+			probe.Properties.RequestPath = nil
+			if hp.RequestPath != "" {
+				probe.Properties.RequestPath = ptr.To(hp.RequestPath)
+			}
+			if hp.IntervalInSeconds != nil {
+				probe.Properties.IntervalInSeconds = ptr.To(*hp.IntervalInSeconds)
+			}
+			if hp.NumberOfProbes != nil {
+				probe.Properties.NumberOfProbes = ptr.To(*hp.NumberOfProbes)
+			}
+		}
+		return []*armnetwork.Probe{probe}
 	}
 	return []*armnetwork.Probe{}
 }
 
+// getInboundNATPools returns the inbound NAT pools of the load balancer, which are mapped to its first frontend IP.
+func getInboundNATPools(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource) []*armnetwork.InboundNatPool {
+	pools := []*armnetwork.InboundNatPool{}
+	if len(frontendIDs) == 0 {
+		return pools
+	}
+	for _, pool := range lbSpec.InboundNATPools {
+		pools = append(pools, &armnetwork.InboundNatPool{
+			Name: ptr.To(pool.Name),
+			Properties: &armnetwork.InboundNatPoolPropertiesFormat{
+				Protocol:                ptr.To(converters.InboundNATPoolProtocolToSDK(pool.Protocol)),
+				FrontendPortRangeStart:  ptr.To(pool.FrontendPortRangeStart),
+				FrontendPortRangeEnd:    ptr.To(pool.FrontendPortRangeEnd),
+				BackendPort:             ptr.To(pool.BackendPort),
+				IdleTimeoutInMinutes:    lbSpec.IdleTimeoutInMinutes,
+				EnableTCPReset:          lbSpec.EnableTCPReset,
+				FrontendIPConfiguration: frontendIDs[0],
+			},
+		})
+	}
+	return pools
+}
+
+// removeStaleInboundNATPools returns pools without the inbound NAT pools previously applied by CAPZ which are no longer
+// wanted, and whether any pool was removed. Pools added outside of CAPZ are kept.
+func (s *LBSpec) removeStaleInboundNATPools(pools []*armnetwork.InboundNatPool, wantedPools []*armnetwork.InboundNatPool) ([]*armnetwork.InboundNatPool, bool) {
+	kept := make([]*armnetwork.InboundNatPool, 0, len(pools))
+	removed := false
+	for _, pool := range pools {
+		name := ptr.Deref(pool.Name, "")
+		if _, tracked := s.LastAppliedInboundNATPools[name]; tracked && !inboundNATPoolExists(wantedPools, *pool) {
+			removed = true
+			continue
+		}
+		kept = append(kept, pool)
+	}
+	if !removed {
+		return pools, false
+	}
+	return kept, true
+}
+
+func inboundNATPoolExists(pools []*armnetwork.InboundNatPool, pool armnetwork.InboundNatPool) bool {
+	for _, p := range pools {
+		if ptr.Deref(p.Name, "") == ptr.Deref(pool.Name, "") {
+			return true
+		}
+	}
+	return false
+}
+
+// inboundNATPoolUpToDate returns true if the inbound NAT pool with the same name as the given pool has the protocol and
+// ports of the given pool.
+func inboundNATPoolUpToDate(pools []*armnetwork.InboundNatPool, pool armnetwork.InboundNatPool) bool {
+	for _, p := range pools {
+		if ptr.Deref(p.Name, "") != ptr.Deref(pool.Name, "") {
+			continue
+		}
+		if p.Properties == nil {
+			return false
+		}
+		return ptr.Deref(p.Properties.Protocol, "") == ptr.Deref(pool.Properties.Protocol, "") &&
+			ptr.Equal(p.Properties.FrontendPortRangeStart, pool.Properties.FrontendPortRangeStart) &&
+			ptr.Equal(p.Properties.FrontendPortRangeEnd, pool.Properties.FrontendPortRangeEnd) &&
+			ptr.Equal(p.Properties.BackendPort, pool.Properties.BackendPort)
+	}
+	return false
+}
+
+// replaceInboundNATPool returns a copy of pools where the inbound NAT pool with the same name as the given pool is
+// replaced by it.
+func replaceInboundNATPool(pools []*armnetwork.InboundNatPool, pool *armnetwork.InboundNatPool) []*armnetwork.InboundNatPool {
+	replaced := make([]*armnetwork.InboundNatPool, 0, len(pools))
+	for _, p := range pools {
+		if ptr.Deref(p.Name, "") == ptr.Deref(pool.Name, "") {
+			p = pool
+		}
+		replaced = append(replaced, p)
+	}
+	return replaced
+}
+
 func probeExists(probes []*armnetwork.Probe, probe armnetwork.Probe) bool {
 	for _, p := range probes {
 		if ptr.Deref(p.Name, "") == ptr.Deref(probe.Name, "") {
@@ -280,6 +475,36 @@ func probeExists(probes []*armnetwork.Probe, probe armnetwork.Probe) bool {
 	return false
 }
 
+// probeUpToDate returns true if the probe with the same name as the given probe has the same properties.
+func probeUpToDate(probes []*armnetwork.Probe, probe armnetwork.Probe) bool {
+	for _, p := range probes {
+		if ptr.Deref(p.Name, "") != ptr.Deref(probe.Name, "") {
+			continue
+		}
+		if p.Properties == nil || probe.Properties == nil {
+			return p.Properties == probe.Properties
+		}
+		return ptr.Deref(p.Properties.Protocol, "") == ptr.Deref(probe.Properties.Protocol, "") &&
+			ptr.Equal(p.Properties.Port, probe.Properties.Port) &&
+			ptr.Deref(p.Properties.RequestPath, "") == ptr.Deref(probe.Properties.RequestPath, "") &&
+			ptr.Equal(p.Properties.IntervalInSeconds, probe.Properties.IntervalInSeconds) &&
+			ptr.Equal(p.Properties.NumberOfProbes, probe.Properties.NumberOfProbes)
+	}
+	return false
+}
+
+// replaceProbe returns a copy of probes where the probe with the same name as the given probe is replaced by it.
+func replaceProbe(probes []*armnetwork.Probe, probe *armnetwork.Probe) []*armnetwork.Probe {
+	replaced := make([]*armnetwork.Probe, 0, len(probes))
+	for _, p := range probes {
+		if ptr.Deref(p.Name, "") == ptr.Deref(probe.Name, "") {
+			p = probe
+		}
+		replaced = append(replaced, p)
+	}
+	return replaced
+}
+
 func outboundRuleExists(rules []*armnetwork.OutboundRule, rule armnetwork.OutboundRule) bool {
 	for _, r := range rules {
 		if ptr.Deref(r.Name, "") == ptr.Deref(rule.Name, "") {
@@ -289,6 +514,47 @@ func outboundRuleExists(rules []*armnetwork.OutboundRule, rule armnetwork.Outbou
 	return false
 }
 
+// outboundRuleUpToDate returns true if the outbound rule with the same name as the given rule has the idle timeout
+// and TCP reset of the given rule, when they are set.
+func outboundRuleUpToDate(rules []*armnetwork.OutboundRule, rule armnetwork.OutboundRule) bool {
+	for _, r := range rules {
+		if ptr.Deref(r.Name, "") != ptr.Deref(rule.Name, "") {
+			continue
+		}
+		if r.Properties == nil {
+			return false
+		}
+		return (rule.Properties.IdleTimeoutInMinutes == nil || ptr.Equal(r.Properties.IdleTimeoutInMinutes, rule.Properties.IdleTimeoutInMinutes)) &&
+			(rule.Properties.EnableTCPReset == nil || ptr.Equal(r.Properties.EnableTCPReset, rule.Properties.EnableTCPReset))
+	}
+	return false
+}
+
+// updateOutboundRule returns a copy of rules where the outbound rule with the same name as the given rule has the
+// idle timeout and TCP reset of the given rule. Its other properties are left as is.
+func updateOutboundRule(rules []*armnetwork.OutboundRule, rule armnetwork.OutboundRule) []*armnetwork.OutboundRule {
+	updated := make([]*armnetwork.OutboundRule, 0, len(rules))
+	for _, r := range rules {
+		if ptr.Deref(r.Name, "") == ptr.Deref(rule.Name, "") {
+			var properties armnetwork.OutboundRulePropertiesFormat
+			if r.Properties != nil {
+				properties = *r.Properties
+			}
+			copied := *r
+			copied.Properties = &properties
+			r = &copied
+			if rule.Properties.IdleTimeoutInMinutes != nil {
+				r.Properties.IdleTimeoutInMinutes = rule.Properties.IdleTimeoutInMinutes
+			}
+			if rule.Properties.EnableTCPReset != nil {
+				r.Properties.EnableTCPReset = rule.Properties.EnableTCPReset
+			}
+		}
+		updated = append(updated, r)
+	}
+	return updated
+}
+
 func poolExists(pools []*armnetwork.BackendAddressPool, pool armnetwork.BackendAddressPool) bool {
 	for _, p := range pools {
 		if ptr.Deref(p.Name, "") == ptr.Deref(pool.Name, "") {
@@ -307,6 +573,98 @@ func lbRuleExists(rules []*armnetwork.LoadBalancingRule, rule armnetwork.LoadBal
 	return false
 }
 
+// lbRuleUpToDate returns true if the load balancing rule with the same name as the given rule has the idle timeout,
+// TCP reset, outbound SNAT setting and backend pool of the given rule, when they are set.
+func lbRuleUpToDate(rules []*armnetwork.LoadBalancingRule, rule armnetwork.LoadBalancingRule) bool {
+	for _, r := range rules {
+		if ptr.Deref(r.Name, "") != ptr.Deref(rule.Name, "") {
+			continue
+		}
+		if r.Properties == nil {
+			return false
+		}
+		return (rule.Properties.IdleTimeoutInMinutes == nil || ptr.Equal(r.Properties.IdleTimeoutInMinutes, rule.Properties.IdleTimeoutInMinutes)) &&
+			(rule.Properties.EnableTCPReset == nil || ptr.Equal(r.Properties.EnableTCPReset, rule.Properties.EnableTCPReset)) &&
+			(rule.Properties.DisableOutboundSnat == nil || ptr.Equal(r.Properties.DisableOutboundSnat, rule.Properties.DisableOutboundSnat)) &&
+			(rule.Properties.BackendAddressPool == nil || subResourceEqual(r.Properties.BackendAddressPool, rule.Properties.BackendAddressPool))
+	}
+	return false
+}
+
+// updateLBRule returns a copy of rules where the load balancing rule with the same name as the given rule has the
+// idle timeout, TCP reset, outbound SNAT setting and backend pool of the given rule. Its other properties are left as is.
+func updateLBRule(rules []*armnetwork.LoadBalancingRule, rule armnetwork.LoadBalancingRule) []*armnetwork.LoadBalancingRule {
+	updated := make([]*armnetwork.LoadBalancingRule, 0, len(rules))
+	for _, r := range rules {
+		if ptr.Deref(r.Name, "") == ptr.Deref(rule.Name, "") {
+			var properties armnetwork.LoadBalancingRulePropertiesFormat
+			if r.Properties != nil {
+				properties = *r.Properties
+			}
+			copied := *r
+			copied.Properties = &properties
+			r = &copied
+			if rule.Properties.IdleTimeoutInMinutes != nil {
+				r.Properties.IdleTimeoutInMinutes = rule.Properties.IdleTimeoutInMinutes
+			}
+			if rule.Properties.EnableTCPReset != nil {
+				r.Properties.EnableTCPReset = rule.Properties.EnableTCPReset
+			}
+			if rule.Properties.DisableOutboundSnat != nil {
+				r.Properties.DisableOutboundSnat = rule.Properties.DisableOutboundSnat
+			}
+			if rule.Properties.BackendAddressPool != nil {
+				r.Properties.BackendAddressPool = rule.Properties.BackendAddressPool
+			}
+		}
+		updated = append(updated, r)
+	}
+	return updated
+}
+
+// subResourceEqual returns true if both sub resources have the same ID, ignoring case.
+func subResourceEqual(a, b *armnetwork.SubResource) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return strings.EqualFold(ptr.Deref(a.ID, ""), ptr.Deref(b.ID, ""))
+}
+
+// gatewayLoadBalancerUpToDate returns true if the existing frontend IP config with the same name is chained to the
+// same Gateway Load Balancer as the desired one.
+func gatewayLoadBalancerUpToDate(configs []*armnetwork.FrontendIPConfiguration, config armnetwork.FrontendIPConfiguration) bool {
+	for _, ip := range configs {
+		if ptr.Deref(ip.Name, "") != ptr.Deref(config.Name, "") {
+			continue
+		}
+		if ip.Properties == nil || ip.Properties.GatewayLoadBalancer == nil {
+			return false
+		}
+		return strings.EqualFold(ptr.Deref(ip.Properties.GatewayLoadBalancer.ID, ""), ptr.Deref(config.Properties.GatewayLoadBalancer.ID, ""))
+	}
+	return false
+}
+
+// chainGatewayLoadBalancer returns the frontend IP configs with the Gateway Load Balancer of the existing frontend IP
+// config with the same name set to the one of the desired config. The other properties of the existing config are kept.
+func chainGatewayLoadBalancer(configs []*armnetwork.FrontendIPConfiguration, config armnetwork.FrontendIPConfiguration) []*armnetwork.FrontendIPConfiguration {
+	chained := make([]*armnetwork.FrontendIPConfiguration, 0, len(configs))
+	for _, ip := range configs {
+		if ptr.Deref(ip.Name, "") == ptr.Deref(config.Name, "") {
+			updated := *ip
+			properties := armnetwork.FrontendIPConfigurationPropertiesFormat{}
+			if ip.Properties != nil {
+				properties = *ip.Properties
+			}
+			properties.GatewayLoadBalancer = config.Properties.GatewayLoadBalancer
+			updated.Properties = &properties
+			ip = &updated
+		}
+		chained = append(chained, ip)
+	}
+	return chained
+}
+
 func ipExists(configs []*armnetwork.FrontendIPConfiguration, config armnetwork.FrontendIPConfiguration) bool {
 	for _, ip := range configs {
 		if ptr.Deref(ip.Name, "") == ptr.Deref(config.Name, "") {
@@ -61,6 +61,8 @@ func getExistingLBWithMissingOutboundRules() armnetwork.LoadBalancer {
 	return existingLB
 }
 
+const fakeGatewayLBFrontendID = "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gateway-lb/frontendIPConfigurations/my-gateway-frontend"
+
 func TestParameters(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -87,6 +89,114 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "internal API load balancer with an HA ports rule",
+			spec: func() *LBSpec {
+				spec := fakeInternalAPILBSpec
+				spec.EnableHAPorts = true
+				return &spec
+			}(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				rules := result.(armnetwork.LoadBalancer).Properties.LoadBalancingRules
+				g.Expect(rules).To(HaveLen(1))
+				g.Expect(rules[0].Name).To(Equal(ptr.To(lbRuleHAPorts)))
+				g.Expect(rules[0].Properties.Protocol).To(Equal(ptr.To(armnetwork.TransportProtocolAll)))
+				g.Expect(rules[0].Properties.FrontendPort).To(Equal(ptr.To[int32](0)))
+				g.Expect(rules[0].Properties.BackendPort).To(Equal(ptr.To[int32](0)))
+				g.Expect(rules[0].Properties.Probe.ID).To(Equal(ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-private-lb/probes/HTTPSProbe")))
+			},
+			expectedError: "",
+		},
+		{
+			name: "internal API load balancer with a custom frontend port",
+			spec: func() *LBSpec {
+				spec := fakeInternalAPILBSpec
+				spec.FrontendPort = ptr.To[int32](443)
+				return &spec
+			}(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				rules := result.(armnetwork.LoadBalancer).Properties.LoadBalancingRules
+				g.Expect(rules).To(HaveLen(1))
+				g.Expect(rules[0].Properties.FrontendPort).To(Equal(ptr.To[int32](443)))
+				g.Expect(rules[0].Properties.BackendPort).To(Equal(ptr.To[int32](6443)))
+				probes := result.(armnetwork.LoadBalancer).Properties.Probes
+				g.Expect(probes).To(HaveLen(1))
+				g.Expect(probes[0].Properties.Port).To(Equal(ptr.To[int32](6443)))
+			},
+			expectedError: "",
+		},
+		{
+			name: "internal API load balancer with outbound SNAT enabled",
+			spec: func() *LBSpec {
+				spec := fakeInternalAPILBSpec
+				spec.DisableOutboundSNAT = ptr.To(false)
+				return &spec
+			}(),
+			existing: newDefaultInternalAPIServerLB(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				expected := newDefaultInternalAPIServerLB()
+				expected.Properties.LoadBalancingRules[0].Properties.DisableOutboundSnat = ptr.To(false)
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(expected))
+			},
+			expectedError: "",
+		},
+		{
+			name: "load balancer exists with an additional backend pool referenced by the load balancing rule",
+			spec: func() *LBSpec {
+				spec := fakePublicAPILBSpec
+				spec.AdditionalBackendPoolNames = []string{"my-publiclb-green"}
+				spec.RuleBackendPoolName = "my-publiclb-green"
+				return &spec
+			}(),
+			existing: newSamplePublicAPIServerLB(false, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				expected := newSamplePublicAPIServerLB(false, false, false, false, false)
+				expected.Properties.BackendAddressPools = append(expected.Properties.BackendAddressPools, &armnetwork.BackendAddressPool{
+					Name: ptr.To("my-publiclb-green"),
+				})
+				expected.Properties.LoadBalancingRules[0].Properties.BackendAddressPool = &armnetwork.SubResource{
+					ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/my-publiclb-green"),
+				}
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(expected))
+			},
+			expectedError: "",
+		},
+		{
+			name: "load balancer exists with a removed backend pool referenced by the load balancing rule",
+			spec: func() *LBSpec {
+				spec := fakePublicAPILBSpec
+				spec.LastAppliedBackendPools = map[string]interface{}{
+					"my-publiclb-green": "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/my-publiclb-green",
+				}
+				return &spec
+			}(),
+			existing: func() armnetwork.LoadBalancer {
+				existingLB := newSamplePublicAPIServerLB(false, false, false, false, false)
+				existingLB.Properties.BackendAddressPools = append(existingLB.Properties.BackendAddressPools,
+					&armnetwork.BackendAddressPool{Name: ptr.To("my-publiclb-green")},
+					&armnetwork.BackendAddressPool{Name: ptr.To("unmanaged-pool")},
+				)
+				existingLB.Properties.LoadBalancingRules[0].Properties.BackendAddressPool = &armnetwork.SubResource{
+					ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/my-publiclb-green"),
+				}
+				return existingLB
+			}(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				expected := newSamplePublicAPIServerLB(false, false, false, false, false)
+				expected.Properties.BackendAddressPools = append(expected.Properties.BackendAddressPools, &armnetwork.BackendAddressPool{
+					Name: ptr.To("unmanaged-pool"),
+				})
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(expected))
+			},
+			expectedError: "",
+		},
 		{
 			name:     "node outbound load balancer exists with all expected values",
 			spec:     &fakeNodeOutboundLBSpec,
@@ -96,6 +206,89 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name:     "node outbound load balancer with inbound NAT pools",
+			spec:     newNodeOutboundLBSpecWithInboundNATPools(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer).Properties.InboundNatPools).To(Equal(newSampleInboundNATPools()))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "node outbound load balancer exists with missing inbound NAT pools",
+			spec:     newNodeOutboundLBSpecWithInboundNATPools(),
+			existing: newDefaultNodeOutboundLB(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				expected := newDefaultNodeOutboundLB()
+				expected.Properties.InboundNatPools = newSampleInboundNATPools()
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(expected))
+			},
+			expectedError: "",
+		},
+		{
+			name: "node outbound load balancer exists with a changed inbound NAT pool",
+			spec: func() *LBSpec {
+				spec := newNodeOutboundLBSpecWithInboundNATPools()
+				spec.InboundNATPools[0].FrontendPortRangeEnd = 50199
+				return spec
+			}(),
+			existing: func() armnetwork.LoadBalancer {
+				lb := newDefaultNodeOutboundLB()
+				lb.Properties.InboundNatPools = newSampleInboundNATPools()
+				return lb
+			}(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				pools := result.(armnetwork.LoadBalancer).Properties.InboundNatPools
+				g.Expect(pools).To(HaveLen(2))
+				g.Expect(pools[0].Properties.FrontendPortRangeEnd).To(Equal(ptr.To[int32](50199)))
+				g.Expect(pools[1]).To(Equal(newSampleInboundNATPools()[1]))
+			},
+			expectedError: "",
+		},
+		{
+			name: "node outbound load balancer exists with up to date inbound NAT pools",
+			spec: newNodeOutboundLBSpecWithInboundNATPools(),
+			existing: func() armnetwork.LoadBalancer {
+				lb := newDefaultNodeOutboundLB()
+				lb.Properties.InboundNatPools = newSampleInboundNATPools()
+				return lb
+			}(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "node outbound load balancer exists with a removed inbound NAT pool",
+			spec: func() *LBSpec {
+				spec := newNodeOutboundLBSpecWithInboundNATPools()
+				spec.InboundNATPools = spec.InboundNATPools[:1]
+				spec.LastAppliedInboundNATPools = map[string]interface{}{
+					"ssh": "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-cluster/inboundNatPools/ssh",
+					"dns": "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-cluster/inboundNatPools/dns",
+				}
+				return spec
+			}(),
+			existing: func() armnetwork.LoadBalancer {
+				lb := newDefaultNodeOutboundLB()
+				lb.Properties.InboundNatPools = append(newSampleInboundNATPools(), &armnetwork.InboundNatPool{Name: ptr.To("unmanaged-pool")})
+				return lb
+			}(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				expected := newDefaultNodeOutboundLB()
+				expected.Properties.InboundNatPools = []*armnetwork.InboundNatPool{
+					newSampleInboundNATPools()[0],
+					{Name: ptr.To("unmanaged-pool")},
+				}
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(expected))
+			},
+			expectedError: "",
+		},
 		{
 			name:     "load balancer exists with missing frontend IP configs",
 			spec:     &fakePublicAPILBSpec,
@@ -126,6 +319,26 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "load balancer exists with a different idle timeout and TCP reset",
+			spec: func() *LBSpec {
+				spec := fakePublicAPILBSpec
+				spec.IdleTimeoutInMinutes = ptr.To[int32](15)
+				spec.EnableTCPReset = ptr.To(true)
+				return &spec
+			}(),
+			existing: newSamplePublicAPIServerLB(false, false, true, false, true),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				expected := newSamplePublicAPIServerLB(false, false, true, false, true)
+				expected.Properties.LoadBalancingRules[0].Properties.IdleTimeoutInMinutes = ptr.To[int32](15)
+				expected.Properties.LoadBalancingRules[0].Properties.EnableTCPReset = ptr.To(true)
+				expected.Properties.OutboundRules[0].Properties.IdleTimeoutInMinutes = ptr.To[int32](15)
+				expected.Properties.OutboundRules[0].Properties.EnableTCPReset = ptr.To(true)
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(expected))
+			},
+			expectedError: "",
+		},
 		{
 			name:     "load balancer exists with missing probes",
 			spec:     &fakePublicAPILBSpec,
@@ -136,6 +349,130 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "public API load balancer with an https health probe",
+			spec: func() *LBSpec {
+				spec := fakePublicAPILBSpec
+				spec.HealthProbe = &infrav1.HealthProbe{
+					Protocol:          infrav1.ProbeProtocolHTTPS,
+					RequestPath:       "/healthz",
+					IntervalInSeconds: ptr.To[int32](5),
+					NumberOfProbes:    ptr.To[int32](2),
+				}
+				return &spec
+			}(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer).Properties.Probes).To(Equal([]*armnetwork.Probe{
+					{
+						Name: ptr.To(httpsProbe),
+						Properties: &armnetwork.ProbePropertiesFormat{
+							Protocol:          ptr.To(armnetwork.ProbeProtocolHTTPS),
+							Port:              ptr.To[int32](6443),
+							RequestPath:       ptr.To("/healthz"),
+							IntervalInSeconds: ptr.To[int32](5),
+							NumberOfProbes:    ptr.To[int32](2),
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "public API load balancer exists with a changed tcp health probe",
+			spec: func() *LBSpec {
+				spec := fakePublicAPILBSpec
+				spec.HealthProbe = &infrav1.HealthProbe{Protocol: infrav1.ProbeProtocolTCP}
+				return &spec
+			}(),
+			existing: newSamplePublicAPIServerLB(false, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer).Properties.Probes).To(Equal([]*armnetwork.Probe{
+					{
+						Name: ptr.To(httpsProbe),
+						Properties: &armnetwork.ProbePropertiesFormat{
+							Protocol:          ptr.To(armnetwork.ProbeProtocolTCP),
+							Port:              ptr.To[int32](6443),
+							IntervalInSeconds: ptr.To[int32](15),
+							NumberOfProbes:    ptr.To[int32](4),
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "public API load balancer exists with an up to date health probe",
+			spec: func() *LBSpec {
+				spec := fakePublicAPILBSpec
+				spec.HealthProbe = &infrav1.HealthProbe{Protocol: infrav1.ProbeProtocolHTTPS, RequestPath: httpsProbeRequestPath}
+				return &spec
+			}(),
+			existing: newSamplePublicAPIServerLB(false, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "public API load balancer chained to a gateway load balancer",
+			spec: func() *LBSpec {
+				spec := fakePublicAPILBSpec
+				spec.FrontendIPConfigs = []infrav1.FrontendIP{
+					{
+						Name:                "my-publiclb-frontEnd",
+						PublicIP:            &infrav1.PublicIPSpec{Name: "my-publicip"},
+						GatewayLoadBalancer: &infrav1.GatewayLoadBalancerReference{ID: fakeGatewayLBFrontendID},
+					},
+				}
+				return &spec
+			}(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer).Properties.FrontendIPConfigurations).To(Equal([]*armnetwork.FrontendIPConfiguration{
+					{
+						Name: ptr.To("my-publiclb-frontEnd"),
+						Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{
+							PublicIPAddress:     &armnetwork.PublicIPAddress{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-publicip")},
+							GatewayLoadBalancer: &armnetwork.SubResource{ID: ptr.To(fakeGatewayLBFrontendID)},
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "public API load balancer exists and is chained to a gateway load balancer",
+			spec: func() *LBSpec {
+				spec := fakePublicAPILBSpec
+				spec.FrontendIPConfigs = []infrav1.FrontendIP{
+					{
+						Name:                "my-publiclb-frontEnd",
+						PublicIP:            &infrav1.PublicIPSpec{Name: "my-publicip"},
+						GatewayLoadBalancer: &infrav1.GatewayLoadBalancerReference{ID: fakeGatewayLBFrontendID},
+					},
+				}
+				return &spec
+			}(),
+			existing: newSamplePublicAPIServerLB(true, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer).Properties.FrontendIPConfigurations).To(Equal([]*armnetwork.FrontendIPConfiguration{
+					{
+						Name: ptr.To("my-publiclb-frontEnd"),
+						Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{
+							PublicIPAddress:     &armnetwork.PublicIPAddress{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-publicip")},
+							Subnet:              &armnetwork.Subnet{Name: ptr.To("fake-test-subnet")},
+							GatewayLoadBalancer: &armnetwork.SubResource{ID: ptr.To(fakeGatewayLBFrontendID)},
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
 		{
 			name:     "load balancer exists with missing outbound rules",
 			spec:     &fakePublicAPILBSpec,
@@ -208,12 +545,63 @@ func newDefaultNodeOutboundLB() armnetwork.LoadBalancer {
 	}
 }
 
+func newNodeOutboundLBSpecWithInboundNATPools() *LBSpec {
+	spec := fakeNodeOutboundLBSpec
+	spec.InboundNATPools = []infrav1.InboundNATPool{
+		{
+			Name:                   "ssh",
+			FrontendPortRangeStart: 50000,
+			FrontendPortRangeEnd:   50099,
+			BackendPort:            22,
+			Protocol:               infrav1.InboundNATPoolProtocolTCP,
+		},
+		{
+			Name:                   "dns",
+			FrontendPortRangeStart: 53000,
+			FrontendPortRangeEnd:   53009,
+			BackendPort:            53,
+			Protocol:               infrav1.InboundNATPoolProtocolUDP,
+		},
+	}
+	return &spec
+}
+
+func newSampleInboundNATPools() []*armnetwork.InboundNatPool {
+	frontendIPConfiguration := &armnetwork.SubResource{
+		ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-cluster/frontendIPConfigurations/my-cluster-frontEnd"),
+	}
+	return []*armnetwork.InboundNatPool{
+		{
+			Name: ptr.To("ssh"),
+			Properties: &armnetwork.InboundNatPoolPropertiesFormat{
+				Protocol:                ptr.To(armnetwork.TransportProtocolTCP),
+				FrontendPortRangeStart:  ptr.To[int32](50000),
+				FrontendPortRangeEnd:    ptr.To[int32](50099),
+				BackendPort:             ptr.To[int32](22),
+				IdleTimeoutInMinutes:    ptr.To[int32](30),
+				FrontendIPConfiguration: frontendIPConfiguration,
+			},
+		},
+		{
+			Name: ptr.To("dns"),
+			Properties: &armnetwork.InboundNatPoolPropertiesFormat{
+				Protocol:                ptr.To(armnetwork.TransportProtocolUDP),
+				FrontendPortRangeStart:  ptr.To[int32](53000),
+				FrontendPortRangeEnd:    ptr.To[int32](53009),
+				BackendPort:             ptr.To[int32](53),
+				IdleTimeoutInMinutes:    ptr.To[int32](30),
+				FrontendIPConfiguration: frontendIPConfiguration,
+			},
+		},
+	}
+}
+
 func newSamplePublicAPIServerLB(verifyFrontendIP bool, verifyBackendAddressPools bool, verifyLBRules bool, verifyProbes bool, verifyOutboundRules bool) armnetwork.LoadBalancer {
 	var subnet *armnetwork.Subnet
 	var backendAddressPoolProps *armnetwork.BackendAddressPoolPropertiesFormat
 	enableFloatingIP := ptr.To(false)
 	numProbes := ptr.To[int32](4)
-	idleTimeout := ptr.To[int32](4)
+	var allocatedOutboundPorts *int32
 
 	if verifyFrontendIP {
 		subnet = &armnetwork.Subnet{
@@ -232,7 +620,7 @@ func newSamplePublicAPIServerLB(verifyFrontendIP bool, verifyBackendAddressPools
 		numProbes = ptr.To[int32](999)
 	}
 	if verifyOutboundRules {
-		idleTimeout = ptr.To[int32](1000)
+		allocatedOutboundPorts = ptr.To[int32](1000)
 	}
 
 	return armnetwork.LoadBalancer{
@@ -303,8 +691,9 @@ func newSamplePublicAPIServerLB(verifyFrontendIP bool, verifyBackendAddressPools
 						BackendAddressPool: &armnetwork.SubResource{
 							ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/my-publiclb-backendPool"),
 						},
-						Protocol:             ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolAll),
-						IdleTimeoutInMinutes: idleTimeout, // Add to verify that OutboundRules aren't overwritten on update
+						Protocol:               ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolAll),
+						IdleTimeoutInMinutes:   ptr.To[int32](4),
+						AllocatedOutboundPorts: allocatedOutboundPorts, // Add to verify that OutboundRules aren't overwritten on update
 					},
 				},
 			},
@@ -0,0 +1,153 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// client wraps go-sdk.
+type managedClusterClient interface {
+	Get(ctx context.Context, resourceGroupName, name string) (armcontainerservice.ManagedCluster, error)
+	Start(ctx context.Context, resourceGroupName, name string) error
+	Stop(ctx context.Context, resourceGroupName, name string) error
+	RotateClusterCertificates(ctx context.Context, resourceGroupName, name string) error
+}
+
+// routeTableGetter gets the route table attached to a subnet.
+type routeTableGetter interface {
+	GetSubnetRouteTable(ctx context.Context, resourceGroupName, vnetName, subnetName string) (*armnetwork.RouteTable, error)
+}
+
+// AzureClient contains the Azure go-sdk client.
+type AzureClient struct {
+	managedclusters *armcontainerservice.ManagedClustersClient
+}
+
+var _ managedClusterClient = (*AzureClient)(nil)
+
+// NewClient creates a managed clusters client from an authorizer.
+func NewClient(auth azure.Authorizer) (*AzureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create managed clusters client options")
+	}
+	managedClustersClient, err := armcontainerservice.NewManagedClustersClient(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armcontainerservice managed clusters client")
+	}
+	return &AzureClient{managedClustersClient}, nil
+}
+
+// Get gets the managed cluster.
+func (ac *AzureClient) Get(ctx context.Context, resourceGroupName, name string) (armcontainerservice.ManagedCluster, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.AzureClient.Get")
+	defer done()
+
+	resp, err := ac.managedclusters.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return armcontainerservice.ManagedCluster{}, err
+	}
+
+	return resp.ManagedCluster, nil
+}
+
+// Start starts the managed cluster. It doesn't wait for the long running operation to complete, its progress is
+// reported by the provisioning state of the managed cluster.
+func (ac *AzureClient) Start(ctx context.Context, resourceGroupName, name string) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.AzureClient.Start")
+	defer done()
+
+	_, err := ac.managedclusters.BeginStart(ctx, resourceGroupName, name, nil)
+	return err
+}
+
+// Stop stops the managed cluster. It doesn't wait for the long running operation to complete, its progress is
+// reported by the provisioning state of the managed cluster.
+func (ac *AzureClient) Stop(ctx context.Context, resourceGroupName, name string) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.AzureClient.Stop")
+	defer done()
+
+	_, err := ac.managedclusters.BeginStop(ctx, resourceGroupName, name, nil)
+	return err
+}
+
+// RotateClusterCertificates rotates the certificates of the managed cluster. It doesn't wait for the long running
+// operation to complete, its progress is reported by the provisioning state of the managed cluster.
+func (ac *AzureClient) RotateClusterCertificates(ctx context.Context, resourceGroupName, name string) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.AzureClient.RotateClusterCertificates")
+	defer done()
+
+	_, err := ac.managedclusters.BeginRotateClusterCertificates(ctx, resourceGroupName, name, nil)
+	return err
+}
+
+// routeTableClient contains the Azure go-sdk clients for subnets and route tables.
+type routeTableClient struct {
+	subnets     *armnetwork.SubnetsClient
+	routeTables *armnetwork.RouteTablesClient
+}
+
+var _ routeTableGetter = (*routeTableClient)(nil)
+
+// newRouteTableClient creates a route table client from an authorizer.
+func newRouteTableClient(auth azure.Authorizer) (*routeTableClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create route tables client options")
+	}
+	factory, err := armnetwork.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armnetwork client factory")
+	}
+	return &routeTableClient{
+		subnets:     factory.NewSubnetsClient(),
+		routeTables: factory.NewRouteTablesClient(),
+	}, nil
+}
+
+// GetSubnetRouteTable gets the route table attached to the subnet, with its routes. It returns nil if the subnet has
+// no route table attached.
+func (ac *routeTableClient) GetSubnetRouteTable(ctx context.Context, resourceGroupName, vnetName, subnetName string) (*armnetwork.RouteTable, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.routeTableClient.GetSubnetRouteTable")
+	defer done()
+
+	subnet, err := ac.subnets.Get(ctx, resourceGroupName, vnetName, subnetName, nil)
+	if err != nil {
+		return nil, err
+	}
+	if subnet.Properties == nil || subnet.Properties.RouteTable == nil || subnet.Properties.RouteTable.ID == nil {
+		return nil, nil
+	}
+
+	routeTableID, err := arm.ParseResourceID(*subnet.Properties.RouteTable.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse route table ID %s", *subnet.Properties.RouteTable.ID)
+	}
+	routeTable, err := ac.routeTables.Get(ctx, routeTableID.ResourceGroupName, routeTableID.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &routeTable.RouteTable, nil
+}
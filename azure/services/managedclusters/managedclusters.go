@@ -18,16 +18,22 @@ package managedclusters
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/token"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/secret"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +46,16 @@ const (
 	// The aadResourceID is the application-id used by the server side. The access token accessing AKS clusters need to be issued for this app.
 	// Refer: https://azure.github.io/kubelogin/concepts/aks.html?highlight=6dae42f8-4368-4678-94ff-3960e28e3630#azure-kubernetes-service-aad-server
 	aadResourceID = "6dae42f8-4368-4678-94ff-3960e28e3630"
+
+	// powerStateRequeueInterval is how long to wait before checking again on a cluster which is starting or stopping.
+	powerStateRequeueInterval = 30 * time.Second
+
+	// certificateRotationRequeueInterval is how long to wait before checking again on a cluster whose certificates are rotating.
+	certificateRotationRequeueInterval = time.Minute
+
+	// defaultRouteAddressPrefix is the address prefix of the default route, which the userDefinedRouting outbound
+	// type sends the egress traffic of the cluster through.
+	defaultRouteAddressPrefix = "0.0.0.0/0"
 )
 
 // ManagedClusterScope defines the scope interface for a managed cluster.
@@ -54,25 +70,94 @@ type ManagedClusterScope interface {
 	GetUserKubeconfigData() []byte
 	SetUserKubeconfigData([]byte)
 	IsAADEnabled() bool
+	IsVnetManaged() bool
 	AreLocalAccountsDisabled() bool
+	ResourceGroup() string
+	DesiredPowerState() infrav1.ManagedControlPlanePowerState
 	SetOIDCIssuerProfileStatus(*infrav1.OIDCIssuerProfileStatus)
+	SetEffectiveOutboundIPs([]string)
+	SetKubeletIdentityObjectID(string)
+	PendingCertificateRotation() *metav1.Time
+	SetLastCertificateRotation(metav1.Time)
 	MakeClusterCA() *corev1.Secret
 	StoreClusterInfo(context.Context, []byte) error
 }
 
 // New creates a new service.
-func New(scope ManagedClusterScope) *aso.Service[*asocontainerservicev1.ManagedCluster, ManagedClusterScope] {
+func New(scope ManagedClusterScope) (*aso.Service[*asocontainerservicev1.ManagedCluster, ManagedClusterScope], error) {
+	mcClient, err := NewClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	rtClient, err := newRouteTableClient(scope)
+	if err != nil {
+		return nil, err
+	}
 	svc := aso.NewService[*asocontainerservicev1.ManagedCluster](serviceName, scope)
 	svc.Specs = []azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedCluster]{scope.ManagedClusterSpec()}
 	svc.ConditionType = infrav1.ManagedClusterRunningCondition
-	svc.PostCreateOrUpdateResourceHook = postCreateOrUpdateResourceHook
-	return svc
+	svc.PreReconcileHook = func(ctx context.Context, scope ManagedClusterScope) error {
+		return validateUserDefinedRouting(ctx, scope, rtClient)
+	}
+	svc.PostCreateOrUpdateResourceHook = func(ctx context.Context, scope ManagedClusterScope, managedCluster *asocontainerservicev1.ManagedCluster, err error) error {
+		return postCreateOrUpdateResourceHook(ctx, scope, mcClient, managedCluster, err)
+	}
+	return svc, nil
+}
+
+// validateUserDefinedRouting checks that a cluster with the userDefinedRouting outbound type is in a subnet brought
+// by the user, with a route table attached which has a default route. AKS requires it to send the egress traffic of
+// the cluster through the route table instead of a load balancer.
+func validateUserDefinedRouting(ctx context.Context, scope ManagedClusterScope, rtClient routeTableGetter) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.validateUserDefinedRouting")
+	defer done()
+
+	spec, ok := scope.ManagedClusterSpec().(*ManagedClusterSpec)
+	if !ok || ptr.Deref(spec.OutboundType, "") != infrav1.ManagedControlPlaneOutboundTypeUserDefinedRouting {
+		return nil
+	}
+
+	if scope.IsVnetManaged() {
+		return azure.WithTerminalError(errors.New("the userDefinedRouting outbound type requires a subnet which is not managed by CAPZ, with a route table attached"))
+	}
+
+	subnetID, err := arm.ParseResourceID(spec.VnetSubnetID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse subnet ID %s", spec.VnetSubnetID)
+	}
+	routeTable, err := rtClient.GetSubnetRouteTable(ctx, subnetID.ResourceGroupName, subnetID.Parent.Name, subnetID.Name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get route table of subnet %s", spec.VnetSubnetID)
+	}
+	if routeTable == nil {
+		return errors.Errorf("subnet %s has no route table attached, which the userDefinedRouting outbound type requires", spec.VnetSubnetID)
+	}
+	if routeTable.Properties != nil {
+		for _, route := range routeTable.Properties.Routes {
+			if route.Properties != nil && ptr.Deref(route.Properties.AddressPrefix, "") == defaultRouteAddressPrefix {
+				return nil
+			}
+		}
+	}
+	return errors.Errorf("route table %s attached to subnet %s has no default route for %s, which the userDefinedRouting outbound type requires",
+		ptr.Deref(routeTable.ID, ""), spec.VnetSubnetID, defaultRouteAddressPrefix)
 }
 
-func postCreateOrUpdateResourceHook(ctx context.Context, scope ManagedClusterScope, managedCluster *asocontainerservicev1.ManagedCluster, err error) error {
+func postCreateOrUpdateResourceHook(ctx context.Context, scope ManagedClusterScope, mcClient managedClusterClient, managedCluster *asocontainerservicev1.ManagedCluster, err error) error {
+	stopped, err := reconcilePowerState(ctx, scope, mcClient, managedCluster, err)
 	if err != nil {
 		return err
 	}
+	if stopped {
+		// The endpoint of a stopped cluster doesn't change, so only the kubeconfigs are refreshed.
+		adminKubeConfigData, userKubeConfigData, err := reconcileKubeconfig(ctx, scope, scope.ASOOwner().GetNamespace())
+		if err != nil {
+			return errors.Wrap(err, "error while reconciling kubeconfigs")
+		}
+		scope.SetAdminKubeconfigData(adminKubeConfigData)
+		scope.SetUserKubeconfigData(userKubeConfigData)
+		return nil
+	}
 
 	// Update control plane endpoint.
 	endpoint := clusterv1.APIEndpoint{
@@ -105,7 +190,112 @@ func postCreateOrUpdateResourceHook(ctx context.Context, scope ManagedClusterSco
 		})
 	}
 
-	return nil
+	// Record the outbound public IPs of the AKS load balancer so that the tags service can tag them.
+	var effectiveOutboundIPs []string
+	if managedCluster.Status.NetworkProfile != nil && managedCluster.Status.NetworkProfile.LoadBalancerProfile != nil {
+		for _, ip := range managedCluster.Status.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs {
+			if ip.Id != nil {
+				effectiveOutboundIPs = append(effectiveOutboundIPs, *ip.Id)
+			}
+		}
+	}
+	scope.SetEffectiveOutboundIPs(effectiveOutboundIPs)
+
+	// Record the kubelet identity so that the acrattachments service can grant it pull access to registries.
+	scope.SetKubeletIdentityObjectID(ptr.Deref(managedCluster.Status.IdentityProfile[kubeletIdentityKey].ObjectId, ""))
+
+	return reconcileCertificateRotation(ctx, scope, mcClient)
+}
+
+// reconcileCertificateRotation rotates the certificates of the managed cluster when a rotation was requested since
+// the last one. The rotation is recorded once it was started, so that it isn't started again.
+func reconcileCertificateRotation(ctx context.Context, scope ManagedClusterScope, mcClient managedClusterClient) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "managedclusters.reconcileCertificateRotation")
+	defer done()
+
+	rotation := scope.PendingCertificateRotation()
+	if rotation == nil {
+		return nil
+	}
+
+	resourceGroup := scope.ResourceGroup()
+	name := scope.ManagedClusterSpec().ResourceRef().GetName()
+	log.V(2).Info("rotating managed cluster certificates")
+	if err := mcClient.RotateClusterCertificates(ctx, resourceGroup, name); err != nil {
+		return errors.Wrapf(err, "failed to rotate certificates of managed cluster %s/%s", resourceGroup, name)
+	}
+	scope.SetLastCertificateRotation(*rotation)
+	return azure.WithTransientError(errors.New("managed cluster certificates are rotating"), certificateRotationRequeueInterval)
+}
+
+// reconcilePowerState starts or stops the managed cluster when its power state differs from the desired one.
+// It returns true when the cluster is stopped as desired. That is a steady state even though ASO fails to apply
+// changes to the stopped cluster, so reconcileErr is ignored in that case.
+func reconcilePowerState(ctx context.Context, scope ManagedClusterScope, mcClient managedClusterClient, managedCluster *asocontainerservicev1.ManagedCluster, reconcileErr error) (bool, error) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "managedclusters.reconcilePowerState")
+	defer done()
+
+	desired := scope.DesiredPowerState()
+	// A running cluster which ASO reconciled successfully doesn't need to be looked up in Azure.
+	if desired == infrav1.ManagedControlPlanePowerStateRunning && reconcileErr == nil && !isStopped(managedCluster) {
+		return false, nil
+	}
+
+	resourceGroup := scope.ResourceGroup()
+	name := scope.ManagedClusterSpec().ResourceRef().GetName()
+	existing, err := mcClient.Get(ctx, resourceGroup, name)
+	if azure.ResourceNotFound(err) {
+		// The cluster is still being created.
+		return false, reconcileErr
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get managed cluster %s/%s", resourceGroup, name)
+	}
+
+	var actual armcontainerservice.Code
+	var provisioningState string
+	if existing.Properties != nil {
+		if existing.Properties.PowerState != nil {
+			actual = ptr.Deref(existing.Properties.PowerState.Code, "")
+		}
+		provisioningState = ptr.Deref(existing.Properties.ProvisioningState, "")
+	}
+
+	switch {
+	case provisioningState == "Starting" || provisioningState == "Stopping":
+		return false, azure.WithTransientError(errors.Errorf("managed cluster is %s", strings.ToLower(provisioningState)), powerStateRequeueInterval)
+	case actual == "":
+		// The power state isn't known yet, e.g. while the cluster is being created.
+		return false, reconcileErr
+	case string(actual) == string(desired):
+		if desired == infrav1.ManagedControlPlanePowerStateStopped {
+			return true, nil
+		}
+		return false, reconcileErr
+	case desired == infrav1.ManagedControlPlanePowerStateStopped:
+		if reconcileErr != nil {
+			// Let any ongoing update of the cluster finish before stopping it.
+			return false, reconcileErr
+		}
+		log.V(2).Info("stopping managed cluster")
+		if err := mcClient.Stop(ctx, resourceGroup, name); err != nil {
+			return false, errors.Wrapf(err, "failed to stop managed cluster %s/%s", resourceGroup, name)
+		}
+		return false, azure.WithTransientError(errors.New("managed cluster is stopping"), powerStateRequeueInterval)
+	default:
+		log.V(2).Info("starting managed cluster")
+		if err := mcClient.Start(ctx, resourceGroup, name); err != nil {
+			return false, errors.Wrapf(err, "failed to start managed cluster %s/%s", resourceGroup, name)
+		}
+		return false, azure.WithTransientError(errors.New("managed cluster is starting"), powerStateRequeueInterval)
+	}
+}
+
+// isStopped returns true when ASO last observed the managed cluster as stopped.
+func isStopped(managedCluster *asocontainerservicev1.ManagedCluster) bool {
+	return managedCluster != nil &&
+		managedCluster.Status.PowerState != nil &&
+		ptr.Deref(managedCluster.Status.PowerState.Code, "") == asocontainerservicev1.PowerState_Code_STATUS_Stopped
 }
 
 // reconcileKubeconfig will reconcile admin kubeconfig and user kubeconfig.
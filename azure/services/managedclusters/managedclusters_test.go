@@ -19,8 +19,13 @@ package managedclusters
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
@@ -28,7 +33,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/managedclusters/mock_managedclusters"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/secret"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -39,15 +46,58 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 		g := NewGomegaWithT(t)
 		mockCtrl := gomock.NewController(t)
 		scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+		mcClient := mock_managedclusters.NewMockmanagedClusterClient(mockCtrl)
 
-		err := postCreateOrUpdateResourceHook(context.Background(), scope, nil, errors.New("an error"))
+		scope.EXPECT().DesiredPowerState().Return(infrav1.ManagedControlPlanePowerStateRunning)
+		scope.EXPECT().ResourceGroup().Return("rg")
+		scope.EXPECT().ManagedClusterSpec().Return(&ManagedClusterSpec{Name: "cluster"})
+		mcClient.EXPECT().Get(gomockinternal.AContext(), "rg", "cluster").Return(armcontainerservice.ManagedCluster{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, mcClient, nil, errors.New("an error"))
 		g.Expect(err).To(HaveOccurred())
 	})
 
+	t.Run("stopped cluster", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+		mcClient := mock_managedclusters.NewMockmanagedClusterClient(mockCtrl)
+		namespace := "default"
+		clusterName := "cluster"
+
+		adminASOKubeconfig := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      adminKubeconfigSecretName(clusterName),
+			},
+			Data: map[string][]byte{
+				secret.KubeconfigDataName: []byte("admin credentials"),
+			},
+		}
+		kclient := fakeclient.NewClientBuilder().
+			WithObjects(adminASOKubeconfig).
+			Build()
+		scope.EXPECT().GetClient().Return(kclient).AnyTimes()
+		scope.EXPECT().ASOOwner().Return(&infrav1.AzureManagedControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: namespace}})
+		scope.EXPECT().ClusterName().Return(clusterName).AnyTimes()
+		scope.EXPECT().DesiredPowerState().Return(infrav1.ManagedControlPlanePowerStateStopped)
+		scope.EXPECT().ResourceGroup().Return("rg")
+		scope.EXPECT().ManagedClusterSpec().Return(&ManagedClusterSpec{Name: clusterName})
+		scope.EXPECT().IsAADEnabled().Return(false)
+		scope.EXPECT().AreLocalAccountsDisabled().Return(false)
+		scope.EXPECT().SetAdminKubeconfigData([]byte("admin credentials"))
+		scope.EXPECT().SetUserKubeconfigData(gomock.Nil())
+		mcClient.EXPECT().Get(gomockinternal.AContext(), "rg", clusterName).Return(managedClusterWithPowerState(armcontainerservice.CodeStopped, "Succeeded"), nil)
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, mcClient, nil, errors.New("cluster is stopped"))
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
 	t.Run("successful create or update", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 		mockCtrl := gomock.NewController(t)
 		scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+		mcClient := mock_managedclusters.NewMockmanagedClusterClient(mockCtrl)
 		namespace := "default"
 		clusterName := "cluster"
 
@@ -79,6 +129,7 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 			Port: 443,
 		})
 		scope.EXPECT().ClusterName().Return(clusterName).AnyTimes()
+		scope.EXPECT().DesiredPowerState().Return(infrav1.ManagedControlPlanePowerStateRunning)
 		scope.EXPECT().IsAADEnabled().Return(true)
 		scope.EXPECT().AreLocalAccountsDisabled().Return(false)
 		scope.EXPECT().SetAdminKubeconfigData([]byte("admin credentials"))
@@ -87,6 +138,9 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 		scope.EXPECT().SetOIDCIssuerProfileStatus(&infrav1.OIDCIssuerProfileStatus{
 			IssuerURL: ptr.To("oidc"),
 		})
+		scope.EXPECT().SetEffectiveOutboundIPs([]string{"/subscriptions/123/resourceGroups/node-rg/providers/Microsoft.Network/publicIPAddresses/outbound-ip"})
+		scope.EXPECT().SetKubeletIdentityObjectID("kubelet-object-id")
+		scope.EXPECT().PendingCertificateRotation().Return(nil)
 
 		managedCluster := &asocontainerservicev1.ManagedCluster{
 			ObjectMeta: metav1.ObjectMeta{
@@ -98,10 +152,20 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 				OidcIssuerProfile: &asocontainerservicev1.ManagedClusterOIDCIssuerProfile_STATUS{
 					IssuerURL: ptr.To("oidc"),
 				},
+				NetworkProfile: &asocontainerservicev1.ContainerServiceNetworkProfile_STATUS{
+					LoadBalancerProfile: &asocontainerservicev1.ManagedClusterLoadBalancerProfile_STATUS{
+						EffectiveOutboundIPs: []asocontainerservicev1.ResourceReference_STATUS{
+							{Id: ptr.To("/subscriptions/123/resourceGroups/node-rg/providers/Microsoft.Network/publicIPAddresses/outbound-ip")},
+						},
+					},
+				},
+				IdentityProfile: map[string]asocontainerservicev1.UserAssignedIdentity_STATUS{
+					kubeletIdentityKey: {ObjectId: ptr.To("kubelet-object-id")},
+				},
 			},
 		}
 
-		err := postCreateOrUpdateResourceHook(context.Background(), scope, managedCluster, nil)
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, mcClient, managedCluster, nil)
 		g.Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -109,6 +173,7 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 		g := NewGomegaWithT(t)
 		mockCtrl := gomock.NewController(t)
 		scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+		mcClient := mock_managedclusters.NewMockmanagedClusterClient(mockCtrl)
 		namespace := "default"
 		clusterName := "cluster"
 
@@ -121,6 +186,7 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 			Port: 443,
 		})
 		scope.EXPECT().ClusterName().Return(clusterName).AnyTimes()
+		scope.EXPECT().DesiredPowerState().Return(infrav1.ManagedControlPlanePowerStateRunning)
 		scope.EXPECT().IsAADEnabled().Return(true)
 
 		managedCluster := &asocontainerservicev1.ManagedCluster{
@@ -137,7 +203,309 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 			},
 		}
 
-		err := postCreateOrUpdateResourceHook(context.Background(), scope, managedCluster, nil)
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, mcClient, managedCluster, nil)
 		g.Expect(err).To(HaveOccurred())
 	})
 }
+
+func TestReconcilePowerState(t *testing.T) {
+	notDoneErr := azure.NewOperationNotDoneError(&infrav1.Future{})
+
+	tests := []struct {
+		name           string
+		desired        infrav1.ManagedControlPlanePowerState
+		managedCluster *asocontainerservicev1.ManagedCluster
+		reconcileErr   error
+		expect         func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder)
+		expectStopped  bool
+		expectedError  string
+	}{
+		{
+			name:    "running cluster is not looked up",
+			desired: infrav1.ManagedControlPlanePowerStateRunning,
+			managedCluster: &asocontainerservicev1.ManagedCluster{
+				Status: asocontainerservicev1.ManagedCluster_STATUS{
+					PowerState: &asocontainerservicev1.PowerState_STATUS{
+						Code: ptr.To(asocontainerservicev1.PowerState_Code_STATUS_Running),
+					},
+				},
+			},
+			expect: func(_ *mock_managedclusters.MockmanagedClusterClientMockRecorder) {},
+		},
+		{
+			name:         "cluster being created",
+			desired:      infrav1.ManagedControlPlanePowerStateStopped,
+			reconcileErr: notDoneErr,
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(armcontainerservice.ManagedCluster{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+			},
+			expectedError: notDoneErr.Error(),
+		},
+		{
+			name:    "stops a running cluster",
+			desired: infrav1.ManagedControlPlanePowerStateStopped,
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(managedClusterWithPowerState(armcontainerservice.CodeRunning, "Succeeded"), nil)
+				m.Stop(gomockinternal.AContext(), "rg", "cluster").Return(nil)
+			},
+			expectedError: "managed cluster is stopping",
+		},
+		{
+			name:         "doesn't stop a cluster which is being updated",
+			desired:      infrav1.ManagedControlPlanePowerStateStopped,
+			reconcileErr: notDoneErr,
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(managedClusterWithPowerState(armcontainerservice.CodeRunning, "Updating"), nil)
+			},
+			expectedError: notDoneErr.Error(),
+		},
+		{
+			name:    "waits for the cluster to stop",
+			desired: infrav1.ManagedControlPlanePowerStateStopped,
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(managedClusterWithPowerState(armcontainerservice.CodeStopped, "Stopping"), nil)
+			},
+			expectedError: "managed cluster is stopping",
+		},
+		{
+			name:         "stopped cluster is a steady state",
+			desired:      infrav1.ManagedControlPlanePowerStateStopped,
+			reconcileErr: errors.New("cluster is stopped"),
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(managedClusterWithPowerState(armcontainerservice.CodeStopped, "Succeeded"), nil)
+			},
+			expectStopped: true,
+		},
+		{
+			name:         "starts a stopped cluster",
+			desired:      infrav1.ManagedControlPlanePowerStateRunning,
+			reconcileErr: errors.New("cluster is stopped"),
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(managedClusterWithPowerState(armcontainerservice.CodeStopped, "Succeeded"), nil)
+				m.Start(gomockinternal.AContext(), "rg", "cluster").Return(nil)
+			},
+			expectedError: "managed cluster is starting",
+		},
+		{
+			name:    "starts a cluster which ASO observed as stopped",
+			desired: infrav1.ManagedControlPlanePowerStateRunning,
+			managedCluster: &asocontainerservicev1.ManagedCluster{
+				Status: asocontainerservicev1.ManagedCluster_STATUS{
+					PowerState: &asocontainerservicev1.PowerState_STATUS{
+						Code: ptr.To(asocontainerservicev1.PowerState_Code_STATUS_Stopped),
+					},
+				},
+			},
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(managedClusterWithPowerState(armcontainerservice.CodeStopped, "Succeeded"), nil)
+				m.Start(gomockinternal.AContext(), "rg", "cluster").Return(nil)
+			},
+			expectedError: "managed cluster is starting",
+		},
+		{
+			name:         "running cluster returns the reconcile error",
+			desired:      infrav1.ManagedControlPlanePowerStateRunning,
+			reconcileErr: errors.New("an error"),
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(managedClusterWithPowerState(armcontainerservice.CodeRunning, "Failed"), nil)
+			},
+			expectedError: "an error",
+		},
+		{
+			name:    "failure to stop the cluster",
+			desired: infrav1.ManagedControlPlanePowerStateStopped,
+			expect: func(m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.Get(gomockinternal.AContext(), "rg", "cluster").Return(managedClusterWithPowerState(armcontainerservice.CodeRunning, "Succeeded"), nil)
+				m.Stop(gomockinternal.AContext(), "rg", "cluster").Return(errors.New("internal error"))
+			},
+			expectedError: "failed to stop managed cluster rg/cluster: internal error",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+			mcClient := mock_managedclusters.NewMockmanagedClusterClient(mockCtrl)
+
+			scope.EXPECT().DesiredPowerState().Return(tc.desired)
+			scope.EXPECT().ResourceGroup().Return("rg").AnyTimes()
+			scope.EXPECT().ManagedClusterSpec().Return(&ManagedClusterSpec{Name: "cluster"}).AnyTimes()
+			tc.expect(mcClient.EXPECT())
+
+			stopped, err := reconcilePowerState(context.Background(), scope, mcClient, tc.managedCluster, tc.reconcileErr)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedError)))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(stopped).To(Equal(tc.expectStopped))
+		})
+	}
+}
+
+func TestValidateUserDefinedRouting(t *testing.T) {
+	const subnetID = "/subscriptions/123/resourceGroups/network-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet"
+	udr := ptr.To(infrav1.ManagedControlPlaneOutboundTypeUserDefinedRouting)
+	routeTable := func(addressPrefixes ...string) *armnetwork.RouteTable {
+		rt := &armnetwork.RouteTable{
+			ID:         ptr.To("/subscriptions/123/resourceGroups/network-rg/providers/Microsoft.Network/routeTables/my-rt"),
+			Properties: &armnetwork.RouteTablePropertiesFormat{},
+		}
+		for _, prefix := range addressPrefixes {
+			rt.Properties.Routes = append(rt.Properties.Routes, &armnetwork.Route{
+				Properties: &armnetwork.RoutePropertiesFormat{AddressPrefix: ptr.To(prefix)},
+			})
+		}
+		return rt
+	}
+
+	tests := []struct {
+		name          string
+		outboundType  *infrav1.ManagedControlPlaneOutboundType
+		expect        func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, r *mock_managedclusters.MockrouteTableGetterMockRecorder)
+		expectedError string
+	}{
+		{
+			name:         "other outbound types are not validated",
+			outboundType: ptr.To(infrav1.ManagedControlPlaneOutboundTypeLoadBalancer),
+			expect: func(_ *mock_managedclusters.MockManagedClusterScopeMockRecorder, _ *mock_managedclusters.MockrouteTableGetterMockRecorder) {
+			},
+		},
+		{
+			name:         "subnet with a route table with a default route",
+			outboundType: udr,
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, r *mock_managedclusters.MockrouteTableGetterMockRecorder) {
+				s.IsVnetManaged().Return(false)
+				r.GetSubnetRouteTable(gomockinternal.AContext(), "network-rg", "my-vnet", "my-subnet").Return(routeTable("10.0.0.0/8", "0.0.0.0/0"), nil)
+			},
+		},
+		{
+			name:         "subnet managed by CAPZ",
+			outboundType: udr,
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, _ *mock_managedclusters.MockrouteTableGetterMockRecorder) {
+				s.IsVnetManaged().Return(true)
+			},
+			expectedError: "the userDefinedRouting outbound type requires a subnet which is not managed by CAPZ",
+		},
+		{
+			name:         "subnet without a route table",
+			outboundType: udr,
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, r *mock_managedclusters.MockrouteTableGetterMockRecorder) {
+				s.IsVnetManaged().Return(false)
+				r.GetSubnetRouteTable(gomockinternal.AContext(), "network-rg", "my-vnet", "my-subnet").Return(nil, nil)
+			},
+			expectedError: "subnet " + subnetID + " has no route table attached",
+		},
+		{
+			name:         "route table without a default route",
+			outboundType: udr,
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, r *mock_managedclusters.MockrouteTableGetterMockRecorder) {
+				s.IsVnetManaged().Return(false)
+				r.GetSubnetRouteTable(gomockinternal.AContext(), "network-rg", "my-vnet", "my-subnet").Return(routeTable("10.0.0.0/8"), nil)
+			},
+			expectedError: "has no default route for 0.0.0.0/0",
+		},
+		{
+			name:         "failure to get the route table",
+			outboundType: udr,
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, r *mock_managedclusters.MockrouteTableGetterMockRecorder) {
+				s.IsVnetManaged().Return(false)
+				r.GetSubnetRouteTable(gomockinternal.AContext(), "network-rg", "my-vnet", "my-subnet").Return(nil, errors.New("internal error"))
+			},
+			expectedError: "failed to get route table of subnet " + subnetID + ": internal error",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+			rtClient := mock_managedclusters.NewMockrouteTableGetter(mockCtrl)
+
+			scope.EXPECT().ManagedClusterSpec().Return(&ManagedClusterSpec{
+				Name:         "cluster",
+				OutboundType: tc.outboundType,
+				VnetSubnetID: subnetID,
+			})
+			tc.expect(scope.EXPECT(), rtClient.EXPECT())
+
+			err := validateUserDefinedRouting(context.Background(), scope, rtClient)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedError)))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestReconcileCertificateRotation(t *testing.T) {
+	rotation := metav1.NewTime(time.Date(2024, 10, 14, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name          string
+		pending       *metav1.Time
+		expect        func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, m *mock_managedclusters.MockmanagedClusterClientMockRecorder)
+		expectedError string
+	}{
+		{
+			name:    "no rotation pending",
+			pending: nil,
+			expect: func(_ *mock_managedclusters.MockManagedClusterScopeMockRecorder, _ *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+			},
+		},
+		{
+			name:    "rotates the certificates",
+			pending: &rotation,
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.RotateClusterCertificates(gomockinternal.AContext(), "rg", "cluster").Return(nil)
+				s.SetLastCertificateRotation(rotation)
+			},
+			expectedError: "managed cluster certificates are rotating",
+		},
+		{
+			name:    "failure to rotate the certificates",
+			pending: &rotation,
+			expect: func(_ *mock_managedclusters.MockManagedClusterScopeMockRecorder, m *mock_managedclusters.MockmanagedClusterClientMockRecorder) {
+				m.RotateClusterCertificates(gomockinternal.AContext(), "rg", "cluster").Return(errors.New("internal error"))
+			},
+			expectedError: "failed to rotate certificates of managed cluster rg/cluster: internal error",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+			mcClient := mock_managedclusters.NewMockmanagedClusterClient(mockCtrl)
+
+			scope.EXPECT().PendingCertificateRotation().Return(tc.pending)
+			scope.EXPECT().ResourceGroup().Return("rg").AnyTimes()
+			scope.EXPECT().ManagedClusterSpec().Return(&ManagedClusterSpec{Name: "cluster"}).AnyTimes()
+			tc.expect(scope.EXPECT(), mcClient.EXPECT())
+
+			err := reconcileCertificateRotation(context.Background(), scope, mcClient)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedError)))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func managedClusterWithPowerState(code armcontainerservice.Code, provisioningState string) armcontainerservice.ManagedCluster {
+	return armcontainerservice.ManagedCluster{
+		Properties: &armcontainerservice.ManagedClusterProperties{
+			PowerState:        &armcontainerservice.PowerState{Code: ptr.To(code)},
+			ProvisioningState: ptr.To(provisioningState),
+		},
+	}
+}
@@ -19,8 +19,9 @@ limitations under the License.
 //
 // Generated by this command:
 //
-//	mockgen -destination client_mock.go -package mock_managedclusters -source ../client.go CredentialGetter
+//	mockgen -destination client_mock.go -package mock_managedclusters -source ../client.go managedClusterClient
 //
+
 // Package mock_managedclusters is a generated GoMock package.
 package mock_managedclusters
 
@@ -28,58 +29,125 @@ import (
 	context "context"
 	reflect "reflect"
 
+	armcontainerservice "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	gomock "go.uber.org/mock/gomock"
 )
 
-// MockCredentialGetter is a mock of CredentialGetter interface.
-type MockCredentialGetter struct {
+// MockmanagedClusterClient is a mock of managedClusterClient interface.
+type MockmanagedClusterClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockCredentialGetterMockRecorder
+	recorder *MockmanagedClusterClientMockRecorder
 }
 
-// MockCredentialGetterMockRecorder is the mock recorder for MockCredentialGetter.
-type MockCredentialGetterMockRecorder struct {
-	mock *MockCredentialGetter
+// MockmanagedClusterClientMockRecorder is the mock recorder for MockmanagedClusterClient.
+type MockmanagedClusterClientMockRecorder struct {
+	mock *MockmanagedClusterClient
 }
 
-// NewMockCredentialGetter creates a new mock instance.
-func NewMockCredentialGetter(ctrl *gomock.Controller) *MockCredentialGetter {
-	mock := &MockCredentialGetter{ctrl: ctrl}
-	mock.recorder = &MockCredentialGetterMockRecorder{mock}
+// NewMockmanagedClusterClient creates a new mock instance.
+func NewMockmanagedClusterClient(ctrl *gomock.Controller) *MockmanagedClusterClient {
+	mock := &MockmanagedClusterClient{ctrl: ctrl}
+	mock.recorder = &MockmanagedClusterClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCredentialGetter) EXPECT() *MockCredentialGetterMockRecorder {
+func (m *MockmanagedClusterClient) EXPECT() *MockmanagedClusterClientMockRecorder {
 	return m.recorder
 }
 
-// GetCredentials mocks base method.
-func (m *MockCredentialGetter) GetCredentials(arg0 context.Context, arg1, arg2 string) ([]byte, error) {
+// Get mocks base method.
+func (m *MockmanagedClusterClient) Get(ctx context.Context, resourceGroupName, name string) (armcontainerservice.ManagedCluster, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCredentials", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].(armcontainerservice.ManagedCluster)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCredentials indicates an expected call of GetCredentials.
-func (mr *MockCredentialGetterMockRecorder) GetCredentials(arg0, arg1, arg2 any) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockmanagedClusterClientMockRecorder) Get(ctx, resourceGroupName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockmanagedClusterClient)(nil).Get), ctx, resourceGroupName, name)
+}
+
+// RotateClusterCertificates mocks base method.
+func (m *MockmanagedClusterClient) RotateClusterCertificates(ctx context.Context, resourceGroupName, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateClusterCertificates", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateClusterCertificates indicates an expected call of RotateClusterCertificates.
+func (mr *MockmanagedClusterClientMockRecorder) RotateClusterCertificates(ctx, resourceGroupName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateClusterCertificates", reflect.TypeOf((*MockmanagedClusterClient)(nil).RotateClusterCertificates), ctx, resourceGroupName, name)
+}
+
+// Start mocks base method.
+func (m *MockmanagedClusterClient) Start(ctx context.Context, resourceGroupName, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockmanagedClusterClientMockRecorder) Start(ctx, resourceGroupName, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockCredentialGetter)(nil).GetCredentials), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockmanagedClusterClient)(nil).Start), ctx, resourceGroupName, name)
+}
+
+// Stop mocks base method.
+func (m *MockmanagedClusterClient) Stop(ctx context.Context, resourceGroupName, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockmanagedClusterClientMockRecorder) Stop(ctx, resourceGroupName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockmanagedClusterClient)(nil).Stop), ctx, resourceGroupName, name)
+}
+
+// MockrouteTableGetter is a mock of routeTableGetter interface.
+type MockrouteTableGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockrouteTableGetterMockRecorder
+}
+
+// MockrouteTableGetterMockRecorder is the mock recorder for MockrouteTableGetter.
+type MockrouteTableGetterMockRecorder struct {
+	mock *MockrouteTableGetter
+}
+
+// NewMockrouteTableGetter creates a new mock instance.
+func NewMockrouteTableGetter(ctrl *gomock.Controller) *MockrouteTableGetter {
+	mock := &MockrouteTableGetter{ctrl: ctrl}
+	mock.recorder = &MockrouteTableGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrouteTableGetter) EXPECT() *MockrouteTableGetterMockRecorder {
+	return m.recorder
 }
 
-// GetUserCredentials mocks base method.
-func (m *MockCredentialGetter) GetUserCredentials(arg0 context.Context, arg1, arg2 string) ([]byte, error) {
+// GetSubnetRouteTable mocks base method.
+func (m *MockrouteTableGetter) GetSubnetRouteTable(ctx context.Context, resourceGroupName, vnetName, subnetName string) (*armnetwork.RouteTable, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserCredentials", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "GetSubnetRouteTable", ctx, resourceGroupName, vnetName, subnetName)
+	ret0, _ := ret[0].(*armnetwork.RouteTable)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserCredentials indicates an expected call of GetUserCredentials.
-func (mr *MockCredentialGetterMockRecorder) GetUserCredentials(arg0, arg1, arg2 any) *gomock.Call {
+// GetSubnetRouteTable indicates an expected call of GetSubnetRouteTable.
+func (mr *MockrouteTableGetterMockRecorder) GetSubnetRouteTable(ctx, resourceGroupName, vnetName, subnetName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCredentials", reflect.TypeOf((*MockCredentialGetter)(nil).GetUserCredentials), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetRouteTable", reflect.TypeOf((*MockrouteTableGetter)(nil).GetSubnetRouteTable), ctx, resourceGroupName, vnetName, subnetName)
 }
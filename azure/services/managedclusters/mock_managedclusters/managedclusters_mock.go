@@ -34,6 +34,7 @@ import (
 	v1api20231001 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	gomock "go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
+	v10 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	azure "sigs.k8s.io/cluster-api-provider-azure/azure"
 	v1beta10 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -91,6 +92,34 @@ func (mr *MockManagedClusterScopeMockRecorder) AreLocalAccountsDisabled() *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AreLocalAccountsDisabled", reflect.TypeOf((*MockManagedClusterScope)(nil).AreLocalAccountsDisabled))
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockManagedClusterScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockManagedClusterScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockManagedClusterScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockManagedClusterScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockManagedClusterScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockManagedClusterScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
 // BaseURI mocks base method.
 func (m *MockManagedClusterScope) BaseURI() string {
 	m.ctrl.T.Helper()
@@ -175,20 +204,6 @@ func (mr *MockManagedClusterScopeMockRecorder) DefaultedAzureCallTimeout() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockManagedClusterScope)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockManagedClusterScope) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockManagedClusterScopeMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockManagedClusterScope)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockManagedClusterScope) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -215,6 +230,20 @@ func (mr *MockManagedClusterScopeMockRecorder) DeleteLongRunningOperationState(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLongRunningOperationState", reflect.TypeOf((*MockManagedClusterScope)(nil).DeleteLongRunningOperationState), arg0, arg1, arg2)
 }
 
+// DesiredPowerState mocks base method.
+func (m *MockManagedClusterScope) DesiredPowerState() v1beta1.ManagedControlPlanePowerState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DesiredPowerState")
+	ret0, _ := ret[0].(v1beta1.ManagedControlPlanePowerState)
+	return ret0
+}
+
+// DesiredPowerState indicates an expected call of DesiredPowerState.
+func (mr *MockManagedClusterScopeMockRecorder) DesiredPowerState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DesiredPowerState", reflect.TypeOf((*MockManagedClusterScope)(nil).DesiredPowerState))
+}
+
 // GetAdminKubeconfigData mocks base method.
 func (m *MockManagedClusterScope) GetAdminKubeconfigData() []byte {
 	m.ctrl.T.Helper()
@@ -299,6 +328,20 @@ func (mr *MockManagedClusterScopeMockRecorder) IsAADEnabled() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAADEnabled", reflect.TypeOf((*MockManagedClusterScope)(nil).IsAADEnabled))
 }
 
+// IsVnetManaged mocks base method.
+func (m *MockManagedClusterScope) IsVnetManaged() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVnetManaged")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsVnetManaged indicates an expected call of IsVnetManaged.
+func (mr *MockManagedClusterScopeMockRecorder) IsVnetManaged() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVnetManaged", reflect.TypeOf((*MockManagedClusterScope)(nil).IsVnetManaged))
+}
+
 // MakeClusterCA mocks base method.
 func (m *MockManagedClusterScope) MakeClusterCA() *v1.Secret {
 	m.ctrl.T.Helper()
@@ -341,6 +384,46 @@ func (mr *MockManagedClusterScopeMockRecorder) ManagedClusterSpec() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedClusterSpec", reflect.TypeOf((*MockManagedClusterScope)(nil).ManagedClusterSpec))
 }
 
+// PendingCertificateRotation mocks base method.
+func (m *MockManagedClusterScope) PendingCertificateRotation() *v10.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingCertificateRotation")
+	ret0, _ := ret[0].(*v10.Time)
+	return ret0
+}
+
+// PendingCertificateRotation indicates an expected call of PendingCertificateRotation.
+func (mr *MockManagedClusterScopeMockRecorder) PendingCertificateRotation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCertificateRotation", reflect.TypeOf((*MockManagedClusterScope)(nil).PendingCertificateRotation))
+}
+
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockManagedClusterScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockManagedClusterScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockManagedClusterScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
+}
+
+// ResourceGroup mocks base method.
+func (m *MockManagedClusterScope) ResourceGroup() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceGroup")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ResourceGroup indicates an expected call of ResourceGroup.
+func (mr *MockManagedClusterScopeMockRecorder) ResourceGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceGroup", reflect.TypeOf((*MockManagedClusterScope)(nil).ResourceGroup))
+}
+
 // SetAdminKubeconfigData mocks base method.
 func (m *MockManagedClusterScope) SetAdminKubeconfigData(arg0 []byte) {
 	m.ctrl.T.Helper()
@@ -365,6 +448,42 @@ func (mr *MockManagedClusterScopeMockRecorder) SetControlPlaneEndpoint(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetControlPlaneEndpoint", reflect.TypeOf((*MockManagedClusterScope)(nil).SetControlPlaneEndpoint), arg0)
 }
 
+// SetEffectiveOutboundIPs mocks base method.
+func (m *MockManagedClusterScope) SetEffectiveOutboundIPs(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetEffectiveOutboundIPs", arg0)
+}
+
+// SetEffectiveOutboundIPs indicates an expected call of SetEffectiveOutboundIPs.
+func (mr *MockManagedClusterScopeMockRecorder) SetEffectiveOutboundIPs(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEffectiveOutboundIPs", reflect.TypeOf((*MockManagedClusterScope)(nil).SetEffectiveOutboundIPs), arg0)
+}
+
+// SetKubeletIdentityObjectID mocks base method.
+func (m *MockManagedClusterScope) SetKubeletIdentityObjectID(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetKubeletIdentityObjectID", arg0)
+}
+
+// SetKubeletIdentityObjectID indicates an expected call of SetKubeletIdentityObjectID.
+func (mr *MockManagedClusterScopeMockRecorder) SetKubeletIdentityObjectID(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKubeletIdentityObjectID", reflect.TypeOf((*MockManagedClusterScope)(nil).SetKubeletIdentityObjectID), arg0)
+}
+
+// SetLastCertificateRotation mocks base method.
+func (m *MockManagedClusterScope) SetLastCertificateRotation(arg0 v10.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLastCertificateRotation", arg0)
+}
+
+// SetLastCertificateRotation indicates an expected call of SetLastCertificateRotation.
+func (mr *MockManagedClusterScopeMockRecorder) SetLastCertificateRotation(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastCertificateRotation", reflect.TypeOf((*MockManagedClusterScope)(nil).SetLastCertificateRotation), arg0)
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockManagedClusterScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
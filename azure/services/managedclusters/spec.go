@@ -21,10 +21,13 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
 	"github.com/pkg/errors"
+	"golang.org/x/mod/semver"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -80,15 +83,26 @@ type ManagedClusterSpec struct {
 	// SSHPublicKey is a string literal containing an ssh public key. Will autogenerate and discard if not provided.
 	SSHPublicKey string
 
+	// AdminUsername is the administrator username of the Linux nodes. Defaults to azure.DefaultAKSUserName.
+	AdminUsername string
+
 	// GetAllAgentPools is a function that returns the list of agent pool specifications in this cluster.
 	GetAllAgentPools func() ([]azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedClustersAgentPool], error)
 
 	// PodCIDR is the CIDR block for IP addresses distributed to pods
 	PodCIDR string
 
+	// PodCIDRs are the CIDR blocks, one IPv4 and one IPv6, for IP addresses distributed to pods of a dual-stack cluster.
+	// PodCIDR is then the IPv4 CIDR block.
+	PodCIDRs []string
+
 	// ServiceCIDR is the CIDR block for IP addresses distributed to services
 	ServiceCIDR string
 
+	// ServiceCIDRs are the CIDR blocks, one IPv4 and one IPv6, for IP addresses distributed to services of a dual-stack
+	// cluster. ServiceCIDR is then the IPv4 CIDR block.
+	ServiceCIDRs []string
+
 	// DNSServiceIP is an IP address assigned to the Kubernetes DNS service
 	DNSServiceIP *string
 
@@ -127,6 +141,9 @@ type ManagedClusterSpec struct {
 
 	// DisableLocalAccounts disables getting static credentials for this cluster when set. Expected to only be used for AAD clusters.
 	DisableLocalAccounts *bool
+
+	// AzureMonitorMetricsEnabled enables the Azure Monitor managed service for Prometheus when set.
+	AzureMonitorMetricsEnabled *bool
 }
 
 // HTTPProxyConfig is the HTTP proxy configuration for the cluster.
@@ -295,6 +312,12 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existing *asocontai
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.Service.Parameters")
 	defer done()
 
+	if existing != nil {
+		if err := validateUpgradePath(currentKubernetesVersion(existing), s.Version); err != nil {
+			return nil, azure.WithTerminalError(err)
+		}
+	}
+
 	managedCluster := existing
 	if managedCluster == nil {
 		managedCluster = &asocontainerservicev1.ManagedCluster{
@@ -340,8 +363,13 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existing *asocontai
 		}
 	}
 	if decodedSSHPublicKey != nil {
+		adminUsername := azure.DefaultAKSUserName
+		if s.AdminUsername != "" {
+			adminUsername = s.AdminUsername
+		}
+		// A changed key is rotated on the existing cluster by updating its Linux profile.
 		managedCluster.Spec.LinuxProfile = &asocontainerservicev1.ContainerServiceLinuxProfile{
-			AdminUsername: ptr.To(azure.DefaultAKSUserName),
+			AdminUsername: ptr.To(adminUsername),
 			Ssh: &asocontainerservicev1.ContainerServiceSshConfiguration{
 				PublicKeys: []asocontainerservicev1.ContainerServiceSshPublicKey{
 					{
@@ -378,6 +406,15 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existing *asocontai
 		}
 	}
 
+	if len(s.PodCIDRs) > 0 || len(s.ServiceCIDRs) > 0 {
+		managedCluster.Spec.NetworkProfile.PodCidrs = s.PodCIDRs
+		managedCluster.Spec.NetworkProfile.ServiceCidrs = s.ServiceCIDRs
+		managedCluster.Spec.NetworkProfile.IpFamilies = []asocontainerservicev1.ContainerServiceNetworkProfile_IpFamilies{
+			asocontainerservicev1.ContainerServiceNetworkProfile_IpFamilies_IPv4,
+			asocontainerservicev1.ContainerServiceNetworkProfile_IpFamilies_IPv6,
+		}
+	}
+
 	// OperatorSpec defines how the Secrets generated by ASO should look for the AKS cluster kubeconfigs.
 	// There is no prescribed naming convention that must be followed.
 	managedCluster.Spec.OperatorSpec = &asocontainerservicev1.ManagedClusterOperatorSpec{
@@ -487,6 +524,14 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existing *asocontai
 		}
 	}
 
+	if s.AzureMonitorMetricsEnabled != nil {
+		managedCluster.Spec.AzureMonitorProfile = &asocontainerservicev1.ManagedClusterAzureMonitorProfile{
+			Metrics: &asocontainerservicev1.ManagedClusterAzureMonitorProfileMetrics{
+				Enabled: s.AzureMonitorMetricsEnabled,
+			},
+		}
+	}
+
 	// Only include AgentPoolProfiles during initial cluster creation. Agent pools are managed solely by the
 	// AzureManagedMachinePool controller thereafter.
 	managedCluster.Spec.AgentPoolProfiles = nil
@@ -512,6 +557,61 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existing *asocontai
 	return managedCluster, nil
 }
 
+// currentKubernetesVersion returns the Kubernetes version the existing managed cluster runs, or an empty string if
+// it isn't known yet.
+func currentKubernetesVersion(existing *asocontainerservicev1.ManagedCluster) string {
+	if existing.Status.CurrentKubernetesVersion != nil {
+		return *existing.Status.CurrentKubernetesVersion
+	}
+	return ptr.Deref(existing.Status.KubernetesVersion, "")
+}
+
+// validateUpgradePath returns an error if AKS can't move a managed cluster from the current to the desired Kubernetes
+// version, i.e. if the desired version is a downgrade or skips a minor version. Versions which can't be parsed are
+// left for AKS to validate.
+func validateUpgradePath(current, desired string) error {
+	currentVersion, desiredVersion := "v"+strings.TrimPrefix(current, "v"), "v"+strings.TrimPrefix(desired, "v")
+	if current == "" || !semver.IsValid(currentVersion) || !semver.IsValid(desiredVersion) {
+		return nil
+	}
+
+	currentMajor, currentMinor, err := majorMinor(currentVersion)
+	if err != nil {
+		return err
+	}
+	desiredMajor, desiredMinor, err := majorMinor(desiredVersion)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case desiredMajor != currentMajor:
+		return errors.Errorf("cannot change the Kubernetes version of the managed cluster from %s to %s: changing the major version is not supported", current, desired)
+	case desiredMinor < currentMinor,
+		// A desired version without a patch, e.g. 1.28, is satisfied by any patch of that minor version.
+		desiredMinor == currentMinor && strings.Count(desiredVersion, ".") == 2 && semver.Compare(desiredVersion, currentVersion) < 0:
+		return errors.Errorf("cannot downgrade the Kubernetes version of the managed cluster from %s to %s", current, desired)
+	case desiredMinor > currentMinor+1:
+		return errors.Errorf("cannot upgrade the Kubernetes version of the managed cluster from %s to %s: AKS only supports upgrading one minor version at a time, upgrade to %d.%d first", current, desired, currentMajor, currentMinor+1)
+	}
+	return nil
+}
+
+// majorMinor returns the major and minor version of the given semantic version.
+func majorMinor(version string) (major, minor int, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(semver.MajorMinor(version), "v"), ".", 2)
+	if len(parts) != 2 {
+		return 0, 0, errors.Errorf("invalid version %s", version)
+	}
+	if major, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid major version of %s", version)
+	}
+	if minor, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid minor version of %s", version)
+	}
+	return major, minor, nil
+}
+
 // GetLoadBalancerProfile returns an asocontainerservicev1.ManagedClusterLoadBalancerProfile from the
 // information present in ManagedClusterSpec.LoadBalancerProfile.
 func (s *ManagedClusterSpec) GetLoadBalancerProfile() (loadBalancerProfile *asocontainerservicev1.ManagedClusterLoadBalancerProfile) {
@@ -19,6 +19,7 @@ package managedclusters
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"testing"
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
@@ -98,8 +99,9 @@ func TestParameters(t *testing.T) {
 			OIDCIssuerProfile: &OIDCIssuerProfile{
 				Enabled: ptr.To(true),
 			},
-			DNSPrefix:            ptr.To("dns prefix"),
-			DisableLocalAccounts: ptr.To(true),
+			DNSPrefix:                  ptr.To("dns prefix"),
+			DisableLocalAccounts:       ptr.To(true),
+			AzureMonitorMetricsEnabled: ptr.To(true),
 		}
 
 		expected := &asocontainerservicev1.ManagedCluster{
@@ -130,6 +132,11 @@ func TestParameters(t *testing.T) {
 				AutoScalerProfile: &asocontainerservicev1.ManagedClusterProperties_AutoScalerProfile{
 					Expander: ptr.To(asocontainerservicev1.ManagedClusterProperties_AutoScalerProfile_Expander("expander")),
 				},
+				AzureMonitorProfile: &asocontainerservicev1.ManagedClusterAzureMonitorProfile{
+					Metrics: &asocontainerservicev1.ManagedClusterAzureMonitorProfileMetrics{
+						Enabled: ptr.To(true),
+					},
+				},
 				AzureName:            "name",
 				DisableLocalAccounts: ptr.To(true),
 				DnsPrefix:            ptr.To("dns prefix"),
@@ -261,4 +268,232 @@ func TestParameters(t *testing.T) {
 		g.Expect(actual.Spec.DnsPrefix).To(Equal(ptr.To("managed by CAPZ")))
 		g.Expect(actual.Spec.EnablePodSecurityPolicy).To(Equal(ptr.To(true)))
 	})
+
+	t.Run("with a custom admin username", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			SSHPublicKey:  base64.StdEncoding.EncodeToString([]byte("ssh")),
+			AdminUsername: "capzadmin",
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedClustersAgentPool], error) {
+				return nil, nil
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(actual.Spec.LinuxProfile).To(Equal(&asocontainerservicev1.ContainerServiceLinuxProfile{
+			AdminUsername: ptr.To("capzadmin"),
+			Ssh: &asocontainerservicev1.ContainerServiceSshConfiguration{
+				PublicKeys: []asocontainerservicev1.ContainerServiceSshPublicKey{
+					{
+						KeyData: ptr.To("ssh"),
+					},
+				},
+			},
+		}))
+	})
+
+	t.Run("with a dual-stack kubenet network", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			SSHPublicKey:  base64.StdEncoding.EncodeToString([]byte("ssh")),
+			NetworkPlugin: "kubenet",
+			PodCIDR:       "10.244.0.0/16",
+			PodCIDRs:      []string{"10.244.0.0/16", "fd12:3456:789a::/64"},
+			ServiceCIDR:   "10.0.0.0/16",
+			ServiceCIDRs:  []string{"10.0.0.0/16", "fd12:3456:789a:1::/108"},
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[*asocontainerservicev1.ManagedClustersAgentPool], error) {
+				return nil, nil
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(actual.Spec.NetworkProfile.NetworkPlugin).To(Equal(ptr.To(asocontainerservicev1.NetworkPlugin("kubenet"))))
+		g.Expect(actual.Spec.NetworkProfile.PodCidr).To(Equal(ptr.To("10.244.0.0/16")))
+		g.Expect(actual.Spec.NetworkProfile.PodCidrs).To(Equal([]string{"10.244.0.0/16", "fd12:3456:789a::/64"}))
+		g.Expect(actual.Spec.NetworkProfile.ServiceCidr).To(Equal(ptr.To("10.0.0.0/16")))
+		g.Expect(actual.Spec.NetworkProfile.ServiceCidrs).To(Equal([]string{"10.0.0.0/16", "fd12:3456:789a:1::/108"}))
+		g.Expect(actual.Spec.NetworkProfile.DnsServiceIP).To(Equal(ptr.To("10.0.0.10")))
+		g.Expect(actual.Spec.NetworkProfile.IpFamilies).To(Equal([]asocontainerservicev1.ContainerServiceNetworkProfile_IpFamilies{
+			asocontainerservicev1.ContainerServiceNetworkProfile_IpFamilies_IPv4,
+			asocontainerservicev1.ContainerServiceNetworkProfile_IpFamilies_IPv6,
+		}))
+	})
+
+	t.Run("with a rotated SSH public key", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			SSHPublicKey: base64.StdEncoding.EncodeToString([]byte("new ssh")),
+		}
+		existing := &asocontainerservicev1.ManagedCluster{
+			Spec: asocontainerservicev1.ManagedCluster_Spec{
+				LinuxProfile: &asocontainerservicev1.ContainerServiceLinuxProfile{
+					AdminUsername: ptr.To(azure.DefaultAKSUserName),
+					Ssh: &asocontainerservicev1.ContainerServiceSshConfiguration{
+						PublicKeys: []asocontainerservicev1.ContainerServiceSshPublicKey{
+							{
+								KeyData: ptr.To("old ssh"),
+							},
+						},
+					},
+				},
+			},
+			Status: asocontainerservicev1.ManagedCluster_STATUS{
+				AgentPoolProfiles: []asocontainerservicev1.ManagedClusterAgentPoolProfile_STATUS{},
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), existing)
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(actual.Spec.LinuxProfile.AdminUsername).To(Equal(ptr.To(azure.DefaultAKSUserName)))
+		g.Expect(actual.Spec.LinuxProfile.Ssh.PublicKeys).To(Equal([]asocontainerservicev1.ContainerServiceSshPublicKey{
+			{
+				KeyData: ptr.To("new ssh"),
+			},
+		}))
+	})
+
+	t.Run("with an updated HTTP proxy config", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			HTTPProxyConfig: &HTTPProxyConfig{
+				HTTPProxy:  ptr.To("http://10.20.3.4:8080"),
+				HTTPSProxy: ptr.To("https://10.20.3.4:8443"),
+				NoProxy:    []string{"localhost", "10.0.0.0/8"},
+				TrustedCA:  ptr.To("new-ca"),
+			},
+		}
+		existing := &asocontainerservicev1.ManagedCluster{
+			Spec: asocontainerservicev1.ManagedCluster_Spec{
+				HttpProxyConfig: &asocontainerservicev1.ManagedClusterHTTPProxyConfig{
+					HttpProxy:  ptr.To("http://1.2.3.4:8080"),
+					HttpsProxy: ptr.To("https://1.2.3.4:8443"),
+					NoProxy:    []string{"localhost"},
+					TrustedCa:  ptr.To("old-ca"),
+				},
+			},
+			Status: asocontainerservicev1.ManagedCluster_STATUS{
+				AgentPoolProfiles: []asocontainerservicev1.ManagedClusterAgentPoolProfile_STATUS{},
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), existing)
+
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(actual.Spec.HttpProxyConfig).To(Equal(&asocontainerservicev1.ManagedClusterHTTPProxyConfig{
+			HttpProxy:  ptr.To("http://10.20.3.4:8080"),
+			HttpsProxy: ptr.To("https://10.20.3.4:8443"),
+			NoProxy:    []string{"localhost", "10.0.0.0/8"},
+			TrustedCa:  ptr.To("new-ca"),
+		}))
+	})
+
+	t.Run("with an invalid upgrade of the existing managed cluster", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Version: "1.29.2",
+		}
+		existing := &asocontainerservicev1.ManagedCluster{
+			Status: asocontainerservicev1.ManagedCluster_STATUS{
+				CurrentKubernetesVersion: ptr.To("1.27.9"),
+			},
+		}
+
+		_, err := spec.Parameters(context.Background(), existing)
+
+		g.Expect(err).To(MatchError(ContainSubstring("upgrade to 1.28 first")))
+		var recerr azure.ReconcileError
+		g.Expect(errors.As(err, &recerr)).To(BeTrue())
+		g.Expect(recerr.IsTerminal()).To(BeTrue())
+	})
+}
+
+func TestValidateUpgradePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		desired string
+		wantErr string
+	}{
+		{
+			name:    "unknown current version",
+			current: "",
+			desired: "1.29.2",
+		},
+		{
+			name:    "same version",
+			current: "1.28.5",
+			desired: "1.28.5",
+		},
+		{
+			name:    "patch upgrade",
+			current: "1.28.3",
+			desired: "1.28.5",
+		},
+		{
+			name:    "one minor upgrade",
+			current: "1.28.5",
+			desired: "1.29.2",
+		},
+		{
+			name:    "one minor upgrade with a v prefix",
+			current: "1.28.5",
+			desired: "v1.29.2",
+		},
+		{
+			name:    "minor version without a patch",
+			current: "1.28.5",
+			desired: "1.28",
+		},
+		{
+			name:    "skip a minor version",
+			current: "1.27.9",
+			desired: "1.29.2",
+			wantErr: "cannot upgrade the Kubernetes version of the managed cluster from 1.27.9 to 1.29.2: AKS only supports upgrading one minor version at a time, upgrade to 1.28 first",
+		},
+		{
+			name:    "minor downgrade",
+			current: "1.29.2",
+			desired: "1.28.5",
+			wantErr: "cannot downgrade the Kubernetes version of the managed cluster from 1.29.2 to 1.28.5",
+		},
+		{
+			name:    "patch downgrade",
+			current: "1.28.5",
+			desired: "1.28.3",
+			wantErr: "cannot downgrade the Kubernetes version of the managed cluster from 1.28.5 to 1.28.3",
+		},
+		{
+			name:    "major version change",
+			current: "1.29.2",
+			desired: "2.0.0",
+			wantErr: "cannot change the Kubernetes version of the managed cluster from 1.29.2 to 2.0.0: changing the major version is not supported",
+		},
+		{
+			name:    "unparsable desired version",
+			current: "1.28.5",
+			desired: "latest",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateUpgradePath(tc.current, tc.desired)
+			if tc.wantErr != "" {
+				g.Expect(err).To(MatchError(tc.wantErr))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
 }
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitorworkspaces
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// azureClient contains the Azure go-sdk Client.
+type azureClient struct {
+	workspaces *armmonitor.AzureMonitorWorkspacesClient
+}
+
+// newClient creates a new Azure Monitor workspaces client from an authorizer.
+func newClient(auth azure.Authorizer) (*azureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create monitorworkspaces client options")
+	}
+	factory, err := armmonitor.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armmonitor client factory")
+	}
+	return &azureClient{factory.NewAzureMonitorWorkspacesClient()}, nil
+}
+
+// Get gets the specified Azure Monitor workspace.
+func (ac *azureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "monitorworkspaces.azureClient.Get")
+	defer done()
+
+	resp, err := ac.workspaces.Get(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.AzureMonitorWorkspaceResource, nil
+}
+
+// CreateOrUpdateAsync creates or updates an Azure Monitor workspace.
+// Creating an Azure Monitor workspace is not a long running operation, so we don't ever return a poller.
+func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armmonitor.AzureMonitorWorkspacesClientCreateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "monitorworkspaces.azureClient.CreateOrUpdateAsync")
+	defer done()
+
+	workspace, ok := parameters.(armmonitor.AzureMonitorWorkspaceResource)
+	if !ok {
+		return nil, nil, errors.Errorf("%T is not an armmonitor.AzureMonitorWorkspaceResource", parameters)
+	}
+	resp, err := ac.workspaces.Create(ctx, spec.ResourceGroupName(), spec.ResourceName(), workspace, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.AzureMonitorWorkspaceResource, nil, nil
+}
+
+// DeleteAsync deletes an Azure Monitor workspace.
+// Deleting an Azure Monitor workspace is not a long running operation in this API version, so we don't ever return a poller.
+func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armmonitor.AzureMonitorWorkspacesClientDeleteResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "monitorworkspaces.azureClient.DeleteAsync")
+	defer done()
+
+	_, err = ac.workspaces.Delete(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	return nil, err
+}
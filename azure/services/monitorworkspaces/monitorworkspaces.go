@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitorworkspaces
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// ServiceName is the name of this service.
+const ServiceName = "monitorworkspaces"
+
+// MonitorWorkspaceScope defines the scope interface for an Azure Monitor workspaces service.
+type MonitorWorkspaceScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	MonitorWorkspaceSpec() azure.ResourceSpecGetter
+	AzureMonitorMetricsEnabled() bool
+	AzureMonitorResource() conditions.Setter
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	Scope MonitorWorkspaceScope
+	async.Reconciler
+}
+
+// New creates a new service.
+func New(scope MonitorWorkspaceScope) (*Service, error) {
+	client, err := newClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope: scope,
+		Reconciler: async.New[armmonitor.AzureMonitorWorkspacesClientCreateResponse,
+			armmonitor.AzureMonitorWorkspacesClientDeleteResponse](scope, client, client),
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return ServiceName
+}
+
+// Reconcile idempotently creates the Azure Monitor workspace which stores the Prometheus metrics of the cluster, or
+// deletes it once their collection is disabled.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "monitorworkspaces.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	spec := s.Scope.MonitorWorkspaceSpec()
+	if spec == nil {
+		return nil
+	}
+	if !s.Scope.AzureMonitorMetricsEnabled() {
+		return s.deleteDisabled(ctx, spec)
+	}
+
+	_, err := s.CreateOrUpdateResource(ctx, spec, ServiceName)
+	s.Scope.UpdatePutStatus(infrav1.AzureMonitorWorkspaceReadyCondition, ServiceName, err)
+	return err
+}
+
+// Delete deletes the Azure Monitor workspace.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "monitorworkspaces.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	spec := s.Scope.MonitorWorkspaceSpec()
+	if spec == nil || !s.created() {
+		return nil
+	}
+
+	err := s.DeleteResource(ctx, spec, ServiceName)
+	s.Scope.UpdateDeleteStatus(infrav1.AzureMonitorWorkspaceReadyCondition, ServiceName, err)
+	return err
+}
+
+// deleteDisabled deletes the Azure Monitor workspace once the data collection rule which sends metrics to it is
+// deleted, and removes its ready condition.
+func (s *Service) deleteDisabled(ctx context.Context, spec azure.ResourceSpecGetter) error {
+	if !s.created() || conditions.Has(s.Scope.AzureMonitorResource(), infrav1.DataCollectionRulesReadyCondition) {
+		return nil
+	}
+
+	if err := s.DeleteResource(ctx, spec, ServiceName); err != nil {
+		s.Scope.UpdateDeleteStatus(infrav1.AzureMonitorWorkspaceReadyCondition, ServiceName, err)
+		return err
+	}
+	conditions.Delete(s.Scope.AzureMonitorResource(), infrav1.AzureMonitorWorkspaceReadyCondition)
+	return nil
+}
+
+// created returns true if the Azure Monitor workspace may exist, i.e. if CAPZ reconciled it while the collection of
+// the metrics was enabled and didn't delete it since.
+func (s *Service) created() bool {
+	return s.Scope.AzureMonitorMetricsEnabled() || conditions.Has(s.Scope.AzureMonitorResource(), infrav1.AzureMonitorWorkspaceReadyCondition)
+}
+
+// IsManaged returns always returns true as CAPZ does not support BYO Azure Monitor workspaces.
+func (s *Service) IsManaged(ctx context.Context) (bool, error) {
+	return true, nil
+}
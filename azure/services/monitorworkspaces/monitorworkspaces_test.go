@@ -0,0 +1,251 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitorworkspaces
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/monitorworkspaces/mock_monitorworkspaces"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+var (
+	fakeWorkspace = WorkspaceSpec{
+		Name:          "test-workspace",
+		ResourceGroup: "test-rg",
+		Location:      "eastus",
+		ClusterName:   "test-cluster",
+	}
+	errFake = errors.New("this is an error")
+)
+
+// conditionTypes returns the types of the conditions of the AzureManagedControlPlane.
+func conditionTypes(controlPlane *infrav1.AzureManagedControlPlane) []clusterv1.ConditionType {
+	var types []clusterv1.ConditionType
+	for _, condition := range controlPlane.GetConditions() {
+		types = append(types, condition.Type)
+	}
+	return types
+}
+
+func TestReconcileMonitorWorkspace(t *testing.T) {
+	testcases := []struct {
+		name               string
+		disabled           bool
+		conditions         []clusterv1.ConditionType
+		expectedConditions []clusterv1.ConditionType
+		expectedError      string
+		expect             func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no workspace is specified",
+			expectedError: "",
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(nil)
+			},
+		},
+		{
+			name:          "create workspace succeeds",
+			expectedError: "",
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeWorkspace, ServiceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.AzureMonitorWorkspaceReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "create workspace fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeWorkspace, ServiceName).Return(nil, errFake)
+				s.UpdatePutStatus(infrav1.AzureMonitorWorkspaceReadyCondition, ServiceName, errFake)
+			},
+		},
+		{
+			name:     "noop if metrics were never enabled",
+			disabled: true,
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+			},
+		},
+		{
+			name:               "workspace is kept until the data collection rule is deleted once metrics are disabled",
+			disabled:           true,
+			conditions:         []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition, infrav1.DataCollectionRulesReadyCondition},
+			expectedConditions: []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition, infrav1.DataCollectionRulesReadyCondition},
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+			},
+		},
+		{
+			name:       "workspace is deleted and its condition removed once metrics are disabled",
+			disabled:   true,
+			conditions: []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition},
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+				r.DeleteResource(gomockinternal.AContext(), &fakeWorkspace, ServiceName).Return(nil)
+			},
+		},
+		{
+			name:               "workspace condition is kept when its deletion fails once metrics are disabled",
+			disabled:           true,
+			conditions:         []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition},
+			expectedConditions: []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition},
+			expectedError:      errFake.Error(),
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+				r.DeleteResource(gomockinternal.AContext(), &fakeWorkspace, ServiceName).Return(errFake)
+				s.UpdateDeleteStatus(infrav1.AzureMonitorWorkspaceReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_monitorworkspaces.NewMockMonitorWorkspaceScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+			controlPlane := &infrav1.AzureManagedControlPlane{}
+			for _, condition := range tc.conditions {
+				conditions.MarkTrue(controlPlane, condition)
+			}
+			scopeMock.EXPECT().AzureMonitorMetricsEnabled().Return(!tc.disabled).AnyTimes()
+			scopeMock.EXPECT().AzureMonitorResource().Return(controlPlane).AnyTimes()
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(conditionTypes(controlPlane)).To(ConsistOf(tc.expectedConditions))
+		})
+	}
+}
+
+func TestDeleteMonitorWorkspace(t *testing.T) {
+	testcases := []struct {
+		name               string
+		disabled           bool
+		conditions         []clusterv1.ConditionType
+		expectedConditions []clusterv1.ConditionType
+		expectedError      string
+		expect             func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no workspace is specified",
+			expectedError: "",
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(nil)
+			},
+		},
+		{
+			name:          "delete workspace succeeds",
+			expectedError: "",
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+				r.DeleteResource(gomockinternal.AContext(), &fakeWorkspace, ServiceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.AzureMonitorWorkspaceReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:     "noop if metrics were never enabled",
+			disabled: true,
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+			},
+		},
+		{
+			name:               "workspace created before metrics were disabled is deleted",
+			disabled:           true,
+			conditions:         []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition},
+			expectedConditions: []clusterv1.ConditionType{infrav1.AzureMonitorWorkspaceReadyCondition},
+			expect: func(s *mock_monitorworkspaces.MockMonitorWorkspaceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.MonitorWorkspaceSpec().Return(&fakeWorkspace)
+				r.DeleteResource(gomockinternal.AContext(), &fakeWorkspace, ServiceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.AzureMonitorWorkspaceReadyCondition, ServiceName, nil)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_monitorworkspaces.NewMockMonitorWorkspaceScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+			controlPlane := &infrav1.AzureManagedControlPlane{}
+			for _, condition := range tc.conditions {
+				conditions.MarkTrue(controlPlane, condition)
+			}
+			scopeMock.EXPECT().AzureMonitorMetricsEnabled().Return(!tc.disabled).AnyTimes()
+			scopeMock.EXPECT().AzureMonitorResource().Return(controlPlane).AnyTimes()
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(conditionTypes(controlPlane)).To(ConsistOf(tc.expectedConditions))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitorworkspaces
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/monitor/armmonitor"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+)
+
+// WorkspaceSpec defines the specification for an Azure Monitor workspace.
+type WorkspaceSpec struct {
+	Name           string
+	ResourceGroup  string
+	Location       string
+	ClusterName    string
+	AdditionalTags infrav1.Tags
+}
+
+// ResourceName returns the name of the Azure Monitor workspace.
+func (s *WorkspaceSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the Azure Monitor workspace.
+func (s *WorkspaceSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for Azure Monitor workspaces.
+func (s *WorkspaceSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters returns the parameters for the Azure Monitor workspace.
+func (s *WorkspaceSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		if _, ok := existing.(armmonitor.AzureMonitorWorkspaceResource); !ok {
+			return nil, errors.Errorf("%T is not an armmonitor.AzureMonitorWorkspaceResource", existing)
+		}
+		// Azure Monitor workspaces have no properties CAPZ manages, so an existing workspace is up to date.
+		return nil, nil
+	}
+
+	return armmonitor.AzureMonitorWorkspaceResource{
+		Location: ptr.To(s.Location),
+		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.ClusterName,
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        ptr.To(s.Name),
+			Additional:  s.AdditionalTags,
+		})),
+		Properties: &armmonitor.AzureMonitorWorkspaceResourceProperties{},
+	}, nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netappvolumes
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const (
+	// netAppAPIVersion is the API version of the Microsoft.NetApp resource types.
+	netAppAPIVersion = "2023-07-01"
+	// subnetsAPIVersion is the API version of the Microsoft.Network/virtualNetworks/subnets resource type.
+	subnetsAPIVersion = "2023-05-01"
+)
+
+// azureClient contains the Azure go-sdk Client.
+// NetApp resources are managed as generic resources, which saves a dependency on the NetApp SDK module.
+type azureClient struct {
+	resources      *armresources.Client
+	apiCallTimeout time.Duration
+}
+
+// newClient creates a new NetApp volumes client from an authorizer.
+func newClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*azureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create netappvolumes client options")
+	}
+	factory, err := armresources.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armresources client factory")
+	}
+	return &azureClient{factory.NewClient(), apiCallTimeout}, nil
+}
+
+// resourceID returns the Azure resource ID of the NetApp resource with the given spec.
+func resourceID(spec azure.ResourceSpecGetter) (string, error) {
+	netAppSpec, ok := spec.(netAppResourceSpec)
+	if !ok {
+		return "", errors.Errorf("%T is not a NetApp resource spec", spec)
+	}
+	return netAppSpec.ResourceID(), nil
+}
+
+// Get gets the specified NetApp resource.
+func (ac *azureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "netappvolumes.azureClient.Get")
+	defer done()
+
+	id, err := resourceID(spec)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ac.resources.GetByID(ctx, id, netAppAPIVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GenericResource, nil
+}
+
+// GetSubnet gets the subnet with the given resource ID.
+func (ac *azureClient) GetSubnet(ctx context.Context, subnetID string) (armresources.GenericResource, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "netappvolumes.azureClient.GetSubnet")
+	defer done()
+
+	resp, err := ac.resources.GetByID(ctx, subnetID, subnetsAPIVersion, nil)
+	if err != nil {
+		return armresources.GenericResource{}, err
+	}
+	return resp.GenericResource, nil
+}
+
+// CreateOrUpdateAsync creates or updates a NetApp resource asynchronously.
+// It sends a PUT request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
+func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armresources.ClientCreateOrUpdateByIDResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "netappvolumes.azureClient.CreateOrUpdateAsync")
+	defer done()
+
+	resource, ok := parameters.(armresources.GenericResource)
+	if !ok && parameters != nil {
+		return nil, nil, errors.Errorf("%T is not an armresources.GenericResource", parameters)
+	}
+	id, err := resourceID(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := &armresources.ClientBeginCreateOrUpdateByIDOptions{ResumeToken: resumeToken}
+	poller, err = ac.resources.BeginCreateOrUpdateByID(ctx, id, netAppAPIVersion, resource, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	resp, err := poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// If an error occurs, return the poller.
+		// This means the long-running operation didn't finish in the specified timeout.
+		return nil, poller, err
+	}
+
+	// if the operation completed, return a nil poller
+	return resp.GenericResource, nil, err
+}
+
+// DeleteAsync deletes a NetApp resource asynchronously. DeleteAsync sends a DELETE
+// request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
+func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armresources.ClientDeleteByIDResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "netappvolumes.azureClient.DeleteAsync")
+	defer done()
+
+	id, err := resourceID(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &armresources.ClientBeginDeleteByIDOptions{ResumeToken: resumeToken}
+	poller, err = ac.resources.BeginDeleteByID(ctx, id, netAppAPIVersion, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
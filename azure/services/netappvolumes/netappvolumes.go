@@ -0,0 +1,185 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netappvolumes
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const (
+	// ServiceName is the name of this service.
+	ServiceName = "netappvolumes"
+	// netAppDelegation is the service the subnets of NetApp volumes must be delegated to.
+	netAppDelegation = "Microsoft.NetApp/volumes"
+	// subnetDelegationRequeueTime is the time after which a volume whose subnet isn't delegated is checked again.
+	subnetDelegationRequeueTime = time.Minute
+)
+
+// NetAppVolumeScope defines the scope interface for a NetApp volumes service.
+type NetAppVolumeScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	NetAppAccountSpecs() []azure.ResourceSpecGetter
+	NetAppCapacityPoolSpecs() []azure.ResourceSpecGetter
+	NetAppVolumeSpecs() []azure.ResourceSpecGetter
+}
+
+// subnetGetter gets the subnets NetApp volumes are placed in.
+type subnetGetter interface {
+	GetSubnet(ctx context.Context, subnetID string) (armresources.GenericResource, error)
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	Scope NetAppVolumeScope
+	async.Reconciler
+	subnets subnetGetter
+}
+
+// New creates a new service.
+func New(scope NetAppVolumeScope) (*Service, error) {
+	client, err := newClient(scope, scope.DefaultedAzureCallTimeout())
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope: scope,
+		Reconciler: async.New[armresources.ClientCreateOrUpdateByIDResponse,
+			armresources.ClientDeleteByIDResponse](scope, client, client),
+		subnets: client,
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return ServiceName
+}
+
+// Reconcile idempotently creates or updates the NetApp accounts, capacity pools and volumes. The volumes are only
+// created once their accounts and capacity pools exist, and their subnets are delegated to NetApp.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "netappvolumes.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	volumeSpecs := s.Scope.NetAppVolumeSpecs()
+	if len(volumeSpecs) == 0 {
+		return nil
+	}
+
+	resultErr := s.createOrUpdate(ctx, s.Scope.NetAppAccountSpecs())
+	if resultErr == nil {
+		resultErr = s.createOrUpdate(ctx, s.Scope.NetAppCapacityPoolSpecs())
+	}
+	if resultErr == nil {
+		for _, spec := range volumeSpecs {
+			if err := s.validateSubnet(ctx, spec.(*VolumeSpec)); err != nil {
+				resultErr = err
+				break
+			}
+		}
+	}
+	if resultErr == nil {
+		resultErr = s.createOrUpdate(ctx, volumeSpecs)
+	}
+
+	s.Scope.UpdatePutStatus(infrav1.NetAppVolumesReadyCondition, ServiceName, resultErr)
+	return resultErr
+}
+
+// Delete deletes the NetApp volumes, then their capacity pools and accounts.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "netappvolumes.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	volumeSpecs := s.Scope.NetAppVolumeSpecs()
+	if len(volumeSpecs) == 0 {
+		return nil
+	}
+
+	resultErr := s.delete(ctx, volumeSpecs)
+	if resultErr == nil {
+		resultErr = s.delete(ctx, s.Scope.NetAppCapacityPoolSpecs())
+	}
+	if resultErr == nil {
+		resultErr = s.delete(ctx, s.Scope.NetAppAccountSpecs())
+	}
+
+	s.Scope.UpdateDeleteStatus(infrav1.NetAppVolumesReadyCondition, ServiceName, resultErr)
+	return resultErr
+}
+
+// IsManaged returns always returns true as CAPZ does not support BYO NetApp volumes.
+func (s *Service) IsManaged(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+// createOrUpdate creates or updates the resources of the given specs, independently of the result of the previous one.
+// If multiple errors occur, the most pressing one is returned.
+// Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
+func (s *Service) createOrUpdate(ctx context.Context, specs []azure.ResourceSpecGetter) error {
+	var resultErr error
+	for _, spec := range specs {
+		if _, err := s.CreateOrUpdateResource(ctx, spec, ServiceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+	return resultErr
+}
+
+// delete deletes the resources of the given specs, independently of the result of the previous one.
+// If multiple errors occur, the most pressing one is returned.
+// Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error deleting) -> operationNotDoneError (i.e. deleting in progress) -> no error (i.e. deleted)
+func (s *Service) delete(ctx context.Context, specs []azure.ResourceSpecGetter) error {
+	var resultErr error
+	for _, spec := range specs {
+		if err := s.DeleteResource(ctx, spec, ServiceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+	return resultErr
+}
+
+// validateSubnet returns an error if the subnet of the volume isn't delegated to NetApp. As the delegation is
+// configured outside of CAPZ, the subnet is checked again after a while.
+func (s *Service) validateSubnet(ctx context.Context, spec *VolumeSpec) error {
+	subnet, err := s.subnets.GetSubnet(ctx, spec.SubnetID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get subnet %s of NetApp volume %s", spec.SubnetID, spec.Name)
+	}
+	if !hasDelegation(subnet, netAppDelegation) {
+		return azure.WithTransientError(errors.Errorf("subnet %s of NetApp volume %s must be delegated to %s", spec.SubnetID, spec.Name, netAppDelegation), subnetDelegationRequeueTime)
+	}
+	return nil
+}
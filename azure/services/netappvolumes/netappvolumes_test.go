@@ -0,0 +1,256 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netappvolumes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/netappvolumes/mock_netappvolumes"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+const fakeSubnetID = "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/anf"
+
+var (
+	fakeAccount = AccountSpec{
+		Name:           "account",
+		ResourceGroup:  "test-rg",
+		SubscriptionID: "123",
+		Location:       "westus2",
+		ClusterName:    "test-cluster",
+	}
+	fakePool = CapacityPoolSpec{
+		Name:           "pool",
+		AccountName:    "account",
+		ResourceGroup:  "test-rg",
+		SubscriptionID: "123",
+		Location:       "westus2",
+		ServiceLevel:   "Premium",
+		SizeTiB:        4,
+		ClusterName:    "test-cluster",
+	}
+	fakeVolume = VolumeSpec{
+		Name:             "postgres",
+		AccountName:      "account",
+		CapacityPoolName: "pool",
+		ResourceGroup:    "test-rg",
+		SubscriptionID:   "123",
+		Location:         "westus2",
+		ServiceLevel:     "Premium",
+		SizeGiB:          100,
+		Protocol:         infrav1.NetAppVolumeProtocolNFSv3,
+		SubnetID:         fakeSubnetID,
+		ClusterName:      "test-cluster",
+	}
+	fakeDelegatedSubnet = armresources.GenericResource{
+		Properties: map[string]interface{}{
+			"delegations": []interface{}{
+				map[string]interface{}{
+					"name":       "netapp",
+					"properties": map[string]interface{}{"serviceName": "Microsoft.NetApp/volumes"},
+				},
+			},
+		},
+	}
+	errFake       = errors.New("this is an error")
+	errCreateDone = azure.NewOperationNotDoneError(&infrav1.Future{})
+)
+
+func TestReconcileNetAppVolumes(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, n *mock_netappvolumes.MocksubnetGetterMockRecorder)
+	}{
+		{
+			name:          "noop if no NetApp volumes are specified",
+			expectedError: "",
+			expect: func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, n *mock_netappvolumes.MocksubnetGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.NetAppVolumeSpecs().Return(nil)
+			},
+		},
+		{
+			name:          "create the account, capacity pool and volume",
+			expectedError: "",
+			expect: func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, n *mock_netappvolumes.MocksubnetGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.NetAppVolumeSpecs().Return([]azure.ResourceSpecGetter{&fakeVolume})
+				s.NetAppAccountSpecs().Return([]azure.ResourceSpecGetter{&fakeAccount})
+				s.NetAppCapacityPoolSpecs().Return([]azure.ResourceSpecGetter{&fakePool})
+				gomock.InOrder(
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeAccount, ServiceName).Return(nil, nil),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePool, ServiceName).Return(nil, nil),
+					n.GetSubnet(gomockinternal.AContext(), fakeSubnetID).Return(fakeDelegatedSubnet, nil),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeVolume, ServiceName).Return(nil, nil),
+				)
+				s.UpdatePutStatus(infrav1.NetAppVolumesReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "volume is not created in a subnet which isn't delegated to NetApp",
+			expectedError: "subnet " + fakeSubnetID + " of NetApp volume postgres must be delegated to Microsoft.NetApp/volumes. Object will be requeued after 1m0s",
+			expect: func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, n *mock_netappvolumes.MocksubnetGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.NetAppVolumeSpecs().Return([]azure.ResourceSpecGetter{&fakeVolume})
+				s.NetAppAccountSpecs().Return([]azure.ResourceSpecGetter{&fakeAccount})
+				s.NetAppCapacityPoolSpecs().Return([]azure.ResourceSpecGetter{&fakePool})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeAccount, ServiceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePool, ServiceName).Return(nil, nil)
+				n.GetSubnet(gomockinternal.AContext(), fakeSubnetID).Return(armresources.GenericResource{
+					Properties: map[string]interface{}{"delegations": []interface{}{}},
+				}, nil)
+				s.UpdatePutStatus(infrav1.NetAppVolumesReadyCondition, ServiceName, gomockinternal.ErrStrEq("subnet "+fakeSubnetID+" of NetApp volume postgres must be delegated to Microsoft.NetApp/volumes. Object will be requeued after 1m0s"))
+			},
+		},
+		{
+			name:          "volume is not created while its capacity pool is being created",
+			expectedError: errCreateDone.Error(),
+			expect: func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, n *mock_netappvolumes.MocksubnetGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.NetAppVolumeSpecs().Return([]azure.ResourceSpecGetter{&fakeVolume})
+				s.NetAppAccountSpecs().Return([]azure.ResourceSpecGetter{&fakeAccount})
+				s.NetAppCapacityPoolSpecs().Return([]azure.ResourceSpecGetter{&fakePool})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeAccount, ServiceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePool, ServiceName).Return(nil, errCreateDone)
+				s.UpdatePutStatus(infrav1.NetAppVolumesReadyCondition, ServiceName, errCreateDone)
+			},
+		},
+		{
+			name:          "fail to get the subnet of a volume",
+			expectedError: "failed to get subnet " + fakeSubnetID + " of NetApp volume postgres: " + errFake.Error(),
+			expect: func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, n *mock_netappvolumes.MocksubnetGetterMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.NetAppVolumeSpecs().Return([]azure.ResourceSpecGetter{&fakeVolume})
+				s.NetAppAccountSpecs().Return([]azure.ResourceSpecGetter{&fakeAccount})
+				s.NetAppCapacityPoolSpecs().Return([]azure.ResourceSpecGetter{&fakePool})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeAccount, ServiceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePool, ServiceName).Return(nil, nil)
+				n.GetSubnet(gomockinternal.AContext(), fakeSubnetID).Return(armresources.GenericResource{}, errFake)
+				s.UpdatePutStatus(infrav1.NetAppVolumesReadyCondition, ServiceName, gomockinternal.ErrStrEq("failed to get subnet "+fakeSubnetID+" of NetApp volume postgres: "+errFake.Error()))
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_netappvolumes.NewMockNetAppVolumeScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+			subnetsMock := mock_netappvolumes.NewMocksubnetGetter(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT(), subnetsMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+				subnets:    subnetsMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteNetAppVolumes(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no NetApp volumes are specified",
+			expectedError: "",
+			expect: func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.NetAppVolumeSpecs().Return(nil)
+			},
+		},
+		{
+			name:          "delete the volume, capacity pool and account",
+			expectedError: "",
+			expect: func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.NetAppVolumeSpecs().Return([]azure.ResourceSpecGetter{&fakeVolume})
+				s.NetAppCapacityPoolSpecs().Return([]azure.ResourceSpecGetter{&fakePool})
+				s.NetAppAccountSpecs().Return([]azure.ResourceSpecGetter{&fakeAccount})
+				gomock.InOrder(
+					r.DeleteResource(gomockinternal.AContext(), &fakeVolume, ServiceName).Return(nil),
+					r.DeleteResource(gomockinternal.AContext(), &fakePool, ServiceName).Return(nil),
+					r.DeleteResource(gomockinternal.AContext(), &fakeAccount, ServiceName).Return(nil),
+				)
+				s.UpdateDeleteStatus(infrav1.NetAppVolumesReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "capacity pool is kept while the volume is being deleted",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_netappvolumes.MockNetAppVolumeScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.NetAppVolumeSpecs().Return([]azure.ResourceSpecGetter{&fakeVolume})
+				r.DeleteResource(gomockinternal.AContext(), &fakeVolume, ServiceName).Return(errFake)
+				s.UpdateDeleteStatus(infrav1.NetAppVolumesReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_netappvolumes.NewMockNetAppVolumeScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netappvolumes
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+)
+
+const (
+	// netAppAccountsProvider is the provider segment of NetApp account resource IDs.
+	netAppAccountsProvider = "/providers/Microsoft.NetApp/netAppAccounts/"
+	// bytesPerGiB is the number of bytes in a GiB, the unit of volume quotas.
+	bytesPerGiB = int64(1) << 30
+	// bytesPerTiB is the number of bytes in a TiB, the unit of capacity pool sizes.
+	bytesPerTiB = int64(1) << 40
+)
+
+// netAppResourceSpec is implemented by the specs of the NetApp resources, which are all managed as generic resources.
+type netAppResourceSpec interface {
+	// ResourceID returns the Azure resource ID of the resource.
+	ResourceID() string
+}
+
+// AccountSpec defines the specification for a NetApp account.
+type AccountSpec struct {
+	Name           string
+	ResourceGroup  string
+	SubscriptionID string
+	Location       string
+	ClusterName    string
+	AdditionalTags infrav1.Tags
+}
+
+// ResourceName returns the name of the NetApp account.
+func (s *AccountSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the NetApp account.
+func (s *AccountSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for NetApp accounts.
+func (s *AccountSpec) OwnerResourceName() string {
+	return ""
+}
+
+// ResourceID returns the Azure resource ID of the NetApp account.
+func (s *AccountSpec) ResourceID() string {
+	return accountID(s.SubscriptionID, s.ResourceGroup, s.Name)
+}
+
+// Parameters returns the parameters for the NetApp account.
+func (s *AccountSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		// NetApp accounts have no properties CAPZ manages, so an existing account is up to date.
+		return nil, nil
+	}
+
+	return armresources.GenericResource{
+		Location:   ptr.To(s.Location),
+		Tags:       tags(s.ClusterName, s.Name, s.AdditionalTags),
+		Properties: map[string]interface{}{},
+	}, nil
+}
+
+// CapacityPoolSpec defines the specification for a capacity pool of a NetApp account.
+type CapacityPoolSpec struct {
+	Name           string
+	AccountName    string
+	ResourceGroup  string
+	SubscriptionID string
+	Location       string
+	ServiceLevel   string
+	SizeTiB        int64
+	ClusterName    string
+	AdditionalTags infrav1.Tags
+}
+
+// ResourceName returns the name of the capacity pool.
+func (s *CapacityPoolSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the capacity pool.
+func (s *CapacityPoolSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName returns the name of the NetApp account of the capacity pool.
+func (s *CapacityPoolSpec) OwnerResourceName() string {
+	return s.AccountName
+}
+
+// ResourceID returns the Azure resource ID of the capacity pool.
+func (s *CapacityPoolSpec) ResourceID() string {
+	return capacityPoolID(s.SubscriptionID, s.ResourceGroup, s.AccountName, s.Name)
+}
+
+// Parameters returns the parameters for the capacity pool.
+func (s *CapacityPoolSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	size := s.SizeTiB * bytesPerTiB
+	if existing != nil {
+		existingPool, ok := existing.(armresources.GenericResource)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armresources.GenericResource", existing)
+		}
+		properties, _ := existingPool.Properties.(map[string]interface{})
+		serviceLevel, _ := properties["serviceLevel"].(string)
+		if strings.EqualFold(serviceLevel, s.ServiceLevel) && int64Property(properties, "size") == size {
+			return nil, nil
+		}
+	}
+
+	return armresources.GenericResource{
+		Location: ptr.To(s.Location),
+		Tags:     tags(s.ClusterName, s.Name, s.AdditionalTags),
+		Properties: map[string]interface{}{
+			"serviceLevel": s.ServiceLevel,
+			"size":         size,
+		},
+	}, nil
+}
+
+// VolumeSpec defines the specification for a NetApp volume.
+type VolumeSpec struct {
+	Name             string
+	AccountName      string
+	CapacityPoolName string
+	ResourceGroup    string
+	SubscriptionID   string
+	Location         string
+	ServiceLevel     string
+	SizeGiB          int64
+	Protocol         infrav1.NetAppVolumeProtocol
+	SubnetID         string
+	ClusterName      string
+	AdditionalTags   infrav1.Tags
+}
+
+// ResourceName returns the name of the volume.
+func (s *VolumeSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the volume.
+func (s *VolumeSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName returns the name of the capacity pool of the volume.
+func (s *VolumeSpec) OwnerResourceName() string {
+	return s.CapacityPoolName
+}
+
+// ResourceID returns the Azure resource ID of the volume.
+func (s *VolumeSpec) ResourceID() string {
+	return capacityPoolID(s.SubscriptionID, s.ResourceGroup, s.AccountName, s.CapacityPoolName) + "/volumes/" + s.Name
+}
+
+// Parameters returns the parameters for the volume. The protocol and subnet of an existing volume can't be changed,
+// so only its quota is updated.
+func (s *VolumeSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	quota := s.SizeGiB * bytesPerGiB
+	if existing != nil {
+		existingVolume, ok := existing.(armresources.GenericResource)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armresources.GenericResource", existing)
+		}
+		properties, _ := existingVolume.Properties.(map[string]interface{})
+		if int64Property(properties, "usageThreshold") == quota {
+			return nil, nil
+		}
+	}
+
+	return armresources.GenericResource{
+		Location: ptr.To(s.Location),
+		Tags:     tags(s.ClusterName, s.Name, s.AdditionalTags),
+		Properties: map[string]interface{}{
+			"creationToken":  s.Name,
+			"serviceLevel":   s.ServiceLevel,
+			"usageThreshold": quota,
+			"subnetId":       s.SubnetID,
+			"protocolTypes":  []string{string(s.Protocol)},
+		},
+	}, nil
+}
+
+// accountID returns the Azure resource ID of the NetApp account with the given name.
+func accountID(subscriptionID, resourceGroup, name string) string {
+	return "/subscriptions/" + subscriptionID + "/resourceGroups/" + resourceGroup + netAppAccountsProvider + name
+}
+
+// capacityPoolID returns the Azure resource ID of the capacity pool with the given name.
+func capacityPoolID(subscriptionID, resourceGroup, accountName, name string) string {
+	return accountID(subscriptionID, resourceGroup, accountName) + "/capacityPools/" + name
+}
+
+// tags returns the tags of a NetApp resource owned by the cluster.
+func tags(clusterName, name string, additionalTags infrav1.Tags) map[string]*string {
+	return converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
+		ClusterName: clusterName,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        ptr.To(name),
+		Additional:  additionalTags,
+	}))
+}
+
+// int64Property returns the numeric property with the given name of a generic resource, or 0 if it isn't set.
+func int64Property(properties map[string]interface{}, name string) int64 {
+	switch value := properties[name].(type) {
+	case float64:
+		return int64(value)
+	case int64:
+		return value
+	default:
+		return 0
+	}
+}
+
+// hasDelegation returns true if the subnet with the given properties is delegated to the given service.
+func hasDelegation(subnet armresources.GenericResource, serviceName string) bool {
+	properties, _ := subnet.Properties.(map[string]interface{})
+	delegations, _ := properties["delegations"].([]interface{})
+	for _, delegation := range delegations {
+		delegationProperties, _ := delegation.(map[string]interface{})["properties"].(map[string]interface{})
+		if service, _ := delegationProperties["serviceName"].(string); strings.EqualFold(service, serviceName) {
+			return true
+		}
+	}
+	return false
+}
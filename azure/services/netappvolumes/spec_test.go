@@ -0,0 +1,149 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netappvolumes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestResourceIDs(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(fakeAccount.ResourceID()).To(Equal("/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.NetApp/netAppAccounts/account"))
+	g.Expect(fakePool.ResourceID()).To(Equal("/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.NetApp/netAppAccounts/account/capacityPools/pool"))
+	g.Expect(fakeVolume.ResourceID()).To(Equal("/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.NetApp/netAppAccounts/account/capacityPools/pool/volumes/postgres"))
+}
+
+func TestAccountParameters(t *testing.T) {
+	g := NewWithT(t)
+
+	params, err := fakeAccount.Parameters(context.TODO(), nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	account := params.(armresources.GenericResource)
+	g.Expect(account.Location).To(Equal(ptr.To("westus2")))
+	g.Expect(account.Tags).To(HaveKeyWithValue("sigs.k8s.io_cluster-api-provider-azure_cluster_test-cluster", ptr.To("owned")))
+
+	params, err = fakeAccount.Parameters(context.TODO(), armresources.GenericResource{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(params).To(BeNil())
+}
+
+func TestCapacityPoolParameters(t *testing.T) {
+	testcases := []struct {
+		name     string
+		existing interface{}
+		expect   func(g *WithT, result interface{})
+	}{
+		{
+			name:     "new capacity pool",
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result.(armresources.GenericResource).Properties).To(Equal(map[string]interface{}{
+					"serviceLevel": "Premium",
+					"size":         int64(4) << 40,
+				}))
+			},
+		},
+		{
+			name: "existing capacity pool is up to date",
+			existing: armresources.GenericResource{
+				Properties: map[string]interface{}{"serviceLevel": "Premium", "size": float64(int64(4) << 40)},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "existing capacity pool is resized",
+			existing: armresources.GenericResource{
+				Properties: map[string]interface{}{"serviceLevel": "Premium", "size": float64(int64(2) << 40)},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result.(armresources.GenericResource).Properties).To(HaveKeyWithValue("size", int64(4)<<40))
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			result, err := fakePool.Parameters(context.TODO(), tc.existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			tc.expect(g, result)
+		})
+	}
+}
+
+func TestVolumeParameters(t *testing.T) {
+	testcases := []struct {
+		name     string
+		existing interface{}
+		expect   func(g *WithT, result interface{})
+	}{
+		{
+			name:     "new volume",
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result.(armresources.GenericResource).Properties).To(Equal(map[string]interface{}{
+					"creationToken":  "postgres",
+					"serviceLevel":   "Premium",
+					"usageThreshold": int64(100) << 30,
+					"subnetId":       fakeSubnetID,
+					"protocolTypes":  []string{"NFSv3"},
+				}))
+			},
+		},
+		{
+			name: "existing volume is up to date",
+			existing: armresources.GenericResource{
+				Properties: map[string]interface{}{"usageThreshold": float64(int64(100) << 30)},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "existing volume is resized",
+			existing: armresources.GenericResource{
+				Properties: map[string]interface{}{"usageThreshold": float64(int64(50) << 30)},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result.(armresources.GenericResource).Properties).To(HaveKeyWithValue("usageThreshold", int64(100)<<30))
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			result, err := fakeVolume.Parameters(context.TODO(), tc.existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			tc.expect(g, result)
+		})
+	}
+}
+
+func TestHasDelegation(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(hasDelegation(fakeDelegatedSubnet, netAppDelegation)).To(BeTrue())
+	g.Expect(hasDelegation(fakeDelegatedSubnet, "Microsoft.DBforPostgreSQL/flexibleServers")).To(BeFalse())
+	g.Expect(hasDelegation(armresources.GenericResource{}, netAppDelegation)).To(BeFalse())
+}
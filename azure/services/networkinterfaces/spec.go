@@ -41,6 +41,7 @@ type NICSpec struct {
 	VNetName                  string
 	VNetResourceGroup         string
 	StaticIPAddress           string
+	LBResourceGroup           string
 	PublicLBName              string
 	PublicLBAddressPoolName   string
 	PublicLBNATRuleName       string
@@ -108,13 +109,13 @@ func (s *NICSpec) Parameters(ctx context.Context, existing interface{}) (paramet
 		if s.PublicLBAddressPoolName != "" {
 			backendAddressPools = append(backendAddressPools,
 				&armnetwork.BackendAddressPool{
-					ID: ptr.To(azure.AddressPoolID(s.SubscriptionID, s.ResourceGroup, s.PublicLBName, s.PublicLBAddressPoolName)),
+					ID: ptr.To(azure.AddressPoolID(s.SubscriptionID, s.LBResourceGroup, s.PublicLBName, s.PublicLBAddressPoolName)),
 				})
 		}
 		if s.PublicLBNATRuleName != "" {
 			primaryIPConfig.LoadBalancerInboundNatRules = []*armnetwork.InboundNatRule{
 				{
-					ID: ptr.To(azure.NATRuleID(s.SubscriptionID, s.ResourceGroup, s.PublicLBName, s.PublicLBNATRuleName)),
+					ID: ptr.To(azure.NATRuleID(s.SubscriptionID, s.LBResourceGroup, s.PublicLBName, s.PublicLBNATRuleName)),
 				},
 			}
 		}
@@ -122,7 +123,7 @@ func (s *NICSpec) Parameters(ctx context.Context, existing interface{}) (paramet
 	if s.InternalLBName != "" && s.InternalLBAddressPoolName != "" {
 		backendAddressPools = append(backendAddressPools,
 			&armnetwork.BackendAddressPool{
-				ID: ptr.To(azure.AddressPoolID(s.SubscriptionID, s.ResourceGroup, s.InternalLBName, s.InternalLBAddressPoolName)),
+				ID: ptr.To(azure.AddressPoolID(s.SubscriptionID, s.LBResourceGroup, s.InternalLBName, s.InternalLBAddressPoolName)),
 			})
 	}
 	primaryIPConfig.LoadBalancerBackendAddressPools = backendAddressPools
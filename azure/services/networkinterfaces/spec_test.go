@@ -38,6 +38,7 @@ var (
 		SubnetName:            "my-subnet",
 		VNetName:              "my-vnet",
 		VNetResourceGroup:     "my-rg",
+		LBResourceGroup:       "my-rg",
 		PublicLBName:          "my-public-lb",
 		AcceleratedNetworking: nil,
 		ClusterName:           "my-cluster",
@@ -73,6 +74,7 @@ var (
 		SubnetName:              "my-subnet",
 		VNetName:                "my-vnet",
 		VNetResourceGroup:       "my-rg",
+		LBResourceGroup:         "my-rg",
 		PublicLBName:            "my-public-lb",
 		PublicLBAddressPoolName: "cluster-name-outboundBackendPool",
 		StaticIPAddress:         "fake.static.ip",
@@ -90,6 +92,7 @@ var (
 		SubnetName:              "my-subnet",
 		VNetName:                "my-vnet",
 		VNetResourceGroup:       "my-rg",
+		LBResourceGroup:         "my-rg",
 		PublicLBName:            "my-public-lb",
 		PublicLBAddressPoolName: "cluster-name-outboundBackendPool",
 		AcceleratedNetworking:   nil,
@@ -106,6 +109,7 @@ var (
 		SubnetName:                "my-subnet",
 		VNetName:                  "my-vnet",
 		VNetResourceGroup:         "my-rg",
+		LBResourceGroup:           "my-rg",
 		PublicLBName:              "my-public-lb",
 		PublicLBAddressPoolName:   "my-public-lb-backendPool",
 		PublicLBNATRuleName:       "azure-test1",
@@ -116,6 +120,27 @@ var (
 		ClusterName:               "my-cluster",
 	}
 
+	fakeSeparateResourceGroupsNICSpec = NICSpec{
+		Name:                      "my-net-interface",
+		ResourceGroup:             "compute-rg",
+		Location:                  "fake-location",
+		SubscriptionID:            "123",
+		MachineName:               "azure-test1",
+		SubnetName:                "my-subnet",
+		VNetName:                  "my-vnet",
+		VNetResourceGroup:         "network-rg",
+		LBResourceGroup:           "my-rg",
+		PublicLBName:              "my-public-lb",
+		PublicLBAddressPoolName:   "my-public-lb-backendPool",
+		PublicLBNATRuleName:       "azure-test1",
+		InternalLBName:            "my-internal-lb",
+		InternalLBAddressPoolName: "my-internal-lb-backendPool",
+		PublicIPName:              "my-public-ip",
+		AcceleratedNetworking:     nil,
+		SKU:                       &fakeSku,
+		ClusterName:               "my-cluster",
+	}
+
 	fakeAcceleratedNetworkingNICSpec = NICSpec{
 		Name:                  "my-net-interface",
 		ResourceGroup:         "my-rg",
@@ -125,6 +150,7 @@ var (
 		SubnetName:            "my-subnet",
 		VNetName:              "my-vnet",
 		VNetResourceGroup:     "my-rg",
+		LBResourceGroup:       "my-rg",
 		PublicLBName:          "my-public-lb",
 		AcceleratedNetworking: nil,
 		SKU:                   &fakeSku,
@@ -140,6 +166,7 @@ var (
 		SubnetName:            "my-subnet",
 		VNetName:              "my-vnet",
 		VNetResourceGroup:     "my-rg",
+		LBResourceGroup:       "my-rg",
 		PublicLBName:          "my-public-lb",
 		AcceleratedNetworking: ptr.To(false),
 		ClusterName:           "my-cluster",
@@ -155,6 +182,7 @@ var (
 		VNetName:              "my-vnet",
 		IPv6Enabled:           true,
 		VNetResourceGroup:     "my-rg",
+		LBResourceGroup:       "my-rg",
 		PublicLBName:          "my-public-lb",
 		AcceleratedNetworking: nil,
 		SKU:                   &fakeSku,
@@ -171,6 +199,7 @@ var (
 		SubnetName:                "my-subnet",
 		VNetName:                  "my-vnet",
 		VNetResourceGroup:         "my-rg",
+		LBResourceGroup:           "my-rg",
 		PublicLBName:              "my-public-lb",
 		PublicLBAddressPoolName:   "my-public-lb-backendPool",
 		PublicLBNATRuleName:       "azure-test1",
@@ -191,6 +220,7 @@ var (
 		VNetName:              "my-vnet",
 		IPv6Enabled:           false,
 		VNetResourceGroup:     "my-rg",
+		LBResourceGroup:       "my-rg",
 		PublicLBName:          "my-public-lb",
 		AcceleratedNetworking: nil,
 		SKU:                   &fakeSku,
@@ -208,6 +238,7 @@ var (
 		VNetName:              "my-vnet",
 		IPv6Enabled:           false,
 		VNetResourceGroup:     "my-rg",
+		LBResourceGroup:       "my-rg",
 		PublicLBName:          "my-public-lb",
 		AcceleratedNetworking: nil,
 		SKU:                   &fakeSku,
@@ -225,6 +256,7 @@ var (
 		VNetName:              "my-vnet",
 		IPv6Enabled:           false,
 		VNetResourceGroup:     "my-rg",
+		LBResourceGroup:       "my-rg",
 		PublicLBName:          "my-public-lb",
 		AcceleratedNetworking: nil,
 		SKU:                   &fakeSku,
@@ -242,6 +274,7 @@ var (
 		VNetName:              "my-vnet",
 		IPv6Enabled:           false,
 		VNetResourceGroup:     "my-rg",
+		LBResourceGroup:       "my-rg",
 		PublicIPName:          "pip-azure-test1",
 		AcceleratedNetworking: nil,
 		SKU:                   &fakeSku,
@@ -371,6 +404,23 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name:     "get parameters for network interface in a separate resource group from its vnet and load balancers",
+			spec:     &fakeSeparateResourceGroupsNICSpec,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.Interface{}))
+				ipConfig := result.(armnetwork.Interface).Properties.IPConfigurations[0].Properties
+				g.Expect(ipConfig.Subnet.ID).To(Equal(ptr.To("/subscriptions/123/resourceGroups/network-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet")))
+				g.Expect(ipConfig.LoadBalancerInboundNatRules).To(Equal([]*armnetwork.InboundNatRule{{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-public-lb/inboundNatRules/azure-test1")}}))
+				g.Expect(ipConfig.LoadBalancerBackendAddressPools).To(Equal([]*armnetwork.BackendAddressPool{
+					{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-public-lb/backendAddressPools/my-public-lb-backendPool")},
+					{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-internal-lb/backendAddressPools/my-internal-lb-backendPool")},
+				}))
+				g.Expect(ipConfig.PublicIPAddress.ID).To(Equal(ptr.To("/subscriptions/123/resourceGroups/compute-rg/providers/Microsoft.Network/publicIPAddresses/my-public-ip")))
+			},
+			expectedError: "",
+		},
 		{
 			name:     "get parameters for network interface with accelerated networking",
 			spec:     &fakeAcceleratedNetworkingNICSpec,
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedresources
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// client wraps go-sdk.
+type client interface {
+	ListByResourceGroup(ctx context.Context, resourceGroupName, filter string) ([]*armresources.GenericResourceExpanded, error)
+	GetProvider(ctx context.Context, resourceProviderNamespace string) (armresources.Provider, error)
+	BeginDeleteByID(ctx context.Context, resourceID, apiVersion string) error
+}
+
+// AzureClient contains the Azure go-sdk client.
+type AzureClient struct {
+	resources *armresources.Client
+	providers *armresources.ProvidersClient
+}
+
+var _ client = (*AzureClient)(nil)
+
+// NewClient creates a resources client from an authorizer.
+func NewClient(auth azure.Authorizer) (*AzureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create resources client options")
+	}
+	factory, err := armresources.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armresources client factory")
+	}
+	return &AzureClient{
+		resources: factory.NewClient(),
+		providers: factory.NewProvidersClient(),
+	}, nil
+}
+
+// ListByResourceGroup returns all the resources in a resource group which match the filter.
+func (ac *AzureClient) ListByResourceGroup(ctx context.Context, resourceGroupName, filter string) ([]*armresources.GenericResourceExpanded, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "orphanedresources.AzureClient.ListByResourceGroup")
+	defer done()
+
+	var resources []*armresources.GenericResourceExpanded
+	pager := ac.resources.NewListByResourceGroupPager(resourceGroupName, &armresources.ClientListByResourceGroupOptions{Filter: &filter})
+	for pager.More() {
+		nextResult, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, nextResult.Value...)
+	}
+
+	return resources, nil
+}
+
+// GetProvider returns a resource provider, including the API versions of its resource types.
+func (ac *AzureClient) GetProvider(ctx context.Context, resourceProviderNamespace string) (armresources.Provider, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "orphanedresources.AzureClient.GetProvider")
+	defer done()
+
+	resp, err := ac.providers.Get(ctx, resourceProviderNamespace, nil)
+	if err != nil {
+		return armresources.Provider{}, err
+	}
+
+	return resp.Provider, nil
+}
+
+// BeginDeleteByID starts the deletion of a resource without waiting for it to finish.
+func (ac *AzureClient) BeginDeleteByID(ctx context.Context, resourceID, apiVersion string) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "orphanedresources.AzureClient.BeginDeleteByID")
+	defer done()
+
+	_, err := ac.resources.BeginDeleteByID(ctx, resourceID, apiVersion, nil)
+	return err
+}
@@ -0,0 +1,101 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../client.go
+//
+// Generated by this command:
+//
+//	mockgen -destination client_mock.go -package mock_orphanedresources -source ../client.go client
+//
+
+// Package mock_orphanedresources is a generated GoMock package.
+package mock_orphanedresources
+
+import (
+	context "context"
+	reflect "reflect"
+
+	armresources "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockclient is a mock of client interface.
+type Mockclient struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientMockRecorder
+}
+
+// MockclientMockRecorder is the mock recorder for Mockclient.
+type MockclientMockRecorder struct {
+	mock *Mockclient
+}
+
+// NewMockclient creates a new mock instance.
+func NewMockclient(ctrl *gomock.Controller) *Mockclient {
+	mock := &Mockclient{ctrl: ctrl}
+	mock.recorder = &MockclientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockclient) EXPECT() *MockclientMockRecorder {
+	return m.recorder
+}
+
+// BeginDeleteByID mocks base method.
+func (m *Mockclient) BeginDeleteByID(ctx context.Context, resourceID, apiVersion string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginDeleteByID", ctx, resourceID, apiVersion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeginDeleteByID indicates an expected call of BeginDeleteByID.
+func (mr *MockclientMockRecorder) BeginDeleteByID(ctx, resourceID, apiVersion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginDeleteByID", reflect.TypeOf((*Mockclient)(nil).BeginDeleteByID), ctx, resourceID, apiVersion)
+}
+
+// GetProvider mocks base method.
+func (m *Mockclient) GetProvider(ctx context.Context, resourceProviderNamespace string) (armresources.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProvider", ctx, resourceProviderNamespace)
+	ret0, _ := ret[0].(armresources.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvider indicates an expected call of GetProvider.
+func (mr *MockclientMockRecorder) GetProvider(ctx, resourceProviderNamespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProvider", reflect.TypeOf((*Mockclient)(nil).GetProvider), ctx, resourceProviderNamespace)
+}
+
+// ListByResourceGroup mocks base method.
+func (m *Mockclient) ListByResourceGroup(ctx context.Context, resourceGroupName, filter string) ([]*armresources.GenericResourceExpanded, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByResourceGroup", ctx, resourceGroupName, filter)
+	ret0, _ := ret[0].([]*armresources.GenericResourceExpanded)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByResourceGroup indicates an expected call of ListByResourceGroup.
+func (mr *MockclientMockRecorder) ListByResourceGroup(ctx, resourceGroupName, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByResourceGroup", reflect.TypeOf((*Mockclient)(nil).ListByResourceGroup), ctx, resourceGroupName, filter)
+}
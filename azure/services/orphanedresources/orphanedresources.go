@@ -0,0 +1,179 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedresources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// ServiceName is the name of this service.
+const ServiceName = "orphanedresources"
+
+// OrphanedResourcesScope defines the scope interface for an orphaned resources service.
+type OrphanedResourcesScope interface {
+	azure.Authorizer
+	azure.AsyncReconciler
+	ClusterName() string
+	ResourceGroup() string
+}
+
+// Service deletes Azure resources which are tagged as owned by a cluster but were left behind, e.g. by a
+// reconcile which failed partway, after the resources of the cluster's spec have been deleted.
+type Service struct {
+	Scope OrphanedResourcesScope
+	client
+}
+
+// New creates a new service.
+func New(scope OrphanedResourcesScope) (*Service, error) {
+	cli, err := NewClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope:  scope,
+		client: cli,
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return ServiceName
+}
+
+// Reconcile is a no-op, orphaned resources are only deleted when the cluster is deleted.
+func (s *Service) Reconcile(ctx context.Context) error {
+	return nil
+}
+
+// Delete deletes all the resources in the cluster's resource group which are still tagged as owned by the cluster.
+// It is meant to be called after all other services have deleted their resources, so any resource it finds is
+// no longer part of the cluster's spec. Deletions are started without waiting for them to finish, and the
+// resource group is checked again on the next call until no owned resources are found.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "orphanedresources.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	rgName := s.Scope.ResourceGroup()
+	filter := fmt.Sprintf("tagName eq '%s' and tagValue eq '%s'", infrav1.ClusterTagKey(s.Scope.ClusterName()), infrav1.ResourceLifecycleOwned)
+	resources, err := s.client.ListByResourceGroup(ctx, rgName, filter)
+	if azure.ResourceNotFound(err) {
+		// The resource group is already gone, so there's nothing left to delete.
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to list resources owned by the cluster in resource group %s", rgName)
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+
+	dryRunner, _ := s.Scope.(azure.DryRunner)
+	apiVersions := make(map[string]string)
+	var errs []error
+	for _, resource := range resources {
+		resourceID := ptr.Deref(resource.ID, "")
+		if dryRunner != nil && dryRunner.IsDryRun() {
+			log.V(2).Info("dry-run mode, skipping delete of orphaned resource", "resource", resourceID)
+			dryRunner.RecordPlannedChange(ServiceName, fmt.Sprintf("delete orphaned resource %s", resourceID))
+			continue
+		}
+		apiVersion, err := s.apiVersion(ctx, resourceID, apiVersions)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		log.V(2).Info("deleting orphaned resource", "resource", resourceID, "apiVersion", apiVersion)
+		if err := s.client.BeginDeleteByID(ctx, resourceID, apiVersion); err != nil && !azure.ResourceNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "failed to delete orphaned resource %s", resourceID))
+		}
+	}
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+	if dryRunner != nil && dryRunner.IsDryRun() {
+		return nil
+	}
+
+	// Requeue to wait for the deletions to finish.
+	return azure.WithTransientError(errors.Errorf("deleting %d orphaned resources in resource group %s", len(resources), rgName), s.Scope.DefaultedReconcilerRequeue())
+}
+
+// apiVersion returns the newest API version of the resource type of the resource with the given ID, preferring
+// stable versions over preview versions. API versions are cached by resource type in apiVersions.
+func (s *Service) apiVersion(ctx context.Context, resourceID string, apiVersions map[string]string) (string, error) {
+	id, err := arm.ParseResourceID(resourceID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse resource ID %s", resourceID)
+	}
+	resourceType := strings.ToLower(id.ResourceType.String())
+	if apiVersion, ok := apiVersions[resourceType]; ok {
+		return apiVersion, nil
+	}
+
+	provider, err := s.client.GetProvider(ctx, id.ResourceType.Namespace)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get resource provider %s", id.ResourceType.Namespace)
+	}
+	for _, providerResourceType := range provider.ResourceTypes {
+		if providerResourceType == nil || !strings.EqualFold(ptr.Deref(providerResourceType.ResourceType, ""), id.ResourceType.Type) {
+			continue
+		}
+		if apiVersion := newestAPIVersion(providerResourceType.APIVersions); apiVersion != "" {
+			apiVersions[resourceType] = apiVersion
+			return apiVersion, nil
+		}
+	}
+	return "", errors.Errorf("failed to find an API version for resource type %s of resource %s", id.ResourceType.String(), resourceID)
+}
+
+// newestAPIVersion returns the newest stable API version, or the newest preview version if there is no stable one.
+// API versions are dates, optionally followed by a suffix like "-preview", so they sort lexicographically.
+func newestAPIVersion(apiVersions []*string) string {
+	var stable, preview []string
+	for _, v := range apiVersions {
+		version := ptr.Deref(v, "")
+		switch {
+		case version == "":
+		case strings.Count(version, "-") > 2:
+			preview = append(preview, version)
+		default:
+			stable = append(stable, version)
+		}
+	}
+	for _, versions := range [][]string{stable, preview} {
+		if len(versions) > 0 {
+			sort.Strings(versions)
+			return versions[len(versions)-1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedresources
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/orphanedresources/mock_orphanedresources"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+)
+
+const (
+	orphanedPublicIPID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-cluster-pip"
+	orphanedNSGID      = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/my-cluster-nsg"
+	ownedFilter        = "tagName eq 'sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster' and tagValue eq 'owned'"
+)
+
+var networkProvider = armresources.Provider{
+	Namespace: ptr.To("Microsoft.Network"),
+	ResourceTypes: []*armresources.ProviderResourceType{
+		{
+			ResourceType: ptr.To("virtualNetworks"),
+			APIVersions:  []*string{ptr.To("2023-09-01")},
+		},
+		{
+			ResourceType: ptr.To("publicIPAddresses"),
+			APIVersions:  []*string{ptr.To("2023-09-01"), ptr.To("2024-01-01-preview"), ptr.To("2023-11-01")},
+		},
+		{
+			ResourceType: ptr.To("networkSecurityGroups"),
+			APIVersions:  []*string{ptr.To("2023-11-01")},
+		},
+	},
+}
+
+// dryRunScope is an OrphanedResourcesScope whose owner is reconciled in dry-run mode.
+type dryRunScope struct {
+	OrphanedResourcesScope
+	changes *[]string
+}
+
+func (dryRunScope) IsDryRun() bool {
+	return true
+}
+
+func (s dryRunScope) RecordPlannedChange(serviceName, change string) {
+	*s.changes = append(*s.changes, serviceName+": "+change)
+}
+
+func TestDeleteOrphanedResources(t *testing.T) {
+	testcases := []struct {
+		name                   string
+		dryRun                 bool
+		expect                 func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder)
+		expectedError          string
+		expectedPlannedChanges []string
+	}{
+		{
+			name: "no orphaned resources",
+			expect: func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+				s.ResourceGroup().Return("my-rg")
+				s.ClusterName().Return("my-cluster")
+				m.ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return(nil, nil)
+			},
+		},
+		{
+			name: "resource group is already deleted",
+			expect: func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+				s.ResourceGroup().Return("my-rg")
+				s.ClusterName().Return("my-cluster")
+				m.ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+			},
+		},
+		{
+			name: "orphaned resources are deleted with the newest stable API version",
+			expect: func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+				s.ResourceGroup().Return("my-rg")
+				s.ClusterName().Return("my-cluster")
+				m.ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return([]*armresources.GenericResourceExpanded{
+					{ID: ptr.To(orphanedPublicIPID)},
+					{ID: ptr.To(orphanedNSGID)},
+				}, nil)
+				m.GetProvider(gomockinternal.AContext(), "Microsoft.Network").Return(networkProvider, nil).Times(2)
+				m.BeginDeleteByID(gomockinternal.AContext(), orphanedPublicIPID, "2023-11-01").Return(nil)
+				m.BeginDeleteByID(gomockinternal.AContext(), orphanedNSGID, "2023-11-01").Return(nil)
+				s.DefaultedReconcilerRequeue().Return(15 * time.Second)
+			},
+			expectedError: "deleting 2 orphaned resources in resource group my-rg",
+		},
+		{
+			name: "API versions are looked up once per resource type",
+			expect: func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+				s.ResourceGroup().Return("my-rg")
+				s.ClusterName().Return("my-cluster")
+				m.ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return([]*armresources.GenericResourceExpanded{
+					{ID: ptr.To(orphanedPublicIPID)},
+					{ID: ptr.To(orphanedPublicIPID + "-2")},
+				}, nil)
+				m.GetProvider(gomockinternal.AContext(), "Microsoft.Network").Return(networkProvider, nil).Times(1)
+				m.BeginDeleteByID(gomockinternal.AContext(), orphanedPublicIPID, "2023-11-01").Return(nil)
+				m.BeginDeleteByID(gomockinternal.AContext(), orphanedPublicIPID+"-2", "2023-11-01").Return(nil)
+				s.DefaultedReconcilerRequeue().Return(15 * time.Second)
+			},
+			expectedError: "deleting 2 orphaned resources in resource group my-rg",
+		},
+		{
+			name: "failure to delete an orphaned resource doesn't prevent deleting others",
+			expect: func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+				s.ResourceGroup().Return("my-rg")
+				s.ClusterName().Return("my-cluster")
+				m.ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return([]*armresources.GenericResourceExpanded{
+					{ID: ptr.To(orphanedPublicIPID)},
+					{ID: ptr.To(orphanedNSGID)},
+				}, nil)
+				m.GetProvider(gomockinternal.AContext(), "Microsoft.Network").Return(networkProvider, nil).Times(2)
+				m.BeginDeleteByID(gomockinternal.AContext(), orphanedPublicIPID, "2023-11-01").Return(errors.New("public IP is in use"))
+				m.BeginDeleteByID(gomockinternal.AContext(), orphanedNSGID, "2023-11-01").Return(nil)
+			},
+			expectedError: "failed to delete orphaned resource " + orphanedPublicIPID + ": public IP is in use",
+		},
+		{
+			name: "unknown resource type",
+			expect: func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+				s.ResourceGroup().Return("my-rg")
+				s.ClusterName().Return("my-cluster")
+				m.ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return([]*armresources.GenericResourceExpanded{
+					{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/unknownThings/my-thing")},
+				}, nil)
+				m.GetProvider(gomockinternal.AContext(), "Microsoft.Network").Return(networkProvider, nil)
+			},
+			expectedError: "failed to find an API version for resource type Microsoft.Network/unknownThings",
+		},
+		{
+			name: "error listing resources",
+			expect: func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+				s.ResourceGroup().Return("my-rg")
+				s.ClusterName().Return("my-cluster")
+				m.ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return(nil, errors.New("boom"))
+			},
+			expectedError: "failed to list resources owned by the cluster in resource group my-rg: boom",
+		},
+		{
+			name:   "dry run: orphaned resources are not deleted",
+			dryRun: true,
+			expect: func(s *mock_orphanedresources.MockOrphanedResourcesScopeMockRecorder, m *mock_orphanedresources.MockclientMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(time.Minute)
+				s.ResourceGroup().Return("my-rg")
+				s.ClusterName().Return("my-cluster")
+				m.ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return([]*armresources.GenericResourceExpanded{
+					{ID: ptr.To(orphanedPublicIPID)},
+				}, nil)
+			},
+			expectedPlannedChanges: []string{"orphanedresources: delete orphaned resource " + orphanedPublicIPID},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_orphanedresources.NewMockOrphanedResourcesScope(mockCtrl)
+			clientMock := mock_orphanedresources.NewMockclient(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), clientMock.EXPECT())
+
+			var scope OrphanedResourcesScope = scopeMock
+			var plannedChanges []string
+			if tc.dryRun {
+				scope = dryRunScope{OrphanedResourcesScope: scopeMock, changes: &plannedChanges}
+			}
+			s := &Service{
+				Scope:  scope,
+				client: clientMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(strings.ReplaceAll(err.Error(), "\n", "")).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(plannedChanges).To(Equal(tc.expectedPlannedChanges))
+		})
+	}
+}
+
+func TestDeleteOrphanedResourcesRequeuesUntilDeleted(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scopeMock := mock_orphanedresources.NewMockOrphanedResourcesScope(mockCtrl)
+	clientMock := mock_orphanedresources.NewMockclient(mockCtrl)
+
+	scopeMock.EXPECT().AzureServiceReconcileTimeout(ServiceName).Return(time.Minute).AnyTimes()
+	scopeMock.EXPECT().DefaultedReconcilerRequeue().Return(15 * time.Second).AnyTimes()
+	scopeMock.EXPECT().ResourceGroup().Return("my-rg").AnyTimes()
+	scopeMock.EXPECT().ClusterName().Return("my-cluster").AnyTimes()
+	gomock.InOrder(
+		clientMock.EXPECT().ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return([]*armresources.GenericResourceExpanded{
+			{ID: ptr.To(orphanedPublicIPID)},
+		}, nil),
+		clientMock.EXPECT().GetProvider(gomockinternal.AContext(), "Microsoft.Network").Return(networkProvider, nil),
+		clientMock.EXPECT().BeginDeleteByID(gomockinternal.AContext(), orphanedPublicIPID, "2023-11-01").Return(nil),
+		clientMock.EXPECT().ListByResourceGroup(gomockinternal.AContext(), "my-rg", ownedFilter).Return(nil, nil),
+	)
+
+	s := &Service{
+		Scope:  scopeMock,
+		client: clientMock,
+	}
+
+	// the orphaned public IP is still being deleted, so the deletion is retried.
+	err := s.Delete(context.TODO())
+	var recerr azure.ReconcileError
+	g.Expect(errors.As(err, &recerr)).To(BeTrue())
+	g.Expect(recerr.IsTransient()).To(BeTrue())
+	g.Expect(recerr.RequeueAfter()).To(Equal(15 * time.Second))
+
+	// once the orphaned public IP is gone, there's nothing left to delete.
+	g.Expect(s.Delete(context.TODO())).To(Succeed())
+}
+
+func TestNewestAPIVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(newestAPIVersion(nil)).To(BeEmpty())
+	g.Expect(newestAPIVersion([]*string{ptr.To("2023-01-01"), ptr.To("2024-01-01-preview"), ptr.To("2023-06-01")})).To(Equal("2023-06-01"))
+	g.Expect(newestAPIVersion([]*string{ptr.To("2023-01-01-preview"), ptr.To("2024-01-01-preview")})).To(Equal("2024-01-01-preview"))
+}
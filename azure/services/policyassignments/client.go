@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyassignments
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// policyAssignmentsAPIVersion is the API version of the Microsoft.Authorization/policyAssignments resource type.
+const policyAssignmentsAPIVersion = "2022-06-01"
+
+// azureClient contains the Azure go-sdk Client.
+// Policy assignments are managed as generic resources, as there is no dedicated SDK client for them.
+type azureClient struct {
+	resources      *armresources.Client
+	apiCallTimeout time.Duration
+}
+
+// newClient creates a new policy assignments client from an authorizer.
+func newClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*azureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create policyassignments client options")
+	}
+	factory, err := armresources.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armresources client factory")
+	}
+	return &azureClient{factory.NewClient(), apiCallTimeout}, nil
+}
+
+// Get gets the specified policy assignment.
+func (ac *azureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "policyassignments.azureClient.Get")
+	defer done()
+
+	resp, err := ac.resources.GetByID(ctx, policyAssignmentID(spec.OwnerResourceName(), spec.ResourceName()), policyAssignmentsAPIVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GenericResource, nil
+}
+
+// CreateOrUpdateAsync creates or updates a policy assignment asynchronously.
+// It sends a PUT request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
+func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armresources.ClientCreateOrUpdateByIDResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "policyassignments.azureClient.CreateOrUpdateAsync")
+	defer done()
+
+	assignment, ok := parameters.(armresources.GenericResource)
+	if !ok && parameters != nil {
+		return nil, nil, errors.Errorf("%T is not an armresources.GenericResource", parameters)
+	}
+
+	opts := &armresources.ClientBeginCreateOrUpdateByIDOptions{ResumeToken: resumeToken}
+	poller, err = ac.resources.BeginCreateOrUpdateByID(ctx, policyAssignmentID(spec.OwnerResourceName(), spec.ResourceName()), policyAssignmentsAPIVersion, assignment, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	resp, err := poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// If an error occurs, return the poller.
+		// This means the long-running operation didn't finish in the specified timeout.
+		return nil, poller, err
+	}
+
+	// if the operation completed, return a nil poller
+	return resp.GenericResource, nil, err
+}
+
+// DeleteAsync deletes a policy assignment asynchronously. DeleteAsync sends a DELETE
+// request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
+func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armresources.ClientDeleteByIDResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "policyassignments.azureClient.DeleteAsync")
+	defer done()
+
+	opts := &armresources.ClientBeginDeleteByIDOptions{ResumeToken: resumeToken}
+	poller, err = ac.resources.BeginDeleteByID(ctx, policyAssignmentID(spec.OwnerResourceName(), spec.ResourceName()), policyAssignmentsAPIVersion, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyassignments
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// ServiceName is the name of this service.
+const ServiceName = "policyassignments"
+
+// PolicyAssignmentScope defines the scope interface for a policy assignments service.
+type PolicyAssignmentScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	PolicyAssignmentSpecs() []azure.ResourceSpecGetter
+	AnnotationJSON(string) (map[string]interface{}, error)
+	UpdateAnnotationJSON(string, map[string]interface{}) error
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	Scope PolicyAssignmentScope
+	async.Reconciler
+}
+
+// New creates a new service.
+func New(scope PolicyAssignmentScope) (*Service, error) {
+	client, err := newClient(scope, scope.DefaultedAzureCallTimeout())
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope: scope,
+		Reconciler: async.New[armresources.ClientCreateOrUpdateByIDResponse,
+			armresources.ClientDeleteByIDResponse](scope, client, client),
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return ServiceName
+}
+
+// Reconcile idempotently creates or updates the policy assignments, and deletes the policy assignments which were
+// removed from the spec since they were last applied.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "policyassignments.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	specs := s.Scope.PolicyAssignmentSpecs()
+	lastApplied, err := s.Scope.AnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation)
+	if err != nil {
+		return err
+	}
+	if len(specs) == 0 && len(lastApplied) == 0 {
+		return nil
+	}
+
+	newAnnotation := make(map[string]interface{})
+
+	// We go through the list of PolicyAssignmentSpecs to reconcile each one, independently of the result of the previous one.
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
+	var resultErr error
+	for _, spec := range specs {
+		assignmentSpec := spec.(*PolicyAssignmentSpec)
+		if _, err := s.CreateOrUpdateResource(ctx, assignmentSpec, ServiceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || resultErr == nil {
+				resultErr = err
+			}
+		}
+		newAnnotation[strings.ToLower(policyAssignmentID(assignmentSpec.Scope, assignmentSpec.Name))] = assignmentSpec.PolicyDefinitionID
+	}
+
+	// Policy assignments which were removed from the spec are deleted. They are kept in the annotation until they
+	// are, so that a failed deletion is retried.
+	for _, id := range removedAssignmentIDs(lastApplied, newAnnotation) {
+		spec, err := specFromID(id)
+		if err != nil {
+			return err
+		}
+		if err := s.DeleteResource(ctx, spec, ServiceName); err != nil {
+			newAnnotation[id] = lastApplied[id]
+			if !azure.IsOperationNotDoneError(err) || resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+
+	if err := s.Scope.UpdateAnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation, newAnnotation); err != nil {
+		return err
+	}
+
+	s.Scope.UpdatePutStatus(infrav1.PolicyAssignmentsReadyCondition, ServiceName, resultErr)
+	return resultErr
+}
+
+// Delete deletes the policy assignments.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "policyassignments.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(ServiceName))
+	defer cancel()
+
+	specs := s.Scope.PolicyAssignmentSpecs()
+	if len(specs) == 0 {
+		return nil
+	}
+
+	// We go through the list of PolicyAssignmentSpecs to delete each one, independently of the result of the previous one.
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error deleting) -> operationNotDoneError (i.e. deleting in progress) -> no error (i.e. deleted)
+	var resultErr error
+	for _, spec := range specs {
+		if err := s.DeleteResource(ctx, spec, ServiceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || resultErr == nil {
+				resultErr = err
+			}
+		}
+	}
+
+	s.Scope.UpdateDeleteStatus(infrav1.PolicyAssignmentsReadyCondition, ServiceName, resultErr)
+	return resultErr
+}
+
+// IsManaged returns always returns true as CAPZ does not support BYO policy assignments.
+func (s *Service) IsManaged(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+// removedAssignmentIDs returns the sorted IDs of the last applied policy assignments which are no longer desired.
+func removedAssignmentIDs(lastApplied, desired map[string]interface{}) []string {
+	var ids []string
+	for id := range lastApplied {
+		if _, ok := desired[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// specFromID returns the spec of the policy assignment with the given resource ID, which is sufficient to delete it.
+func specFromID(id string) (*PolicyAssignmentSpec, error) {
+	scope, name, err := parsePolicyAssignmentID(id)
+	if err != nil {
+		return nil, err
+	}
+	scopeID, err := azureutil.ParseResourceID(scope)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse scope of policy assignment %s", id)
+	}
+	return &PolicyAssignmentSpec{
+		Name:          name,
+		ResourceGroup: scopeID.ResourceGroupName,
+		Scope:         scope,
+	}, nil
+}
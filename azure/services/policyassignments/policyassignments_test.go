@@ -0,0 +1,238 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyassignments
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/policyassignments/mock_policyassignments"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var (
+	fakeTagsAssignment = PolicyAssignmentSpec{
+		Name:               "require-tags",
+		ResourceGroup:      "test-rg",
+		Scope:              "/subscriptions/123/resourceGroups/test-rg",
+		PolicyDefinitionID: "/providers/Microsoft.Authorization/policyDefinitions/871b6d14-10aa-478d-b590-94f262ecfa99",
+		PolicyParameters: map[string]apiextensionsv1.JSON{
+			"tagName": {Raw: []byte(`"environment"`)},
+		},
+	}
+	fakeLocationsAssignment = PolicyAssignmentSpec{
+		Name:               "allowed-locations",
+		ResourceGroup:      "test-rg",
+		Scope:              "/subscriptions/123/resourceGroups/test-rg",
+		PolicyDefinitionID: "/providers/Microsoft.Authorization/policyDefinitions/e56962a6-4747-49cd-b67b-bf8b01975c4c",
+	}
+	fakeRemovedAssignment = PolicyAssignmentSpec{
+		Name:          "removed",
+		ResourceGroup: "test-rg",
+		Scope:         "/subscriptions/123/resourcegroups/test-rg",
+	}
+	fakeTagsAssignmentID      = "/subscriptions/123/resourcegroups/test-rg/providers/microsoft.authorization/policyassignments/require-tags"
+	fakeLocationsAssignmentID = "/subscriptions/123/resourcegroups/test-rg/providers/microsoft.authorization/policyassignments/allowed-locations"
+	fakeRemovedAssignmentID   = "/subscriptions/123/resourcegroups/test-rg/providers/microsoft.authorization/policyassignments/removed"
+	errFake                   = errors.New("this is an error")
+	errCreateDone             = azure.NewOperationNotDoneError(&infrav1.Future{})
+)
+
+func TestReconcilePolicyAssignments(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no policy assignments are specified or were applied",
+			expectedError: "",
+			expect: func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PolicyAssignmentSpecs().Return(nil)
+				s.AnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation).Return(map[string]interface{}{}, nil)
+			},
+		},
+		{
+			name:          "create policy assignments succeeds",
+			expectedError: "",
+			expect: func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PolicyAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeTagsAssignment, &fakeLocationsAssignment})
+				s.AnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation).Return(map[string]interface{}{}, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeTagsAssignment, ServiceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeLocationsAssignment, ServiceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation, map[string]interface{}{
+					fakeTagsAssignmentID:      fakeTagsAssignment.PolicyDefinitionID,
+					fakeLocationsAssignmentID: fakeLocationsAssignment.PolicyDefinitionID,
+				}).Return(nil)
+				s.UpdatePutStatus(infrav1.PolicyAssignmentsReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "policy assignment removed from the spec is deleted",
+			expectedError: "",
+			expect: func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PolicyAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeTagsAssignment})
+				s.AnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation).Return(map[string]interface{}{
+					fakeTagsAssignmentID:    fakeTagsAssignment.PolicyDefinitionID,
+					fakeRemovedAssignmentID: fakeLocationsAssignment.PolicyDefinitionID,
+				}, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeTagsAssignment, ServiceName).Return(nil, nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeRemovedAssignment, ServiceName).Return(nil)
+				s.UpdateAnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation, map[string]interface{}{
+					fakeTagsAssignmentID: fakeTagsAssignment.PolicyDefinitionID,
+				}).Return(nil)
+				s.UpdatePutStatus(infrav1.PolicyAssignmentsReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "policy assignment which fails to be deleted is kept in the annotation",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PolicyAssignmentSpecs().Return(nil)
+				s.AnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation).Return(map[string]interface{}{
+					fakeRemovedAssignmentID: fakeLocationsAssignment.PolicyDefinitionID,
+				}, nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeRemovedAssignment, ServiceName).Return(errFake)
+				s.UpdateAnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation, map[string]interface{}{
+					fakeRemovedAssignmentID: fakeLocationsAssignment.PolicyDefinitionID,
+				}).Return(nil)
+				s.UpdatePutStatus(infrav1.PolicyAssignmentsReadyCondition, ServiceName, errFake)
+			},
+		},
+		{
+			name:          "first policy assignment create fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PolicyAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeTagsAssignment, &fakeLocationsAssignment})
+				s.AnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation).Return(map[string]interface{}{}, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeTagsAssignment, ServiceName).Return(nil, errFake)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeLocationsAssignment, ServiceName).Return(nil, errCreateDone)
+				s.UpdateAnnotationJSON(azure.PolicyAssignmentLastAppliedAnnotation, map[string]interface{}{
+					fakeTagsAssignmentID:      fakeTagsAssignment.PolicyDefinitionID,
+					fakeLocationsAssignmentID: fakeLocationsAssignment.PolicyDefinitionID,
+				}).Return(nil)
+				s.UpdatePutStatus(infrav1.PolicyAssignmentsReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_policyassignments.NewMockPolicyAssignmentScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeletePolicyAssignments(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no policy assignments are specified",
+			expectedError: "",
+			expect: func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PolicyAssignmentSpecs().Return(nil)
+			},
+		},
+		{
+			name:          "delete policy assignments succeeds",
+			expectedError: "",
+			expect: func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PolicyAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeTagsAssignment, &fakeLocationsAssignment})
+				r.DeleteResource(gomockinternal.AContext(), &fakeTagsAssignment, ServiceName).Return(nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeLocationsAssignment, ServiceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.PolicyAssignmentsReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "policy assignment delete fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_policyassignments.MockPolicyAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(ServiceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PolicyAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeTagsAssignment, &fakeLocationsAssignment})
+				r.DeleteResource(gomockinternal.AContext(), &fakeTagsAssignment, ServiceName).Return(errFake)
+				r.DeleteResource(gomockinternal.AContext(), &fakeLocationsAssignment, ServiceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.PolicyAssignmentsReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_policyassignments.NewMockPolicyAssignmentScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyassignments
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// policyAssignmentsProvider is the provider segment of policy assignment resource IDs.
+const policyAssignmentsProvider = "/providers/Microsoft.Authorization/policyAssignments/"
+
+// PolicyAssignmentSpec defines the specification for an Azure Policy assignment.
+type PolicyAssignmentSpec struct {
+	Name string
+	// ResourceGroup is the resource group of the assignment's scope.
+	ResourceGroup string
+	// Scope is the Azure resource ID the policy is assigned to.
+	Scope              string
+	PolicyDefinitionID string
+	PolicyParameters   map[string]apiextensionsv1.JSON
+}
+
+// ResourceName returns the name of the policy assignment.
+func (s *PolicyAssignmentSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the assignment's scope.
+func (s *PolicyAssignmentSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName returns the Azure resource ID of the assignment's scope.
+func (s *PolicyAssignmentSpec) OwnerResourceName() string {
+	return s.Scope
+}
+
+// Parameters returns the parameters for the policy assignment.
+func (s *PolicyAssignmentSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	parameters, err := s.parameterValues()
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		existingAssignment, ok := existing.(armresources.GenericResource)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armresources.GenericResource", existing)
+		}
+		if s.isUpToDate(existingAssignment, parameters) {
+			return nil, nil
+		}
+	}
+
+	return armresources.GenericResource{
+		Properties: map[string]interface{}{
+			"policyDefinitionId": s.PolicyDefinitionID,
+			"parameters":         parameters,
+		},
+	}, nil
+}
+
+// parameterValues returns the parameters of the policy assignment in the format of the Azure API, i.e. with each
+// value wrapped in an object.
+func (s *PolicyAssignmentSpec) parameterValues() (map[string]interface{}, error) {
+	parameters := make(map[string]interface{}, len(s.PolicyParameters))
+	for name, raw := range s.PolicyParameters {
+		var value interface{}
+		if err := json.Unmarshal(raw.Raw, &value); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse value of parameter %s of policy assignment %s", name, s.Name)
+		}
+		parameters[name] = map[string]interface{}{"value": value}
+	}
+	return parameters, nil
+}
+
+// isUpToDate returns true if the existing policy assignment assigns the desired policy definition with the desired parameters.
+func (s *PolicyAssignmentSpec) isUpToDate(existing armresources.GenericResource, parameters map[string]interface{}) bool {
+	properties, ok := existing.Properties.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	definitionID, _ := properties["policyDefinitionId"].(string)
+	if !strings.EqualFold(definitionID, s.PolicyDefinitionID) {
+		return false
+	}
+	existingParameters, _ := properties["parameters"].(map[string]interface{})
+	if len(existingParameters) == 0 && len(parameters) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(existingParameters, parameters)
+}
+
+// policyAssignmentID returns the Azure resource ID of the policy assignment with the given name in the given scope.
+func policyAssignmentID(scope, name string) string {
+	return scope + policyAssignmentsProvider + name
+}
+
+// parsePolicyAssignmentID returns the scope and name of the policy assignment with the given resource ID.
+func parsePolicyAssignmentID(id string) (scope, name string, err error) {
+	i := strings.LastIndex(strings.ToLower(id), strings.ToLower(policyAssignmentsProvider))
+	if i < 0 {
+		return "", "", errors.Errorf("%s is not the resource ID of a policy assignment", id)
+	}
+	return id[:i], id[i+len(policyAssignmentsProvider):], nil
+}
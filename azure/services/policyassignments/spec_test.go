@@ -0,0 +1,159 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyassignments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestParameters(t *testing.T) {
+	testcases := []struct {
+		name          string
+		spec          *PolicyAssignmentSpec
+		existing      interface{}
+		expect        func(g *WithT, result interface{})
+		expectedError string
+	}{
+		{
+			name:     "new policy assignment",
+			spec:     &fakeTagsAssignment,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armresources.GenericResource{
+					Properties: map[string]interface{}{
+						"policyDefinitionId": fakeTagsAssignment.PolicyDefinitionID,
+						"parameters": map[string]interface{}{
+							"tagName": map[string]interface{}{"value": "environment"},
+						},
+					},
+				}))
+			},
+		},
+		{
+			name: "existing policy assignment is up to date",
+			spec: &fakeTagsAssignment,
+			existing: armresources.GenericResource{
+				Properties: map[string]interface{}{
+					"policyDefinitionId": fakeTagsAssignment.PolicyDefinitionID,
+					"parameters": map[string]interface{}{
+						"tagName": map[string]interface{}{"value": "environment"},
+					},
+					"enforcementMode": "Default",
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "existing policy assignment without parameters is up to date",
+			spec: &fakeLocationsAssignment,
+			existing: armresources.GenericResource{
+				Properties: map[string]interface{}{
+					"policyDefinitionId": fakeLocationsAssignment.PolicyDefinitionID,
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "existing policy assignment with other parameters",
+			spec: &fakeTagsAssignment,
+			existing: armresources.GenericResource{
+				Properties: map[string]interface{}{
+					"policyDefinitionId": fakeTagsAssignment.PolicyDefinitionID,
+					"parameters": map[string]interface{}{
+						"tagName": map[string]interface{}{"value": "owner"},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armresources.GenericResource{}))
+				properties := result.(armresources.GenericResource).Properties.(map[string]interface{})
+				g.Expect(properties["parameters"]).To(Equal(map[string]interface{}{
+					"tagName": map[string]interface{}{"value": "environment"},
+				}))
+			},
+		},
+		{
+			name: "existing policy assignment assigns another policy definition",
+			spec: &fakeLocationsAssignment,
+			existing: armresources.GenericResource{
+				Properties: map[string]interface{}{
+					"policyDefinitionId": fakeTagsAssignment.PolicyDefinitionID,
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armresources.GenericResource{}))
+				properties := result.(armresources.GenericResource).Properties.(map[string]interface{})
+				g.Expect(properties["policyDefinitionId"]).To(Equal(fakeLocationsAssignment.PolicyDefinitionID))
+			},
+		},
+		{
+			name: "parameter value is not valid JSON",
+			spec: &PolicyAssignmentSpec{
+				Name:               "invalid",
+				PolicyDefinitionID: fakeTagsAssignment.PolicyDefinitionID,
+				PolicyParameters: map[string]apiextensionsv1.JSON{
+					"tagName": {Raw: []byte(`{`)},
+				},
+			},
+			existing:      nil,
+			expectedError: "failed to parse value of parameter tagName of policy assignment invalid: unexpected end of JSON input",
+		},
+		{
+			name:          "existing is not a generic resource",
+			spec:          &fakeTagsAssignment,
+			existing:      "wrong type",
+			expectedError: "string is not an armresources.GenericResource",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				tc.expect(g, result)
+			}
+		})
+	}
+}
+
+func TestParsePolicyAssignmentID(t *testing.T) {
+	g := NewWithT(t)
+
+	scope, name, err := parsePolicyAssignmentID(policyAssignmentID("/subscriptions/123/resourceGroups/test-rg", "require-tags"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(scope).To(Equal("/subscriptions/123/resourceGroups/test-rg"))
+	g.Expect(name).To(Equal("require-tags"))
+
+	_, _, err = parsePolicyAssignmentID("/subscriptions/123/resourceGroups/test-rg")
+	g.Expect(err).To(HaveOccurred())
+}
@@ -51,9 +51,14 @@ func (s RecordSpec) ResourceGroupName() string {
 // Parameters returns the parameters for a record set.
 func (s RecordSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
 	if existing != nil {
-		if _, ok := existing.(armprivatedns.RecordSet); !ok {
+		existingSet, ok := existing.(armprivatedns.RecordSet)
+		if !ok {
 			return nil, errors.Errorf("%T is not an armprivatedns.RecordSet", existing)
 		}
+		if s.isUpToDate(existingSet) {
+			// record set already points at the expected IP
+			return nil, nil
+		}
 	}
 	set := armprivatedns.RecordSet{
 		Properties: &armprivatedns.RecordSetProperties{
@@ -76,3 +81,21 @@ func (s RecordSpec) Parameters(ctx context.Context, existing interface{}) (param
 
 	return set, nil
 }
+
+// isUpToDate returns true if the record set only holds a single record for the IP of the spec.
+func (s RecordSpec) isUpToDate(existing armprivatedns.RecordSet) bool {
+	if existing.Properties == nil {
+		return false
+	}
+	aRecords, aaaaRecords := existing.Properties.ARecords, existing.Properties.AaaaRecords
+	switch converters.GetRecordType(s.Record.IP) {
+	case armprivatedns.RecordTypeA:
+		return len(aaaaRecords) == 0 && len(aRecords) == 1 && aRecords[0] != nil &&
+			ptr.Deref(aRecords[0].IPv4Address, "") == s.Record.IP
+	case armprivatedns.RecordTypeAAAA:
+		return len(aRecords) == 0 && len(aaaaRecords) == 1 && aaaaRecords[0] != nil &&
+			ptr.Deref(aaaaRecords[0].IPv6Address, "") == s.Record.IP
+	default:
+		return false
+	}
+}
@@ -97,6 +97,90 @@ func TestRecordSpec_Parameters(t *testing.T) {
 				}))
 			},
 		},
+		{
+			name:          "existing private dns record pointing at the expected ip",
+			expectedError: "",
+			spec:          recordSpec,
+			existing: armprivatedns.RecordSet{
+				Name: ptr.To("privatednsHostname"),
+				Properties: &armprivatedns.RecordSetProperties{
+					TTL: ptr.To[int64](300),
+					ARecords: []*armprivatedns.ARecord{
+						{
+							IPv4Address: ptr.To("10.0.0.8"),
+						},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name:          "existing private dns record pointing at a previous ip",
+			expectedError: "",
+			spec:          recordSpec,
+			existing: armprivatedns.RecordSet{
+				Name: ptr.To("privatednsHostname"),
+				Properties: &armprivatedns.RecordSetProperties{
+					TTL: ptr.To[int64](300),
+					ARecords: []*armprivatedns.ARecord{
+						{
+							IPv4Address: ptr.To("10.0.0.4"),
+						},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armprivatedns.RecordSet{
+					Properties: &armprivatedns.RecordSetProperties{
+						TTL: ptr.To[int64](300),
+						ARecords: []*armprivatedns.ARecord{
+							{
+								IPv4Address: ptr.To("10.0.0.8"),
+							},
+						},
+					},
+				}))
+			},
+		},
+		{
+			name:          "existing private dns record with an additional ip",
+			expectedError: "",
+			spec:          recordSpec,
+			existing: armprivatedns.RecordSet{
+				Name: ptr.To("privatednsHostname"),
+				Properties: &armprivatedns.RecordSetProperties{
+					TTL: ptr.To[int64](300),
+					ARecords: []*armprivatedns.ARecord{
+						{
+							IPv4Address: ptr.To("10.0.0.8"),
+						},
+						{
+							IPv4Address: ptr.To("10.0.0.4"),
+						},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armprivatedns.RecordSet{
+					Properties: &armprivatedns.RecordSetProperties{
+						TTL: ptr.To[int64](300),
+						ARecords: []*armprivatedns.ARecord{
+							{
+								IPv4Address: ptr.To("10.0.0.8"),
+							},
+						},
+					},
+				}))
+			},
+		},
+		{
+			name:          "existing private dns record of the wrong type",
+			expectedError: "string is not an armprivatedns.RecordSet",
+			spec:          recordSpec,
+			existing:      "not a record set",
+		},
 	}
 
 	for _, tc := range testcases {
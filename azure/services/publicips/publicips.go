@@ -21,6 +21,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
@@ -37,6 +38,7 @@ type PublicIPScope interface {
 	azure.AsyncStatusUpdater
 	azure.ClusterDescriber
 	PublicIPSpecs() []azure.ResourceSpecGetter
+	SetPublicIPFQDN(name, fqdn string)
 }
 
 // Service provides operations on Azure resources.
@@ -75,7 +77,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "publicips.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	specs := s.Scope.PublicIPSpecs()
@@ -88,10 +90,15 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
 	var result error
 	for _, publicIPSpec := range specs {
-		if _, err := s.CreateOrUpdateResource(ctx, publicIPSpec, serviceName); err != nil {
+		publicIP, err := s.CreateOrUpdateResource(ctx, publicIPSpec, serviceName)
+		if err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
+			continue
+		}
+		if fqdn := publicIPFQDN(publicIP); fqdn != "" {
+			s.Scope.SetPublicIPFQDN(publicIPSpec.ResourceName(), fqdn)
 		}
 	}
 
@@ -104,7 +111,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "publicips.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	specs := s.Scope.PublicIPSpecs()
@@ -169,3 +176,12 @@ func (s *Service) isIPManaged(ctx context.Context, spec azure.ResourceSpecGetter
 func (s *Service) IsManaged(ctx context.Context) (bool, error) {
 	return true, nil
 }
+
+// publicIPFQDN returns the fully qualified domain name Azure assigned to a public IP, or an empty string if it has none.
+func publicIPFQDN(publicIP interface{}) string {
+	ip, ok := publicIP.(armnetwork.PublicIPAddress)
+	if !ok || ip.Properties == nil || ip.Properties.DNSSettings == nil {
+		return ""
+	}
+	return ptr.Deref(ip.Properties.DNSSettings.Fqdn, "")
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 )
 
@@ -39,6 +40,8 @@ type PublicIPSpec struct {
 	FailureDomains   []*string
 	AdditionalTags   infrav1.Tags
 	IPTags           []infrav1.IPTag
+	SKU              infrav1.PublicIPSKU
+	Zones            []string
 }
 
 // ResourceName returns the name of the public IP.
@@ -80,6 +83,16 @@ func (s *PublicIPSpec) Parameters(ctx context.Context, existing interface{}) (pa
 		}
 	}
 
+	sku := armnetwork.PublicIPAddressSKUNameStandard
+	zones := s.FailureDomains
+	if s.SKU == infrav1.PublicIPSKUBasic {
+		// Basic SKU public IPs don't support availability zones.
+		sku = armnetwork.PublicIPAddressSKUNameBasic
+		zones = nil
+	} else if len(s.Zones) > 0 {
+		zones = azure.PtrSlice(&s.Zones)
+	}
+
 	return armnetwork.PublicIPAddress{
 		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
 			ClusterName: s.ClusterName,
@@ -87,7 +100,7 @@ func (s *PublicIPSpec) Parameters(ctx context.Context, existing interface{}) (pa
 			Name:        ptr.To(s.Name),
 			Additional:  s.AdditionalTags,
 		})),
-		SKU:              &armnetwork.PublicIPAddressSKU{Name: ptr.To(armnetwork.PublicIPAddressSKUNameStandard)},
+		SKU:              &armnetwork.PublicIPAddressSKU{Name: ptr.To(sku)},
 		Name:             ptr.To(s.Name),
 		Location:         ptr.To(s.Location),
 		ExtendedLocation: converters.ExtendedLocationToNetworkSDK(s.ExtendedLocation),
@@ -97,6 +110,6 @@ func (s *PublicIPSpec) Parameters(ctx context.Context, existing interface{}) (pa
 			DNSSettings:              dnsSettings,
 			IPTags:                   converters.IPTagsToSDK(s.IPTags),
 		},
-		Zones: s.FailureDomains,
+		Zones: zones,
 	}, nil
 }
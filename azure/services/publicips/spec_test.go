@@ -107,6 +107,25 @@ var (
 	}
 )
 
+// newPublicIPWithoutDNS returns the public IP rendered from fakePublicIPSpecWithoutDNS with the given SKU and zones.
+func newPublicIPWithoutDNS(sku armnetwork.PublicIPAddressSKUName, zones []*string) armnetwork.PublicIPAddress {
+	return armnetwork.PublicIPAddress{
+		Name:     ptr.To("my-publicip-2"),
+		SKU:      &armnetwork.PublicIPAddressSKU{Name: ptr.To(sku)},
+		Location: ptr.To("centralIndia"),
+		Tags: map[string]*string{
+			"Name": ptr.To("my-publicip-2"),
+			"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+			"foo": ptr.To("bar"),
+		},
+		Properties: &armnetwork.PublicIPAddressPropertiesFormat{
+			PublicIPAddressVersion:   ptr.To(armnetwork.IPVersionIPv4),
+			PublicIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodStatic),
+		},
+		Zones: zones,
+	}
+}
+
 func TestParameters(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -136,6 +155,40 @@ func TestParameters(t *testing.T) {
 			expected:      fakePublicIPWithoutDNS,
 			expectedError: "",
 		},
+		{
+			name:     "zone-redundant public ipv4 address",
+			existing: nil,
+			spec: func() PublicIPSpec {
+				spec := fakePublicIPSpecWithoutDNS
+				spec.Zones = []string{"1", "2", "3"}
+				return spec
+			}(),
+			expected:      newPublicIPWithoutDNS(armnetwork.PublicIPAddressSKUNameStandard, []*string{ptr.To("1"), ptr.To("2"), ptr.To("3")}),
+			expectedError: "",
+		},
+		{
+			name:     "single-zone public ipv4 address",
+			existing: nil,
+			spec: func() PublicIPSpec {
+				spec := fakePublicIPSpecWithoutDNS
+				spec.SKU = infrav1.PublicIPSKUStandard
+				spec.Zones = []string{"2"}
+				return spec
+			}(),
+			expected:      newPublicIPWithoutDNS(armnetwork.PublicIPAddressSKUNameStandard, []*string{ptr.To("2")}),
+			expectedError: "",
+		},
+		{
+			name:     "basic sku public ipv4 address is not zonal",
+			existing: nil,
+			spec: func() PublicIPSpec {
+				spec := fakePublicIPSpecWithoutDNS
+				spec.SKU = infrav1.PublicIPSKUBasic
+				return spec
+			}(),
+			expected:      newPublicIPWithoutDNS(armnetwork.PublicIPAddressSKUNameBasic, nil),
+			expectedError: "",
+		},
 		{
 			name:          "public ipv6 address with dns",
 			existing:      nil,
@@ -26,7 +26,6 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
-	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/cache/ttllru"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
@@ -46,6 +45,10 @@ type Cache struct {
 	// data is the cached sku information from Azure.
 	// synchronization required if data is cached across reconcile calls, (i.e., refreshed in background as Runnable via mgr.Add(...))
 	data []armcompute.ResourceSKU
+
+	// vmCapabilities memoizes the capabilities of VM sizes converted from data.
+	vmCapabilities   map[string]VMCapabilities
+	vmCapabilitiesMu sync.Mutex
 }
 
 // Cacher describes the ability to get and to add items to cache.
@@ -145,6 +148,35 @@ func (c *Cache) Get(ctx context.Context, name string, kind ResourceType) (SKU, e
 	return SKU{}, azure.WithTerminalError(fmt.Errorf("resource sku with name '%s' and category '%s' not found in location '%s'", name, string(kind), c.location))
 }
 
+// GetVMCapabilities returns the capabilities of a virtual machine size in the cache's location. As caches are
+// shared per location and credentials, converted capabilities are reused across reconciles of the same subscription.
+func (c *Cache) GetVMCapabilities(ctx context.Context, size string) (VMCapabilities, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "resourceskus.Cache.GetVMCapabilities")
+	defer done()
+
+	c.vmCapabilitiesMu.Lock()
+	defer c.vmCapabilitiesMu.Unlock()
+
+	if capabilities, ok := c.vmCapabilities[size]; ok {
+		return capabilities, nil
+	}
+
+	sku, err := c.Get(ctx, size, VirtualMachines)
+	if err != nil {
+		return VMCapabilities{}, err
+	}
+	capabilities, err := SKUToVMCapabilities(sku, c.location)
+	if err != nil {
+		return VMCapabilities{}, errors.Wrapf(err, "failed to get capabilities of VM size %s", size)
+	}
+
+	if c.vmCapabilities == nil {
+		c.vmCapabilities = make(map[string]VMCapabilities)
+	}
+	c.vmCapabilities[size] = capabilities
+	return capabilities, nil
+}
+
 // Map invokes a function over all cached values.
 func (c *Cache) Map(ctx context.Context, mapFn func(sku SKU)) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "resourceskus.Cache.Map")
@@ -175,41 +207,10 @@ func (c *Cache) GetZones(ctx context.Context, location string) ([]string, error)
 	mapFn := func(sku SKU) {
 		// Look for VMs only
 		if sku.ResourceType != nil && strings.EqualFold(*sku.ResourceType, string(VirtualMachines)) {
-			// find matching location
-			for _, locationInfo := range sku.LocationInfo {
-				if !strings.EqualFold(*locationInfo.Location, location) {
-					continue
-				}
-				// Use map for easy deletion and iteration
-				availableZones := make(map[string]bool)
-
-				// add all zones
-				for _, zone := range locationInfo.Zones {
-					availableZones[*zone] = true
-				}
-
-				if sku.Restrictions != nil {
-					for _, restriction := range sku.Restrictions {
-						// Can't deploy anything in this subscription in this location. Bail out.
-						if ptr.Deref(restriction.Type, "") == armcompute.ResourceSKURestrictionsTypeLocation {
-							availableZones = nil
-							break
-						}
-
-						// remove restricted zones
-						for _, restrictedZone := range restriction.RestrictionInfo.Zones {
-							delete(availableZones, *restrictedZone)
-						}
-					}
-				}
-
-				// add to global list, if any exist. it's okay for the final list to be empty.
-				// that means the region may not support AZ yet.
-				for zone := range availableZones {
-					allZones[zone] = true
-				}
-
-				break
+			// add to global list, if any exist. it's okay for the final list to be empty.
+			// that means the region may not support AZ yet.
+			for _, zone := range sku.availableZones(location) {
+				allZones[zone] = true
 			}
 		}
 	}
@@ -237,41 +238,10 @@ func (c *Cache) GetZonesWithVMSize(ctx context.Context, size, location string) (
 	var allZones = make(map[string]bool)
 	mapFn := func(sku SKU) {
 		if sku.Name != nil && strings.EqualFold(*sku.Name, size) && sku.ResourceType != nil && strings.EqualFold(*sku.ResourceType, string(VirtualMachines)) {
-			// find matching location
-			for _, locationInfo := range sku.LocationInfo {
-				if !strings.EqualFold(*locationInfo.Location, location) {
-					continue
-				}
-				// Use map for easy deletion and iteration
-				availableZones := make(map[string]bool)
-
-				// add all zones
-				for _, zone := range locationInfo.Zones {
-					availableZones[*zone] = true
-				}
-
-				if sku.Restrictions != nil {
-					for _, restriction := range sku.Restrictions {
-						// Can't deploy anything in this subscription in this location. Bail out.
-						if ptr.Deref(restriction.Type, "") == armcompute.ResourceSKURestrictionsTypeLocation {
-							availableZones = nil
-							break
-						}
-
-						// remove restricted zones
-						for _, restrictedZone := range restriction.RestrictionInfo.Zones {
-							delete(availableZones, *restrictedZone)
-						}
-					}
-				}
-
-				// add to global list, if any exist. it's okay for the final list to be empty.
-				// that means the region may not support AZ yet.
-				for zone := range availableZones {
-					allZones[zone] = true
-				}
-
-				break
+			// add to global list, if any exist. it's okay for the final list to be empty.
+			// that means the region may not support AZ yet.
+			for _, zone := range sku.availableZones(location) {
+				allZones[zone] = true
 			}
 		}
 	}
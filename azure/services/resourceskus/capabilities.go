@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceskus
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// VMCapabilities describes the capabilities of a virtual machine size in a location
+// which are needed to validate a machine spec before creating it.
+type VMCapabilities struct {
+	// VCPUs is the number of vCPUs of the VM size.
+	VCPUs int64
+	// MemoryGB is the amount of memory of the VM size in GB.
+	MemoryGB float64
+	// EphemeralOSDiskSupported is true if the VM size supports ephemeral OS disks.
+	EphemeralOSDiskSupported bool
+	// MaxEphemeralDiskSizeGB is the size in GB of the largest ephemeral OS disk which can be
+	// placed on the cache or resource disk of the VM size. It is 0 if ephemeral OS disks are not supported.
+	MaxEphemeralDiskSizeGB int64
+	// AcceleratedNetworkingEnabled is true if the VM size supports accelerated networking.
+	AcceleratedNetworkingEnabled bool
+	// Zones are the availability zones of the location in which the VM size can be deployed,
+	// in lexical order. It is empty if the location doesn't support zones for the VM size.
+	Zones []string
+}
+
+// SKUToVMCapabilities converts a virtual machine resource SKU to the capabilities of the VM size in the given location.
+func SKUToVMCapabilities(sku SKU, location string) (VMCapabilities, error) {
+	vCPUs, err := sku.int64Capability(VCPUs)
+	if err != nil {
+		return VMCapabilities{}, err
+	}
+	memoryGB, err := sku.float64Capability(MemoryGB)
+	if err != nil {
+		return VMCapabilities{}, err
+	}
+
+	capabilities := VMCapabilities{
+		VCPUs:                        vCPUs,
+		MemoryGB:                     memoryGB,
+		EphemeralOSDiskSupported:     sku.HasCapability(EphemeralOSDisk),
+		AcceleratedNetworkingEnabled: sku.HasCapability(AcceleratedNetworking),
+		Zones:                        sku.availableZones(location),
+	}
+
+	if capabilities.EphemeralOSDiskSupported {
+		cachedDiskBytes, err := sku.int64Capability(CachedDiskBytes)
+		if err != nil {
+			return VMCapabilities{}, err
+		}
+		resourceVolumeMB, err := sku.int64Capability(MaxResourceVolumeMB)
+		if err != nil {
+			return VMCapabilities{}, err
+		}
+		capabilities.MaxEphemeralDiskSizeGB = cachedDiskBytes / (1024 * 1024 * 1024)
+		if resourceVolumeGB := resourceVolumeMB / 1024; resourceVolumeGB > capabilities.MaxEphemeralDiskSizeGB {
+			capabilities.MaxEphemeralDiskSizeGB = resourceVolumeGB
+		}
+	}
+
+	return capabilities, nil
+}
+
+// int64Capability returns the integer value of the named capability, or 0 if the SKU doesn't expose it.
+func (s SKU) int64Capability(name string) (int64, error) {
+	value, ok := s.GetCapability(name)
+	if !ok {
+		return 0, nil
+	}
+	intVal, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse capability %s value '%s' as int64", name, value)
+	}
+	return intVal, nil
+}
+
+// float64Capability returns the decimal value of the named capability, or 0 if the SKU doesn't expose it.
+func (s SKU) float64Capability(name string) (float64, error) {
+	value, ok := s.GetCapability(name)
+	if !ok {
+		return 0, nil
+	}
+	floatVal, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse capability %s value '%s' as float64", name, value)
+	}
+	return floatVal, nil
+}
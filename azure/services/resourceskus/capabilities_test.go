@@ -0,0 +1,195 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceskus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+)
+
+func capability(name, value string) *armcompute.ResourceSKUCapabilities {
+	return &armcompute.ResourceSKUCapabilities{Name: ptr.To(name), Value: ptr.To(value)}
+}
+
+// standardD4sV3 is a trimmed down resource SKUs API response for a Standard_D4s_v3 VM size.
+var standardD4sV3 = armcompute.ResourceSKU{
+	Name:         ptr.To("Standard_D4s_v3"),
+	ResourceType: ptr.To(string(VirtualMachines)),
+	Tier:         ptr.To("Standard"),
+	Size:         ptr.To("D4s_v3"),
+	Family:       ptr.To("standardDSv3Family"),
+	Locations:    []*string{ptr.To("eastus")},
+	LocationInfo: []*armcompute.ResourceSKULocationInfo{
+		{
+			Location: ptr.To("eastus"),
+			Zones:    []*string{ptr.To("3"), ptr.To("1"), ptr.To("2")},
+		},
+	},
+	Restrictions: []*armcompute.ResourceSKURestrictions{
+		{
+			Type:   ptr.To(armcompute.ResourceSKURestrictionsTypeZone),
+			Values: []*string{ptr.To("eastus")},
+			RestrictionInfo: &armcompute.ResourceSKURestrictionInfo{
+				Locations: []*string{ptr.To("eastus")},
+				Zones:     []*string{ptr.To("2")},
+			},
+			ReasonCode: ptr.To(armcompute.ResourceSKURestrictionsReasonCodeNotAvailableForSubscription),
+		},
+	},
+	Capabilities: []*armcompute.ResourceSKUCapabilities{
+		capability("MaxResourceVolumeMB", "32768"),
+		capability("OSVhdSizeMB", "1047552"),
+		capability(VCPUs, "4"),
+		capability("MemoryPreservingMaintenanceSupported", "True"),
+		capability("HyperVGenerations", "V1,V2"),
+		capability(MemoryGB, "16"),
+		capability("MaxDataDiskCount", "8"),
+		capability(CPUArchitectureType, "x64"),
+		capability(EphemeralOSDisk, "True"),
+		capability(EncryptionAtHost, "True"),
+		capability("CachedDiskBytes", "107374182400"),
+		capability(AcceleratedNetworking, "True"),
+		capability("RdmaEnabled", "False"),
+		capability("MaxNetworkInterfaces", "2"),
+	},
+}
+
+func TestSKUToVMCapabilities(t *testing.T) {
+	cases := map[string]struct {
+		sku      armcompute.ResourceSKU
+		location string
+		want     VMCapabilities
+		err      string
+	}{
+		"should convert a representative sku": {
+			sku:      standardD4sV3,
+			location: "eastus",
+			want: VMCapabilities{
+				VCPUs:                        4,
+				MemoryGB:                     16,
+				EphemeralOSDiskSupported:     true,
+				MaxEphemeralDiskSizeGB:       100,
+				AcceleratedNetworkingEnabled: true,
+				Zones:                        []string{"1", "3"},
+			},
+		},
+		"should not have zones in another location": {
+			sku:      standardD4sV3,
+			location: "westus",
+			want: VMCapabilities{
+				VCPUs:                        4,
+				MemoryGB:                     16,
+				EphemeralOSDiskSupported:     true,
+				MaxEphemeralDiskSizeGB:       100,
+				AcceleratedNetworkingEnabled: true,
+			},
+		},
+		"should parse fractional memory and no ephemeral os disk support": {
+			sku: armcompute.ResourceSKU{
+				Name: ptr.To("Standard_A1_v2"),
+				Capabilities: []*armcompute.ResourceSKUCapabilities{
+					capability(VCPUs, "1"),
+					capability(MemoryGB, "2.5"),
+					capability("MaxResourceVolumeMB", "10240"),
+					capability(EphemeralOSDisk, "False"),
+					capability(AcceleratedNetworking, "False"),
+				},
+			},
+			location: "eastus",
+			want: VMCapabilities{
+				VCPUs:    1,
+				MemoryGB: 2.5,
+			},
+		},
+		"should use the resource disk size if it is larger than the cache disk": {
+			sku: armcompute.ResourceSKU{
+				Name: ptr.To("Standard_D2ds_v5"),
+				Capabilities: []*armcompute.ResourceSKUCapabilities{
+					capability(EphemeralOSDisk, "True"),
+					capability("MaxResourceVolumeMB", "76800"),
+				},
+			},
+			location: "eastus",
+			want: VMCapabilities{
+				EphemeralOSDiskSupported: true,
+				MaxEphemeralDiskSizeGB:   75,
+			},
+		},
+		"should fail to parse an invalid numeric capability": {
+			sku: armcompute.ResourceSKU{
+				Name: ptr.To("invalid"),
+				Capabilities: []*armcompute.ResourceSKUCapabilities{
+					capability(VCPUs, "four"),
+				},
+			},
+			location: "eastus",
+			err:      "failed to parse capability vCPUs value 'four' as int64: strconv.ParseInt: parsing \"four\": invalid syntax",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := SKUToVMCapabilities(SKU(tc.sku), tc.location)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("did not expect error: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf(diff)
+			}
+		})
+	}
+}
+
+func TestCacheGetVMCapabilities(t *testing.T) {
+	cache := NewStaticCache([]armcompute.ResourceSKU{standardD4sV3}, "eastus")
+
+	got, err := cache.GetVMCapabilities(context.Background(), "Standard_D4s_v3")
+	if err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+	if got.VCPUs != 4 || !got.AcceleratedNetworkingEnabled {
+		t.Fatalf("unexpected capabilities %+v", got)
+	}
+
+	// capabilities are served from the cache once converted
+	cache.data = []armcompute.ResourceSKU{}
+	cached, err := cache.GetVMCapabilities(context.Background(), "Standard_D4s_v3")
+	if err != nil {
+		t.Fatalf("did not expect error: %v", err)
+	}
+	if diff := cmp.Diff(cached, got); diff != "" {
+		t.Fatalf(diff)
+	}
+
+	if _, err := cache.GetVMCapabilities(context.Background(), "Standard_D8s_v3"); err == nil {
+		t.Fatalf("expected an error for an unknown VM size")
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package resourceskus
 
 import (
+	"sort"
 	"strconv"
 	"strings"
 
@@ -69,12 +70,18 @@ const (
 	MaximumPlatformFaultDomainCount = "MaximumPlatformFaultDomainCount"
 	// UltraSSDAvailable identifies the capability for the support of UltraSSD data disks.
 	UltraSSDAvailable = "UltraSSDAvailable"
+	// MaxWriteAcceleratorDisksAllowed identifies the maximum number of data disks with Write Accelerator of a VM size.
+	MaxWriteAcceleratorDisksAllowed = "MaxWriteAcceleratorDisksAllowed"
 	// TrustedLaunchDisabled identifies the absence of the trusted launch capability.
 	TrustedLaunchDisabled = "TrustedLaunchDisabled"
 	// ConfidentialComputingType identifies the capability for confidentical computing.
 	ConfidentialComputingType = "ConfidentialComputingType"
 	// CPUArchitectureType identifies the capability for cpu architecture.
 	CPUArchitectureType = "CpuArchitectureType"
+	// MaxResourceVolumeMB identifies the capability for the size of the temporary resource disk.
+	MaxResourceVolumeMB = "MaxResourceVolumeMB"
+	// CachedDiskBytes identifies the capability for the size of the cache disk.
+	CachedDiskBytes = "CachedDiskBytes"
 )
 
 // HasCapability return true for a capability which can be either
@@ -138,6 +145,44 @@ func (s SKU) GetCapability(name string) (string, bool) {
 	return "", false
 }
 
+// availableZones returns the zones of the given location into which the resource may deploy,
+// excluding zones restricted for the subscription. It returns nil when the resource is restricted
+// in the whole location.
+func (s SKU) availableZones(location string) []string {
+	for _, locationInfo := range s.LocationInfo {
+		if !strings.EqualFold(*locationInfo.Location, location) {
+			continue
+		}
+		// Use map for easy deletion and iteration
+		availableZones := make(map[string]bool)
+
+		// add all zones
+		for _, zone := range locationInfo.Zones {
+			availableZones[*zone] = true
+		}
+
+		for _, restriction := range s.Restrictions {
+			// Can't deploy anything in this subscription in this location. Bail out.
+			if ptr.Deref(restriction.Type, "") == armcompute.ResourceSKURestrictionsTypeLocation {
+				return nil
+			}
+
+			// remove restricted zones
+			for _, restrictedZone := range restriction.RestrictionInfo.Zones {
+				delete(availableZones, *restrictedZone)
+			}
+		}
+
+		zones := make([]string, 0, len(availableZones))
+		for zone := range availableZones {
+			zones = append(zones, zone)
+		}
+		sort.Strings(zones)
+		return zones
+	}
+	return nil
+}
+
 // HasLocationCapability returns true if the provided resource supports the location capability.
 func (s SKU) HasLocationCapability(capabilityName, location, zone string) bool {
 	if s.LocationInfo == nil {
@@ -59,6 +59,34 @@ func (m *MockRoleAssignmentScope) EXPECT() *MockRoleAssignmentScopeMockRecorder
 	return m.recorder
 }
 
+// AzureServiceReconcileTimeout mocks base method.
+func (m *MockRoleAssignmentScope) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AzureServiceReconcileTimeout", serviceName)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// AzureServiceReconcileTimeout indicates an expected call of AzureServiceReconcileTimeout.
+func (mr *MockRoleAssignmentScopeMockRecorder) AzureServiceReconcileTimeout(serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AzureServiceReconcileTimeout", reflect.TypeOf((*MockRoleAssignmentScope)(nil).AzureServiceReconcileTimeout), serviceName)
+}
+
+// BackoffReconcilerRequeue mocks base method.
+func (m *MockRoleAssignmentScope) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackoffReconcilerRequeue", serviceName, key)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// BackoffReconcilerRequeue indicates an expected call of BackoffReconcilerRequeue.
+func (mr *MockRoleAssignmentScopeMockRecorder) BackoffReconcilerRequeue(serviceName, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackoffReconcilerRequeue", reflect.TypeOf((*MockRoleAssignmentScope)(nil).BackoffReconcilerRequeue), serviceName, key)
+}
+
 // BaseURI mocks base method.
 func (m *MockRoleAssignmentScope) BaseURI() string {
 	m.ctrl.T.Helper()
@@ -129,20 +157,6 @@ func (mr *MockRoleAssignmentScopeMockRecorder) DefaultedAzureCallTimeout() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureCallTimeout", reflect.TypeOf((*MockRoleAssignmentScope)(nil).DefaultedAzureCallTimeout))
 }
 
-// DefaultedAzureServiceReconcileTimeout mocks base method.
-func (m *MockRoleAssignmentScope) DefaultedAzureServiceReconcileTimeout() time.Duration {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DefaultedAzureServiceReconcileTimeout")
-	ret0, _ := ret[0].(time.Duration)
-	return ret0
-}
-
-// DefaultedAzureServiceReconcileTimeout indicates an expected call of DefaultedAzureServiceReconcileTimeout.
-func (mr *MockRoleAssignmentScopeMockRecorder) DefaultedAzureServiceReconcileTimeout() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultedAzureServiceReconcileTimeout", reflect.TypeOf((*MockRoleAssignmentScope)(nil).DefaultedAzureServiceReconcileTimeout))
-}
-
 // DefaultedReconcilerRequeue mocks base method.
 func (m *MockRoleAssignmentScope) DefaultedReconcilerRequeue() time.Duration {
 	m.ctrl.T.Helper()
@@ -225,18 +239,30 @@ func (mr *MockRoleAssignmentScopeMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockRoleAssignmentScope)(nil).Name))
 }
 
-// ResourceGroup mocks base method.
-func (m *MockRoleAssignmentScope) ResourceGroup() string {
+// NodeResourceGroup mocks base method.
+func (m *MockRoleAssignmentScope) NodeResourceGroup() string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResourceGroup")
+	ret := m.ctrl.Call(m, "NodeResourceGroup")
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// ResourceGroup indicates an expected call of ResourceGroup.
-func (mr *MockRoleAssignmentScopeMockRecorder) ResourceGroup() *gomock.Call {
+// NodeResourceGroup indicates an expected call of NodeResourceGroup.
+func (mr *MockRoleAssignmentScopeMockRecorder) NodeResourceGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeResourceGroup", reflect.TypeOf((*MockRoleAssignmentScope)(nil).NodeResourceGroup))
+}
+
+// ResetReconcilerRequeueBackoff mocks base method.
+func (m *MockRoleAssignmentScope) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ResetReconcilerRequeueBackoff", serviceName, key)
+}
+
+// ResetReconcilerRequeueBackoff indicates an expected call of ResetReconcilerRequeueBackoff.
+func (mr *MockRoleAssignmentScopeMockRecorder) ResetReconcilerRequeueBackoff(serviceName, key any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceGroup", reflect.TypeOf((*MockRoleAssignmentScope)(nil).ResourceGroup))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetReconcilerRequeueBackoff", reflect.TypeOf((*MockRoleAssignmentScope)(nil).ResetReconcilerRequeueBackoff), serviceName, key)
 }
 
 // RoleAssignmentResourceType mocks base method.
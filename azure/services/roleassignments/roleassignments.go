@@ -39,7 +39,7 @@ type RoleAssignmentScope interface {
 	HasSystemAssignedIdentity() bool
 	RoleAssignmentResourceType() string
 	Name() string
-	ResourceGroup() string
+	NodeResourceGroup() string
 }
 
 // Service provides operations on Azure resources.
@@ -83,7 +83,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "roleassignments.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	log.V(2).Info("reconciling role assignment")
@@ -136,7 +136,7 @@ func (s *Service) getVMPrincipalID(ctx context.Context) (*string, error) {
 	log.V(2).Info("fetching principal ID for VM")
 	spec := &virtualmachines.VMSpec{
 		Name:          s.Scope.Name(),
-		ResourceGroup: s.Scope.ResourceGroup(),
+		ResourceGroup: s.Scope.NodeResourceGroup(),
 	}
 
 	resultVMIface, err := s.virtualMachinesGetter.Get(ctx, spec)
@@ -157,7 +157,7 @@ func (s *Service) getVMSSPrincipalID(ctx context.Context) (*string, error) {
 	log.V(2).Info("fetching principal ID for VMSS")
 	spec := &scalesets.ScaleSetSpec{
 		Name:          s.Scope.Name(),
-		ResourceGroup: s.Scope.ResourceGroup(),
+		ResourceGroup: s.Scope.NodeResourceGroup(),
 	}
 
 	resultVMSSIface, err := s.virtualMachineScaleSetGetter.Get(ctx, spec)
@@ -86,9 +86,9 @@ func TestReconcileRoleAssignmentsVM(t *testing.T) {
 			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
 				m *mock_async.MockGetterMockRecorder,
 				r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.SubscriptionID().AnyTimes().Return("12345")
-				s.ResourceGroup().Return("my-rg")
+				s.NodeResourceGroup().Return("my-rg")
 				s.Name().Return(fakeRoleAssignment1.MachineName)
 				s.HasSystemAssignedIdentity().Return(true)
 				s.RoleAssignmentResourceType().Return("VirtualMachine")
@@ -107,9 +107,9 @@ func TestReconcileRoleAssignmentsVM(t *testing.T) {
 			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
 				m *mock_async.MockGetterMockRecorder,
 				r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.SubscriptionID().AnyTimes().Return("12345")
-				s.ResourceGroup().Return("my-rg")
+				s.NodeResourceGroup().Return("my-rg")
 				s.Name().Return(fakeRoleAssignment1.MachineName)
 				s.HasSystemAssignedIdentity().Return(true)
 				s.RoleAssignmentResourceType().Return("VirtualMachine")
@@ -122,9 +122,9 @@ func TestReconcileRoleAssignmentsVM(t *testing.T) {
 			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
 				m *mock_async.MockGetterMockRecorder,
 				r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.SubscriptionID().AnyTimes().Return("12345")
-				s.ResourceGroup().Return("my-rg")
+				s.NodeResourceGroup().Return("my-rg")
 				s.Name().Return(fakeRoleAssignment1.MachineName)
 				s.RoleAssignmentResourceType().Return("VirtualMachine")
 				s.HasSystemAssignedIdentity().Return(true)
@@ -183,11 +183,11 @@ func TestReconcileRoleAssignmentsVMSS(t *testing.T) {
 			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
 				r *mock_async.MockReconcilerMockRecorder,
 				mvmss *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.HasSystemAssignedIdentity().Return(true)
 				s.RoleAssignmentSpecs(&fakePrincipalID).Return(fakeRoleAssignmentSpecs[1:2])
 				s.RoleAssignmentResourceType().Return(azure.VirtualMachineScaleSet)
-				s.ResourceGroup().Return("my-rg")
+				s.NodeResourceGroup().Return("my-rg")
 				s.Name().Return("test-vmss")
 				mvmss.Get(gomockinternal.AContext(), &fakeVMSSSpec).Return(armcompute.VirtualMachineScaleSet{
 					Identity: &armcompute.VirtualMachineScaleSetIdentity{
@@ -203,9 +203,9 @@ func TestReconcileRoleAssignmentsVMSS(t *testing.T) {
 			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
 				r *mock_async.MockReconcilerMockRecorder,
 				mvmss *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.RoleAssignmentResourceType().Return(azure.VirtualMachineScaleSet)
-				s.ResourceGroup().Return("my-rg")
+				s.NodeResourceGroup().Return("my-rg")
 				s.Name().Return("test-vmss")
 				s.HasSystemAssignedIdentity().Return(true)
 				mvmss.Get(gomockinternal.AContext(), &fakeVMSSSpec).Return(armcompute.VirtualMachineScaleSet{},
@@ -218,11 +218,11 @@ func TestReconcileRoleAssignmentsVMSS(t *testing.T) {
 			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
 				r *mock_async.MockReconcilerMockRecorder,
 				mvmss *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.HasSystemAssignedIdentity().Return(true)
 				s.RoleAssignmentSpecs(&fakePrincipalID).Return(fakeRoleAssignmentSpecs[1:2])
 				s.RoleAssignmentResourceType().Return(azure.VirtualMachineScaleSet)
-				s.ResourceGroup().Return("my-rg")
+				s.NodeResourceGroup().Return("my-rg")
 				s.Name().Return("test-vmss")
 				mvmss.Get(gomockinternal.AContext(), &fakeVMSSSpec).Return(armcompute.VirtualMachineScaleSet{
 					Identity: &armcompute.VirtualMachineScaleSetIdentity{
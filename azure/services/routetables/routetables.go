@@ -35,6 +35,7 @@ type RouteTableScope interface {
 	azure.AsyncStatusUpdater
 	RouteTableSpecs() []azure.ResourceSpecGetter
 	IsVnetManaged() bool
+	UpdateAnnotationJSON(string, map[string]interface{}) error
 }
 
 // Service provides operations on azure resources.
@@ -66,7 +67,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "routetables.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	var resErr error
@@ -83,15 +84,42 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return nil
 	}
 
+	newAnnotation := make(map[string]interface{})
+
 	// We go through the list of route tables to reconcile each one, independently of the result of the previous one.
 	// If multiple errors occur, we return the most pressing one.
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
-	for _, rtSpec := range specs {
-		if _, err := s.CreateOrUpdateResource(ctx, rtSpec, serviceName); err != nil {
+	for _, resourceSpec := range specs {
+		rtSpec := resourceSpec.(*RouteTableSpec)
+		currentAnnotation := make(map[string]string)
+
+		_, err := s.CreateOrUpdateResource(ctx, rtSpec, serviceName)
+		if err != nil {
 			if !azure.IsOperationNotDoneError(err) || resErr == nil {
 				resErr = err
 			}
 		}
+
+		// Routes which were removed from the spec stay tracked until the route table is updated, so that their
+		// removal is retried.
+		if err != nil {
+			for name, addressPrefix := range rtSpec.LastAppliedRoutes {
+				if addressPrefix, ok := addressPrefix.(string); ok {
+					currentAnnotation[name] = addressPrefix
+				}
+			}
+		}
+		for _, route := range rtSpec.Routes {
+			currentAnnotation[route.Name] = route.AddressPrefix
+		}
+
+		if len(currentAnnotation) > 0 {
+			newAnnotation[rtSpec.Name] = currentAnnotation
+		}
+	}
+
+	if err := s.Scope.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, newAnnotation); err != nil {
+		return err
 	}
 
 	s.Scope.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, resErr)
@@ -103,7 +131,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "routetables.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	// Only delete the route tables if their lifecycle is managed by this controller.
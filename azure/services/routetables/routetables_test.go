@@ -32,6 +32,36 @@ import (
 )
 
 var (
+	fakeRTWithRoutes = RouteTableSpec{
+		Name:          "test-rt-1",
+		ResourceGroup: "test-rg",
+		Location:      "fake-location",
+		ClusterName:   "test-cluster",
+		Routes: []infrav1.Route{
+			{
+				Name:          "to-internet",
+				AddressPrefix: "10.2.0.0/16",
+				NextHopType:   infrav1.RouteNextHopTypeInternet,
+			},
+		},
+	}
+	fakeRTWithRemovedRoute = RouteTableSpec{
+		Name:          "test-rt-1",
+		ResourceGroup: "test-rg",
+		Location:      "fake-location",
+		ClusterName:   "test-cluster",
+		Routes: []infrav1.Route{
+			{
+				Name:          "to-internet",
+				AddressPrefix: "10.2.0.0/16",
+				NextHopType:   infrav1.RouteNextHopTypeInternet,
+			},
+		},
+		LastAppliedRoutes: map[string]interface{}{
+			"to-internet": "10.2.0.0/16",
+			"to-removed":  "10.3.0.0/16",
+		},
+	}
 	fakeRT = RouteTableSpec{
 		Name:          "test-rt-1",
 		ResourceGroup: "test-rg",
@@ -62,7 +92,7 @@ func TestReconcileRouteTables(t *testing.T) {
 			name:          "noop if no route table specs are found",
 			expectedError: "",
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{})
 			},
@@ -71,23 +101,53 @@ func TestReconcileRouteTables(t *testing.T) {
 			name:          "create multiple route tables succeeds",
 			expectedError: "",
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT2, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{})
 				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, nil)
 			},
 		},
+		{
+			name:          "records the last applied routes",
+			expectedError: "",
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRTWithRoutes})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRTWithRoutes, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{
+					"test-rt-1": map[string]string{"to-internet": "10.2.0.0/16"},
+				})
+				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "keeps the last applied routes when the update fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRTWithRemovedRoute})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRTWithRemovedRoute, serviceName).Return(nil, errFake)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{
+					"test-rt-1": map[string]string{"to-internet": "10.2.0.0/16", "to-removed": "10.3.0.0/16"},
+				})
+				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, errFake)
+			},
+		},
 		{
 			name:          "first route table create fails",
 			expectedError: errFake.Error(),
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, errFake)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT2, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{})
 				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, errFake)
 			},
 		},
@@ -95,11 +155,12 @@ func TestReconcileRouteTables(t *testing.T) {
 			name:          "second route table create not done",
 			expectedError: errFake.Error(),
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, errFake)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT2, serviceName).Return(nil, notDoneError)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{})
 				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, errFake)
 			},
 		},
@@ -107,7 +168,7 @@ func TestReconcileRouteTables(t *testing.T) {
 			name:          "noop if vnet is not managed",
 			expectedError: "",
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(false)
 			},
 		},
@@ -152,7 +213,7 @@ func TestDeleteRouteTable(t *testing.T) {
 			name:          "noop if no route table specs are found",
 			expectedError: "",
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{})
 			},
@@ -161,7 +222,7 @@ func TestDeleteRouteTable(t *testing.T) {
 			name:          "delete multiple route tables succeeds",
 			expectedError: "",
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.DeleteResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil)
@@ -173,7 +234,7 @@ func TestDeleteRouteTable(t *testing.T) {
 			name:          "first route table delete fails",
 			expectedError: errFake.Error(),
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.DeleteResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(errFake)
@@ -185,7 +246,7 @@ func TestDeleteRouteTable(t *testing.T) {
 			name:          "second route table delete not done",
 			expectedError: errFake.Error(),
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.DeleteResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(errFake)
@@ -197,7 +258,7 @@ func TestDeleteRouteTable(t *testing.T) {
 			name:          "noop if vnet is not managed",
 			expectedError: "",
 			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(false)
 			},
 		},
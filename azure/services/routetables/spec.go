@@ -18,6 +18,7 @@ package routetables
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
@@ -33,8 +34,20 @@ type RouteTableSpec struct {
 	Location       string
 	ClusterName    string
 	AdditionalTags infrav1.Tags
+	// DefaultRouteNextHopIP is the IP of a virtual appliance, such as an Azure Firewall, that
+	// the default route (0.0.0.0/0) of the route table should point to.
+	DefaultRouteNextHopIP string
+	// Routes are the routes of the route table declared in the spec.
+	Routes []infrav1.Route
+	// LastAppliedRoutes are the names of the routes CAPZ applied to the route table in the previous reconcile.
+	LastAppliedRoutes map[string]interface{}
 }
 
+const (
+	defaultRouteName          = "default"
+	defaultRouteAddressPrefix = "0.0.0.0/0"
+)
+
 // ResourceName returns the name of the route table.
 func (s *RouteTableSpec) ResourceName() string {
 	return s.Name
@@ -53,21 +66,128 @@ func (s *RouteTableSpec) OwnerResourceName() string {
 // Parameters returns the parameters for the route table.
 func (s *RouteTableSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
 	if existing != nil {
-		if _, ok := existing.(armnetwork.RouteTable); !ok {
+		existingRT, ok := existing.(armnetwork.RouteTable)
+		if !ok {
 			return nil, errors.Errorf("%T is not an armnetwork.RouteTable", existing)
 		}
 		// route table already exists
-		// currently don't support specifying your own routes via spec.
-		return nil, nil
+		if existingRT.Properties == nil {
+			existingRT.Properties = &armnetwork.RouteTablePropertiesFormat{}
+		}
+		routes, update := s.mergeRoutes(existingRT.Properties.Routes)
+		// The tags of a route table owned by the cluster are kept up to date, tags added outside of CAPZ are kept.
+		var changedTags infrav1.Tags
+		tags := converters.MapToTags(existingRT.Tags)
+		if tags.HasOwned(s.ClusterName) {
+			changedTags = s.tags().Difference(tags)
+		}
+		if !update && len(changedTags) == 0 {
+			return nil, nil
+		}
+		existingRT.Properties.Routes = routes
+		if len(changedTags) > 0 {
+			tags.Merge(changedTags)
+			existingRT.Tags = converters.TagsToMap(tags)
+		}
+		return existingRT, nil
 	}
-	return armnetwork.RouteTable{
+	rt := armnetwork.RouteTable{
 		Location:   ptr.To(s.Location),
 		Properties: &armnetwork.RouteTablePropertiesFormat{},
-		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
-			ClusterName: s.ClusterName,
-			Lifecycle:   infrav1.ResourceLifecycleOwned,
-			Name:        ptr.To(s.Name),
-			Additional:  s.AdditionalTags,
-		})),
-	}, nil
+		Tags:       converters.TagsToMap(s.tags()),
+	}
+	if routes := s.desiredRoutes(); len(routes) > 0 {
+		rt.Properties.Routes = routes
+	}
+	return rt, nil
+}
+
+// tags returns the tags of the route table.
+func (s *RouteTableSpec) tags() infrav1.Tags {
+	return infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.ClusterName,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        ptr.To(s.Name),
+		Additional:  s.AdditionalTags,
+	})
+}
+
+// desiredRoutes returns the default route to DefaultRouteNextHopIP, if set, followed by the routes of the spec.
+func (s *RouteTableSpec) desiredRoutes() []*armnetwork.Route {
+	routes := make([]*armnetwork.Route, 0, len(s.Routes)+1)
+	if s.DefaultRouteNextHopIP != "" {
+		routes = append(routes, s.defaultRoute())
+	}
+	for _, route := range s.Routes {
+		routes = append(routes, converters.RouteToSDK(route))
+	}
+	return routes
+}
+
+// mergeRoutes merges the desired routes with the existing routes of the route table. Existing routes with the same
+// name as a desired route are replaced, routes previously applied by CAPZ which are no longer in the spec are removed,
+// and all other routes are kept as they were added outside of CAPZ. It returns whether the merged routes differ from
+// the existing routes.
+func (s *RouteTableSpec) mergeRoutes(existingRoutes []*armnetwork.Route) ([]*armnetwork.Route, bool) {
+	desiredRoutes := s.desiredRoutes()
+	routes := make([]*armnetwork.Route, 0, len(desiredRoutes)+len(existingRoutes))
+	desired := make(map[string]bool, len(desiredRoutes))
+	update := false
+
+	for _, route := range desiredRoutes {
+		if !routeUpToDate(existingRoutes, route) {
+			update = true
+		}
+		routes = append(routes, route)
+		desired[strings.ToLower(ptr.Deref(route.Name, ""))] = true
+	}
+
+	for _, existingRoute := range existingRoutes {
+		if existingRoute == nil {
+			continue
+		}
+		name := ptr.Deref(existingRoute.Name, "")
+		if desired[strings.ToLower(name)] {
+			// The route is replaced by the desired route.
+			continue
+		}
+		if _, tracked := s.LastAppliedRoutes[name]; tracked {
+			// The route was previously applied by CAPZ and has been removed from the spec.
+			update = true
+			continue
+		}
+		// The route was added outside of CAPZ.
+		routes = append(routes, existingRoute)
+	}
+
+	return routes, update
+}
+
+// defaultRoute returns a route sending all traffic to DefaultRouteNextHopIP.
+func (s *RouteTableSpec) defaultRoute() *armnetwork.Route {
+	return &armnetwork.Route{
+		Name: ptr.To(defaultRouteName),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix:    ptr.To(defaultRouteAddressPrefix),
+			NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+			NextHopIPAddress: ptr.To(s.DefaultRouteNextHopIP),
+		},
+	}
+}
+
+// routeUpToDate returns true if a route with the same name and properties as the given route exists.
+func routeUpToDate(routes []*armnetwork.Route, route *armnetwork.Route) bool {
+	for _, existingRoute := range routes {
+		if existingRoute == nil || !strings.EqualFold(ptr.Deref(existingRoute.Name, ""), ptr.Deref(route.Name, "")) {
+			continue
+		}
+		if existingRoute.Properties == nil {
+			return false
+		}
+		existing, desired := existingRoute.Properties, route.Properties
+		return ptr.Deref(existing.AddressPrefix, "") == ptr.Deref(desired.AddressPrefix, "") &&
+			strings.EqualFold(string(ptr.Deref(existing.NextHopType, "")), string(ptr.Deref(desired.NextHopType, ""))) &&
+			ptr.Deref(existing.NextHopIPAddress, "") == ptr.Deref(desired.NextHopIPAddress, "")
+	}
+	return false
 }
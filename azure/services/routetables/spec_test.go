@@ -23,6 +23,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 )
 
 var (
@@ -39,6 +40,59 @@ var (
 			"foo": "bar",
 		},
 	}
+	fakeFirewallRouteTableSpec = RouteTableSpec{
+		Name:                  "test-rt-1",
+		Location:              "fake-location",
+		ClusterName:           "cluster",
+		DefaultRouteNextHopIP: "10.255.255.132",
+	}
+	fakeDefaultRoute = &armnetwork.Route{
+		Name: ptr.To("default"),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix:    ptr.To("0.0.0.0/0"),
+			NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+			NextHopIPAddress: ptr.To("10.255.255.132"),
+		},
+	}
+	fakeRoutesRouteTableSpec = RouteTableSpec{
+		Name:        "test-rt-1",
+		Location:    "fake-location",
+		ClusterName: "cluster",
+		Routes: []infrav1.Route{
+			{
+				Name:             "to-appliance",
+				AddressPrefix:    "10.1.0.0/16",
+				NextHopType:      infrav1.RouteNextHopTypeVirtualAppliance,
+				NextHopIPAddress: "10.0.0.4",
+			},
+		},
+		LastAppliedRoutes: map[string]interface{}{
+			"to-appliance": "10.1.0.0/16",
+			"to-internet":  "10.2.0.0/16",
+		},
+	}
+	fakeApplianceRoute = &armnetwork.Route{
+		Name: ptr.To("to-appliance"),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix:    ptr.To("10.1.0.0/16"),
+			NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+			NextHopIPAddress: ptr.To("10.0.0.4"),
+		},
+	}
+	fakeInternetRoute = &armnetwork.Route{
+		Name: ptr.To("to-internet"),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix: ptr.To("10.2.0.0/16"),
+			NextHopType:   ptr.To(armnetwork.RouteNextHopTypeInternet),
+		},
+	}
+	fakeUnmanagedRoute = &armnetwork.Route{
+		Name: ptr.To("unmanaged"),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix: ptr.To("10.3.0.0/16"),
+			NextHopType:   ptr.To(armnetwork.RouteNextHopTypeVnetLocal),
+		},
+	}
 	fakeRouteTableTags = map[string]*string{
 		"sigs.k8s.io_cluster-api-provider-azure_cluster_cluster": ptr.To("owned"),
 		"foo":  ptr.To("bar"),
@@ -92,6 +146,175 @@ func TestRouteTableSpec_Parameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name:     "get RouteTable with a default route to the next hop IP",
+			spec:     &fakeFirewallRouteTableSpec,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{fakeDefaultRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "add a default route to an existing RouteTable",
+			spec:     &fakeFirewallRouteTableSpec,
+			existing: fakeRouteTable,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).ID).To(Equal(fakeRouteTable.ID))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{fakeDefaultRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "replace a stale default route and keep other routes",
+			spec: &fakeFirewallRouteTableSpec,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{
+						{
+							Name: ptr.To("default"),
+							Properties: &armnetwork.RoutePropertiesFormat{
+								AddressPrefix:    ptr.To("0.0.0.0/0"),
+								NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+								NextHopIPAddress: ptr.To("10.255.255.4"),
+							},
+						},
+						{
+							Name: ptr.To("custom"),
+						},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{fakeDefaultRoute, {Name: ptr.To("custom")}}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "get result as nil when existing RouteTable already has the default route",
+			spec: &fakeFirewallRouteTableSpec,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{fakeDefaultRoute},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name:     "get RouteTable with the routes of the spec",
+			spec:     &fakeRoutesRouteTableSpec,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{fakeApplianceRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "add a route to an existing RouteTable and keep routes not managed by CAPZ",
+			spec: &fakeRoutesRouteTableSpec,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{fakeUnmanagedRoute},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{fakeApplianceRoute, fakeUnmanagedRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "update a route of an existing RouteTable in place",
+			spec: &fakeRoutesRouteTableSpec,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{
+						{
+							Name: ptr.To("to-appliance"),
+							Properties: &armnetwork.RoutePropertiesFormat{
+								AddressPrefix:    ptr.To("10.1.0.0/16"),
+								NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+								NextHopIPAddress: ptr.To("10.0.0.5"),
+							},
+						},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{fakeApplianceRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "remove a route which was removed from the spec and keep routes not managed by CAPZ",
+			spec: &fakeRoutesRouteTableSpec,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{fakeApplianceRoute, fakeInternetRoute, fakeUnmanagedRoute},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{fakeApplianceRoute, fakeUnmanagedRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "get result as nil when existing RouteTable already has the routes of the spec",
+			spec: &fakeRoutesRouteTableSpec,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{fakeApplianceRoute, fakeUnmanagedRoute},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "update the tags of an existing RouteTable owned by the cluster and keep tags not managed by CAPZ",
+			spec: &fakeRouteTableSpec,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{},
+				Tags: map[string]*string{
+					"sigs.k8s.io_cluster-api-provider-azure_cluster_cluster": ptr.To("owned"),
+					"Name":      ptr.To("test-rt-1"),
+					"foo":       ptr.To("old"),
+					"unmanaged": ptr.To("tag"),
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Tags).To(Equal(map[string]*string{
+					"sigs.k8s.io_cluster-api-provider-azure_cluster_cluster": ptr.To("owned"),
+					"Name":      ptr.To("test-rt-1"),
+					"foo":       ptr.To("bar"),
+					"unmanaged": ptr.To("tag"),
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "get result as nil when existing RouteTable owned by the cluster has the tags of the spec",
+			spec: &fakeRouteTableSpec,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{},
+				Tags:       fakeRouteTableTags,
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
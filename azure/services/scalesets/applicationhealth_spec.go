@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalesets
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
+)
+
+// ApplicationHealthExtensionSpec defines the specification for the application health extension of a VMSS.
+type ApplicationHealthExtensionSpec struct {
+	VMSSName      string
+	ResourceGroup string
+	OSType        string
+	Protocol      infrav1exp.ApplicationHealthProtocol
+	Port          int32
+	RequestPath   string
+}
+
+// ResourceName returns the name of the application health extension.
+func (s *ApplicationHealthExtensionSpec) ResourceName() string {
+	if s.OSType == azure.WindowsOS {
+		return azure.ApplicationHealthExtensionWindows
+	}
+	return azure.ApplicationHealthExtensionLinux
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *ApplicationHealthExtensionSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName returns the name of the VMSS that owns the application health extension.
+func (s *ApplicationHealthExtensionSpec) OwnerResourceName() string {
+	return s.VMSSName
+}
+
+// Parameters returns the parameters for the application health extension.
+func (s *ApplicationHealthExtensionSpec) Parameters(ctx context.Context, existing interface{}) (interface{}, error) {
+	if existing != nil {
+		_, ok := existing.(armcompute.VirtualMachineScaleSetExtension)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armcompute.VirtualMachineScaleSetExtension", existing)
+		}
+
+		// VMSS extension already exists, nothing to update.
+		return nil, nil
+	}
+
+	// The port is passed as a number, the extension rejects settings with a string port.
+	settings := map[string]interface{}{
+		"protocol": string(s.Protocol),
+		"port":     s.Port,
+	}
+	if s.Protocol != infrav1exp.ApplicationHealthProtocolTCP {
+		settings["requestPath"] = s.RequestPath
+	}
+
+	return armcompute.VirtualMachineScaleSetExtension{
+		Name: ptr.To(s.ResourceName()),
+		Properties: &armcompute.VirtualMachineScaleSetExtensionProperties{
+			Publisher:               ptr.To("Microsoft.ManagedServices"),
+			Type:                    ptr.To(s.ResourceName()),
+			TypeHandlerVersion:      ptr.To("1.0"),
+			AutoUpgradeMinorVersion: ptr.To(true),
+			Settings:                settings,
+		},
+	}, nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalesets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
+)
+
+func TestApplicationHealthExtensionParameters(t *testing.T) {
+	testcases := []struct {
+		name          string
+		spec          *ApplicationHealthExtensionSpec
+		existing      interface{}
+		expect        func(g *WithT, result interface{})
+		expectedError string
+	}{
+		{
+			name: "get parameters for a tcp health probe",
+			spec: &ApplicationHealthExtensionSpec{
+				VMSSName:      "my-vmss",
+				ResourceGroup: "my-rg",
+				OSType:        "Linux",
+				Protocol:      infrav1exp.ApplicationHealthProtocolTCP,
+				Port:          22,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armcompute.VirtualMachineScaleSetExtension{
+					Name: ptr.To("ApplicationHealthLinux"),
+					Properties: &armcompute.VirtualMachineScaleSetExtensionProperties{
+						Publisher:               ptr.To("Microsoft.ManagedServices"),
+						Type:                    ptr.To("ApplicationHealthLinux"),
+						TypeHandlerVersion:      ptr.To("1.0"),
+						AutoUpgradeMinorVersion: ptr.To(true),
+						Settings: map[string]interface{}{
+							"protocol": "tcp",
+							"port":     int32(22),
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "get parameters for an http health probe on windows",
+			spec: &ApplicationHealthExtensionSpec{
+				VMSSName:      "my-vmss",
+				ResourceGroup: "my-rg",
+				OSType:        "Windows",
+				Protocol:      infrav1exp.ApplicationHealthProtocolHTTP,
+				Port:          10256,
+				RequestPath:   "/healthz",
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armcompute.VirtualMachineScaleSetExtension{
+					Name: ptr.To("ApplicationHealthWindows"),
+					Properties: &armcompute.VirtualMachineScaleSetExtensionProperties{
+						Publisher:               ptr.To("Microsoft.ManagedServices"),
+						Type:                    ptr.To("ApplicationHealthWindows"),
+						TypeHandlerVersion:      ptr.To("1.0"),
+						AutoUpgradeMinorVersion: ptr.To(true),
+						Settings: map[string]interface{}{
+							"protocol":    "http",
+							"port":        int32(10256),
+							"requestPath": "/healthz",
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "application health extension that already exists",
+			spec: &ApplicationHealthExtensionSpec{
+				VMSSName: "my-vmss",
+				Protocol: infrav1exp.ApplicationHealthProtocolTCP,
+				Port:     22,
+			},
+			existing: armcompute.VirtualMachineScaleSetExtension{},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "existing is not a vmss extension",
+			spec: &ApplicationHealthExtensionSpec{
+				VMSSName: "my-vmss",
+				Protocol: infrav1exp.ApplicationHealthProtocolTCP,
+				Port:     22,
+			},
+			existing: "wrong type",
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "string is not an armcompute.VirtualMachineScaleSetExtension",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			tc.expect(g, result)
+		})
+	}
+}
@@ -23,11 +23,15 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
+// instanceViewExpand is the expand expression to list scale set instances with their instance views.
+const instanceViewExpand = "instanceView"
+
 // Client wraps go-sdk.
 type Client interface {
 	Get(context.Context, azure.ResourceSpecGetter) (interface{}, error)
@@ -36,6 +40,7 @@ type Client interface {
 
 	CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armcompute.VirtualMachineScaleSetsClientCreateOrUpdateResponse], err error)
 	DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armcompute.VirtualMachineScaleSetsClientDeleteResponse], err error)
+	DeleteInstancesAsync(ctx context.Context, spec azure.ResourceSpecGetter, instanceIDs []string) (poller *runtime.Poller[armcompute.VirtualMachineScaleSetsClientDeleteInstancesResponse], err error)
 }
 
 // AzureClient contains the Azure go-sdk Client.
@@ -90,13 +95,15 @@ func newVirtualMachineScaleSetsClient(auth azure.Authorizer) (*armcompute.Virtua
 	return factory.NewVirtualMachineScaleSetsClient(), nil
 }
 
-// ListInstances retrieves information about the model views of a virtual machine scale set.
+// ListInstances retrieves information about the model and instance views of the instances of a virtual machine scale
+// set, following all pages of results.
 func (ac *AzureClient) ListInstances(ctx context.Context, resourceGroupName string, resourceName string) ([]armcompute.VirtualMachineScaleSetVM, error) {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesets.AzureClient.ListInstances")
 	defer done()
 
 	var instances []armcompute.VirtualMachineScaleSetVM
-	pager := ac.scalesetvms.NewListPager(resourceGroupName, resourceName, nil)
+	opts := &armcompute.VirtualMachineScaleSetVMsClientListOptions{Expand: ptr.To(instanceViewExpand)}
+	pager := ac.scalesetvms.NewListPager(resourceGroupName, resourceName, opts)
 	for pager.More() {
 		nextResult, err := pager.NextPage(ctx)
 		if err != nil {
@@ -206,3 +213,45 @@ func (ac *AzureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecG
 	// if the operation completed, return a nil poller.
 	return nil, err
 }
+
+// DeleteInstancesAsync deletes the instances with the given instance IDs from a virtual machine scale set
+// asynchronously. DeleteInstancesAsync sends a POST request to Azure and if accepted without error, the func will
+// return a Poller which can be used to track the ongoing progress of the operation.
+//
+// Parameters:
+//
+//	spec - The ResourceSpecGetter containing used for name and resource group of the virtual machine scale set.
+//	instanceIDs - The instance IDs of the virtual machine scale set instances to delete.
+func (ac *AzureClient) DeleteInstancesAsync(ctx context.Context, spec azure.ResourceSpecGetter, instanceIDs []string) (poller *runtime.Poller[armcompute.VirtualMachineScaleSetsClientDeleteInstancesResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesets.AzureClient.DeleteInstancesAsync")
+	defer done()
+
+	vmInstanceIDs := armcompute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIDs: make([]*string, 0, len(instanceIDs)),
+	}
+	for i := range instanceIDs {
+		vmInstanceIDs.InstanceIDs = append(vmInstanceIDs.InstanceIDs, &instanceIDs[i])
+	}
+	opts := &armcompute.VirtualMachineScaleSetsClientBeginDeleteInstancesOptions{}
+	if scaleSetSpec, ok := spec.(*ScaleSetSpec); ok && scaleSetSpec.ForceDeleteInstances {
+		opts.ForceDeletion = ptr.To(true)
+	}
+	poller, err = ac.scalesets.BeginDeleteInstances(ctx, spec.ResourceGroupName(), spec.ResourceName(), vmInstanceIDs, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the Poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
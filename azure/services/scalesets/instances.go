@@ -0,0 +1,161 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalesets
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// powerStatePrefix is the prefix of the instance view status code reporting the power state of an instance.
+const powerStatePrefix = "PowerState/"
+
+// InstanceState is the provisioning and power state of a scale set instance.
+type InstanceState struct {
+	// ProvisioningState is the provisioning state of the instance.
+	ProvisioningState infrav1.ProvisioningState
+	// PowerState is the power state of the instance, e.g. "running" or "deallocated".
+	// It is empty if Azure didn't report a power state for the instance.
+	PowerState string
+}
+
+// instanceCache caches the instances listed for scale sets, so that the instances of a scale set are listed at most
+// once per version of the scale set over the lifetime of a Service, i.e. within a reconcile. Entries are keyed by
+// scale set and its etag, which Azure changes whenever the scale set model is updated, and are invalidated
+// explicitly after operations which add or remove instances. The zero value is ready to use.
+type instanceCache struct {
+	mu      sync.Mutex
+	entries map[string]instanceCacheEntry
+}
+
+type instanceCacheEntry struct {
+	etag      string
+	instances []armcompute.VirtualMachineScaleSetVM
+}
+
+func instanceCacheKey(resourceGroup, name string) string {
+	return strings.ToLower(resourceGroup + "/" + name)
+}
+
+// get returns the cached instances of a scale set if they were listed for the given etag.
+func (c *instanceCache) get(resourceGroup, name, etag string) ([]armcompute.VirtualMachineScaleSetVM, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[instanceCacheKey(resourceGroup, name)]
+	if !ok || entry.etag != etag {
+		return nil, false
+	}
+	return entry.instances, true
+}
+
+// add caches the instances of a scale set listed for the given etag.
+func (c *instanceCache) add(resourceGroup, name, etag string, instances []armcompute.VirtualMachineScaleSetVM) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]instanceCacheEntry)
+	}
+	c.entries[instanceCacheKey(resourceGroup, name)] = instanceCacheEntry{etag: etag, instances: instances}
+}
+
+// invalidate removes the cached instances of a scale set.
+func (c *instanceCache) invalidate(resourceGroup, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, instanceCacheKey(resourceGroup, name))
+}
+
+// listInstances returns the instances of the scale set described by spec, which exists as existing. They are
+// listed from Azure, with their instance views, unless they were listed already for the same version of the scale set.
+func (s *Service) listInstances(ctx context.Context, spec azure.ResourceSpecGetter, existing interface{}) ([]armcompute.VirtualMachineScaleSetVM, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.listInstances")
+	defer done()
+
+	etag := vmssEtag(existing)
+	if instances, ok := s.instances.get(spec.ResourceGroupName(), spec.ResourceName(), etag); ok {
+		return instances, nil
+	}
+
+	instances, err := s.Client.ListInstances(ctx, spec.ResourceGroupName(), spec.ResourceName())
+	if err != nil {
+		return nil, err
+	}
+	s.instances.add(spec.ResourceGroupName(), spec.ResourceName(), etag, instances)
+	return instances, nil
+}
+
+// vmssEtag returns the etag of an existing scale set, or an empty string if it is unknown.
+func vmssEtag(existing interface{}) string {
+	switch vmss := existing.(type) {
+	case armcompute.VirtualMachineScaleSet:
+		return ptr.Deref(vmss.Etag, "")
+	case *armcompute.VirtualMachineScaleSet:
+		if vmss != nil {
+			return ptr.Deref(vmss.Etag, "")
+		}
+	}
+	return ""
+}
+
+// InstanceStates returns the provisioning and power state of each instance of the scale set, keyed by instance ID.
+func (s *Service) InstanceStates(ctx context.Context) (map[string]InstanceState, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.InstanceStates")
+	defer done()
+
+	spec := s.Scope.ScaleSetSpec(ctx)
+	existing, err := s.Client.Get(ctx, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get existing VMSS")
+	}
+	instances, err := s.listInstances(ctx, spec, existing)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list instances")
+	}
+
+	states := make(map[string]InstanceState, len(instances))
+	for _, instance := range instances {
+		states[ptr.Deref(instance.InstanceID, "")] = instanceState(instance)
+	}
+	return states, nil
+}
+
+// instanceState returns the provisioning and power state of a scale set instance listed with its instance view.
+func instanceState(instance armcompute.VirtualMachineScaleSetVM) InstanceState {
+	var state InstanceState
+	if instance.Properties == nil {
+		return state
+	}
+	state.ProvisioningState = infrav1.ProvisioningState(ptr.Deref(instance.Properties.ProvisioningState, ""))
+	if instance.Properties.InstanceView == nil {
+		return state
+	}
+	for _, status := range instance.Properties.InstanceView.Statuses {
+		if code := ptr.Deref(status.Code, ""); strings.HasPrefix(code, powerStatePrefix) {
+			state.PowerState = strings.TrimPrefix(code, powerStatePrefix)
+			break
+		}
+	}
+	return state
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalesets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5/fake"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets/mock_scalesets"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+func newInstance(id, provisioningState, powerState string) armcompute.VirtualMachineScaleSetVM {
+	instance := armcompute.VirtualMachineScaleSetVM{
+		InstanceID: ptr.To(id),
+		Properties: &armcompute.VirtualMachineScaleSetVMProperties{
+			ProvisioningState: ptr.To(provisioningState),
+			InstanceView: &armcompute.VirtualMachineScaleSetVMInstanceView{
+				Statuses: []*armcompute.InstanceViewStatus{
+					{Code: ptr.To("ProvisioningState/" + provisioningState)},
+				},
+			},
+		},
+	}
+	if powerState != "" {
+		instance.Properties.InstanceView.Statuses = append(instance.Properties.InstanceView.Statuses,
+			&armcompute.InstanceViewStatus{Code: ptr.To(powerStatePrefix + powerState)})
+	}
+	return instance
+}
+
+func TestAzureClientListInstancesPagination(t *testing.T) {
+	g := NewWithT(t)
+
+	var expand string
+	srv := fake.VirtualMachineScaleSetVMsServer{
+		NewListPager: func(resourceGroupName string, virtualMachineScaleSetName string, options *armcompute.VirtualMachineScaleSetVMsClientListOptions) (resp azfake.PagerResponder[armcompute.VirtualMachineScaleSetVMsClientListResponse]) {
+			expand = ptr.Deref(options.Expand, "")
+			for page := 0; page < 3; page++ {
+				result := armcompute.VirtualMachineScaleSetVMListResult{}
+				for i := 0; i < 2; i++ {
+					instance := newInstance(fmt.Sprintf("%d", page*2+i), "Succeeded", "running")
+					result.Value = append(result.Value, &instance)
+				}
+				resp.AddPage(http.StatusOK, armcompute.VirtualMachineScaleSetVMsClientListResponse{VirtualMachineScaleSetVMListResult: result}, nil)
+			}
+			return
+		},
+	}
+	vmsClient, err := armcompute.NewVirtualMachineScaleSetVMsClient(defaultSubscriptionID, &azfake.TokenCredential{}, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{Transport: fake.NewVirtualMachineScaleSetVMsServerTransport(&srv)},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	ac := &AzureClient{scalesetvms: vmsClient}
+
+	instances, err := ac.ListInstances(context.Background(), defaultResourceGroup, defaultVMSSName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(expand).To(Equal(instanceViewExpand))
+	g.Expect(instances).To(HaveLen(6))
+	for i, instance := range instances {
+		g.Expect(instance.InstanceID).To(Equal(ptr.To(fmt.Sprintf("%d", i))))
+	}
+}
+
+func TestAzureClientListInstancesPageError(t *testing.T) {
+	g := NewWithT(t)
+
+	srv := fake.VirtualMachineScaleSetVMsServer{
+		NewListPager: func(resourceGroupName string, virtualMachineScaleSetName string, options *armcompute.VirtualMachineScaleSetVMsClientListOptions) (resp azfake.PagerResponder[armcompute.VirtualMachineScaleSetVMsClientListResponse]) {
+			instance := newInstance("0", "Succeeded", "running")
+			resp.AddPage(http.StatusOK, armcompute.VirtualMachineScaleSetVMsClientListResponse{
+				VirtualMachineScaleSetVMListResult: armcompute.VirtualMachineScaleSetVMListResult{Value: []*armcompute.VirtualMachineScaleSetVM{&instance}},
+			}, nil)
+			resp.AddResponseError(http.StatusTooManyRequests, "TooManyRequests")
+			return
+		},
+	}
+	vmsClient, err := armcompute.NewVirtualMachineScaleSetVMsClient(defaultSubscriptionID, &azfake.TokenCredential{}, &arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: fake.NewVirtualMachineScaleSetVMsServerTransport(&srv),
+			Retry:     policy.RetryOptions{MaxRetries: -1},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	ac := &AzureClient{scalesetvms: vmsClient}
+
+	_, err = ac.ListInstances(context.Background(), defaultResourceGroup, defaultVMSSName)
+	g.Expect(err).To(MatchError(ContainSubstring("could not iterate scalesetvms")))
+}
+
+func TestServiceListInstancesCache(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	clientMock := mock_scalesets.NewMockClient(mockCtrl)
+
+	spec := getDefaultVMSSSpec()
+	instances := []armcompute.VirtualMachineScaleSetVM{newInstance("0", "Succeeded", "running")}
+	v1 := armcompute.VirtualMachineScaleSet{Etag: ptr.To("1")}
+	v2 := armcompute.VirtualMachineScaleSet{Etag: ptr.To("2")}
+
+	s := &Service{Client: clientMock}
+
+	// Instances are listed once for each version of the scale set.
+	clientMock.EXPECT().ListInstances(gomockinternal.AContext(), defaultResourceGroup, defaultVMSSName).Return(instances, nil).Times(1)
+	for i := 0; i < 3; i++ {
+		got, err := s.listInstances(context.Background(), spec, v1)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(got).To(Equal(instances))
+	}
+
+	// A new version of the scale set is listed again.
+	clientMock.EXPECT().ListInstances(gomockinternal.AContext(), defaultResourceGroup, defaultVMSSName).Return(instances, nil).Times(1)
+	_, err := s.listInstances(context.Background(), spec, &v2)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = s.listInstances(context.Background(), spec, v2)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Invalidating the scale set lists it again, even for the same version.
+	s.instances.invalidate(defaultResourceGroup, defaultVMSSName)
+	clientMock.EXPECT().ListInstances(gomockinternal.AContext(), defaultResourceGroup, defaultVMSSName).Return(instances, nil).Times(1)
+	_, err = s.listInstances(context.Background(), spec, v2)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Errors aren't cached.
+	s.instances.invalidate(defaultResourceGroup, defaultVMSSName)
+	clientMock.EXPECT().ListInstances(gomockinternal.AContext(), defaultResourceGroup, defaultVMSSName).Return(nil, internalError())
+	_, err = s.listInstances(context.Background(), spec, v2)
+	g.Expect(err).To(HaveOccurred())
+	clientMock.EXPECT().ListInstances(gomockinternal.AContext(), defaultResourceGroup, defaultVMSSName).Return(instances, nil)
+	_, err = s.listInstances(context.Background(), spec, v2)
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestServiceDeleteInstancesInvalidatesInstanceCache(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scopeMock := mock_scalesets.NewMockScaleSetScope(mockCtrl)
+	clientMock := mock_scalesets.NewMockClient(mockCtrl)
+
+	defaultSpec := newDefaultVMSSSpec()
+	vmss := armcompute.VirtualMachineScaleSet{
+		ID:         ptr.To(defaultVMSSID),
+		Name:       ptr.To(defaultVMSSName),
+		Etag:       ptr.To("1"),
+		Properties: &armcompute.VirtualMachineScaleSetProperties{},
+	}
+	instances := []armcompute.VirtualMachineScaleSetVM{
+		{
+			ID:         ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/my-vmss/virtualMachines/0"),
+			InstanceID: ptr.To("0"),
+		},
+	}
+
+	scopeMock.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout).AnyTimes()
+	scopeMock.EXPECT().ScaleSetSpec(gomockinternal.AContext()).Return(&defaultSpec).AnyTimes()
+	clientMock.EXPECT().Get(gomockinternal.AContext(), &defaultSpec).Return(vmss, nil).Times(3)
+	clientMock.EXPECT().ListInstances(gomockinternal.AContext(), defaultResourceGroup, defaultVMSSName).Return(instances, nil).Times(2)
+	clientMock.EXPECT().DeleteInstancesAsync(gomockinternal.AContext(), &defaultSpec, []string{"0"}).Return(nil, nil)
+
+	s := &Service{Scope: scopeMock, Client: clientMock}
+
+	_, err := s.InstanceStates(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(s.DeleteInstances(context.Background(), []string{"azure://" + ptr.Deref(instances[0].ID, "")})).To(Succeed())
+	_, err = s.InstanceStates(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestServiceInstanceStates(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scopeMock := mock_scalesets.NewMockScaleSetScope(mockCtrl)
+	clientMock := mock_scalesets.NewMockClient(mockCtrl)
+
+	spec := getDefaultVMSSSpec()
+	scopeMock.EXPECT().ScaleSetSpec(gomockinternal.AContext()).Return(spec)
+	clientMock.EXPECT().Get(gomockinternal.AContext(), spec).Return(armcompute.VirtualMachineScaleSet{Etag: ptr.To("1")}, nil)
+	clientMock.EXPECT().ListInstances(gomockinternal.AContext(), defaultResourceGroup, defaultVMSSName).Return([]armcompute.VirtualMachineScaleSetVM{
+		newInstance("0", "Succeeded", "running"),
+		newInstance("1", "Succeeded", "deallocated"),
+		newInstance("2", "Creating", ""),
+		{InstanceID: ptr.To("3")},
+	}, nil)
+
+	s := &Service{Scope: scopeMock, Client: clientMock}
+	states, err := s.InstanceStates(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(states).To(Equal(map[string]InstanceState{
+		"0": {ProvisioningState: infrav1.Succeeded, PowerState: "running"},
+		"1": {ProvisioningState: infrav1.Succeeded, PowerState: "deallocated"},
+		"2": {ProvisioningState: infrav1.Creating},
+		"3": {},
+	}))
+}
+
+func BenchmarkServiceInstanceStates(b *testing.B) {
+	mockCtrl := gomock.NewController(b)
+	defer mockCtrl.Finish()
+	scopeMock := mock_scalesets.NewMockScaleSetScope(mockCtrl)
+	clientMock := mock_scalesets.NewMockClient(mockCtrl)
+
+	instances := make([]armcompute.VirtualMachineScaleSetVM, 1000)
+	for i := range instances {
+		instances[i] = newInstance(fmt.Sprintf("%d", i), "Succeeded", "running")
+	}
+	spec := getDefaultVMSSSpec()
+	scopeMock.EXPECT().ScaleSetSpec(gomock.Any()).Return(spec).AnyTimes()
+	clientMock.EXPECT().Get(gomock.Any(), spec).Return(armcompute.VirtualMachineScaleSet{Etag: ptr.To("1")}, nil).AnyTimes()
+	clientMock.EXPECT().ListInstances(gomock.Any(), defaultResourceGroup, defaultVMSSName).Return(instances, nil).Times(1)
+
+	s := &Service{Scope: scopeMock, Client: clientMock}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := s.InstanceStates(context.Background()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -89,6 +89,21 @@ func (mr *MockClientMockRecorder) DeleteAsync(ctx, spec, resumeToken any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAsync", reflect.TypeOf((*MockClient)(nil).DeleteAsync), ctx, spec, resumeToken)
 }
 
+// DeleteInstancesAsync mocks base method.
+func (m *MockClient) DeleteInstancesAsync(ctx context.Context, spec azure.ResourceSpecGetter, instanceIDs []string) (*runtime.Poller[armcompute.VirtualMachineScaleSetsClientDeleteInstancesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstancesAsync", ctx, spec, instanceIDs)
+	ret0, _ := ret[0].(*runtime.Poller[armcompute.VirtualMachineScaleSetsClientDeleteInstancesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstancesAsync indicates an expected call of DeleteInstancesAsync.
+func (mr *MockClientMockRecorder) DeleteInstancesAsync(ctx, spec, instanceIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstancesAsync", reflect.TypeOf((*MockClient)(nil).DeleteInstancesAsync), ctx, spec, instanceIDs)
+}
+
 // Get mocks base method.
 func (m *MockClient) Get(arg0 context.Context, arg1 azure.ResourceSpecGetter) (any, error) {
 	m.ctrl.T.Helper()
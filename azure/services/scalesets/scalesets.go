@@ -19,6 +19,7 @@ package scalesets
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
@@ -27,6 +28,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/hostgroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/slice"
@@ -35,6 +37,9 @@ import (
 
 const serviceName = "scalesets"
 
+// ErrInstancesNotFound is the error returned when instances to delete no longer exist in the scale set.
+var ErrInstancesNotFound = errors.New("scale set instances not found")
+
 type (
 	// ScaleSetScope defines the scope interface for a scale sets service.
 	ScaleSetScope interface {
@@ -54,6 +59,8 @@ type (
 		Client
 		resourceSKUCache *resourceskus.Cache
 		async.Reconciler
+		instances  instanceCache
+		hostGroups hostgroups.Client
 	}
 )
 
@@ -63,12 +70,17 @@ func New(scope ScaleSetScope, skuCache *resourceskus.Cache) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	hostGroupsClient, err := hostgroups.NewClient(scope)
+	if err != nil {
+		return nil, err
+	}
 	return &Service{
 		Reconciler: async.New[armcompute.VirtualMachineScaleSetsClientCreateOrUpdateResponse,
 			armcompute.VirtualMachineScaleSetsClientDeleteResponse](scope, client, client),
 		Client:           client,
 		Scope:            scope,
 		resourceSKUCache: skuCache,
+		hostGroups:       hostGroupsClient,
 	}, nil
 }
 
@@ -82,7 +94,7 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	if err := s.validateSpec(ctx); err != nil {
@@ -96,10 +108,10 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 		return errors.Errorf("%T is not of type ScaleSetSpec", spec)
 	}
 
-	_, err := s.Client.Get(ctx, spec)
+	existing, err := s.Client.Get(ctx, spec)
 	if err == nil {
 		// We can only get the existing instances if the VMSS already exists
-		scaleSetSpec.VMSSInstances, err = s.Client.ListInstances(ctx, spec.ResourceGroupName(), spec.ResourceName())
+		scaleSetSpec.VMSSInstances, err = s.listInstances(ctx, spec, existing)
 		if err != nil {
 			err = errors.Wrapf(err, "failed to get existing VMSS instances")
 			s.Scope.UpdatePutStatus(infrav1.BootstrapSucceededCondition, serviceName, err)
@@ -107,10 +119,18 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 		}
 	} else if !azure.ResourceNotFound(err) {
 		return errors.Wrapf(err, "failed to get existing VMSS")
+	} else if scaleSetSpec.HostGroupID != "" {
+		// The host group of an existing VMSS can't change, so it's only checked before the VMSS is created.
+		if err := hostgroups.ValidatePlacement(ctx, s.hostGroups, scaleSetSpec.HostGroupID, scaleSetSpec.Location, scaleSetSpec.FailureDomains); err != nil {
+			s.Scope.UpdatePutStatus(infrav1.BootstrapSucceededCondition, serviceName, err)
+			return err
+		}
 	}
 
 	result, err := s.CreateOrUpdateResource(ctx, scaleSetSpec, serviceName)
 	s.Scope.UpdatePutStatus(infrav1.BootstrapSucceededCondition, serviceName, err)
+	// The update may have scaled the VMSS, so the instances listed before it are stale.
+	s.instances.invalidate(spec.ResourceGroupName(), spec.ResourceName())
 
 	if err == nil && result != nil {
 		vmss, ok := result.(armcompute.VirtualMachineScaleSet)
@@ -141,7 +161,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
 	defer cancel()
 
 	scaleSetSpec := s.Scope.ScaleSetSpec(ctx)
@@ -158,12 +178,79 @@ func (s *Service) Delete(ctx context.Context) error {
 	}()
 
 	err := s.DeleteResource(ctx, scaleSetSpec, serviceName)
+	s.instances.invalidate(scaleSetSpec.ResourceGroupName(), scaleSetSpec.ResourceName())
 
 	s.Scope.UpdateDeleteStatus(infrav1.BootstrapSucceededCondition, serviceName, err)
 
 	return err
 }
 
+// DeleteInstances deletes exactly the scale set instances with the given provider IDs. Instances are looked up by
+// provider ID rather than by index, so that instances added or removed concurrently, e.g. by an autoscaler, are
+// never deleted by mistake. If any provider ID no longer matches an instance of the scale set, it returns an error
+// wrapping ErrInstancesNotFound without deleting any instance.
+func (s *Service) DeleteInstances(ctx context.Context, providerIDs []string) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.DeleteInstances")
+	defer done()
+
+	if len(providerIDs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.AzureServiceReconcileTimeout(serviceName))
+	defer cancel()
+
+	spec := s.Scope.ScaleSetSpec(ctx)
+	scaleSetSpec, ok := spec.(*ScaleSetSpec)
+	if !ok {
+		return errors.Errorf("%T is not a ScaleSetSpec", spec)
+	}
+
+	vmss, err := s.getVirtualMachineScaleSet(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	// Provider IDs may differ in the casing of the resource group name, so match them case-insensitively.
+	instanceIDsByProviderID := make(map[string]string, len(vmss.Instances))
+	for providerID, instance := range vmss.InstancesByProviderID(scaleSetSpec.OrchestrationMode) {
+		instanceIDsByProviderID[strings.ToLower(providerID)] = instance.InstanceID
+	}
+	instanceIDs := make([]string, 0, len(providerIDs))
+	var missing []string
+	for _, providerID := range providerIDs {
+		instanceID, ok := instanceIDsByProviderID[strings.ToLower(providerID)]
+		if !ok {
+			missing = append(missing, providerID)
+			continue
+		}
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+	if len(missing) > 0 {
+		return errors.Wrapf(ErrInstancesNotFound, "failed to delete instances of scale set %s/%s: instances with provider IDs %s no longer exist", spec.ResourceGroupName(), spec.ResourceName(), strings.Join(missing, ", "))
+	}
+
+	log.V(2).Info("deleting scale set instances", "scaleSet", spec.ResourceName(), "instanceIDs", instanceIDs)
+	poller, err := s.Client.DeleteInstancesAsync(ctx, spec, instanceIDs)
+	s.instances.invalidate(spec.ResourceGroupName(), spec.ResourceName())
+	if poller != nil && azure.IsContextDeadlineExceededOrCanceledError(err) {
+		future, err := converters.PollerToFuture(poller, infrav1.DeleteFuture, serviceName, spec.ResourceName(), spec.ResourceGroupName())
+		if err != nil {
+			return errors.Wrap(err, "failed to convert poller to future")
+		}
+		// The future isn't stored as a long-running operation state because it would be mistaken for the deletion
+		// of the whole scale set. Deleting instances which are being deleted already is a no-op, so the deletion
+		// is just requested again when retrying.
+		return azure.WithTransientError(azure.NewOperationNotDoneError(future), s.Scope.DefaultedReconcilerRequeue())
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete instances of scale set %s/%s", spec.ResourceGroupName(), spec.ResourceName())
+	}
+
+	log.V(2).Info("successfully deleted scale set instances", "scaleSet", spec.ResourceName(), "instanceIDs", instanceIDs)
+	return nil
+}
+
 func (s *Service) validateSpec(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.validateSpec")
 	defer done()
@@ -179,28 +266,23 @@ func (s *Service) validateSpec(ctx context.Context) error {
 		return errors.Wrapf(err, "failed to get SKU %s in compute api", scaleSetSpec.Size)
 	}
 
-	// Checking if the requested VM size has at least 2 vCPUS
-	vCPUCapability, err := sku.HasCapabilityWithCapacity(resourceskus.VCPUs, resourceskus.MinimumVCPUS)
+	capabilities, err := s.resourceSKUCache.GetVMCapabilities(ctx, scaleSetSpec.Size)
 	if err != nil {
-		return azure.WithTerminalError(errors.Wrap(err, "failed to validate the vCPU capability"))
+		return azure.WithTerminalError(errors.Wrap(err, "failed to validate the vm size capabilities"))
 	}
 
-	if !vCPUCapability {
+	// Checking if the requested VM size has at least 2 vCPUS
+	if capabilities.VCPUs < resourceskus.MinimumVCPUS {
 		return azure.WithTerminalError(errors.New("vm size should be bigger or equal to at least 2 vCPUs"))
 	}
 
 	// Checking if the requested VM size has at least 2 Gi of memory
-	MemoryCapability, err := sku.HasCapabilityWithCapacity(resourceskus.MemoryGB, resourceskus.MinimumMemory)
-	if err != nil {
-		return azure.WithTerminalError(errors.Wrap(err, "failed to validate the memory capability"))
-	}
-
-	if !MemoryCapability {
+	if capabilities.MemoryGB < resourceskus.MinimumMemory {
 		return azure.WithTerminalError(errors.New("vm memory should be bigger or equal to at least 2Gi"))
 	}
 
 	// enable ephemeral OS
-	if scaleSetSpec.OSDisk.DiffDiskSettings != nil && !sku.HasCapability(resourceskus.EphemeralOSDisk) {
+	if scaleSetSpec.OSDisk.DiffDiskSettings != nil && !capabilities.EphemeralOSDiskSupported {
 		return azure.WithTerminalError(fmt.Errorf("vm size %s does not support ephemeral os. select a different vm size or disable ephemeral os", scaleSetSpec.Size))
 	}
 
@@ -286,7 +368,7 @@ func (s *Service) getVirtualMachineScaleSet(ctx context.Context, spec azure.Reso
 		return nil, errors.Errorf("%T is not an armcompute.VirtualMachineScaleSet", vmssResult)
 	}
 
-	vmssInstances, err := s.Client.ListInstances(ctx, spec.ResourceGroupName(), spec.ResourceName())
+	vmssInstances, err := s.listInstances(ctx, spec, vmss)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list instances")
 	}
@@ -115,7 +115,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "update an existing vmss",
 			expectedError: "",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := getDefaultVMSSSpec()
 				// Validate spec
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(spec).AnyTimes()
@@ -133,7 +133,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "create a vmss, skip list instances if vmss doesn't exist",
 			expectedError: "",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := getDefaultVMSSSpec()
 				// Validate spec
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(spec).AnyTimes()
@@ -150,7 +150,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "error getting existing vmss",
 			expectedError: "failed to get existing VMSS:.*#: Internal Server Error: StatusCode=500",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := getDefaultVMSSSpec()
 				// Validate spec
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(spec).AnyTimes()
@@ -161,7 +161,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "failed to list instances",
 			expectedError: "failed to get existing VMSS instances:.*#: Internal Server Error: StatusCode=500",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := getDefaultVMSSSpec()
 				// Validate spec
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(spec).AnyTimes()
@@ -174,7 +174,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "failed to create a vmss",
 			expectedError: "#: Internal Server Error: StatusCode=500",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := getDefaultVMSSSpec()
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(spec).AnyTimes()
 				m.Get(gomockinternal.AContext(), &defaultSpec).Return(&resultVMSS, nil)
@@ -189,7 +189,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "failed to reconcile replicas",
 			expectedError: "unable to reconcile VMSS replicas:.*#: Internal Server Error: StatusCode=500",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := getDefaultVMSSSpec()
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(spec).AnyTimes()
 				m.Get(gomockinternal.AContext(), &defaultSpec).Return(&resultVMSS, nil)
@@ -205,7 +205,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "validate spec failure: less than 2 vCPUs",
 			expectedError: "reconcile error that cannot be recovered occurred: vm size should be bigger or equal to at least 2 vCPUs. Object will not be requeued",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := newDefaultVMSSSpec()
 				spec.Size = "VM_SIZE_1_CPU"
 				spec.Capacity = 2
@@ -217,7 +217,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "validate spec failure: Memory is less than 2Gi",
 			expectedError: "reconcile error that cannot be recovered occurred: vm memory should be bigger or equal to at least 2Gi. Object will not be requeued",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := newDefaultVMSSSpec()
 				spec.Size = "VM_SIZE_1_MEM"
 				spec.Capacity = 2
@@ -229,7 +229,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "validate spec failure: failed to get SKU",
 			expectedError: "failed to get SKU INVALID_VM_SIZE in compute api: reconcile error that cannot be recovered occurred: resource sku with name 'INVALID_VM_SIZE' and category 'virtualMachines' not found in location 'test-location'. Object will not be requeued",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := newDefaultVMSSSpec()
 				spec.Size = "INVALID_VM_SIZE"
 				spec.Capacity = 2
@@ -241,7 +241,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "validate spec failure: fail to create a vm with ultra disk implicitly enabled by data disk, when location not supported",
 			expectedError: "reconcile error that cannot be recovered occurred: vm size VM_SIZE_USSD does not support ultra disks in location test-location. select a different vm size or disable ultra disks. Object will not be requeued",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := newDefaultVMSSSpec()
 				spec.Size = vmSizeUSSD
 				spec.Capacity = 2
@@ -258,7 +258,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "validate spec failure: fail to create a vm with ultra disk explicitly enabled via additional capabilities, when location not supported",
 			expectedError: "reconcile error that cannot be recovered occurred: vm size VM_SIZE_USSD does not support ultra disks in location test-location. select a different vm size or disable ultra disks. Object will not be requeued",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := newDefaultVMSSSpec()
 				spec.Size = vmSizeUSSD
 				spec.Capacity = 2
@@ -273,7 +273,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "validate spec failure: fail to create a vm with ultra disk explicitly enabled via additional capabilities, when location not supported",
 			expectedError: "reconcile error that cannot be recovered occurred: vm size VM_SIZE_USSD does not support ultra disks in location test-location. select a different vm size or disable ultra disks. Object will not be requeued",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := newDefaultVMSSSpec()
 				spec.Size = vmSizeUSSD
 				spec.Capacity = 2
@@ -293,7 +293,7 @@ func TestReconcileVMSS(t *testing.T) {
 			name:          "validate spec failure: fail to create a vm with diagnostics set to User Managed but empty StorageAccountURI",
 			expectedError: "reconcile error that cannot be recovered occurred: userManaged must be specified when storageAccountType is 'UserManaged'. Object will not be requeued",
 			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				spec := newDefaultVMSSSpec()
 				spec.Size = vmSizeUSSD
 				spec.Capacity = 2
@@ -358,7 +358,7 @@ func TestDeleteVMSS(t *testing.T) {
 			name:          "successfully delete an existing vmss",
 			expectedError: "",
 			expect: func(s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(&defaultSpec).AnyTimes()
 				r.DeleteResource(gomockinternal.AContext(), &defaultSpec, serviceName).Return(nil)
 				s.UpdateDeleteStatus(infrav1.BootstrapSucceededCondition, serviceName, nil)
@@ -372,7 +372,7 @@ func TestDeleteVMSS(t *testing.T) {
 			name:          "successfully delete an existing vmss, fetch call returns error",
 			expectedError: "",
 			expect: func(s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(&defaultSpec).AnyTimes()
 				r.DeleteResource(gomockinternal.AContext(), &defaultSpec, serviceName).Return(nil)
 				s.UpdateDeleteStatus(infrav1.BootstrapSucceededCondition, serviceName, nil)
@@ -383,7 +383,7 @@ func TestDeleteVMSS(t *testing.T) {
 			name:          "failed to delete an existing vmss",
 			expectedError: "#: Internal Server Error: StatusCode=500",
 			expect: func(s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
-				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(&defaultSpec).AnyTimes()
 				r.DeleteResource(gomockinternal.AContext(), &defaultSpec, serviceName).Return(internalError())
 				s.UpdateDeleteStatus(infrav1.BootstrapSucceededCondition, serviceName, internalError())
@@ -422,6 +422,95 @@ func TestDeleteVMSS(t *testing.T) {
 	}
 }
 
+func TestDeleteInstances(t *testing.T) {
+	defaultSpec := newDefaultVMSSSpec()
+	resultVMSS := getResultVMSS()
+	instances := newDefaultInstances()
+	instances[0].ID = ptr.To(defaultVMSSID + "/virtualMachines/1")
+	instances[1].ID = ptr.To(defaultVMSSID + "/virtualMachines/2")
+	providerID := azureutil.ProviderIDPrefix + *instances[1].ID
+	missingProviderID := azureutil.ProviderIDPrefix + defaultVMSSID + "/virtualMachines/3"
+
+	testcases := []struct {
+		name          string
+		providerIDs   []string
+		expectedError string
+		notFound      bool
+		expect        func(s *mock_scalesets.MockScaleSetScopeMockRecorder, m *mock_scalesets.MockClientMockRecorder)
+	}{
+		{
+			name:        "no provider IDs",
+			providerIDs: nil,
+			expect:      func(s *mock_scalesets.MockScaleSetScopeMockRecorder, m *mock_scalesets.MockClientMockRecorder) {},
+		},
+		{
+			name:        "successfully delete a known instance",
+			providerIDs: []string{strings.ToUpper(providerID)},
+			expect: func(s *mock_scalesets.MockScaleSetScopeMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ScaleSetSpec(gomockinternal.AContext()).Return(&defaultSpec)
+				m.Get(gomockinternal.AContext(), &defaultSpec).Return(resultVMSS, nil)
+				m.ListInstances(gomockinternal.AContext(), defaultSpec.ResourceGroup, defaultSpec.Name).Return(instances, nil)
+				m.DeleteInstancesAsync(gomockinternal.AContext(), &defaultSpec, []string{"my-vm-2"}).Return(nil, nil)
+			},
+		},
+		{
+			name:          "instance no longer exists",
+			providerIDs:   []string{providerID, missingProviderID},
+			expectedError: "instances with provider IDs " + missingProviderID + " no longer exist",
+			notFound:      true,
+			expect: func(s *mock_scalesets.MockScaleSetScopeMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ScaleSetSpec(gomockinternal.AContext()).Return(&defaultSpec)
+				m.Get(gomockinternal.AContext(), &defaultSpec).Return(resultVMSS, nil)
+				m.ListInstances(gomockinternal.AContext(), defaultSpec.ResourceGroup, defaultSpec.Name).Return(instances, nil)
+			},
+		},
+		{
+			name:          "failed to delete instances",
+			providerIDs:   []string{providerID},
+			expectedError: "#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_scalesets.MockScaleSetScopeMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
+				s.AzureServiceReconcileTimeout(serviceName).Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ScaleSetSpec(gomockinternal.AContext()).Return(&defaultSpec)
+				m.Get(gomockinternal.AContext(), &defaultSpec).Return(resultVMSS, nil)
+				m.ListInstances(gomockinternal.AContext(), defaultSpec.ResourceGroup, defaultSpec.Name).Return(instances, nil)
+				m.DeleteInstancesAsync(gomockinternal.AContext(), &defaultSpec, []string{"my-vm-2"}).Return(nil, internalError())
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_scalesets.NewMockScaleSetScope(mockCtrl)
+			mockClient := mock_scalesets.NewMockClient(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), mockClient.EXPECT())
+
+			s := &Service{
+				Scope:  scopeMock,
+				Client: mockClient,
+			}
+
+			err := s.DeleteInstances(context.TODO(), tc.providerIDs)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				if tc.notFound {
+					g.Expect(err).To(MatchError(ErrInstancesNotFound))
+				}
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func getFakeSkus() []armcompute.ResourceSKU {
 	return []armcompute.ResourceSKU{
 		{
@@ -696,6 +785,7 @@ func newDefaultVMSSSpec() ScaleSetSpec {
 		SubnetName:                   "my-subnet",
 		VNetName:                     "my-vnet",
 		VNetResourceGroup:            defaultResourceGroup,
+		LBResourceGroup:              defaultResourceGroup,
 		PublicLBName:                 "capz-lb",
 		PublicLBAddressPoolName:      "backendPool",
 		AcceleratedNetworking:        nil,
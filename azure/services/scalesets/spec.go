@@ -45,6 +45,7 @@ type ScaleSetSpec struct {
 	SubnetName                   string
 	VNetName                     string
 	VNetResourceGroup            string
+	LBResourceGroup              string
 	PublicLBName                 string
 	PublicLBAddressPoolName      string
 	AcceleratedNetworking        *bool
@@ -72,6 +73,20 @@ type ScaleSetSpec struct {
 	ShouldPatchCustomData        bool
 	HasReplicasExternallyManaged bool
 	AdditionalTags               infrav1.Tags
+	// ModelUpdatesPaused skips updating the model of an existing Scale Set while still reconciling its capacity.
+	ModelUpdatesPaused bool
+	// PlatformFaultDomainCount is the number of fault domains of the Scale Set. It must not exceed the maximum fault
+	// domain count of the region, which is a capability of AvailabilitySetSKU.
+	PlatformFaultDomainCount *int32
+	AvailabilitySetSKU       *resourceskus.SKU
+	// HostGroupID is the resource ID of the dedicated host group the Scale Set is placed in.
+	HostGroupID string
+	// InboundNATPoolNames are the names of the inbound NAT pools of the public load balancer the Scale Set is associated with.
+	InboundNATPoolNames []string
+	// LBInboundNATPools are the inbound NAT pools of the public load balancer.
+	LBInboundNATPools []infrav1.InboundNATPool
+	// ForceDeleteInstances sets forceDeletion when deleting instances of the Scale Set.
+	ForceDeleteInstances bool
 }
 
 // ResourceName returns the name of the Scale Set.
@@ -97,6 +112,16 @@ func (s *ScaleSetSpec) existingParameters(ctx context.Context, existing interfac
 
 	existingInfraVMSS := converters.SDKToVMSS(existingVMSS, s.VMSSInstances)
 
+	// The capacity of an existing Scale Set can grow beyond the ports of its inbound NAT pools, e.g. when the
+	// MachinePool is scaled up or while surging.
+	if err := s.validateInboundNATPools(s.Capacity); err != nil {
+		return nil, err
+	}
+
+	if s.ModelUpdatesPaused {
+		return s.capacityOnlyParameters(existingVMSS, existingInfraVMSS.Capacity), nil
+	}
+
 	params, err := s.Parameters(ctx, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to generate scale set update parameters for %s", s.Name)
@@ -119,6 +144,9 @@ func (s *ScaleSetSpec) existingParameters(ctx context.Context, existing interfac
 	if s.MaxSurge > 0 && (hasModelChanges || !updated) && !s.HasReplicasExternallyManaged {
 		// surge capacity with the intention of lowering during instance reconciliation
 		surge := s.Capacity + int64(s.MaxSurge)
+		if err := s.validateInboundNATPools(surge); err != nil {
+			return nil, err
+		}
 		vmss.SKU.Capacity = ptr.To[int64](surge)
 	}
 
@@ -132,12 +160,83 @@ func (s *ScaleSetSpec) existingParameters(ctx context.Context, existing interfac
 	return vmss, nil
 }
 
+// validatePlatformFaultDomainCount returns a terminal error if the platform fault domain count exceeds the maximum fault
+// domain count of the region.
+func (s *ScaleSetSpec) validatePlatformFaultDomainCount() error {
+	if s.AvailabilitySetSKU == nil {
+		return errors.New("unable to get required availability set SKU from machine pool cache")
+	}
+	maxCountStr, ok := s.AvailabilitySetSKU.GetCapability(resourceskus.MaximumPlatformFaultDomainCount)
+	if !ok {
+		return errors.Errorf("unable to get required availability set SKU capability %s", resourceskus.MaximumPlatformFaultDomainCount)
+	}
+	maxCount, err := strconv.ParseInt(maxCountStr, 10, 32)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse availability set fault domain count")
+	}
+	if int64(*s.PlatformFaultDomainCount) > maxCount {
+		return azure.WithTerminalError(errors.Errorf("platform fault domain count %d exceeds the maximum fault domain count %d of location %s", *s.PlatformFaultDomainCount, maxCount, s.Location))
+	}
+	return nil
+}
+
+// validateInboundNATPools returns a terminal error if an inbound NAT pool of the Scale Set doesn't exist on the public
+// load balancer, or if its frontend port range doesn't have a port for each of the given number of instances.
+func (s *ScaleSetSpec) validateInboundNATPools(capacity int64) error {
+	for _, name := range s.InboundNATPoolNames {
+		var pool *infrav1.InboundNATPool
+		for i := range s.LBInboundNATPools {
+			if s.LBInboundNATPools[i].Name == name {
+				pool = &s.LBInboundNATPools[i]
+				break
+			}
+		}
+		if pool == nil {
+			return azure.WithTerminalError(errors.Errorf("inbound NAT pool %s doesn't exist on the node outbound load balancer", name))
+		}
+		if ports := int64(pool.FrontendPortRangeEnd-pool.FrontendPortRangeStart) + 1; ports < capacity {
+			return azure.WithTerminalError(errors.Errorf("frontend port range %d-%d of inbound NAT pool %s has fewer ports than the capacity %d of the scale set",
+				pool.FrontendPortRangeStart, pool.FrontendPortRangeEnd, name, capacity))
+		}
+	}
+	return nil
+}
+
+// capacityOnlyParameters returns the existing Scale Set with only its capacity increased to the desired capacity, or nil
+// if the capacity does not need to increase. Decreases in replica count are handled by deleting AzureMachinePoolMachine
+// instances in the MachinePoolScope.
+func (s *ScaleSetSpec) capacityOnlyParameters(existingVMSS armcompute.VirtualMachineScaleSet, existingCapacity int64) interface{} {
+	if s.HasReplicasExternallyManaged || s.Capacity <= existingCapacity {
+		return nil
+	}
+
+	vmss := existingVMSS
+	sku := armcompute.SKU{}
+	if existingVMSS.SKU != nil {
+		sku = *existingVMSS.SKU
+	}
+	sku.Capacity = ptr.To[int64](s.Capacity)
+	vmss.SKU = &sku
+	if vmss.Properties != nil && vmss.Properties.VirtualMachineProfile != nil {
+		properties := *vmss.Properties
+		profile := *properties.VirtualMachineProfile
+		profile.NetworkProfile = nil
+		properties.VirtualMachineProfile = &profile
+		vmss.Properties = &properties
+	}
+	return vmss
+}
+
 // Parameters returns the parameters for the Scale Set.
 func (s *ScaleSetSpec) Parameters(ctx context.Context, existing interface{}) (parameters interface{}, err error) {
 	if existing != nil {
 		return s.existingParameters(ctx, existing)
 	}
 
+	if err := s.validateInboundNATPools(s.Capacity); err != nil {
+		return armcompute.VirtualMachineScaleSet{}, err
+	}
+
 	if s.AcceleratedNetworking == nil {
 		// set accelerated networking to the capability of the VMSize
 		accelNet := s.SKU.HasCapability(resourceskus.AcceleratedNetworking)
@@ -218,6 +317,19 @@ func (s *ScaleSetSpec) Parameters(ctx context.Context, existing interface{}) (pa
 		}
 	}
 
+	if s.HostGroupID != "" {
+		vmss.Properties.HostGroup = &armcompute.SubResource{
+			ID: ptr.To(s.HostGroupID),
+		}
+	}
+
+	if s.PlatformFaultDomainCount != nil {
+		if err := s.validatePlatformFaultDomainCount(); err != nil {
+			return armcompute.VirtualMachineScaleSet{}, err
+		}
+		vmss.Properties.PlatformFaultDomainCount = s.PlatformFaultDomainCount
+	}
+
 	// Assign Identity to VMSS
 	if s.Identity == infrav1.VMIdentitySystemAssigned {
 		vmss.Identity = &armcompute.VirtualMachineScaleSetIdentity{
@@ -302,10 +414,17 @@ func (s *ScaleSetSpec) getVirtualMachineScaleSetNetworkConfiguration() *[]armcom
 		if s.PublicLBAddressPoolName != "" {
 			backendAddressPools = append(backendAddressPools,
 				armcompute.SubResource{
-					ID: ptr.To(azure.AddressPoolID(s.SubscriptionID, s.ResourceGroup, s.PublicLBName, s.PublicLBAddressPoolName)),
+					ID: ptr.To(azure.AddressPoolID(s.SubscriptionID, s.LBResourceGroup, s.PublicLBName, s.PublicLBAddressPoolName)),
 				})
 		}
 	}
+	var inboundNATPools []armcompute.SubResource
+	for _, name := range s.InboundNATPoolNames {
+		inboundNATPools = append(inboundNATPools,
+			armcompute.SubResource{
+				ID: ptr.To(azure.InboundNATPoolID(s.SubscriptionID, s.LBResourceGroup, s.PublicLBName, name)),
+			})
+	}
 	nicConfigs := []armcompute.VirtualMachineScaleSetNetworkConfiguration{}
 	for i, n := range s.NetworkInterfaces {
 		nicConfig := armcompute.VirtualMachineScaleSetNetworkConfiguration{}
@@ -354,6 +473,9 @@ func (s *ScaleSetSpec) getVirtualMachineScaleSetNetworkConfiguration() *[]armcom
 		}
 		if i == 0 {
 			ipconfigs[0].Properties.LoadBalancerBackendAddressPools = azure.PtrSlice(&backendAddressPools)
+			if len(inboundNATPools) > 0 {
+				ipconfigs[0].Properties.LoadBalancerInboundNatPools = azure.PtrSlice(&inboundNATPools)
+			}
 			nicConfig.Properties.Primary = ptr.To(true)
 		}
 		nicConfig.Properties.IPConfigurations = azure.PtrSlice(&ipconfigs)
@@ -401,12 +523,17 @@ func (s *ScaleSetSpec) generateStorageProfile(ctx context.Context) (*armcompute.
 	}
 
 	dataDisks := make([]armcompute.VirtualMachineScaleSetDataDisk, len(s.DataDisks))
+	writeAcceleratorDisks := 0
 	for i, disk := range s.DataDisks {
 		dataDisks[i] = armcompute.VirtualMachineScaleSetDataDisk{
-			CreateOption: ptr.To(armcompute.DiskCreateOptionTypesEmpty),
-			DiskSizeGB:   ptr.To[int32](disk.DiskSizeGB),
-			Lun:          disk.Lun,
-			Name:         ptr.To(azure.GenerateDataDiskName(s.Name, disk.NameSuffix)),
+			CreateOption:            ptr.To(armcompute.DiskCreateOptionTypesEmpty),
+			DiskSizeGB:              ptr.To[int32](disk.DiskSizeGB),
+			Lun:                     disk.Lun,
+			Name:                    ptr.To(azure.GenerateDataDiskName(s.Name, disk.NameSuffix)),
+			WriteAcceleratorEnabled: disk.WriteAcceleratorEnabled,
+		}
+		if ptr.Deref(disk.WriteAcceleratorEnabled, false) {
+			writeAcceleratorDisks++
 		}
 
 		if disk.ManagedDisk != nil {
@@ -421,6 +548,17 @@ func (s *ScaleSetSpec) generateStorageProfile(ctx context.Context) (*armcompute.
 	}
 	storageProfile.DataDisks = azure.PtrSlice(&dataDisks)
 
+	// check the support for write accelerator based on vm size
+	if writeAcceleratorDisks > 0 {
+		supported, err := s.SKU.HasCapabilityWithCapacity(resourceskus.MaxWriteAcceleratorDisksAllowed, int64(writeAcceleratorDisks))
+		if err != nil {
+			return nil, err
+		}
+		if !supported {
+			return nil, azure.WithTerminalError(errors.Errorf("VM size %s does not support write accelerator on %d data disks. Select a different VM size or disable write accelerator", s.Size, writeAcceleratorDisks))
+		}
+	}
+
 	if s.VMImage == nil {
 		return nil, errors.Errorf("vm image is nil")
 	}
@@ -46,7 +46,17 @@ var (
 	hostEncryptionSpec, hostEncryptionVMSS                                             = getHostEncryptionVMSS()
 	hostEncryptionUnsupportedSpec                                                      = getHostEncryptionUnsupportedSpec()
 	ephemeralReadSpec, ephemeralReadVMSS                                               = getEphemeralReadOnlyVMSS()
+	platformFaultDomainCountSpec, platformFaultDomainCountVMSS                         = getPlatformFaultDomainCountVMSS()
+	platformFaultDomainCountExceedsMaxSpec                                             = getPlatformFaultDomainCountExceedsMaxSpec()
+	hostGroupSpec, hostGroupVMSS                                                       = getHostGroupVMSS()
+	inboundNATPoolSpec, inboundNATPoolVMSS                                             = getInboundNATPoolVMSS()
+	inboundNATPoolTooSmallSpec                                                         = getInboundNATPoolTooSmallSpec()
+	inboundNATPoolMissingSpec                                                          = getInboundNATPoolMissingSpec()
 	defaultExistingSpec, defaultExistingVMSS, defaultExistingVMSSClone                 = getExistingDefaultVMSS()
+	pausedScaleOutSpec, pausedExistingVMSS, pausedScaleOutVMSS                         = getModelUpdatesPausedVMSS(3)
+	pausedUnchangedCapacitySpec, pausedUnchangedCapacityVMSS, _                        = getModelUpdatesPausedVMSS(2)
+	inboundNATPoolSurgeSpec                                                            = withExistingInboundNATPool(defaultExistingSpec)
+	inboundNATPoolPausedScaleOutSpec                                                   = withExistingInboundNATPool(pausedScaleOutSpec)
 	userManagedStorageAccountDiagnosticsSpec, userManagedStorageAccountDiagnosticsVMSS = getUserManagedAndStorageAcccountDiagnosticsVMSS()
 	managedDiagnosticsSpec, managedDiagnoisticsVMSS                                    = getManagedDiagnosticsVMSS()
 	disabledDiagnosticsSpec, disabledDiagnosticsVMSS                                   = getDisabledDiagnosticsVMSS()
@@ -350,6 +360,67 @@ func getHostEncryptionUnsupportedSpec() ScaleSetSpec {
 	return spec
 }
 
+func getPlatformFaultDomainCountVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec, vmss := getDefaultVMSS()
+	spec.Location = "test-location"
+	spec.PlatformFaultDomainCount = ptr.To[int32](2)
+	spec.AvailabilitySetSKU = &resourceskus.SKU{
+		Capabilities: []*armcompute.ResourceSKUCapabilities{
+			{
+				Name:  ptr.To(resourceskus.MaximumPlatformFaultDomainCount),
+				Value: ptr.To("3"),
+			},
+		},
+	}
+	vmss.Location = ptr.To("test-location")
+	vmss.Properties.PlatformFaultDomainCount = ptr.To[int32](2)
+
+	return spec, vmss
+}
+
+func getPlatformFaultDomainCountExceedsMaxSpec() ScaleSetSpec {
+	spec, _ := getPlatformFaultDomainCountVMSS()
+	spec.PlatformFaultDomainCount = ptr.To[int32](5)
+	return spec
+}
+
+func getHostGroupVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec, vmss := getDefaultVMSS()
+	spec.HostGroupID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-host-group"
+	vmss.Properties.HostGroup = &armcompute.SubResource{
+		ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-host-group"),
+	}
+
+	return spec, vmss
+}
+
+func getInboundNATPoolVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec, vmss := getDefaultVMSS()
+	spec.InboundNATPoolNames = []string{"ssh"}
+	spec.LBInboundNATPools = []infrav1.InboundNATPool{
+		{Name: "ssh", FrontendPortRangeStart: 50000, FrontendPortRangeEnd: 50001, BackendPort: 22},
+		{Name: "dns", FrontendPortRangeStart: 53000, FrontendPortRangeEnd: 53000, BackendPort: 53},
+	}
+	ipConfig := vmss.Properties.VirtualMachineProfile.NetworkProfile.NetworkInterfaceConfigurations[0].Properties.IPConfigurations[0]
+	ipConfig.Properties.LoadBalancerInboundNatPools = []*armcompute.SubResource{
+		{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/capz-lb/inboundNatPools/ssh")},
+	}
+
+	return spec, vmss
+}
+
+func getInboundNATPoolTooSmallSpec() ScaleSetSpec {
+	spec, _ := getInboundNATPoolVMSS()
+	spec.InboundNATPoolNames = []string{"dns"}
+	return spec
+}
+
+func getInboundNATPoolMissingSpec() ScaleSetSpec {
+	spec, _ := getInboundNATPoolVMSS()
+	spec.InboundNATPoolNames = []string{"rdp"}
+	return spec
+}
+
 func getEphemeralReadOnlyVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
 	spec := newDefaultVMSSSpec()
 	spec.Size = "VM_SIZE_EPH"
@@ -415,6 +486,30 @@ func getExistingDefaultVMSS() (s ScaleSetSpec, existing armcompute.VirtualMachin
 	return spec, existingVMSS, clone
 }
 
+func getModelUpdatesPausedVMSS(capacity int64) (s ScaleSetSpec, existing armcompute.VirtualMachineScaleSet, result armcompute.VirtualMachineScaleSet) {
+	spec, existingVMSS, _ := getExistingDefaultVMSS()
+	spec.Capacity = capacity
+	spec.ModelUpdatesPaused = true
+
+	// only the capacity changes, the image version of the existing model is kept and no capacity is surged.
+	clone := newDefaultExistingVMSS("VM_SIZE")
+	clone.SKU.Capacity = ptr.To[int64](capacity)
+	clone.Properties.AdditionalCapabilities = &armcompute.AdditionalCapabilities{UltraSSDEnabled: ptr.To(true)}
+	clone.Properties.VirtualMachineProfile.NetworkProfile = nil
+
+	return spec, existingVMSS, clone
+}
+
+// withExistingInboundNATPool returns a copy of the spec of an existing Scale Set associated with an inbound NAT pool
+// which has a port for two instances.
+func withExistingInboundNATPool(spec ScaleSetSpec) ScaleSetSpec {
+	spec.InboundNATPoolNames = []string{"ssh"}
+	spec.LBInboundNATPools = []infrav1.InboundNATPool{
+		{Name: "ssh", FrontendPortRangeStart: 50000, FrontendPortRangeEnd: 50001, BackendPort: 22},
+	}
+	return spec
+}
+
 func getUserManagedAndStorageAcccountDiagnosticsVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
 	storageURI := "https://fakeurl"
 	spec := newDefaultVMSSSpec()
@@ -635,6 +730,48 @@ func TestScaleSetParameters(t *testing.T) {
 			expected:      nil,
 			expectedError: "reconcile error that cannot be recovered occurred: encryption at host is not supported for VM type VM_SIZE_EAH. Object will not be requeued",
 		},
+		{
+			name:          "platform fault domain count vmss",
+			spec:          platformFaultDomainCountSpec,
+			existing:      nil,
+			expected:      platformFaultDomainCountVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "platform fault domain count exceeds the maximum of the region",
+			spec:          platformFaultDomainCountExceedsMaxSpec,
+			existing:      nil,
+			expected:      nil,
+			expectedError: "reconcile error that cannot be recovered occurred: platform fault domain count 5 exceeds the maximum fault domain count 3 of location test-location. Object will not be requeued",
+		},
+		{
+			name:          "vmss associated with an inbound NAT pool",
+			spec:          inboundNATPoolSpec,
+			existing:      nil,
+			expected:      inboundNATPoolVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "inbound NAT pool frontend port range smaller than the capacity",
+			spec:          inboundNATPoolTooSmallSpec,
+			existing:      nil,
+			expected:      nil,
+			expectedError: "reconcile error that cannot be recovered occurred: frontend port range 53000-53000 of inbound NAT pool dns has fewer ports than the capacity 2 of the scale set. Object will not be requeued",
+		},
+		{
+			name:          "inbound NAT pool missing from the load balancer",
+			spec:          inboundNATPoolMissingSpec,
+			existing:      nil,
+			expected:      nil,
+			expectedError: "reconcile error that cannot be recovered occurred: inbound NAT pool rdp doesn't exist on the node outbound load balancer. Object will not be requeued",
+		},
+		{
+			name:          "vmss in a dedicated host group",
+			spec:          hostGroupSpec,
+			existing:      nil,
+			expected:      hostGroupVMSS,
+			expectedError: "",
+		},
 		{
 			name:          "ephemeral os disk read only vmss",
 			spec:          ephemeralReadSpec,
@@ -649,6 +786,34 @@ func TestScaleSetParameters(t *testing.T) {
 			expected:      defaultExistingVMSSClone,
 			expectedError: "",
 		},
+		{
+			name:          "scale out existing vmss without updating its model while model updates are paused",
+			spec:          pausedScaleOutSpec,
+			existing:      pausedExistingVMSS,
+			expected:      pausedScaleOutVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "existing vmss with model changes is not updated while model updates are paused",
+			spec:          pausedUnchangedCapacitySpec,
+			existing:      pausedUnchangedCapacityVMSS,
+			expected:      nil,
+			expectedError: "",
+		},
+		{
+			name:          "inbound NAT pool frontend port range smaller than the surged capacity of an existing vmss",
+			spec:          inboundNATPoolSurgeSpec,
+			existing:      defaultExistingVMSS,
+			expected:      nil,
+			expectedError: "reconcile error that cannot be recovered occurred: frontend port range 50000-50001 of inbound NAT pool ssh has fewer ports than the capacity 3 of the scale set. Object will not be requeued",
+		},
+		{
+			name:          "inbound NAT pool frontend port range smaller than the capacity of an existing vmss while model updates are paused",
+			spec:          inboundNATPoolPausedScaleOutSpec,
+			existing:      pausedExistingVMSS,
+			expected:      nil,
+			expectedError: "reconcile error that cannot be recovered occurred: frontend port range 50000-50001 of inbound NAT pool ssh has fewer ports than the capacity 3 of the scale set. Object will not be requeued",
+		},
 		{
 			name:          "vm with diagnostics set to User Managed and StorageAccountURI set",
 			spec:          userManagedStorageAccountDiagnosticsSpec,
@@ -707,3 +872,36 @@ func TestScaleSetParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestScaleSetNetworkInterfacesSubnets(t *testing.T) {
+	g := NewWithT(t)
+
+	subnetIDs := func(spec *ScaleSetSpec) []string {
+		var ids []string
+		for _, nic := range *spec.getVirtualMachineScaleSetNetworkConfiguration() {
+			for _, ipConfig := range nic.Properties.IPConfigurations {
+				ids = append(ids, *ipConfig.Properties.Subnet.ID)
+			}
+		}
+		return ids
+	}
+	poolSpec := func(name, subnetName string) *ScaleSetSpec {
+		return &ScaleSetSpec{
+			Name:              name,
+			SubscriptionID:    "123",
+			VNetName:          "my-vnet",
+			VNetResourceGroup: "my-rg",
+			SubnetName:        subnetName,
+			NetworkInterfaces: []infrav1.NetworkInterface{
+				{SubnetName: subnetName, PrivateIPConfigs: 1},
+			},
+		}
+	}
+
+	g.Expect(subnetIDs(poolSpec("pool-1", "node-subnet-1"))).To(Equal([]string{
+		"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/node-subnet-1",
+	}))
+	g.Expect(subnetIDs(poolSpec("pool-2", "node-subnet-2"))).To(Equal([]string{
+		"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/node-subnet-2",
+	}))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
@@ -68,12 +69,41 @@ func (ac *azureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (
 	return resp.VirtualMachineScaleSetVM, nil
 }
 
-// CreateOrUpdateAsync is a dummy implementation to fulfill the async.Reconciler interface.
+// CreateOrUpdateAsync updates a virtual machine scale set instance asynchronously, e.g. to change its scale-in
+// protection. Instances are never created directly, as they are created by their VMSS.
+// It sends a PUT request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
 func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armcompute.VirtualMachineScaleSetVMsClientUpdateResponse], err error) {
-	_, _, done := tele.StartSpanWithLogger(ctx, "scalesets.AzureClient.CreateOrUpdateAsync")
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesetvms.AzureClient.CreateOrUpdateAsync")
 	defer done()
 
-	return nil, nil, nil
+	var instance armcompute.VirtualMachineScaleSetVM
+	if parameters != nil {
+		var ok bool
+		if instance, ok = parameters.(armcompute.VirtualMachineScaleSetVM); !ok {
+			return nil, nil, errors.Errorf("%T is not an armcompute.VirtualMachineScaleSetVM", parameters)
+		}
+	}
+
+	opts := &armcompute.VirtualMachineScaleSetVMsClientBeginUpdateOptions{ResumeToken: resumeToken}
+	poller, err = ac.scalesetvms.BeginUpdate(ctx, spec.ResourceGroupName(), spec.OwnerResourceName(), spec.ResourceName(), instance, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	resp, err := poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the Poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return nil, poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return resp.VirtualMachineScaleSetVM, nil, err
 }
 
 // DeleteAsync deletes a virtual machine scale set instance asynchronously. DeleteAsync sends a DELETE
@@ -83,8 +113,7 @@ func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecG
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesetvms.AzureClient.DeleteAsync")
 	defer done()
 
-	opts := &armcompute.VirtualMachineScaleSetVMsClientBeginDeleteOptions{ResumeToken: resumeToken}
-	poller, err = ac.scalesetvms.BeginDelete(ctx, spec.ResourceGroupName(), spec.OwnerResourceName(), spec.ResourceName(), opts)
+	poller, err = ac.scalesetvms.BeginDelete(ctx, spec.ResourceGroupName(), spec.OwnerResourceName(), spec.ResourceName(), deleteOptions(spec, resumeToken))
 	if err != nil {
 		return nil, err
 	}
@@ -103,3 +132,12 @@ func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecG
 	// if the operation completed, return a nil poller.
 	return nil, err
 }
+
+// deleteOptions returns the options to delete the instance of a spec with, force deleting it if the spec asks for it.
+func deleteOptions(spec azure.ResourceSpecGetter, resumeToken string) *armcompute.VirtualMachineScaleSetVMsClientBeginDeleteOptions {
+	opts := &armcompute.VirtualMachineScaleSetVMsClientBeginDeleteOptions{ResumeToken: resumeToken}
+	if vmSpec, ok := spec.(*ScaleSetVMSpec); ok && vmSpec.ForceDelete {
+		opts.ForceDeletion = ptr.To(true)
+	}
+	return opts
+}
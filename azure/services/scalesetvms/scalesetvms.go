@@ -103,8 +103,9 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		log.V(4).Info("VMSS is uniform", "vmssName", scaleSetVMSpec.Name, "providerID", scaleSetVMSpec.ProviderID, "instanceID", scaleSetVMSpec.InstanceID)
 	}
 
-	// We only want to get the resource if it exists and handle the not found error.
-	// We're using CreateOrUpdateResource() to do so but it doesn't actually create or update anything since getter.Parameters() always returns nil.
+	// Instances are created by their scale set, so we only get the resource if it exists and handle the not found error.
+	// We're using CreateOrUpdateResource() to do so, which never creates an instance since getter.Parameters() returns nil
+	// if it doesn't exist yet. It only updates an existing Uniform instance whose scale-in protection changed.
 	result, err = reconciler.CreateOrUpdateResource(ctx, getter, serviceName)
 	if err != nil {
 		return err
@@ -27,6 +27,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
@@ -238,3 +239,30 @@ func TestDeleteVMSS(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *ScaleSetVMSpec
+		expected *armcompute.VirtualMachineScaleSetVMsClientBeginDeleteOptions
+	}{
+		{
+			name:     "graceful deletion by default",
+			spec:     &ScaleSetVMSpec{InstanceID: "0"},
+			expected: &armcompute.VirtualMachineScaleSetVMsClientBeginDeleteOptions{ResumeToken: "token"},
+		},
+		{
+			name:     "force deletion",
+			spec:     &ScaleSetVMSpec{InstanceID: "0", ForceDelete: true},
+			expected: &armcompute.VirtualMachineScaleSetVMsClientBeginDeleteOptions{ResumeToken: "token", ForceDeletion: ptr.To(true)},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			g.Expect(deleteOptions(tc.spec, "token")).To(Equal(tc.expected))
+		})
+	}
+}
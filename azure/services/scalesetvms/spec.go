@@ -18,6 +18,10 @@ package scalesetvms
 
 import (
 	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 )
 
 // ScaleSetVMSpec defines the specification for a VMSS VM.
@@ -29,6 +33,10 @@ type ScaleSetVMSpec struct {
 	ProviderID    string
 	ResourceID    string
 	IsFlex        bool
+	// ForceDelete sets forceDeletion when deleting the instance instead of deleting it gracefully.
+	ForceDelete bool
+	// ProtectFromScaleIn is the desired protectFromScaleIn setting of the instance.
+	ProtectFromScaleIn bool
 }
 
 // ResourceName returns the instance ID of the VMSS VM. This is because the it is identified by the instance ID in Azure instead of the name.
@@ -46,9 +54,33 @@ func (s *ScaleSetVMSpec) OwnerResourceName() string {
 	return s.ScaleSetName
 }
 
-// Parameters is a no-op for VMSS VMs as this spec is only used to Get().
+// Parameters returns the existing instance with the desired scale-in protection if it differs from the existing one.
+// The spec never creates an instance, as instances are created by their VMSS.
 func (s *ScaleSetVMSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
-	return nil, nil
+	if existing == nil {
+		return nil, nil
+	}
+
+	instance, ok := existing.(armcompute.VirtualMachineScaleSetVM)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armcompute.VirtualMachineScaleSetVM", existing)
+	}
+	if instance.Properties == nil {
+		instance.Properties = &armcompute.VirtualMachineScaleSetVMProperties{}
+	}
+	policy := instance.Properties.ProtectionPolicy
+	if policy == nil {
+		policy = &armcompute.VirtualMachineScaleSetVMProtectionPolicy{}
+	}
+	if ptr.Deref(policy.ProtectFromScaleIn, false) == s.ProtectFromScaleIn {
+		return nil, nil
+	}
+
+	instance.Properties.ProtectionPolicy = &armcompute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         ptr.To(s.ProtectFromScaleIn),
+		ProtectFromScaleSetActions: policy.ProtectFromScaleSetActions,
+	}
+	return instance, nil
 }
 
 // VMSSFlexGetter defines the specification for a VMSS flex VM.
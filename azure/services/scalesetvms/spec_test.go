@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalesetvms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestScaleSetVMSpecParameters(t *testing.T) {
+	instance := func(policy *armcompute.VirtualMachineScaleSetVMProtectionPolicy) armcompute.VirtualMachineScaleSetVM {
+		return armcompute.VirtualMachineScaleSetVM{
+			InstanceID: ptr.To("0"),
+			Location:   ptr.To("eastus"),
+			Properties: &armcompute.VirtualMachineScaleSetVMProperties{
+				ProtectionPolicy: policy,
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		protectFromScaleIn bool
+		existing           interface{}
+		want               interface{}
+		wantErr            bool
+	}{
+		{
+			name:               "instance does not exist",
+			protectFromScaleIn: true,
+			existing:           nil,
+			want:               nil,
+		},
+		{
+			name:               "instance without protection stays unprotected",
+			protectFromScaleIn: false,
+			existing:           instance(nil),
+			want:               nil,
+		},
+		{
+			name:               "instance is already protected",
+			protectFromScaleIn: true,
+			existing:           instance(&armcompute.VirtualMachineScaleSetVMProtectionPolicy{ProtectFromScaleIn: ptr.To(true)}),
+			want:               nil,
+		},
+		{
+			name:               "instance gets protected from scale-in",
+			protectFromScaleIn: true,
+			existing:           instance(nil),
+			want:               instance(&armcompute.VirtualMachineScaleSetVMProtectionPolicy{ProtectFromScaleIn: ptr.To(true)}),
+		},
+		{
+			name:               "instance protection is removed and scale set actions protection is kept",
+			protectFromScaleIn: false,
+			existing:           instance(&armcompute.VirtualMachineScaleSetVMProtectionPolicy{ProtectFromScaleIn: ptr.To(true), ProtectFromScaleSetActions: ptr.To(true)}),
+			want:               instance(&armcompute.VirtualMachineScaleSetVMProtectionPolicy{ProtectFromScaleIn: ptr.To(false), ProtectFromScaleSetActions: ptr.To(true)}),
+		},
+		{
+			name:               "existing is not an instance",
+			protectFromScaleIn: true,
+			existing:           armcompute.VirtualMachine{},
+			wantErr:            true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := &ScaleSetVMSpec{
+				Name:               "my-vm",
+				InstanceID:         "0",
+				ResourceGroup:      "my-rg",
+				ScaleSetName:       "my-vmss",
+				ProtectFromScaleIn: tc.protectFromScaleIn,
+			}
+			got, err := spec.Parameters(context.Background(), tc.existing)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.want == nil {
+				g.Expect(got).To(BeNil())
+			} else {
+				g.Expect(got).To(Equal(tc.want))
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 )
 
@@ -36,6 +37,8 @@ type NSGSpec struct {
 	ResourceGroup            string
 	AdditionalTags           infrav1.Tags
 	LastAppliedSecurityRules map[string]interface{}
+	RulesMode                infrav1.SecurityRulesMode
+	ReservedPriorities       *infrav1.SecurityRulePriorityRange
 }
 
 // ResourceName returns the name of the security group.
@@ -58,6 +61,7 @@ func (s *NSGSpec) Parameters(ctx context.Context, existing interface{}) (interfa
 	securityRules := make([]*armnetwork.SecurityRule, 0)
 	newAnnotation := map[string]string{}
 	var etag *string
+	tags := s.tags()
 
 	if existing != nil {
 		existingNSG, ok := existing.(armnetwork.SecurityGroup)
@@ -67,8 +71,30 @@ func (s *NSGSpec) Parameters(ctx context.Context, existing interface{}) (interfa
 		// security group already exists
 		// We append the existing NSG etag to the header to ensure we only apply the updates if the NSG has not been modified.
 		etag = existingNSG.Etag
+
+		// The tags of a security group owned by the cluster are kept up to date, tags added outside of CAPZ are kept.
+		tagsChanged := false
+		if existingTags := converters.MapToTags(existingNSG.Tags); existingTags.HasOwned(s.ClusterName) {
+			tagsChanged = len(tags.Difference(existingTags)) > 0
+			existingTags.Merge(tags)
+			tags = existingTags
+		}
+
+		if s.RulesMode == infrav1.SecurityRulesModeAdditive {
+			var update bool
+			var err error
+			securityRules, update, err = s.mergeSecurityRules(existingNSG.Properties.SecurityRules)
+			if err != nil {
+				return nil, err
+			}
+			if !update && !tagsChanged {
+				return nil, nil
+			}
+			return s.securityGroup(securityRules, etag, tags), nil
+		}
+
 		// Check if the expected rules are present
-		update := false
+		update := tagsChanged
 
 		for _, rule := range s.SecurityRules {
 			sdkRule := converters.SecurityRuleToSDK(rule)
@@ -103,19 +129,115 @@ func (s *NSGSpec) Parameters(ctx context.Context, existing interface{}) (interfa
 		}
 	}
 
+	return s.securityGroup(securityRules, etag, tags), nil
+}
+
+// tags returns the tags of the security group.
+func (s *NSGSpec) tags() infrav1.Tags {
+	return infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.ClusterName,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        ptr.To(s.Name),
+		Additional:  s.AdditionalTags,
+	})
+}
+
+// securityGroup returns the security group parameters with the given security rules and tags.
+func (s *NSGSpec) securityGroup(securityRules []*armnetwork.SecurityRule, etag *string, tags infrav1.Tags) armnetwork.SecurityGroup {
 	return armnetwork.SecurityGroup{
 		Location: ptr.To(s.Location),
 		Properties: &armnetwork.SecurityGroupPropertiesFormat{
 			SecurityRules: securityRules,
 		},
 		Etag: etag,
-		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
-			ClusterName: s.ClusterName,
-			Lifecycle:   infrav1.ResourceLifecycleOwned,
-			Name:        ptr.To(s.Name),
-			Additional:  s.AdditionalTags,
-		})),
-	}, nil
+		Tags: converters.TagsToMap(tags),
+	}
+}
+
+// mergeSecurityRules merges the security rules of the spec with the existing rules of the security group for the
+// "Additive" rules mode. Existing rules with the same name as a rule of the spec are replaced, rules previously applied
+// by CAPZ which are no longer in the spec are removed, and all other rules are kept as they were added outside of CAPZ.
+// It returns whether the merged rules differ from the existing rules.
+func (s *NSGSpec) mergeSecurityRules(existingRules []*armnetwork.SecurityRule) ([]*armnetwork.SecurityRule, bool, error) {
+	securityRules := make([]*armnetwork.SecurityRule, 0, len(s.SecurityRules)+len(existingRules))
+	desired := make(map[string]bool, len(s.SecurityRules))
+	update := false
+
+	for _, rule := range s.SecurityRules {
+		sdkRule := converters.SecurityRuleToSDK(rule)
+		if !ruleUpToDate(existingRules, sdkRule) {
+			update = true
+		}
+		securityRules = append(securityRules, sdkRule)
+		desired[strings.ToLower(rule.Name)] = true
+	}
+
+	for _, existingRule := range existingRules {
+		name := ptr.Deref(existingRule.Name, "")
+		if desired[strings.ToLower(name)] {
+			// The rule is replaced by the rule of the spec.
+			continue
+		}
+		if _, tracked := s.LastAppliedSecurityRules[name]; tracked {
+			// The rule was previously applied by CAPZ and has been removed from the spec.
+			update = true
+			continue
+		}
+		if s.ReservedPriorities != nil && existingRule.Properties != nil {
+			if priority := ptr.Deref(existingRule.Properties.Priority, 0); s.ReservedPriorities.Contains(priority) {
+				return nil, false, azure.WithTerminalError(errors.Errorf(
+					"security rule %s of security group %s was not added by CAPZ but uses priority %d, which is reserved for CAPZ-managed rules (%d-%d)",
+					name, s.Name, priority, s.ReservedPriorities.Min, s.ReservedPriorities.Max))
+			}
+		}
+		// The rule was added outside of CAPZ.
+		securityRules = append(securityRules, existingRule)
+	}
+
+	return securityRules, update, nil
+}
+
+// ruleUpToDate returns true if a rule with the same name and properties as the given rule exists.
+func ruleUpToDate(rules []*armnetwork.SecurityRule, rule *armnetwork.SecurityRule) bool {
+	for _, existingRule := range rules {
+		if !strings.EqualFold(ptr.Deref(existingRule.Name, ""), ptr.Deref(rule.Name, "")) {
+			continue
+		}
+		if existingRule.Properties == nil {
+			return false
+		}
+		existing, desired := existingRule.Properties, rule.Properties
+		return ptr.Deref(existing.Description, "") == ptr.Deref(desired.Description, "") &&
+			strings.EqualFold(string(ptr.Deref(existing.Protocol, "")), string(ptr.Deref(desired.Protocol, ""))) &&
+			strings.EqualFold(string(ptr.Deref(existing.Direction, "")), string(ptr.Deref(desired.Direction, ""))) &&
+			strings.EqualFold(string(ptr.Deref(existing.Access, "")), string(ptr.Deref(desired.Access, ""))) &&
+			ptr.Deref(existing.Priority, 0) == ptr.Deref(desired.Priority, 0) &&
+			strings.EqualFold(ptr.Deref(existing.SourcePortRange, ""), ptr.Deref(desired.SourcePortRange, "")) &&
+			strings.EqualFold(ptr.Deref(existing.DestinationPortRange, ""), ptr.Deref(desired.DestinationPortRange, "")) &&
+			strings.EqualFold(ptr.Deref(existing.SourceAddressPrefix, ""), ptr.Deref(desired.SourceAddressPrefix, "")) &&
+			strings.EqualFold(ptr.Deref(existing.DestinationAddressPrefix, ""), ptr.Deref(desired.DestinationAddressPrefix, "")) &&
+			sameAddressPrefixes(existing.SourceAddressPrefixes, desired.SourceAddressPrefixes)
+	}
+	return false
+}
+
+// sameAddressPrefixes returns true if both lists contain the same address prefixes, regardless of order.
+func sameAddressPrefixes(a, b []*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	prefixes := make(map[string]int, len(a))
+	for _, prefix := range a {
+		prefixes[strings.ToLower(ptr.Deref(prefix, ""))]++
+	}
+	for _, prefix := range b {
+		key := strings.ToLower(ptr.Deref(prefix, ""))
+		if prefixes[key] == 0 {
+			return false
+		}
+		prefixes[key]--
+	}
+	return true
 }
 
 // TODO: review this logic and make sure it is what we want. It seems incorrect to skip rules that don't have a certain protocol, etc.
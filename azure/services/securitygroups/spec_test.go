@@ -64,6 +64,18 @@ var (
 		DestinationPorts: ptr.To("80"),
 		Action:           infrav1.SecurityRuleActionAllow,
 	}
+	otherRuleModified = infrav1.SecurityRule{
+		Name:             "other_rule",
+		Description:      "Test Rule",
+		Priority:         500,
+		Protocol:         infrav1.SecurityGroupProtocolTCP,
+		Direction:        infrav1.SecurityRuleDirectionInbound,
+		Source:           ptr.To("*"),
+		SourcePorts:      ptr.To("*"),
+		Destination:      ptr.To("*"),
+		DestinationPorts: ptr.To("8080"),
+		Action:           infrav1.SecurityRuleActionAllow,
+	}
 	denyRule = infrav1.SecurityRule{
 		Name:             "deny_rule",
 		Description:      "Deny Rule",
@@ -321,6 +333,240 @@ func TestParameters(t *testing.T) {
 				g.Expect(result).To(BeNil())
 			},
 		},
+		{
+			name: "additive NSG keeps a rule added outside of CAPZ when adding a rule",
+			spec: &NSGSpec{
+				Name:     "test-nsg",
+				Location: "test-location",
+				SecurityRules: infrav1.SecurityRules{
+					otherRule,
+					customRule,
+				},
+				ResourceGroup:      "test-group",
+				ClusterName:        "my-cluster",
+				RulesMode:          infrav1.SecurityRulesModeAdditive,
+				ReservedPriorities: &infrav1.SecurityRulePriorityRange{Min: 500, Max: 599},
+				LastAppliedSecurityRules: map[string]interface{}{
+					"other_rule": otherRule.Description,
+				},
+			},
+			existing: armnetwork.SecurityGroup{
+				Name:     ptr.To("test-nsg"),
+				Location: ptr.To("test-location"),
+				Etag:     ptr.To("fake-etag"),
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules: []*armnetwork.SecurityRule{
+						converters.SecurityRuleToSDK(sshRule),
+						converters.SecurityRuleToSDK(otherRule),
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armnetwork.SecurityGroup{
+					Location: ptr.To("test-location"),
+					Etag:     ptr.To("fake-etag"),
+					Properties: &armnetwork.SecurityGroupPropertiesFormat{
+						SecurityRules: []*armnetwork.SecurityRule{
+							converters.SecurityRuleToSDK(otherRule),
+							converters.SecurityRuleToSDK(customRule),
+							converters.SecurityRuleToSDK(sshRule),
+						},
+					},
+					Tags: map[string]*string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+						"Name": ptr.To("test-nsg"),
+					},
+				}))
+			},
+		},
+		{
+			name: "additive NSG replaces a modified rule and removes a rule previously applied by CAPZ",
+			spec: &NSGSpec{
+				Name:     "test-nsg",
+				Location: "test-location",
+				SecurityRules: infrav1.SecurityRules{
+					otherRuleModified,
+				},
+				ResourceGroup:      "test-group",
+				ClusterName:        "my-cluster",
+				RulesMode:          infrav1.SecurityRulesModeAdditive,
+				ReservedPriorities: &infrav1.SecurityRulePriorityRange{Min: 500, Max: 599},
+				LastAppliedSecurityRules: map[string]interface{}{
+					"other_rule":  otherRule.Description,
+					"custom_rule": customRule.Description,
+				},
+			},
+			existing: armnetwork.SecurityGroup{
+				Name:     ptr.To("test-nsg"),
+				Location: ptr.To("test-location"),
+				Etag:     ptr.To("fake-etag"),
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules: []*armnetwork.SecurityRule{
+						converters.SecurityRuleToSDK(sshRule),
+						converters.SecurityRuleToSDK(otherRule),
+						converters.SecurityRuleToSDK(customRule),
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armnetwork.SecurityGroup{
+					Location: ptr.To("test-location"),
+					Etag:     ptr.To("fake-etag"),
+					Properties: &armnetwork.SecurityGroupPropertiesFormat{
+						SecurityRules: []*armnetwork.SecurityRule{
+							converters.SecurityRuleToSDK(otherRuleModified),
+							converters.SecurityRuleToSDK(sshRule),
+						},
+					},
+					Tags: map[string]*string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+						"Name": ptr.To("test-nsg"),
+					},
+				}))
+			},
+		},
+		{
+			name: "additive NSG with all rules present and a rule added outside of CAPZ",
+			spec: &NSGSpec{
+				Name:     "test-nsg",
+				Location: "test-location",
+				SecurityRules: infrav1.SecurityRules{
+					otherRule,
+				},
+				ResourceGroup:      "test-group",
+				ClusterName:        "my-cluster",
+				RulesMode:          infrav1.SecurityRulesModeAdditive,
+				ReservedPriorities: &infrav1.SecurityRulePriorityRange{Min: 500, Max: 599},
+				LastAppliedSecurityRules: map[string]interface{}{
+					"other_rule": otherRule.Description,
+				},
+			},
+			existing: armnetwork.SecurityGroup{
+				Name:     ptr.To("test-nsg"),
+				Location: ptr.To("test-location"),
+				Etag:     ptr.To("fake-etag"),
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules: []*armnetwork.SecurityRule{
+						converters.SecurityRuleToSDK(sshRule),
+						converters.SecurityRuleToSDK(otherRule),
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "additive NSG with a rule added outside of CAPZ using a reserved priority",
+			spec: &NSGSpec{
+				Name:     "test-nsg",
+				Location: "test-location",
+				SecurityRules: infrav1.SecurityRules{
+					otherRule,
+				},
+				ResourceGroup:      "test-group",
+				ClusterName:        "my-cluster",
+				RulesMode:          infrav1.SecurityRulesModeAdditive,
+				ReservedPriorities: &infrav1.SecurityRulePriorityRange{Min: 500, Max: 599},
+			},
+			existing: armnetwork.SecurityGroup{
+				Name:     ptr.To("test-nsg"),
+				Location: ptr.To("test-location"),
+				Etag:     ptr.To("fake-etag"),
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules: []*armnetwork.SecurityRule{
+						converters.SecurityRuleToSDK(otherRule),
+						converters.SecurityRuleToSDK(denyRule),
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: security rule deny_rule of security group test-nsg was not added by CAPZ but uses priority 510, which is reserved for CAPZ-managed rules (500-599). Object will not be requeued",
+		},
+		{
+			name: "NSG owned by the cluster already exists with all rules present and outdated tags",
+			spec: &NSGSpec{
+				Name:     "test-nsg",
+				Location: "test-location",
+				SecurityRules: infrav1.SecurityRules{
+					sshRule,
+				},
+				ResourceGroup:  "test-group",
+				ClusterName:    "my-cluster",
+				AdditionalTags: infrav1.Tags{"foo": "bar"},
+			},
+			existing: armnetwork.SecurityGroup{
+				Name:     ptr.To("test-nsg"),
+				Location: ptr.To("test-location"),
+				Etag:     ptr.To("fake-etag"),
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules: []*armnetwork.SecurityRule{
+						converters.SecurityRuleToSDK(sshRule),
+					},
+				},
+				Tags: map[string]*string{
+					"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+					"Name":      ptr.To("test-nsg"),
+					"unmanaged": ptr.To("tag"),
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.SecurityGroup{}))
+				g.Expect(result).To(Equal(armnetwork.SecurityGroup{
+					Properties: &armnetwork.SecurityGroupPropertiesFormat{
+						SecurityRules: []*armnetwork.SecurityRule{
+							converters.SecurityRuleToSDK(sshRule),
+						},
+					},
+					Location: ptr.To("test-location"),
+					Etag:     ptr.To("fake-etag"),
+					Tags: map[string]*string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+						"Name":      ptr.To("test-nsg"),
+						"foo":       ptr.To("bar"),
+						"unmanaged": ptr.To("tag"),
+					},
+				}))
+			},
+		},
+		{
+			name: "additive NSG owned by the cluster already exists with all rules present and outdated tags",
+			spec: &NSGSpec{
+				Name:     "test-nsg",
+				Location: "test-location",
+				SecurityRules: infrav1.SecurityRules{
+					otherRule,
+				},
+				ResourceGroup:  "test-group",
+				ClusterName:    "my-cluster",
+				RulesMode:      infrav1.SecurityRulesModeAdditive,
+				AdditionalTags: infrav1.Tags{"foo": "bar"},
+			},
+			existing: armnetwork.SecurityGroup{
+				Name:     ptr.To("test-nsg"),
+				Location: ptr.To("test-location"),
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules: []*armnetwork.SecurityRule{
+						converters.SecurityRuleToSDK(otherRule),
+					},
+				},
+				Tags: map[string]*string{
+					"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+					"Name": ptr.To("test-nsg"),
+					"foo":  ptr.To("baz"),
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.SecurityGroup{}))
+				g.Expect(result.(armnetwork.SecurityGroup).Tags).To(Equal(map[string]*string{
+					"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+					"Name": ptr.To("test-nsg"),
+					"foo":  ptr.To("bar"),
+				}))
+			},
+		},
 		{
 			name: "NSG does not exist",
 			spec: &NSGSpec{
@@ -18,14 +18,26 @@ package subnets
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	asonetworkv1 "github.com/Azure/azure-service-operator/v2/api/network/v1api20201101"
+	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets/mock_subnets"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestPostCreateOrUpdateResourceHook(t *testing.T) {
@@ -55,3 +67,87 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 		g.Expect(postCreateOrUpdateResourceHook(context.Background(), scope, subnet, nil)).To(Succeed())
 	})
 }
+
+func TestAdoptSubnet(t *testing.T) {
+	const subnetID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet"
+
+	for _, adoptAnnotation := range []bool{true, false} {
+		adoptAnnotation := adoptAnnotation
+		t.Run(fmt.Sprintf("adopt annotation: %t", adoptAnnotation), func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			ctx := context.Background()
+
+			sch := runtime.NewScheme()
+			g.Expect(asonetworkv1.AddToScheme(sch)).To(Succeed())
+			g.Expect(infrav1.AddToScheme(sch)).To(Succeed())
+			c := fakeclient.NewClientBuilder().
+				WithScheme(sch).
+				Build()
+
+			owner := &infrav1.AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-cluster",
+					Namespace: "default",
+				},
+			}
+			if adoptAnnotation {
+				// resource IDs are matched case-insensitively.
+				owner.Annotations = map[string]string{infrav1.AdoptAnnotation: "/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.Network/virtualNetworks/other-vnet, " + strings.ToUpper(subnetID)}
+			}
+			r := aso.New[*asonetworkv1.VirtualNetworksSubnet](c, "my-cluster", owner)
+			// The subnet belongs to a pre-existing vnet, so it isn't managed by CAPZ by default.
+			spec := &SubnetSpec{
+				Name:          "my-subnet",
+				VNetName:      "my-vnet",
+				IsVNetManaged: false,
+				CIDRs:         []string{"10.0.0.0/24"},
+			}
+			key := types.NamespacedName{Namespace: "default", Name: "my-vnet-my-subnet"}
+
+			// The first reconcile creates the ASO resource with the "skip" reconcile policy.
+			_, err := r.CreateOrUpdateResource(ctx, spec, serviceName)
+			g.Expect(azure.IsOperationNotDoneError(err)).To(BeTrue())
+
+			// ASO finds the existing subnet in Azure.
+			subnet := &asonetworkv1.VirtualNetworksSubnet{}
+			g.Expect(c.Get(ctx, key, subnet)).To(Succeed())
+			g.Expect(subnet.Annotations).To(HaveKeyWithValue(asoannotations.ReconcilePolicy, string(asoannotations.ReconcilePolicySkip)))
+			subnet.Annotations[genruntime.ResourceIDAnnotation] = subnetID
+			subnet.Status = asonetworkv1.VirtualNetworks_Subnet_STATUS{
+				Id: ptr.To(subnetID),
+				Conditions: []conditions.Condition{
+					{
+						Type:   conditions.ConditionTypeReady,
+						Status: metav1.ConditionTrue,
+					},
+				},
+			}
+			g.Expect(c.Update(ctx, subnet)).To(Succeed())
+			before := subnet.DeepCopy()
+
+			// The next reconcile adopts the subnet without changing it.
+			result, err := r.CreateOrUpdateResource(ctx, spec, serviceName)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).NotTo(BeNil())
+			subnet = &asonetworkv1.VirtualNetworksSubnet{}
+			g.Expect(c.Get(ctx, key, subnet)).To(Succeed())
+			g.Expect(subnet.Spec).To(Equal(before.Spec))
+			g.Expect(subnet.Annotations).To(HaveKeyWithValue(asoannotations.ReconcilePolicy, string(asoannotations.ReconcilePolicySkip)))
+			if !adoptAnnotation {
+				g.Expect(subnet.Annotations).To(Equal(before.Annotations))
+				return
+			}
+			g.Expect(subnet.Annotations).To(HaveKeyWithValue("sigs.k8s.io/cluster-api-provider-azure-adopted", "true"))
+
+			// Subsequent reconciles manage the adopted subnet like a subnet created by CAPZ.
+			spec.CIDRs = []string{"10.0.0.0/16"}
+			_, err = r.CreateOrUpdateResource(ctx, spec, serviceName)
+			g.Expect(azure.IsOperationNotDoneError(err)).To(BeTrue())
+			subnet = &asonetworkv1.VirtualNetworksSubnet{}
+			g.Expect(c.Get(ctx, key, subnet)).To(Succeed())
+			g.Expect(subnet.Annotations).To(HaveKeyWithValue(asoannotations.ReconcilePolicy, string(asoannotations.ReconcilePolicyManage)))
+			g.Expect(subnet.Annotations).To(HaveKeyWithValue("sigs.k8s.io/cluster-api-provider-azure-adopted", "true"))
+			g.Expect(subnet.Spec.AddressPrefix).To(Equal(ptr.To("10.0.0.0/16")))
+		})
+	}
+}
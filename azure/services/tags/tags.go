@@ -66,7 +66,9 @@ func (s *Service) Name() string {
 // key for those types should be listed here so their tags are always
 // interpreted as managed.
 var alwaysManagedAnnotations = map[string]struct{}{
-	azure.ManagedClusterTagsLastAppliedAnnotation: {},
+	azure.ManagedClusterTagsLastAppliedAnnotation:    {},
+	azure.NodeResourceGroupTagsLastAppliedAnnotation: {},
+	azure.AKSOutboundTagsLastAppliedAnnotation:       {},
 }
 
 // Reconcile ensures tags are correct.
@@ -74,6 +76,9 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "tags.Service.Reconcile")
 	defer done()
 
+	// Specs sharing an annotation, like the AKS outbound resources, are all compared with the tags
+	// applied before this reconcile, as the annotation is updated after each of them.
+	lastAppliedTagsByAnnotation := make(map[string]map[string]interface{})
 	for _, tagsSpec := range s.Scope.TagsSpecs() {
 		existingTags, err := s.client.GetAtScope(ctx, tagsSpec.Scope)
 		if err != nil {
@@ -89,9 +94,13 @@ func (s *Service) Reconcile(ctx context.Context) error {
 			continue
 		}
 
-		lastAppliedTags, err := s.Scope.AnnotationJSON(tagsSpec.Annotation)
-		if err != nil {
-			return err
+		lastAppliedTags, ok := lastAppliedTagsByAnnotation[tagsSpec.Annotation]
+		if !ok {
+			lastAppliedTags, err = s.Scope.AnnotationJSON(tagsSpec.Annotation)
+			if err != nil {
+				return err
+			}
+			lastAppliedTagsByAnnotation[tagsSpec.Annotation] = lastAppliedTags
 		}
 		changed, createdOrUpdated, deleted, newAnnotation := TagsChanged(lastAppliedTags, tagsSpec.Tags, tags)
 		if changed {
@@ -153,19 +162,19 @@ func TagsChanged(lastAppliedTags map[string]interface{}, desiredTags map[string]
 	deleted := map[string]string{}
 
 	// The new annotation that we need to set if anything is created/updated.
-	newAnnotation := map[string]interface{}{}
+	// Entries in the desiredTags always need to be noted in the newAnnotation. We
+	// know they're going to be created or updated.
+	newAnnotation := converters.TagsToAnnotation(desiredTags)
 
 	// Loop over lastAppliedTags, checking if entries are in desiredTags.
 	// If an entry is present in lastAppliedTags but not in desiredTags, it has been deleted
 	// since last time. We flag this in the deleted map.
-	for t, v := range lastAppliedTags {
+	for t, v := range converters.AnnotationToTags(lastAppliedTags) {
 		_, ok := desiredTags[t]
 
 		// Entry isn't in desiredTags, it has been deleted.
 		if !ok {
-			// Cast v to a string here. This should be fine, tags are always
-			// strings.
-			deleted[t] = v.(string)
+			deleted[t] = v
 			changed = true
 		}
 	}
@@ -181,20 +190,15 @@ func TagsChanged(lastAppliedTags map[string]interface{}, desiredTags map[string]
 	for t, v := range desiredTags {
 		av, ok := currentTags[t]
 
-		// Entries in the desiredTags always need to be noted in the newAnnotation. We
-		// know they're going to be created or updated.
-		newAnnotation[t] = v
-
 		// Entry isn't in desiredTags, it's new.
 		if !ok {
 			createdOrUpdated[t] = v
-			newAnnotation[t] = v
 			changed = true
 			continue
 		}
 
 		// Entry is in desiredTags, has the value changed?
-		if v != *av {
+		if v != ptr.Deref(av, "") {
 			createdOrUpdated[t] = v
 			changed = true
 		}
@@ -157,6 +157,149 @@ func TestReconcileTags(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:          "reconcile node resource group tags independently of other tags",
+			expectedError: "",
+			expect: func(s *mock_tags.MockTagScopeMockRecorder, m *mock_tags.MockclientMockRecorder) {
+				annotation := azure.NodeResourceGroupTagsLastAppliedAnnotation
+				gomock.InOrder(
+					s.ClusterName().AnyTimes().Return("test-cluster"),
+					s.TagsSpecs().Return([]azure.TagsSpec{
+						{
+							Scope: "/subscriptions/123/resourceGroups/MC_rg_cluster_westus",
+							Tags: map[string]string{
+								"team": "b",
+							},
+							Annotation: annotation,
+						},
+					}),
+					m.GetAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus").Return(armresources.TagsResource{Properties: &armresources.Tags{
+						Tags: map[string]*string{
+							"cluster-tag": ptr.To("set-by-aks"),
+							"team":        ptr.To("a"),
+							"old":         ptr.To("tag"),
+						},
+					}}, nil),
+					s.AnnotationJSON(annotation).Return(map[string]interface{}{"team": "a", "old": "tag"}, nil),
+					m.UpdateAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus", armresources.TagsPatchResource{
+						Operation: ptr.To(armresources.TagsPatchOperationMerge),
+						Properties: &armresources.Tags{
+							Tags: map[string]*string{
+								"team": ptr.To("b"),
+							},
+						},
+					}),
+					m.UpdateAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus", armresources.TagsPatchResource{
+						Operation: ptr.To(armresources.TagsPatchOperationDelete),
+						Properties: &armresources.Tags{
+							Tags: map[string]*string{
+								"old": ptr.To("tag"),
+							},
+						},
+					}),
+					s.UpdateAnnotationJSON(annotation, map[string]interface{}{"team": "b"}),
+				)
+			},
+		},
+		{
+			name:          "apply tags to the AKS outbound resources",
+			expectedError: "",
+			expect: func(s *mock_tags.MockTagScopeMockRecorder, m *mock_tags.MockclientMockRecorder) {
+				annotation := azure.AKSOutboundTagsLastAppliedAnnotation
+				gomock.InOrder(
+					s.ClusterName().AnyTimes().Return("test-cluster"),
+					s.TagsSpecs().Return([]azure.TagsSpec{
+						{
+							Scope: "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/loadBalancers/kubernetes",
+							Tags: map[string]string{
+								"foo": "bar",
+							},
+							Annotation: annotation,
+						},
+					}),
+					m.GetAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/loadBalancers/kubernetes").Return(armresources.TagsResource{Properties: &armresources.Tags{
+						Tags: map[string]*string{
+							"aks-managed-cluster-name": ptr.To("cluster"),
+						},
+					}}, nil),
+					s.AnnotationJSON(annotation),
+					m.UpdateAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/loadBalancers/kubernetes", armresources.TagsPatchResource{
+						Operation: ptr.To(armresources.TagsPatchOperationMerge),
+						Properties: &armresources.Tags{
+							Tags: map[string]*string{
+								"foo": ptr.To("bar"),
+							},
+						},
+					}),
+					s.UpdateAnnotationJSON(annotation, map[string]interface{}{"foo": "bar"}),
+				)
+			},
+		},
+		{
+			name:          "re-apply tags to recreated AKS outbound resources",
+			expectedError: "",
+			expect: func(s *mock_tags.MockTagScopeMockRecorder, m *mock_tags.MockclientMockRecorder) {
+				annotation := azure.AKSOutboundTagsLastAppliedAnnotation
+				gomock.InOrder(
+					s.ClusterName().AnyTimes().Return("test-cluster"),
+					s.TagsSpecs().Return([]azure.TagsSpec{
+						{
+							Scope: "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/loadBalancers/kubernetes",
+							Tags: map[string]string{
+								"foo": "bar",
+							},
+							Annotation: annotation,
+						},
+						{
+							Scope: "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/recreated-ip",
+							Tags: map[string]string{
+								"foo": "bar",
+							},
+							Annotation: annotation,
+						},
+					}),
+					m.GetAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/loadBalancers/kubernetes").Return(armresources.TagsResource{Properties: &armresources.Tags{
+						Tags: map[string]*string{
+							"foo": ptr.To("bar"),
+							"old": ptr.To("tag"),
+						},
+					}}, nil),
+					s.AnnotationJSON(annotation).Return(map[string]interface{}{"foo": "bar", "old": "tag"}, nil),
+					m.UpdateAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/loadBalancers/kubernetes", armresources.TagsPatchResource{
+						Operation: ptr.To(armresources.TagsPatchOperationDelete),
+						Properties: &armresources.Tags{
+							Tags: map[string]*string{
+								"old": ptr.To("tag"),
+							},
+						},
+					}),
+					s.UpdateAnnotationJSON(annotation, map[string]interface{}{"foo": "bar"}),
+					// The public IP was recreated by AKS without any of the previously applied tags.
+					m.GetAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/recreated-ip").Return(armresources.TagsResource{Properties: &armresources.Tags{
+						Tags: map[string]*string{
+							"aks-managed-type": ptr.To("aks-managed-outbound-ip"),
+						},
+					}}, nil),
+					m.UpdateAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/recreated-ip", armresources.TagsPatchResource{
+						Operation: ptr.To(armresources.TagsPatchOperationMerge),
+						Properties: &armresources.Tags{
+							Tags: map[string]*string{
+								"foo": ptr.To("bar"),
+							},
+						},
+					}),
+					m.UpdateAtScope(gomockinternal.AContext(), "/subscriptions/123/resourceGroups/MC_rg_cluster_westus/providers/Microsoft.Network/publicIPAddresses/recreated-ip", armresources.TagsPatchResource{
+						Operation: ptr.To(armresources.TagsPatchOperationDelete),
+						Properties: &armresources.Tags{
+							Tags: map[string]*string{
+								"old": ptr.To("tag"),
+							},
+						},
+					}),
+					s.UpdateAnnotationJSON(annotation, map[string]interface{}{"foo": "bar"}),
+				)
+			},
+		},
 		{
 			name:          "delete removed tags",
 			expectedError: "",
@@ -439,6 +582,42 @@ func TestTagsChanged(t *testing.T) {
 				"foo": "hello",
 				"bar": "welcome",
 			},
+		},
+		"numeric tag values decoded from the annotation are unchanged": {
+			lastAppliedTags: map[string]interface{}{
+				"count":   float64(3),
+				"account": float64(12345678901),
+			},
+			desiredTags: map[string]string{
+				"count":   "3",
+				"account": "12345678901",
+			},
+			currentTags: map[string]*string{
+				"count":   ptr.To("3"),
+				"account": ptr.To("12345678901"),
+			},
+			expectedResult:           false,
+			expectedCreatedOrUpdated: map[string]string{},
+			expectedDeleted:          map[string]string{},
+			expectedNewAnnotations: map[string]interface{}{
+				"count":   "3",
+				"account": "12345678901",
+			},
+		},
+		"numeric tag value decoded from the annotation deleted": {
+			lastAppliedTags: map[string]interface{}{
+				"count": float64(3),
+			},
+			desiredTags: map[string]string{},
+			currentTags: map[string]*string{
+				"count": ptr.To("3"),
+			},
+			expectedResult:           true,
+			expectedCreatedOrUpdated: map[string]string{},
+			expectedDeleted: map[string]string{
+				"count": "3",
+			},
+			expectedNewAnnotations: map[string]interface{}{},
 		}}
 
 	for name, test := range tests {
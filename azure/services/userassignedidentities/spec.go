@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userassignedidentities
+
+import (
+	"context"
+
+	asomanagedidentityv1 "github.com/Azure/azure-service-operator/v2/api/managedidentity/v1api20230131"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+// UserAssignedIdentitySpec defines the specification for a user-assigned identity.
+type UserAssignedIdentitySpec struct {
+	Name           string
+	ResourceGroup  string
+	Location       string
+	ClusterName    string
+	AdditionalTags infrav1.Tags
+}
+
+// ResourceRef implements azure.ASOResourceSpecGetter.
+func (s *UserAssignedIdentitySpec) ResourceRef() *asomanagedidentityv1.UserAssignedIdentity {
+	return &asomanagedidentityv1.UserAssignedIdentity{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: s.Name,
+		},
+	}
+}
+
+// Parameters implements azure.ASOResourceSpecGetter.
+func (s *UserAssignedIdentitySpec) Parameters(ctx context.Context, existing *asomanagedidentityv1.UserAssignedIdentity) (params *asomanagedidentityv1.UserAssignedIdentity, err error) {
+	identity := &asomanagedidentityv1.UserAssignedIdentity{}
+	if existing != nil {
+		identity = existing
+	}
+
+	identity.Spec.AzureName = s.Name
+	identity.Spec.Owner = &genruntime.KnownResourceReference{
+		Name: s.ResourceGroup,
+	}
+	identity.Spec.Location = ptr.To(s.Location)
+	identity.Spec.Tags = infrav1.Build(infrav1.BuildParams{
+		ClusterName: s.ClusterName,
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        ptr.To(s.Name),
+		Additional:  s.AdditionalTags,
+	})
+
+	return identity, nil
+}
+
+// WasManaged implements azure.ASOResourceSpecGetter.
+func (s *UserAssignedIdentitySpec) WasManaged(resource *asomanagedidentityv1.UserAssignedIdentity) bool {
+	// CAPZ only creates user-assigned identities it manages.
+	return true
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userassignedidentities
+
+import (
+	"context"
+	"testing"
+
+	asomanagedidentityv1 "github.com/Azure/azure-service-operator/v2/api/managedidentity/v1api20230131"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+var fakeUserAssignedIdentitySpec = &UserAssignedIdentitySpec{
+	Name:           "my-identity",
+	ResourceGroup:  "my-rg",
+	Location:       "eastus",
+	ClusterName:    "my-cluster",
+	AdditionalTags: infrav1.Tags{"foo": "bar"},
+}
+
+func TestParameters(t *testing.T) {
+	testcases := []struct {
+		name     string
+		existing *asomanagedidentityv1.UserAssignedIdentity
+		expected *asomanagedidentityv1.UserAssignedIdentity
+	}{
+		{
+			name:     "new user-assigned identity",
+			existing: nil,
+			expected: &asomanagedidentityv1.UserAssignedIdentity{
+				Spec: asomanagedidentityv1.UserAssignedIdentity_Spec{
+					AzureName: "my-identity",
+					Location:  ptr.To("eastus"),
+					Owner: &genruntime.KnownResourceReference{
+						Name: "my-rg",
+					},
+					Tags: map[string]string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": "owned",
+						"Name": "my-identity",
+						"foo":  "bar",
+					},
+				},
+			},
+		},
+		{
+			name: "existing user-assigned identity keeps its status",
+			existing: &asomanagedidentityv1.UserAssignedIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-identity",
+					Namespace: "default",
+				},
+				Spec: asomanagedidentityv1.UserAssignedIdentity_Spec{
+					AzureName: "my-identity",
+					Location:  ptr.To("eastus"),
+				},
+				Status: asomanagedidentityv1.UserAssignedIdentity_STATUS{
+					PrincipalId: ptr.To("principal-id"),
+				},
+			},
+			expected: &asomanagedidentityv1.UserAssignedIdentity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-identity",
+					Namespace: "default",
+				},
+				Spec: asomanagedidentityv1.UserAssignedIdentity_Spec{
+					AzureName: "my-identity",
+					Location:  ptr.To("eastus"),
+					Owner: &genruntime.KnownResourceReference{
+						Name: "my-rg",
+					},
+					Tags: map[string]string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": "owned",
+						"Name": "my-identity",
+						"foo":  "bar",
+					},
+				},
+				Status: asomanagedidentityv1.UserAssignedIdentity_STATUS{
+					PrincipalId: ptr.To("principal-id"),
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := fakeUserAssignedIdentitySpec.Parameters(context.TODO(), tc.existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).To(Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userassignedidentities
+
+import (
+	"context"
+
+	asomanagedidentityv1 "github.com/Azure/azure-service-operator/v2/api/managedidentity/v1api20230131"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+)
+
+const serviceName = "userassignedidentities"
+
+// UserAssignedIdentityScope defines the scope interface for a user-assigned identity service.
+type UserAssignedIdentityScope interface {
+	aso.Scope
+	UserAssignedIdentitySpecs() []azure.ASOResourceSpecGetter[*asomanagedidentityv1.UserAssignedIdentity]
+	SetUserAssignedIdentityStatus(status infrav1.ManagedIdentityStatus)
+}
+
+// New creates a new service.
+func New(scope UserAssignedIdentityScope) *aso.Service[*asomanagedidentityv1.UserAssignedIdentity, UserAssignedIdentityScope] {
+	svc := aso.NewService[*asomanagedidentityv1.UserAssignedIdentity, UserAssignedIdentityScope](serviceName, scope)
+	svc.Specs = scope.UserAssignedIdentitySpecs()
+	svc.ConditionType = infrav1.UserAssignedIdentitiesReadyCondition
+	svc.PostCreateOrUpdateResourceHook = postCreateOrUpdateResourceHook
+	return svc
+}
+
+func postCreateOrUpdateResourceHook(_ context.Context, scope UserAssignedIdentityScope, result *asomanagedidentityv1.UserAssignedIdentity, err error) error {
+	if err != nil {
+		return err
+	}
+	// result only gets populated once the identity is ready or if it already exists.
+	if result != nil && result.Status.Id != nil {
+		scope.SetUserAssignedIdentityStatus(infrav1.ManagedIdentityStatus{
+			Name:        result.AzureName(),
+			ProviderID:  azureutil.ProviderIDPrefix + ptr.Deref(result.Status.Id, ""),
+			PrincipalID: ptr.Deref(result.Status.PrincipalId, ""),
+			ClientID:    ptr.Deref(result.Status.ClientId, ""),
+		})
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userassignedidentities
+
+import (
+	"context"
+	"testing"
+
+	asomanagedidentityv1 "github.com/Azure/azure-service-operator/v2/api/managedidentity/v1api20230131"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso/mock_aso"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/userassignedidentities/mock_userassignedidentities"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	reconcilerutils "sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+func TestReconcileUserAssignedIdentities(t *testing.T) {
+	g := NewGomegaWithT(t)
+	mockCtrl := gomock.NewController(t)
+	scope := mock_userassignedidentities.NewMockUserAssignedIdentityScope(mockCtrl)
+	reconciler := mock_aso.NewMockReconciler[*asomanagedidentityv1.UserAssignedIdentity](mockCtrl)
+
+	specs := []azure.ASOResourceSpecGetter[*asomanagedidentityv1.UserAssignedIdentity]{fakeUserAssignedIdentitySpec}
+	created := &asomanagedidentityv1.UserAssignedIdentity{
+		Spec: asomanagedidentityv1.UserAssignedIdentity_Spec{
+			AzureName: "my-identity",
+		},
+		Status: asomanagedidentityv1.UserAssignedIdentity_STATUS{
+			Id:          ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity"),
+			PrincipalId: ptr.To("principal-id"),
+			ClientId:    ptr.To("client-id"),
+		},
+	}
+
+	scope.EXPECT().GetClient().Return(nil)
+	scope.EXPECT().ClusterName().Return("my-cluster")
+	scope.EXPECT().ASOOwner().Return(&infrav1.AzureCluster{})
+	scope.EXPECT().UserAssignedIdentitySpecs().Return(specs)
+	scope.EXPECT().AzureServiceReconcileTimeout(serviceName).Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+	reconciler.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), fakeUserAssignedIdentitySpec, serviceName).Return(created, nil)
+	scope.EXPECT().SetUserAssignedIdentityStatus(infrav1.ManagedIdentityStatus{
+		Name:        "my-identity",
+		ProviderID:  "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+		PrincipalID: "principal-id",
+		ClientID:    "client-id",
+	})
+	scope.EXPECT().UpdatePutStatus(infrav1.UserAssignedIdentitiesReadyCondition, serviceName, nil)
+
+	s := New(scope)
+	s.Reconciler = reconciler
+
+	err := s.Reconcile(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestPostCreateOrUpdateResourceHook(t *testing.T) {
+	t.Run("error creating or updating", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_userassignedidentities.NewMockUserAssignedIdentityScope(mockCtrl)
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, nil, errors.New("an error"))
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("identity not created yet", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_userassignedidentities.NewMockUserAssignedIdentityScope(mockCtrl)
+
+		identity := &asomanagedidentityv1.UserAssignedIdentity{
+			Spec: asomanagedidentityv1.UserAssignedIdentity_Spec{
+				AzureName: "my-identity",
+			},
+		}
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, identity, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("successful create or update", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_userassignedidentities.NewMockUserAssignedIdentityScope(mockCtrl)
+
+		scope.EXPECT().SetUserAssignedIdentityStatus(infrav1.ManagedIdentityStatus{
+			Name:        "my-identity",
+			ProviderID:  "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+			PrincipalID: "principal-id",
+			ClientID:    "client-id",
+		})
+
+		identity := &asomanagedidentityv1.UserAssignedIdentity{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-identity",
+				Namespace: "default",
+			},
+			Spec: asomanagedidentityv1.UserAssignedIdentity_Spec{
+				AzureName: "my-identity",
+			},
+			Status: asomanagedidentityv1.UserAssignedIdentity_STATUS{
+				Id:          ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity"),
+				PrincipalId: ptr.To("principal-id"),
+				ClientId:    ptr.To("client-id"),
+			},
+		}
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, identity, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
+	"unicode"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
@@ -31,6 +33,13 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/util/generators"
 )
 
+const (
+	// cloudConfigFormat is the format of cloud-init bootstrap data. Bootstrap data secrets without a format use it.
+	cloudConfigFormat = "cloud-config"
+	// ignitionFormat is the format of Ignition bootstrap data.
+	ignitionFormat = "ignition"
+)
+
 // VMSpec defines the specification for a Virtual Machine.
 type VMSpec struct {
 	Name                   string
@@ -41,6 +50,9 @@ type VMSpec struct {
 	Role                   string
 	NICIDs                 []string
 	SSHKeyData             string
+	AdditionalSSHKeys      []infrav1.SSHPublicKey
+	WindowsConfiguration   *infrav1.WindowsConfiguration
+	AdminPassword          string
 	Size                   string
 	AvailabilitySetID      string
 	Zone                   string
@@ -56,6 +68,8 @@ type VMSpec struct {
 	SKU                    resourceskus.SKU
 	Image                  *infrav1.Image
 	BootstrapData          string
+	BootstrapDataFormat    string
+	BootstrapDataSource    infrav1.BootstrapDataSource
 	ProviderID             string
 }
 
@@ -99,6 +113,10 @@ func (s *VMSpec) Parameters(ctx context.Context, existing interface{}) (params i
 		return nil, err
 	}
 
+	if err := s.validateBootstrapData(); err != nil {
+		return nil, err
+	}
+
 	osProfile, err := s.generateOSProfile()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate OS Profile")
@@ -141,6 +159,7 @@ func (s *VMSpec) Parameters(ctx context.Context, existing interface{}) (params i
 			EvictionPolicy:     evictionPolicy,
 			BillingProfile:     billingProfile,
 			DiagnosticsProfile: converters.GetDiagnosticsProfile(s.DiagnosticsProfile),
+			UserData:           s.userData(),
 		},
 		Identity: identity,
 		Zones:    s.getZones(),
@@ -216,12 +235,17 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 	}
 
 	dataDisks := make([]*armcompute.DataDisk, len(s.DataDisks))
+	writeAcceleratorDisks := 0
 	for i, disk := range s.DataDisks {
 		dataDisks[i] = &armcompute.DataDisk{
-			CreateOption: ptr.To(armcompute.DiskCreateOptionTypesEmpty),
-			DiskSizeGB:   ptr.To[int32](disk.DiskSizeGB),
-			Lun:          disk.Lun,
-			Name:         ptr.To(azure.GenerateDataDiskName(s.Name, disk.NameSuffix)),
+			CreateOption:            ptr.To(armcompute.DiskCreateOptionTypesEmpty),
+			DiskSizeGB:              ptr.To[int32](disk.DiskSizeGB),
+			Lun:                     disk.Lun,
+			Name:                    ptr.To(azure.GenerateDataDiskName(s.Name, disk.NameSuffix)),
+			WriteAcceleratorEnabled: disk.WriteAcceleratorEnabled,
+		}
+		if ptr.Deref(disk.WriteAcceleratorEnabled, false) {
+			writeAcceleratorDisks++
 		}
 		if disk.CachingType != "" {
 			dataDisks[i].Caching = ptr.To(armcompute.CachingTypes(disk.CachingType))
@@ -244,6 +268,17 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 	}
 	storageProfile.DataDisks = dataDisks
 
+	// check the support for write accelerator based on vm size
+	if writeAcceleratorDisks > 0 {
+		supported, err := s.SKU.HasCapabilityWithCapacity(resourceskus.MaxWriteAcceleratorDisksAllowed, int64(writeAcceleratorDisks))
+		if err != nil {
+			return nil, err
+		}
+		if !supported {
+			return nil, azure.WithTerminalError(fmt.Errorf("VM size %s does not support write accelerator on %d data disks. Select a different VM size or disable write accelerator", s.Size, writeAcceleratorDisks))
+		}
+	}
+
 	imageRef, err := converters.ImageToSDK(s.Image)
 	if err != nil {
 		return nil, err
@@ -254,6 +289,43 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 	return storageProfile, nil
 }
 
+// validateBootstrapData checks that the format of the bootstrap data is supported by the VM's operating system and
+// by the mechanism the bootstrap data is passed to the VM with.
+func (s *VMSpec) validateBootstrapData() error {
+	switch s.BootstrapDataFormat {
+	case "", cloudConfigFormat:
+		if s.BootstrapDataSource == infrav1.BootstrapDataSourceUserData {
+			return azure.WithTerminalError(errors.New("cloud-config bootstrap data must be passed as custom data, cloud-init doesn't read user data"))
+		}
+	case ignitionFormat:
+		if s.OSDisk.OSType == string(armcompute.OperatingSystemTypesWindows) {
+			return azure.WithTerminalError(errors.New("ignition bootstrap data is not supported for Windows VMs"))
+		}
+		if s.BootstrapDataSource == infrav1.BootstrapDataSourceCustomScriptExtension {
+			return azure.WithTerminalError(errors.New("ignition bootstrap data can't be run with the Custom Script Extension"))
+		}
+	default:
+		return azure.WithTerminalError(errors.Errorf("unsupported bootstrap data format %q", s.BootstrapDataFormat))
+	}
+	return nil
+}
+
+// customData returns the bootstrap data if it is passed to the VM as custom data.
+func (s *VMSpec) customData() *string {
+	if s.BootstrapDataSource == infrav1.BootstrapDataSourceUserData || s.BootstrapDataSource == infrav1.BootstrapDataSourceCustomScriptExtension {
+		return nil
+	}
+	return ptr.To(s.BootstrapData)
+}
+
+// userData returns the bootstrap data if it is passed to the VM as user data.
+func (s *VMSpec) userData() *string {
+	if s.BootstrapDataSource != infrav1.BootstrapDataSourceUserData {
+		return nil
+	}
+	return ptr.To(s.BootstrapData)
+}
+
 func (s *VMSpec) generateOSProfile() (*armcompute.OSProfile, error) {
 	sshKey, err := base64.StdEncoding.DecodeString(s.SSHKeyData)
 	if err != nil {
@@ -263,7 +335,7 @@ func (s *VMSpec) generateOSProfile() (*armcompute.OSProfile, error) {
 	osProfile := &armcompute.OSProfile{
 		ComputerName:  ptr.To(s.Name),
 		AdminUsername: ptr.To(azure.DefaultUserName),
-		CustomData:    ptr.To(s.BootstrapData),
+		CustomData:    s.customData(),
 	}
 
 	switch s.OSDisk.OSType {
@@ -275,20 +347,53 @@ func (s *VMSpec) generateOSProfile() (*armcompute.OSProfile, error) {
 		// but the password on the VM will NOT be the same as created here.
 		// Access is provided via SSH public key that is set during deployment
 		// Azure also provides a way to reset user passwords in the case of need.
-		osProfile.AdminPassword = ptr.To(generators.SudoRandomPassword(123))
+		//
+		// When an admin password secret is referenced, its value is used instead.
+		adminPassword := generators.SudoRandomPassword(123)
+		if s.AdminPassword != "" {
+			if err := validateAdminPassword(s.AdminPassword); err != nil {
+				return nil, err
+			}
+			adminPassword = s.AdminPassword
+		}
+		osProfile.AdminPassword = ptr.To(adminPassword)
 		osProfile.WindowsConfiguration = &armcompute.WindowsConfiguration{
 			EnableAutomaticUpdates: ptr.To(false),
 		}
+		if config := s.WindowsConfiguration; config != nil {
+			osProfile.WindowsConfiguration.EnableAutomaticUpdates = ptr.To(ptr.Deref(config.EnableAutomaticUpdates, false))
+			if config.TimeZone != "" {
+				osProfile.WindowsConfiguration.TimeZone = ptr.To(config.TimeZone)
+			}
+			if len(config.WinRMListeners) > 0 {
+				osProfile.WindowsConfiguration.WinRM, osProfile.Secrets = generateWinRM(config.WinRMListeners)
+			}
+		}
 	default:
+		publicKeys := []*armcompute.SSHPublicKey{
+			{
+				Path:    ptr.To(fmt.Sprintf("/home/%s/.ssh/authorized_keys", azure.DefaultUserName)),
+				KeyData: ptr.To(string(sshKey)),
+			},
+		}
+		for _, key := range s.AdditionalSSHKeys {
+			// Azure only accepts keys in the authorized_keys file of the admin user.
+			if key.Username != azure.DefaultUserName {
+				return nil, errors.Errorf("ssh public keys can only be authorized for the admin user %s, not %s", azure.DefaultUserName, key.Username)
+			}
+			keyData, err := base64.StdEncoding.DecodeString(key.KeyData)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode ssh public key of user %s", key.Username)
+			}
+			publicKeys = append(publicKeys, &armcompute.SSHPublicKey{
+				Path:    ptr.To(fmt.Sprintf("/home/%s/.ssh/authorized_keys", azure.DefaultUserName)),
+				KeyData: ptr.To(string(keyData)),
+			})
+		}
 		osProfile.LinuxConfiguration = &armcompute.LinuxConfiguration{
 			DisablePasswordAuthentication: ptr.To(true),
 			SSH: &armcompute.SSHConfiguration{
-				PublicKeys: []*armcompute.SSHPublicKey{
-					{
-						Path:    ptr.To(fmt.Sprintf("/home/%s/.ssh/authorized_keys", azure.DefaultUserName)),
-						KeyData: ptr.To(string(sshKey)),
-					},
-				},
+				PublicKeys: publicKeys,
 			},
 		}
 	}
@@ -296,6 +401,79 @@ func (s *VMSpec) generateOSProfile() (*armcompute.OSProfile, error) {
 	return osProfile, nil
 }
 
+// generateWinRM returns the WinRM configuration of the listeners and the Key Vault certificates the Https listeners use.
+func generateWinRM(listeners []infrav1.WinRMListener) (*armcompute.WinRMConfiguration, []*armcompute.VaultSecretGroup) {
+	winRM := &armcompute.WinRMConfiguration{}
+	var secrets []*armcompute.VaultSecretGroup
+	for _, listener := range listeners {
+		winRMListener := &armcompute.WinRMListener{
+			Protocol: ptr.To(armcompute.ProtocolTypes(listener.Protocol)),
+		}
+		if listener.Protocol == infrav1.WinRMProtocolHTTPS {
+			winRMListener.CertificateURL = ptr.To(listener.CertificateURL)
+			secrets = addVaultCertificate(secrets, listener.KeyVaultID, listener.CertificateURL)
+		}
+		winRM.Listeners = append(winRM.Listeners, winRMListener)
+	}
+	return winRM, secrets
+}
+
+// addVaultCertificate adds the certificate to the secret group of its Key Vault, creating the group if needed.
+func addVaultCertificate(secrets []*armcompute.VaultSecretGroup, keyVaultID, certificateURL string) []*armcompute.VaultSecretGroup {
+	certificate := &armcompute.VaultCertificate{
+		CertificateURL:   ptr.To(certificateURL),
+		CertificateStore: ptr.To("My"),
+	}
+	for _, secret := range secrets {
+		if strings.EqualFold(ptr.Deref(secret.SourceVault.ID, ""), keyVaultID) {
+			secret.VaultCertificates = append(secret.VaultCertificates, certificate)
+			return secrets
+		}
+	}
+	return append(secrets, &armcompute.VaultSecretGroup{
+		SourceVault:       &armcompute.SubResource{ID: ptr.To(keyVaultID)},
+		VaultCertificates: []*armcompute.VaultCertificate{certificate},
+	})
+}
+
+// disallowedAdminPasswords are the passwords Azure rejects for the administrator account of a Windows VM.
+var disallowedAdminPasswords = []string{
+	"abc@123", "P@$$w0rd", "P@ssw0rd", "P@ssword123", "Pa$$word",
+	"pass@word1", "Password!", "Password1", "Password22", "iloveyou!",
+}
+
+// validateAdminPassword checks the administrator password of a Windows VM against the complexity requirements of Azure:
+// between 8 and 123 characters long, with at least three of lowercase, uppercase, digit and special characters.
+func validateAdminPassword(password string) error {
+	if len(password) < 8 || len(password) > 123 {
+		return errors.New("the admin password must be between 8 and 123 characters long")
+	}
+
+	var lower, upper, digit, special int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			special = 1
+		}
+	}
+	if lower+upper+digit+special < 3 {
+		return errors.New("the admin password must contain at least three of lowercase characters, uppercase characters, digits and special characters")
+	}
+
+	for _, disallowed := range disallowedAdminPasswords {
+		if password == disallowed {
+			return errors.New("the admin password is a commonly used password which is not allowed")
+		}
+	}
+	return nil
+}
+
 func (s *VMSpec) generateSecurityProfile(storageProfile *armcompute.StorageProfile) (*armcompute.SecurityProfile, error) {
 	if s.SecurityProfile == nil {
 		return nil, nil
@@ -18,12 +18,14 @@ package virtualmachines
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -50,6 +52,28 @@ var (
 		},
 	}
 
+	validSKUWithWriteAccelerator = resourceskus.SKU{
+		Name: ptr.To("Standard_M8ms"),
+		Kind: ptr.To(string(resourceskus.VirtualMachines)),
+		Locations: []*string{
+			ptr.To("test-location"),
+		},
+		Capabilities: []*armcompute.ResourceSKUCapabilities{
+			{
+				Name:  ptr.To(resourceskus.VCPUs),
+				Value: ptr.To("8"),
+			},
+			{
+				Name:  ptr.To(resourceskus.MemoryGB),
+				Value: ptr.To("218"),
+			},
+			{
+				Name:  ptr.To(resourceskus.MaxWriteAcceleratorDisksAllowed),
+				Value: ptr.To("1"),
+			},
+		},
+	}
+
 	validSKUWithEncryptionAtHost = resourceskus.SKU{
 		Name: ptr.To("Standard_D2v3"),
 		Kind: ptr.To(string(resourceskus.VirtualMachines)),
@@ -270,6 +294,83 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "can create a vm with additional ssh public keys",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				AdditionalSSHKeys: []infrav1.SSHPublicKey{
+					{Username: "capi", KeyData: "YWxpY2Uta2V5"},
+					{Username: "capi", KeyData: "Ym9iLWtleQ=="},
+				},
+				Size:  "Standard_D2v3",
+				Zone:  "1",
+				Image: &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:   validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				publicKeys := result.(armcompute.VirtualMachine).Properties.OSProfile.LinuxConfiguration.SSH.PublicKeys
+				g.Expect(publicKeys).To(HaveLen(3))
+				g.Expect(publicKeys[0].Path).To(Equal(ptr.To("/home/capi/.ssh/authorized_keys")))
+				g.Expect(publicKeys[1:]).To(Equal([]*armcompute.SSHPublicKey{
+					{
+						Path:    ptr.To("/home/capi/.ssh/authorized_keys"),
+						KeyData: ptr.To("alice-key"),
+					},
+					{
+						Path:    ptr.To("/home/capi/.ssh/authorized_keys"),
+						KeyData: ptr.To("bob-key"),
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "fails if an additional ssh public key is not base64 encoded",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				AdditionalSSHKeys: []infrav1.SSHPublicKey{
+					{Username: "capi", KeyData: "not base64!"},
+				},
+				Size:  "Standard_D2v3",
+				Zone:  "1",
+				Image: &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:   validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "failed to generate OS Profile: failed to decode ssh public key of user capi: illegal base64 data at input byte 3",
+		},
+		{
+			name: "fails if an additional ssh public key is for a user other than the admin user",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				AdditionalSSHKeys: []infrav1.SSHPublicKey{
+					{Username: "alice", KeyData: "YWxpY2Uta2V5"},
+				},
+				Size:  "Standard_D2v3",
+				Zone:  "1",
+				Image: &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:   validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "failed to generate OS Profile: ssh public keys can only be authorized for the admin user capi, not alice",
+		},
 		{
 			name: "can create a vm with user assigned identity ",
 			spec: &VMSpec{
@@ -365,6 +466,96 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "can create a windows vm with a windows configuration",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Zone:       "1",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Windows",
+					DiskSizeGB: ptr.To[int32](128),
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+					},
+				},
+				WindowsConfiguration: &infrav1.WindowsConfiguration{
+					EnableAutomaticUpdates: ptr.To(true),
+					TimeZone:               "Pacific Standard Time",
+					WinRMListeners: []infrav1.WinRMListener{
+						{Protocol: infrav1.WinRMProtocolHTTP},
+						{
+							Protocol:       infrav1.WinRMProtocolHTTPS,
+							CertificateURL: "https://my-vault.vault.azure.net/secrets/winrm/1",
+							KeyVaultID:     "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.KeyVault/vaults/my-vault",
+						},
+					},
+				},
+				AdminPassword: "Sup3r-Secret",
+				SKU:           validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				osProfile := result.(armcompute.VirtualMachine).Properties.OSProfile
+				g.Expect(osProfile.AdminPassword).To(Equal(ptr.To("Sup3r-Secret")))
+				g.Expect(osProfile.LinuxConfiguration).To(BeNil())
+				g.Expect(osProfile.WindowsConfiguration.EnableAutomaticUpdates).To(Equal(ptr.To(true)))
+				g.Expect(osProfile.WindowsConfiguration.TimeZone).To(Equal(ptr.To("Pacific Standard Time")))
+				g.Expect(osProfile.WindowsConfiguration.WinRM.Listeners).To(Equal([]*armcompute.WinRMListener{
+					{Protocol: ptr.To(armcompute.ProtocolTypesHTTP)},
+					{
+						Protocol:       ptr.To(armcompute.ProtocolTypesHTTPS),
+						CertificateURL: ptr.To("https://my-vault.vault.azure.net/secrets/winrm/1"),
+					},
+				}))
+				g.Expect(osProfile.Secrets).To(Equal([]*armcompute.VaultSecretGroup{
+					{
+						SourceVault: &armcompute.SubResource{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.KeyVault/vaults/my-vault")},
+						VaultCertificates: []*armcompute.VaultCertificate{
+							{
+								CertificateURL:   ptr.To("https://my-vault.vault.azure.net/secrets/winrm/1"),
+								CertificateStore: ptr.To("My"),
+							},
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "creating a windows vm with an admin password which does not meet the complexity requirements fails",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Zone:       "1",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Windows",
+					DiskSizeGB: ptr.To[int32](128),
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+					},
+				},
+				WindowsConfiguration: &infrav1.WindowsConfiguration{
+					AdminPasswordSecretRef: &corev1.SecretKeySelector{Key: "password"},
+				},
+				AdminPassword: "onlylowercase1",
+				SKU:           validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "failed to generate OS Profile: the admin password must contain at least three of lowercase characters, uppercase characters, digits and special characters",
+		},
 		{
 			name: "can create a vm with encryption",
 			spec: &VMSpec{
@@ -1345,6 +1536,263 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "can create a vm with write accelerator on a data disk",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_M8ms",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:        validSKUWithWriteAccelerator,
+				DataDisks: []infrav1.DataDisk{
+					{
+						NameSuffix:              "log",
+						DiskSizeGB:              128,
+						Lun:                     ptr.To[int32](0),
+						CachingType:             "None",
+						WriteAcceleratorEnabled: ptr.To(true),
+						ManagedDisk: &infrav1.ManagedDiskParameters{
+							StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+						},
+					},
+				},
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				dataDisks := result.(armcompute.VirtualMachine).Properties.StorageProfile.DataDisks
+				g.Expect(dataDisks).To(HaveLen(1))
+				g.Expect(dataDisks[0].WriteAcceleratorEnabled).To(Equal(ptr.To(true)))
+			},
+			expectedError: "",
+		},
+		{
+			name: "fails to create a vm with write accelerator if the vm size doesn't support it",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:        validSKU,
+				DataDisks: []infrav1.DataDisk{
+					{
+						NameSuffix:              "log",
+						DiskSizeGB:              128,
+						Lun:                     ptr.To[int32](0),
+						CachingType:             "None",
+						WriteAcceleratorEnabled: ptr.To(true),
+						ManagedDisk: &infrav1.ManagedDiskParameters{
+							StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+						},
+					},
+				},
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: VM size Standard_D2v3 does not support write accelerator on 1 data disks. Select a different VM size or disable write accelerator. Object will not be requeued",
+		},
+		{
+			name: "can create a vm with a zone-redundant data disk",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:        validSKU,
+				DataDisks: []infrav1.DataDisk{
+					{
+						NameSuffix: "mydisk",
+						DiskSizeGB: 64,
+						Lun:        ptr.To[int32](0),
+						ManagedDisk: &infrav1.ManagedDiskParameters{
+							StorageAccountType: string(armcompute.StorageAccountTypesPremiumZRS),
+						},
+					},
+				},
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				dataDisks := result.(armcompute.VirtualMachine).Properties.StorageProfile.DataDisks
+				g.Expect(dataDisks).To(HaveLen(1))
+				g.Expect(dataDisks[0].ManagedDisk.StorageAccountType).To(Equal(ptr.To(armcompute.StorageAccountTypesPremiumZRS)))
+			},
+			expectedError: "",
+		},
+		{
+			name: "passes cloud-config bootstrap data as custom data",
+			spec: &VMSpec{
+				Name:                "my-vm",
+				Role:                infrav1.Node,
+				NICIDs:              []string{"my-nic"},
+				SSHKeyData:          "fakesshpublickey",
+				Size:                "Standard_D2v3",
+				Image:               &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:                 validSKU,
+				BootstrapData:       "fake-bootstrap-data",
+				BootstrapDataFormat: "cloud-config",
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.OSProfile.CustomData).To(Equal(ptr.To("fake-bootstrap-data")))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.UserData).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "passes ignition bootstrap data as custom data",
+			spec: &VMSpec{
+				Name:                "my-vm",
+				Role:                infrav1.Node,
+				NICIDs:              []string{"my-nic"},
+				SSHKeyData:          "fakesshpublickey",
+				Size:                "Standard_D2v3",
+				Image:               &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:                 validSKU,
+				BootstrapData:       "fake-bootstrap-data",
+				BootstrapDataFormat: "ignition",
+				BootstrapDataSource: infrav1.BootstrapDataSourceCustomData,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.OSProfile.CustomData).To(Equal(ptr.To("fake-bootstrap-data")))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.UserData).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "passes ignition bootstrap data as user data",
+			spec: &VMSpec{
+				Name:                "my-vm",
+				Role:                infrav1.Node,
+				NICIDs:              []string{"my-nic"},
+				SSHKeyData:          "fakesshpublickey",
+				Size:                "Standard_D2v3",
+				Image:               &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:                 validSKU,
+				BootstrapData:       "fake-bootstrap-data",
+				BootstrapDataFormat: "ignition",
+				BootstrapDataSource: infrav1.BootstrapDataSourceUserData,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.OSProfile.CustomData).To(BeNil())
+				g.Expect(result.(armcompute.VirtualMachine).Properties.UserData).To(Equal(ptr.To("fake-bootstrap-data")))
+			},
+			expectedError: "",
+		},
+		{
+			name: "runs bootstrap data with the custom script extension instead of passing it as custom data",
+			spec: &VMSpec{
+				Name:                "my-vm",
+				Role:                infrav1.Node,
+				NICIDs:              []string{"my-nic"},
+				SSHKeyData:          "fakesshpublickey",
+				Size:                "Standard_D2v3",
+				Image:               &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:                 validSKU,
+				BootstrapData:       "fake-bootstrap-data",
+				BootstrapDataSource: infrav1.BootstrapDataSourceCustomScriptExtension,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.OSProfile.CustomData).To(BeNil())
+				g.Expect(result.(armcompute.VirtualMachine).Properties.UserData).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "fails to run ignition bootstrap data with the custom script extension",
+			spec: &VMSpec{
+				Name:                "my-vm",
+				Role:                infrav1.Node,
+				NICIDs:              []string{"my-nic"},
+				SSHKeyData:          "fakesshpublickey",
+				Size:                "Standard_D2v3",
+				Image:               &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:                 validSKU,
+				BootstrapData:       "fake-bootstrap-data",
+				BootstrapDataFormat: "ignition",
+				BootstrapDataSource: infrav1.BootstrapDataSourceCustomScriptExtension,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: ignition bootstrap data can't be run with the Custom Script Extension. Object will not be requeued",
+		},
+		{
+			name: "fails to pass cloud-config bootstrap data as user data",
+			spec: &VMSpec{
+				Name:                "my-vm",
+				Role:                infrav1.Node,
+				NICIDs:              []string{"my-nic"},
+				SSHKeyData:          "fakesshpublickey",
+				Size:                "Standard_D2v3",
+				Image:               &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:                 validSKU,
+				BootstrapData:       "fake-bootstrap-data",
+				BootstrapDataSource: infrav1.BootstrapDataSourceUserData,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: cloud-config bootstrap data must be passed as custom data, cloud-init doesn't read user data. Object will not be requeued",
+		},
+		{
+			name: "fails with ignition bootstrap data for a windows vm",
+			spec: &VMSpec{
+				Name:                "my-vm",
+				Role:                infrav1.Node,
+				NICIDs:              []string{"my-nic"},
+				SSHKeyData:          "fakesshpublickey",
+				Size:                "Standard_D2v3",
+				Image:               &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:                 validSKU,
+				BootstrapData:       "fake-bootstrap-data",
+				BootstrapDataFormat: "ignition",
+				OSDisk: infrav1.OSDisk{
+					OSType: "Windows",
+				},
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: ignition bootstrap data is not supported for Windows VMs. Object will not be requeued",
+		},
+		{
+			name: "fails with an unsupported bootstrap data format",
+			spec: &VMSpec{
+				Name:                "my-vm",
+				Role:                infrav1.Node,
+				NICIDs:              []string{"my-nic"},
+				SSHKeyData:          "fakesshpublickey",
+				Size:                "Standard_D2v3",
+				Image:               &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:                 validSKU,
+				BootstrapData:       "fake-bootstrap-data",
+				BootstrapDataFormat: "shell-script",
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: unsupported bootstrap data format \"shell-script\". Object will not be requeued",
+		},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -1363,3 +1811,83 @@ func TestParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestParametersOSDiskCaching(t *testing.T) {
+	for _, cachingType := range armcompute.PossibleCachingTypesValues() {
+		cachingType := cachingType
+		t.Run(string(cachingType), func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			spec := &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:        validSKU,
+				OSDisk: infrav1.OSDisk{
+					OSType:      "Linux",
+					CachingType: string(cachingType),
+				},
+			}
+
+			result, err := spec.Parameters(context.TODO(), nil)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+			g.Expect(result.(armcompute.VirtualMachine).Properties.StorageProfile.OSDisk.Caching).To(Equal(ptr.To(cachingType)))
+		})
+	}
+}
+
+func TestValidateAdminPassword(t *testing.T) {
+	testcases := []struct {
+		name          string
+		password      string
+		expectedError string
+	}{
+		{
+			name:     "password with lowercase, uppercase, digit and special characters",
+			password: "Sup3r-Secret",
+		},
+		{
+			name:     "password with three of the four character classes",
+			password: "Sup3rSecret",
+		},
+		{
+			name:          "password which is too short",
+			password:      "Ab1-",
+			expectedError: "the admin password must be between 8 and 123 characters long",
+		},
+		{
+			name:          "password which is too long",
+			password:      "Ab1-" + strings.Repeat("a", 120),
+			expectedError: "the admin password must be between 8 and 123 characters long",
+		},
+		{
+			name:          "password with only two character classes",
+			password:      "onlylowercase1",
+			expectedError: "the admin password must contain at least three of lowercase characters, uppercase characters, digits and special characters",
+		},
+		{
+			name:          "commonly used password",
+			password:      "P@ssw0rd",
+			expectedError: "the admin password is a commonly used password which is not allowed",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			err := validateAdminPassword(tc.password)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
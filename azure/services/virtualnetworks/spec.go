@@ -29,13 +29,16 @@ import (
 
 // VNetSpec defines the specification for a Virtual Network.
 type VNetSpec struct {
-	ResourceGroup    string
-	Name             string
-	CIDRs            []string
-	Location         string
-	ExtendedLocation *infrav1.ExtendedLocationSpec
-	ClusterName      string
-	AdditionalTags   infrav1.Tags
+	ResourceGroup        string
+	Name                 string
+	CIDRs                []string
+	Location             string
+	ExtendedLocation     *infrav1.ExtendedLocationSpec
+	ClusterName          string
+	AdditionalTags       infrav1.Tags
+	DDoSProtectionPlanID string
+	EnableDDoSProtection bool
+	DNSServers           []string
 }
 
 // ResourceRef implements azure.ASOResourceSpecGetter.
@@ -73,6 +76,26 @@ func (s *VNetSpec) Parameters(ctx context.Context, existing *asonetworkv1.Virtua
 	vnet.Spec.AddressSpace = &asonetworkv1.AddressSpace{
 		AddressPrefixes: s.CIDRs,
 	}
+	vnet.Spec.EnableDdosProtection = nil
+	vnet.Spec.DdosProtectionPlan = nil
+	if s.EnableDDoSProtection {
+		vnet.Spec.EnableDdosProtection = ptr.To(true)
+	}
+	if s.DDoSProtectionPlanID != "" {
+		vnet.Spec.DdosProtectionPlan = &asonetworkv1.SubResource{
+			Reference: &genruntime.ResourceReference{
+				ARMID: s.DDoSProtectionPlanID,
+			},
+		}
+	}
+
+	// An empty list of DNS servers resets the virtual network to the DNS servers provided by Azure.
+	vnet.Spec.DhcpOptions = nil
+	if len(s.DNSServers) > 0 {
+		vnet.Spec.DhcpOptions = &asonetworkv1.DhcpOptions{
+			DnsServers: s.DNSServers,
+		}
+	}
 
 	return vnet, nil
 }
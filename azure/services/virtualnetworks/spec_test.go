@@ -115,6 +115,107 @@ func TestParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "vnet with ddos protection plan",
+			spec: VNetSpec{
+				ResourceGroup:        "rg",
+				Name:                 "name",
+				CIDRs:                []string{"cidr"},
+				Location:             "location",
+				ClusterName:          "cluster",
+				DDoSProtectionPlanID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/ddosProtectionPlans/plan",
+				EnableDDoSProtection: true,
+			},
+			expected: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_cluster": "owned",
+						"sigs.k8s.io_cluster-api-provider-azure_role":            "common",
+						"Name": "name",
+					},
+					AzureName: "name",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "rg",
+					},
+					Location: ptr.To("location"),
+					AddressSpace: &asonetworkv1.AddressSpace{
+						AddressPrefixes: []string{"cidr"},
+					},
+					EnableDdosProtection: ptr.To(true),
+					DdosProtectionPlan: &asonetworkv1.SubResource{
+						Reference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/ddosProtectionPlans/plan",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "vnet with custom dns servers",
+			spec: VNetSpec{
+				ResourceGroup: "rg",
+				Name:          "name",
+				CIDRs:         []string{"cidr"},
+				Location:      "location",
+				ClusterName:   "cluster",
+				DNSServers:    []string{"10.0.0.4", "10.0.0.5"},
+			},
+			expected: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_cluster": "owned",
+						"sigs.k8s.io_cluster-api-provider-azure_role":            "common",
+						"Name": "name",
+					},
+					AzureName: "name",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "rg",
+					},
+					Location: ptr.To("location"),
+					AddressSpace: &asonetworkv1.AddressSpace{
+						AddressPrefixes: []string{"cidr"},
+					},
+					DhcpOptions: &asonetworkv1.DhcpOptions{
+						DnsServers: []string{"10.0.0.4", "10.0.0.5"},
+					},
+				},
+			},
+		},
+		{
+			name: "existing vnet with custom dns servers which are cleared",
+			spec: VNetSpec{
+				ResourceGroup: "rg",
+				Name:          "name",
+				CIDRs:         []string{"cidr"},
+				Location:      "location",
+				ClusterName:   "cluster",
+			},
+			existing: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"tags": "set",
+					},
+					DhcpOptions: &asonetworkv1.DhcpOptions{
+						DnsServers: []string{"10.0.0.4", "10.0.0.5"},
+					},
+				},
+			},
+			expected: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"tags": "set",
+					},
+					AzureName: "name",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "rg",
+					},
+					Location: ptr.To("location"),
+					AddressSpace: &asonetworkv1.AddressSpace{
+						AddressPrefixes: []string{"cidr"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -114,6 +114,10 @@ func (acr *AzureClusterReconciler) SetupWithManager(ctx context.Context, mgr ctr
 // +kubebuilder:rbac:groups=resources.azure.com,resources=resourcegroups/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups=network.azure.com,resources=natgateways;bastionhosts;privateendpoints;virtualnetworks;virtualnetworkssubnets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=network.azure.com,resources=natgateways/status;bastionhosts/status;privateendpoints/status;virtualnetworks/status;virtualnetworkssubnets/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=managedidentity.azure.com,resources=userassignedidentities,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=managedidentity.azure.com,resources=userassignedidentities/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=compute.azure.com,resources=diskencryptionsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=compute.azure.com,resources=diskencryptionsets/status,verbs=get;list;watch
 
 // Reconcile idempotently gets, creates, and updates a cluster.
 func (acr *AzureClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -128,6 +132,7 @@ func (acr *AzureClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		tele.KVP("kind", infrav1.AzureClusterKind),
 	)
 	defer done()
+	defer func() { tele.RecordError(ctx, reterr) }()
 
 	// Fetch the AzureCluster instance
 	azureCluster := &infrav1.AzureCluster{}
@@ -229,7 +234,7 @@ func (acr *AzureClusterReconciler) reconcileNormal(ctx context.Context, clusterS
 			if reconcileError.IsTerminal() {
 				acr.Recorder.Eventf(clusterScope.AzureCluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to reconcile AzureCluster").Error())
 				log.Error(err, "failed to reconcile AzureCluster", "name", clusterScope.ClusterName())
-				conditions.MarkFalse(azureCluster, infrav1.NetworkInfrastructureReadyCondition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "")
+				conditions.MarkFalse(azureCluster, infrav1.NetworkInfrastructureReadyCondition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
 				return reconcile.Result{}, nil
 			}
 			if reconcileError.IsTransient() {
@@ -253,7 +258,13 @@ func (acr *AzureClusterReconciler) reconcileNormal(ctx context.Context, clusterS
 		azureCluster.Spec.ControlPlaneEndpoint.Host = clusterScope.APIServerHost()
 	}
 	if azureCluster.Spec.ControlPlaneEndpoint.Port == 0 {
-		azureCluster.Spec.ControlPlaneEndpoint.Port = clusterScope.APIServerPort()
+		azureCluster.Spec.ControlPlaneEndpoint.Port = clusterScope.APIServerFrontendPort()
+	}
+
+	// Don't mark us ready in dry-run mode, the Azure resources have not been created or updated.
+	if clusterScope.IsDryRun() {
+		acr.reportDryRunPlan(clusterScope)
+		return reconcile.Result{}, nil
 	}
 
 	// No errors, so mark us ready so the Cluster API Cluster Controller can pull it
@@ -295,7 +306,13 @@ func (acr *AzureClusterReconciler) reconcileDelete(ctx context.Context, clusterS
 		return reconcile.Result{}, errors.Wrap(err, "failed to create a new AzureClusterReconciler")
 	}
 
-	if err := acs.Delete(ctx); err != nil {
+	if ShouldSkipAzureDeletion(azureCluster) {
+		// Pausing stops ASO from deleting the Azure resources when their ASO resources are garbage collected.
+		log.Info("Skipping AzureCluster deletion; Azure resources are left in place.")
+		if err := acs.Pause(ctx); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to pause cluster services")
+		}
+	} else if err := acs.Delete(ctx); err != nil {
 		// Handle transient errors
 		var reconcileError azure.ReconcileError
 		if errors.As(err, &reconcileError) {
@@ -315,6 +332,12 @@ func (acr *AzureClusterReconciler) reconcileDelete(ctx context.Context, clusterS
 		return reconcile.Result{}, wrappedErr
 	}
 
+	// Keep the finalizer in dry-run mode, the Azure resources have not been deleted.
+	if clusterScope.IsDryRun() {
+		acr.reportDryRunPlan(clusterScope)
+		return reconcile.Result{}, nil
+	}
+
 	// Cluster is deleted so remove the finalizer.
 	controllerutil.RemoveFinalizer(azureCluster, infrav1.ClusterFinalizer)
 
@@ -328,3 +351,13 @@ func (acr *AzureClusterReconciler) reconcileDelete(ctx context.Context, clusterS
 
 	return reconcile.Result{}, nil
 }
+
+// reportDryRunPlan emits an event for each change planned in dry-run mode and summarizes them in the
+// NetworkInfrastructureReady condition.
+func (acr *AzureClusterReconciler) reportDryRunPlan(clusterScope *scope.ClusterScope) {
+	changes := clusterScope.PlannedChanges()
+	for _, change := range changes {
+		acr.Recorder.Eventf(clusterScope.AzureCluster, corev1.EventTypeNormal, "DryRunPlannedChange", "%s", change)
+	}
+	conditions.MarkFalse(clusterScope.AzureCluster, infrav1.NetworkInfrastructureReadyCondition, infrav1.DryRunReason, clusterv1.ConditionSeverityInfo, "%d changes planned in dry-run mode", len(changes))
+}
@@ -41,6 +41,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -358,6 +359,53 @@ func TestAzureClusterReconcilePaused(t *testing.T) {
 	g.Expect(instance.GetAnnotations()).NotTo(HaveKey(clusterctlv1.BlockMoveAnnotation))
 }
 
+func TestAzureClusterReconcileDryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	tc := TestClusterReconcileInput{
+		createAzureClusterService: func(cs *scope.ClusterScope) (*azureClusterService, error) {
+			return getDefaultAzureClusterService(func(acs *azureClusterService) {
+				acs.skuCache = resourceskus.NewStaticCache([]armcompute.ResourceSKU{}, cs.Location())
+				acs.scope = cs
+				acs.Reconcile = func(context.Context) error {
+					cs.RecordPlannedChange("group", "create resource my-rg/my-rg")
+					return nil
+				}
+				acs.Delete = func(context.Context) error {
+					cs.RecordPlannedChange("group", "delete resource my-rg/my-rg")
+					return nil
+				}
+			}), nil
+		},
+		azureClusterOptions: func(ac *infrav1.AzureCluster) {
+			ac.Annotations = map[string]string{infrav1.DryRunAnnotation: "true"}
+			ac.Finalizers = []string{infrav1.ClusterFinalizer}
+		},
+		cache: &scope.ClusterCache{},
+	}
+
+	reconciler, clusterScope, err := getClusterReconcileInputs(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	recorder := reconciler.Recorder.(*record.FakeRecorder)
+
+	result, err := reconciler.reconcileNormal(context.Background(), clusterScope)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(reconcile.Result{}))
+	g.Expect(clusterScope.AzureCluster.Status.Ready).To(BeFalse())
+	g.Expect(conditions.GetReason(clusterScope.AzureCluster, infrav1.NetworkInfrastructureReadyCondition)).To(Equal(infrav1.DryRunReason))
+	g.Expect(recorder.Events).To(Receive(Equal("Normal DryRunPlannedChange group: create resource my-rg/my-rg")))
+
+	reconciler, clusterScope, err = getClusterReconcileInputs(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	recorder = reconciler.Recorder.(*record.FakeRecorder)
+
+	result, err = reconciler.reconcileDelete(context.Background(), clusterScope)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(reconcile.Result{}))
+	g.Expect(clusterScope.AzureCluster.Finalizers).To(ContainElement(infrav1.ClusterFinalizer))
+	g.Expect(recorder.Events).To(Receive(Equal("Normal DryRunPlannedChange group: delete resource my-rg/my-rg")))
+}
+
 func TestAzureClusterReconcileDelete(t *testing.T) {
 	cases := map[string]TestClusterReconcileInput{
 		"should delete successfully": {
@@ -431,6 +479,43 @@ func TestAzureClusterReconcileDelete(t *testing.T) {
 	}
 }
 
+func TestAzureClusterReconcileDeleteSkipDeletion(t *testing.T) {
+	g := NewWithT(t)
+
+	var deleted, paused bool
+	tc := TestClusterReconcileInput{
+		createAzureClusterService: func(cs *scope.ClusterScope) (*azureClusterService, error) {
+			return getDefaultAzureClusterService(func(acs *azureClusterService) {
+				acs.skuCache = resourceskus.NewStaticCache([]armcompute.ResourceSKU{}, cs.Location())
+				acs.scope = cs
+				acs.Delete = func(context.Context) error {
+					deleted = true
+					return nil
+				}
+				acs.Pause = func(context.Context) error {
+					paused = true
+					return nil
+				}
+			}), nil
+		},
+		azureClusterOptions: func(ac *infrav1.AzureCluster) {
+			ac.Annotations = map[string]string{infrav1.SkipDeletionAnnotation: "true"}
+			ac.Finalizers = []string{infrav1.ClusterFinalizer}
+		},
+		cache: &scope.ClusterCache{},
+	}
+
+	reconciler, clusterScope, err := getClusterReconcileInputs(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	result, err := reconciler.reconcileDelete(context.Background(), clusterScope)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(reconcile.Result{}))
+	g.Expect(deleted).To(BeFalse())
+	g.Expect(paused).To(BeTrue())
+	g.Expect(clusterScope.AzureCluster.Finalizers).NotTo(ContainElement(infrav1.ClusterFinalizer))
+}
+
 func getDefaultAzureClusterService(changes ...func(*azureClusterService)) *azureClusterService {
 	input := &azureClusterService{
 		services: []azure.ServiceReconciler{},
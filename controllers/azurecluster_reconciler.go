@@ -18,14 +18,22 @@ package controllers
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/azurefirewalls"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bastionhosts"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/diagnosticsettings"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/diskencryptionsets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/loadbalancers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/natgateways"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/netappvolumes"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/orphanedresources"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/policyassignments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privatedns"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
@@ -33,8 +41,10 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/routetables"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/securitygroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/userassignedidentities"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualnetworks"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/vnetpeerings"
+	"sigs.k8s.io/cluster-api-provider-azure/feature"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
@@ -44,11 +54,14 @@ type azureClusterService struct {
 	scope *scope.ClusterScope
 	// services is the list of services that are reconciled by this controller.
 	// The order of the services is important as it determines the order in which the services are reconciled.
-	services  []azure.ServiceReconciler
-	skuCache  *resourceskus.Cache
-	Reconcile func(context.Context) error
-	Pause     func(context.Context) error
-	Delete    func(context.Context) error
+	services []azure.ServiceReconciler
+	// orphanedResourcesSvc, if set, deletes resources left behind in an unmanaged resource group after all
+	// services have been deleted.
+	orphanedResourcesSvc azure.ServiceReconciler
+	skuCache             *resourceskus.Cache
+	Reconcile            func(context.Context) error
+	Pause                func(context.Context) error
+	Delete               func(context.Context) error
 }
 
 // newAzureClusterService populates all the services based on input scope.
@@ -81,6 +94,22 @@ func newAzureClusterService(scope *scope.ClusterScope) (*azureClusterService, er
 	if err != nil {
 		return nil, err
 	}
+	azureFirewallsSvc, err := azurefirewalls.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	diagnosticSettingsSvc, err := diagnosticsettings.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	policyAssignmentsSvc, err := policyassignments.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	netAppVolumesSvc, err := netappvolumes.New(scope)
+	if err != nil {
+		return nil, err
+	}
 	acs := &azureClusterService{
 		scope: scope,
 		services: []azure.ServiceReconciler{
@@ -96,9 +125,22 @@ func newAzureClusterService(scope *scope.ClusterScope) (*azureClusterService, er
 			privateDNSSvc,
 			privateendpoints.New(scope),
 			bastionhosts.New(scope),
+			azureFirewallsSvc,
+			diagnosticSettingsSvc,
+			policyAssignmentsSvc,
+			userassignedidentities.New(scope),
+			diskencryptionsets.New(scope),
+			netAppVolumesSvc,
 		},
 		skuCache: skuCache,
 	}
+	if feature.Gates.Enabled(feature.OrphanedResourceDeletion) {
+		orphanedResourcesSvc, err := orphanedresources.New(scope)
+		if err != nil {
+			return nil, err
+		}
+		acs.orphanedResourcesSvc = orphanedResourcesSvc
+	}
 	acs.Reconcile = acs.reconcile
 	acs.Pause = acs.pause
 	acs.Delete = acs.delete
@@ -111,6 +153,11 @@ func (s *azureClusterService) reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "controllers.azureClusterService.Reconcile")
 	defer done()
 
+	services, err := s.orderedServices()
+	if err != nil {
+		return err
+	}
+
 	if err := s.setFailureDomainsForLocation(ctx); err != nil {
 		return errors.Wrap(err, "failed to get availability zones")
 	}
@@ -119,8 +166,12 @@ func (s *azureClusterService) reconcile(ctx context.Context) error {
 	s.scope.SetDNSName()
 	s.scope.SetControlPlaneSecurityRules()
 
-	for _, service := range s.services {
-		if err := service.Reconcile(ctx); err != nil {
+	if err := s.scope.ValidatePublicIPSKUs(); err != nil {
+		return errors.Wrap(err, "invalid public IP SKU")
+	}
+
+	for _, service := range services {
+		if err := ReconcileService(ctx, service); err != nil {
 			return errors.Wrapf(err, "failed to reconcile AzureCluster service %s", service.Name())
 		}
 	}
@@ -154,6 +205,7 @@ func (s *azureClusterService) delete(ctx context.Context) error {
 	if !ShouldDeleteIndividualResources(ctx, s.scope) {
 		// If the resource group is managed, delete it.
 		// We need to explicitly delete vnet peerings, as it is not part of the resource group.
+		// Disabled services don't apply here: their resources are deleted along with the resource group.
 		vnetPeeringsSvc, err := s.getService(vnetpeerings.ServiceName)
 		if err != nil {
 			return errors.Wrap(err, "failed to get vnet peerings service")
@@ -174,9 +226,19 @@ func (s *azureClusterService) delete(ctx context.Context) error {
 	} else {
 		// If the resource group is not managed we need to delete resources inside the group one by one.
 		// services are deleted in reverse order from the order in which they are reconciled.
-		for i := len(s.services) - 1; i >= 0; i-- {
-			if err := s.services[i].Delete(ctx); err != nil {
-				return errors.Wrapf(err, "failed to delete AzureCluster service %s", s.services[i].Name())
+		services, err := s.orderedServices()
+		if err != nil {
+			return err
+		}
+		for i := len(services) - 1; i >= 0; i-- {
+			if err := DeleteService(ctx, services[i]); err != nil {
+				return errors.Wrapf(err, "failed to delete AzureCluster service %s", services[i].Name())
+			}
+		}
+		// Any resources still owned by the cluster aren't part of its spec, e.g. because a reconcile failed partway.
+		if s.orphanedResourcesSvc != nil {
+			if err := s.orphanedResourcesSvc.Delete(ctx); err != nil {
+				return errors.Wrap(err, "failed to delete orphaned resources")
 			}
 		}
 	}
@@ -213,3 +275,81 @@ func (s *azureClusterService) getService(name string) (azure.ServiceReconciler,
 	}
 	return nil, errors.Errorf("service %s not found", name)
 }
+
+// orderedServices returns the services in the order in which they are reconciled. Services listed in the
+// AzureCluster's infrav1.ServiceReconcileOrderAnnotation come first, in the listed order, followed by all other
+// services in the default order. Services listed in its infrav1.DisabledServicesAnnotation are left out.
+func (s *azureClusterService) orderedServices() ([]azure.ServiceReconciler, error) {
+	services, err := s.customOrderedServices()
+	if err != nil {
+		return nil, err
+	}
+
+	disabled, err := s.disabledServices()
+	if err != nil || len(disabled) == 0 {
+		return services, err
+	}
+	enabled := make([]azure.ServiceReconciler, 0, len(services))
+	for _, service := range services {
+		if !disabled[service.Name()] {
+			enabled = append(enabled, service)
+		}
+	}
+	return enabled, nil
+}
+
+// disabledServices returns the names of the services listed in the AzureCluster's
+// infrav1.DisabledServicesAnnotation. The group and vnetpeerings services can't be disabled, as deleting a cluster
+// whose resource group is managed relies on them to delete the resource group and the peerings outside of it.
+func (s *azureClusterService) disabledServices() (map[string]bool, error) {
+	disabled := make(map[string]bool)
+	for _, name := range strings.Split(s.scope.AzureCluster.GetAnnotations()[infrav1.DisabledServicesAnnotation], ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if name == groups.ServiceName || name == vnetpeerings.ServiceName {
+			return nil, errors.Errorf("invalid %s annotation: service %s can't be disabled", infrav1.DisabledServicesAnnotation, name)
+		}
+		if _, err := s.getService(name); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s annotation", infrav1.DisabledServicesAnnotation)
+		}
+		disabled[name] = true
+	}
+	return disabled, nil
+}
+
+// customOrderedServices returns the services listed in the AzureCluster's infrav1.ServiceReconcileOrderAnnotation,
+// in the listed order, followed by all other services in the default order.
+func (s *azureClusterService) customOrderedServices() ([]azure.ServiceReconciler, error) {
+	order, ok := s.scope.AzureCluster.GetAnnotations()[infrav1.ServiceReconcileOrderAnnotation]
+	if !ok || strings.TrimSpace(order) == "" {
+		return s.services, nil
+	}
+
+	services := make([]azure.ServiceReconciler, 0, len(s.services))
+	listed := make(map[string]bool, len(s.services))
+	for _, name := range strings.Split(order, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if listed[name] {
+			return nil, errors.Errorf("invalid %s annotation: service %s is listed more than once", infrav1.ServiceReconcileOrderAnnotation, name)
+		}
+		service, err := s.getService(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s annotation", infrav1.ServiceReconcileOrderAnnotation)
+		}
+		listed[name] = true
+		services = append(services, service)
+	}
+
+	for _, service := range s.services {
+		if !listed[service.Name()] {
+			services = append(services, service)
+		}
+	}
+
+	return services, nil
+}
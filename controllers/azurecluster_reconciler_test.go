@@ -45,6 +45,7 @@ import (
 
 func TestAzureClusterServiceReconcile(t *testing.T) {
 	cases := map[string]struct {
+		annotations   map[string]string
 		expectedError string
 		expect        func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder)
 	}{
@@ -66,6 +67,112 @@ func TestAzureClusterServiceReconcile(t *testing.T) {
 					two.Name().Return("two"))
 			},
 		},
+		"services are reconciled in the custom order": {
+			annotations: map[string]string{
+				infrav1.ServiceReconcileOrderAnnotation: "three, one",
+			},
+			expectedError: "",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+				one.Name().Return("one").AnyTimes()
+				two.Name().Return("two").AnyTimes()
+				three.Name().Return("three").AnyTimes()
+				gomock.InOrder(
+					three.Reconcile(gomockinternal.AContext()).Return(nil),
+					one.Reconcile(gomockinternal.AContext()).Return(nil),
+					two.Reconcile(gomockinternal.AContext()).Return(nil))
+			},
+		},
+		"custom order lists a missing service": {
+			annotations: map[string]string{
+				infrav1.ServiceReconcileOrderAnnotation: "three,four",
+			},
+			expectedError: "invalid sigs.k8s.io/cluster-api-provider-azure-service-reconcile-order annotation: service four not found",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+				one.Name().Return("one").AnyTimes()
+				two.Name().Return("two").AnyTimes()
+				three.Name().Return("three").AnyTimes()
+			},
+		},
+		"disabled service is skipped": {
+			annotations: map[string]string{
+				infrav1.DisabledServicesAnnotation: "two",
+			},
+			expectedError: "",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+				one.Name().Return("one").AnyTimes()
+				two.Name().Return("two").AnyTimes()
+				three.Name().Return("three").AnyTimes()
+				gomock.InOrder(
+					one.Reconcile(gomockinternal.AContext()).Return(nil),
+					three.Reconcile(gomockinternal.AContext()).Return(nil))
+			},
+		},
+		"disabled service is skipped in the custom order": {
+			annotations: map[string]string{
+				infrav1.ServiceReconcileOrderAnnotation: "three,one",
+				infrav1.DisabledServicesAnnotation:      "one, two",
+			},
+			expectedError: "",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+				one.Name().Return("one").AnyTimes()
+				two.Name().Return("two").AnyTimes()
+				three.Name().Return("three").AnyTimes()
+				three.Reconcile(gomockinternal.AContext()).Return(nil)
+			},
+		},
+		"service which is not disabled is reconciled": {
+			annotations: map[string]string{
+				infrav1.DisabledServicesAnnotation: "",
+			},
+			expectedError: "",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+				gomock.InOrder(
+					one.Reconcile(gomockinternal.AContext()).Return(nil),
+					two.Reconcile(gomockinternal.AContext()).Return(nil),
+					three.Reconcile(gomockinternal.AContext()).Return(nil))
+			},
+		},
+		"disabled services list a missing service": {
+			annotations: map[string]string{
+				infrav1.DisabledServicesAnnotation: "four",
+			},
+			expectedError: "invalid sigs.k8s.io/cluster-api-provider-azure-disabled-services annotation: service four not found",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+				one.Name().Return("one").AnyTimes()
+				two.Name().Return("two").AnyTimes()
+				three.Name().Return("three").AnyTimes()
+			},
+		},
+		"disabled services list the group service": {
+			annotations: map[string]string{
+				infrav1.DisabledServicesAnnotation: "two," + groups.ServiceName,
+			},
+			expectedError: "invalid sigs.k8s.io/cluster-api-provider-azure-disabled-services annotation: service group can't be disabled",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+				one.Name().Return("one").AnyTimes()
+				two.Name().Return("two").AnyTimes()
+				three.Name().Return("three").AnyTimes()
+			},
+		},
+		"disabled services list the vnetpeerings service": {
+			annotations: map[string]string{
+				infrav1.DisabledServicesAnnotation: vnetpeerings.ServiceName,
+			},
+			expectedError: "invalid sigs.k8s.io/cluster-api-provider-azure-disabled-services annotation: service vnetpeerings can't be disabled",
+			expect: func(_ *mock_azure.MockServiceReconcilerMockRecorder, _ *mock_azure.MockServiceReconcilerMockRecorder, _ *mock_azure.MockServiceReconcilerMockRecorder) {
+			},
+		},
+		"custom order lists a service more than once": {
+			annotations: map[string]string{
+				infrav1.ServiceReconcileOrderAnnotation: "three,one,three",
+			},
+			expectedError: "invalid sigs.k8s.io/cluster-api-provider-azure-service-reconcile-order annotation: service three is listed more than once",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+				one.Name().Return("one").AnyTimes()
+				two.Name().Return("two").AnyTimes()
+				three.Name().Return("three").AnyTimes()
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -84,8 +191,12 @@ func TestAzureClusterServiceReconcile(t *testing.T) {
 
 			s := &azureClusterService{
 				scope: &scope.ClusterScope{
-					Cluster:      &clusterv1.Cluster{},
-					AzureCluster: &infrav1.AzureCluster{},
+					Cluster: &clusterv1.Cluster{},
+					AzureCluster: &infrav1.AzureCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: tc.annotations,
+						},
+					},
 				},
 				services: []azure.ServiceReconciler{
 					svcOneMock,
@@ -106,6 +217,112 @@ func TestAzureClusterServiceReconcile(t *testing.T) {
 	}
 }
 
+func TestAzureClusterServiceSetFailureDomainsForLocation(t *testing.T) {
+	skus := []armcompute.ResourceSKU{
+		{
+			Name:         ptr.To("Standard_D2s_v3"),
+			ResourceType: ptr.To(string(resourceskus.VirtualMachines)),
+			Locations: []*string{
+				ptr.To("westus2"),
+			},
+			LocationInfo: []*armcompute.ResourceSKULocationInfo{
+				{
+					Location: ptr.To("westus2"),
+					Zones:    []*string{ptr.To("1"), ptr.To("2"), ptr.To("3")},
+				},
+			},
+		},
+		{
+			Name:         ptr.To("Standard_D2s_v3"),
+			ResourceType: ptr.To(string(resourceskus.VirtualMachines)),
+			Locations: []*string{
+				ptr.To("westcentralus"),
+			},
+			LocationInfo: []*armcompute.ResourceSKULocationInfo{
+				{
+					Location: ptr.To("westcentralus"),
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		spec     infrav1.AzureClusterSpec
+		expected clusterv1.FailureDomains
+	}{
+		"zonal region sets a control plane failure domain per zone": {
+			spec: infrav1.AzureClusterSpec{
+				AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+					Location: "westus2",
+				},
+			},
+			expected: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"2": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"3": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+		"zonal region respects failure domains excluded from the control plane": {
+			spec: infrav1.AzureClusterSpec{
+				AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+					Location: "westus2",
+					FailureDomains: clusterv1.FailureDomains{
+						"3": clusterv1.FailureDomainSpec{ControlPlane: false},
+					},
+				},
+			},
+			expected: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"2": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"3": clusterv1.FailureDomainSpec{ControlPlane: false},
+			},
+		},
+		"non-zonal region sets no failure domains": {
+			spec: infrav1.AzureClusterSpec{
+				AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+					Location: "westcentralus",
+				},
+			},
+			expected: nil,
+		},
+		"extended location sets no failure domains": {
+			spec: infrav1.AzureClusterSpec{
+				AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+					Location: "westus2",
+					ExtendedLocation: &infrav1.ExtendedLocationSpec{
+						Name: "losangeles",
+						Type: "EdgeZone",
+					},
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			clusterScope := &scope.ClusterScope{
+				Cluster: &clusterv1.Cluster{},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: tc.spec,
+				},
+			}
+			s := &azureClusterService{
+				scope:    clusterScope,
+				skuCache: resourceskus.NewStaticCache(skus, tc.spec.Location),
+			}
+
+			g.Expect(s.setFailureDomainsForLocation(context.TODO())).To(Succeed())
+			g.Expect(clusterScope.AzureCluster.Status.FailureDomains).To(Equal(tc.expected))
+			g.Expect(clusterScope.AvailabilitySetEnabled()).To(Equal(len(tc.expected) == 0))
+		})
+	}
+}
+
 func TestAzureClusterServicePause(t *testing.T) {
 	type pausingServiceReconciler struct {
 		*mock_azure.MockServiceReconciler
@@ -389,3 +606,114 @@ func TestAzureClusterServiceDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureClusterServiceDeleteOrphanedResources(t *testing.T) {
+	clusterName := "cluster"
+	azClusterName := "azCluster"
+	namespace := "ns"
+	resourceGroup := "rg"
+
+	cases := map[string]struct {
+		expectedError string
+		expect        func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, orphans *mock_azure.MockServiceReconcilerMockRecorder)
+	}{
+		"orphaned resources are deleted after all services": {
+			expectedError: "",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, orphans *mock_azure.MockServiceReconcilerMockRecorder) {
+				gomock.InOrder(
+					two.Delete(gomockinternal.AContext()).Return(nil),
+					one.Delete(gomockinternal.AContext()).Return(nil),
+					orphans.Delete(gomockinternal.AContext()).Return(nil))
+			},
+		},
+		"orphaned resources are not deleted when a service fails to delete": {
+			expectedError: "failed to delete AzureCluster service two: some error happened",
+			expect: func(_ *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, _ *mock_azure.MockServiceReconcilerMockRecorder) {
+				gomock.InOrder(
+					two.Delete(gomockinternal.AContext()).Return(errors.New("some error happened")),
+					two.Name().Return("two"))
+			},
+		},
+		"orphaned resources fail to delete": {
+			expectedError: "failed to delete orphaned resources: some error happened",
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, orphans *mock_azure.MockServiceReconcilerMockRecorder) {
+				gomock.InOrder(
+					two.Delete(gomockinternal.AContext()).Return(nil),
+					one.Delete(gomockinternal.AContext()).Return(nil),
+					orphans.Delete(gomockinternal.AContext()).Return(errors.New("some error happened")))
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			svcOneMock := mock_azure.NewMockServiceReconciler(mockCtrl)
+			svcTwoMock := mock_azure.NewMockServiceReconciler(mockCtrl)
+			orphansMock := mock_azure.NewMockServiceReconciler(mockCtrl)
+
+			tc.expect(svcOneMock.EXPECT(), svcTwoMock.EXPECT(), orphansMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(asoresourcesv1.AddToScheme(scheme)).To(Succeed())
+			// The resource group isn't managed, so resources are deleted one by one.
+			rg := &asoresourcesv1.ResourceGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceGroup,
+					Namespace: namespace,
+				},
+			}
+			c := fakeclient.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(rg).
+				Build()
+
+			s := &azureClusterService{
+				scope: &scope.ClusterScope{
+					Client: c,
+					AzureCluster: &infrav1.AzureCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      azClusterName,
+							Namespace: namespace,
+						},
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: resourceGroup,
+							NetworkSpec: infrav1.NetworkSpec{
+								Vnet: infrav1.VnetSpec{
+									ResourceGroup: resourceGroup,
+								},
+							},
+						},
+					},
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              clusterName,
+							Namespace:         namespace,
+							DeletionTimestamp: &metav1.Time{Time: time.Now()},
+						},
+					},
+				},
+				services: []azure.ServiceReconciler{
+					svcOneMock,
+					svcTwoMock,
+				},
+				orphanedResourcesSvc: orphansMock,
+				skuCache:             resourceskus.NewStaticCache([]armcompute.ResourceSKU{}, ""),
+			}
+
+			err := s.delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/pkg/coalescing"
 	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
@@ -47,10 +48,33 @@ import (
 // AzureMachineReconciler reconciles an AzureMachine object.
 type AzureMachineReconciler struct {
 	client.Client
-	Recorder                  record.EventRecorder
-	Timeouts                  reconciler.Timeouts
-	WatchFilterValue          string
-	createAzureMachineService azureMachineServiceCreator
+	Recorder                     record.EventRecorder
+	Timeouts                     reconciler.Timeouts
+	WatchFilterValue             string
+	ControlPlaneVMSizeValidation ControlPlaneVMSizeValidation
+	createAzureMachineService    azureMachineServiceCreator
+}
+
+// ControlPlaneVMSizeValidation configures the minimum size of the VMs of control plane machines, which are
+// checked before the VMs are created.
+type ControlPlaneVMSizeValidation struct {
+	// MinVCPUs is the minimum number of vCPUs of a control plane VM size. 0 disables the check.
+	MinVCPUs int64
+	// MinMemoryGB is the minimum amount of memory in GB of a control plane VM size. 0 disables the check.
+	MinMemoryGB float64
+	// WarnOnly emits a warning event for control plane VM sizes below the minimums instead of rejecting them.
+	WarnOnly bool
+}
+
+// validate returns an error if the capabilities of a VM size are below the minimums.
+func (v ControlPlaneVMSizeValidation) validate(vmSize string, capabilities resourceskus.VMCapabilities) error {
+	if capabilities.VCPUs < v.MinVCPUs {
+		return errors.Errorf("VM size %s has %d vCPUs, control plane machines require at least %d", vmSize, capabilities.VCPUs, v.MinVCPUs)
+	}
+	if capabilities.MemoryGB < v.MinMemoryGB {
+		return errors.Errorf("VM size %s has %gGB of memory, control plane machines require at least %gGB", vmSize, capabilities.MemoryGB, v.MinMemoryGB)
+	}
+	return nil
 }
 
 type azureMachineServiceCreator func(machineScope *scope.MachineScope) (*azureMachineService, error)
@@ -144,6 +168,7 @@ func (amr *AzureMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		tele.KVP("kind", "AzureMachine"),
 	)
 	defer done()
+	defer func() { tele.RecordError(ctx, reterr) }()
 
 	// Fetch the AzureMachine VM.
 	azureMachine := &infrav1.AzureMachine{}
@@ -287,6 +312,18 @@ func (amr *AzureMachineReconciler) reconcileNormal(ctx context.Context, machineS
 		return reconcile.Result{}, errors.Wrap(err, "failed to init machine scope cache")
 	}
 
+	if err := amr.validateControlPlaneVMSize(machineScope); err != nil {
+		if errors.As(err, &reconcileError) && reconcileError.IsTerminal() {
+			amr.Recorder.Eventf(machineScope.AzureMachine, corev1.EventTypeWarning, "InvalidVMSize", err.Error())
+			log.Error(err, "Control plane VM size is too small")
+			machineScope.SetFailureReason(capierrors.InvalidConfigurationMachineError)
+			machineScope.SetFailureMessage(err)
+			machineScope.SetNotReady()
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrap(err, "failed to validate the control plane VM size")
+	}
+
 	// Mark the AzureMachine as failed if the identities are not ready.
 	cond := conditions.Get(machineScope.AzureMachine, infrav1.VMIdentitiesReadyCondition)
 	if cond != nil && cond.Status == corev1.ConditionFalse && cond.Reason == infrav1.UserAssignedIdentityMissingReason {
@@ -372,7 +409,16 @@ func (amr *AzureMachineReconciler) reconcileDelete(ctx context.Context, machineS
 		return reconcile.Result{}, err
 	}
 
-	if ShouldDeleteIndividualResources(ctx, clusterScope) {
+	if ShouldSkipAzureDeletion(machineScope.AzureMachine, clusterScope.AzureCluster) {
+		log.Info("Skipping AzureMachine Deletion; Azure resources are left in place.")
+		ams, err := amr.createAzureMachineService(machineScope)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to create azure machine service")
+		}
+		if err := ams.Pause(ctx); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to pause azure machine services")
+		}
+	} else if ShouldDeleteIndividualResources(ctx, clusterScope) {
 		log.Info("Deleting AzureMachine")
 		ams, err := amr.createAzureMachineService(machineScope)
 		if err != nil {
@@ -406,3 +452,24 @@ func (amr *AzureMachineReconciler) reconcileDelete(ctx context.Context, machineS
 
 	return reconcile.Result{}, nil
 }
+
+// validateControlPlaneVMSize checks that the VM size of a control plane machine whose VM hasn't been created yet
+// meets the configured minimums, and returns a terminal error if it doesn't. Undersized VM sizes only cause a
+// warning event if the validation is configured to warn.
+func (amr *AzureMachineReconciler) validateControlPlaneVMSize(machineScope *scope.MachineScope) error {
+	if !machineScope.IsControlPlane() || machineScope.ProviderID() != "" {
+		return nil
+	}
+	capabilities, err := machineScope.VMCapabilities()
+	if err != nil {
+		return errors.Wrap(err, "failed to get the capabilities of the VM size")
+	}
+	if err := amr.ControlPlaneVMSizeValidation.validate(machineScope.AzureMachine.Spec.VMSize, capabilities); err != nil {
+		if amr.ControlPlaneVMSizeValidation.WarnOnly {
+			amr.Recorder.Eventf(machineScope.AzureMachine, corev1.EventTypeWarning, "VMSizeBelowMinimum", err.Error())
+			return nil
+		}
+		return azure.WithTerminalError(err)
+	}
+	return nil
+}
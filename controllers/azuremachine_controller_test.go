@@ -18,9 +18,11 @@ package controllers
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -43,14 +45,16 @@ import (
 )
 
 type TestMachineReconcileInput struct {
-	createAzureMachineService func(*scope.MachineScope) (*azureMachineService, error)
-	azureMachineOptions       func(am *infrav1.AzureMachine)
-	expectedErr               string
-	machineScopeFailureReason capierrors.MachineStatusError
-	ready                     bool
-	cache                     *scope.MachineCache
-	skuCache                  scope.SKUCacher
-	expectedResult            reconcile.Result
+	createAzureMachineService    func(*scope.MachineScope) (*azureMachineService, error)
+	azureMachineOptions          func(am *infrav1.AzureMachine)
+	expectedErr                  string
+	machineScopeFailureReason    capierrors.MachineStatusError
+	ready                        bool
+	cache                        *scope.MachineCache
+	skuCache                     scope.SKUCacher
+	expectedResult               reconcile.Result
+	controlPlane                 bool
+	controlPlaneVMSizeValidation ControlPlaneVMSizeValidation
 }
 
 func TestAzureMachineReconcile(t *testing.T) {
@@ -192,6 +196,33 @@ func TestAzureMachineReconcileNormal(t *testing.T) {
 			skuCache:                  fakeSKUCacher{},
 			expectedErr:               "failed to init machine scope cache",
 		},
+		"should fail if the control plane VM size is too small": {
+			createAzureMachineService:    getFakeAzureMachineService,
+			cache:                        &scope.MachineCache{VMSKU: vmSKU(2, 8)},
+			controlPlane:                 true,
+			controlPlaneVMSizeValidation: ControlPlaneVMSizeValidation{MinVCPUs: 4, MinMemoryGB: 16},
+			machineScopeFailureReason:    capierrors.InvalidConfigurationMachineError,
+		},
+		"should reconcile a control plane machine whose VM size is big enough": {
+			createAzureMachineService:    getFakeAzureMachineService,
+			cache:                        &scope.MachineCache{VMSKU: vmSKU(4, 16)},
+			controlPlane:                 true,
+			controlPlaneVMSizeValidation: ControlPlaneVMSizeValidation{MinVCPUs: 4, MinMemoryGB: 16},
+			ready:                        true,
+		},
+		"should only warn about a too small control plane VM size if configured to": {
+			createAzureMachineService:    getFakeAzureMachineService,
+			cache:                        &scope.MachineCache{VMSKU: vmSKU(4, 8)},
+			controlPlane:                 true,
+			controlPlaneVMSizeValidation: ControlPlaneVMSizeValidation{MinVCPUs: 4, MinMemoryGB: 16, WarnOnly: true},
+			ready:                        true,
+		},
+		"should not validate the VM size of worker machines": {
+			createAzureMachineService:    getFakeAzureMachineService,
+			cache:                        &scope.MachineCache{VMSKU: vmSKU(2, 8)},
+			controlPlaneVMSizeValidation: ControlPlaneVMSizeValidation{MinVCPUs: 4, MinMemoryGB: 16},
+			ready:                        true,
+		},
 		"should fail if identities are not ready": {
 			azureMachineOptions: func(am *infrav1.AzureMachine) {
 				am.Status.Conditions = clusterv1.Conditions{
@@ -347,6 +378,55 @@ func TestAzureMachineReconcileDelete(t *testing.T) {
 	}
 }
 
+func TestAzureMachineReconcileDeleteSkipDeletion(t *testing.T) {
+	g := NewWithT(t)
+
+	var deleted bool
+	tc := TestMachineReconcileInput{
+		createAzureMachineService: func(machineScope *scope.MachineScope) (*azureMachineService, error) {
+			ams, err := getFakeAzureMachineService(machineScope)
+			if err != nil {
+				return nil, err
+			}
+			ams.Delete = func(context.Context) error {
+				deleted = true
+				return nil
+			}
+			return ams, nil
+		},
+		azureMachineOptions: func(am *infrav1.AzureMachine) {
+			am.Annotations = map[string]string{infrav1.SkipDeletionAnnotation: "true"}
+			am.Finalizers = []string{infrav1.MachineFinalizer}
+		},
+		cache: &scope.MachineCache{},
+	}
+
+	reconciler, machineScope, clusterScope, err := getMachineReconcileInputs(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	result, err := reconciler.reconcileDelete(context.Background(), machineScope, clusterScope)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(reconcile.Result{}))
+	g.Expect(deleted).To(BeFalse())
+	g.Expect(machineScope.AzureMachine.Finalizers).NotTo(ContainElement(infrav1.MachineFinalizer))
+}
+
+func vmSKU(vCPUs, memoryGB int) resourceskus.SKU {
+	return resourceskus.SKU{
+		Name: ptr.To("Standard_D2s_v3"),
+		Capabilities: []*armcompute.ResourceSKUCapabilities{
+			{
+				Name:  ptr.To(resourceskus.VCPUs),
+				Value: ptr.To(strconv.Itoa(vCPUs)),
+			},
+			{
+				Name:  ptr.To(resourceskus.MemoryGB),
+				Value: ptr.To(strconv.Itoa(memoryGB)),
+			},
+		},
+	}
+}
+
 func getMachineReconcileInputs(tc TestMachineReconcileInput) (*AzureMachineReconciler, *scope.MachineScope, *scope.ClusterScope, error) {
 	scheme, err := newScheme()
 	if err != nil {
@@ -368,6 +448,9 @@ func getMachineReconcileInputs(tc TestMachineReconcileInput) (*AzureMachineRecon
 		m.Spec.Bootstrap = clusterv1.Bootstrap{
 			DataSecretName: ptr.To("fooSecret"),
 		}
+		if tc.controlPlane {
+			m.Labels[clusterv1.MachineControlPlaneLabel] = ""
+		}
 	})
 	azureClusterIdentity := getFakeAzureClusterIdentity(func(identity *infrav1.AzureClusterIdentity) {
 		identity.Spec.ClientSecret.Name = "fooSecret"
@@ -400,9 +483,10 @@ func getMachineReconcileInputs(tc TestMachineReconcileInput) (*AzureMachineRecon
 		Build()
 
 	reconciler := &AzureMachineReconciler{
-		Client:                    client,
-		Recorder:                  record.NewFakeRecorder(128),
-		createAzureMachineService: tc.createAzureMachineService,
+		Client:                       client,
+		Recorder:                     record.NewFakeRecorder(128),
+		ControlPlaneVMSizeValidation: tc.controlPlaneVMSizeValidation,
+		createAzureMachineService:    tc.createAzureMachineService,
 	}
 
 	clusterScope, err := scope.NewClusterScope(context.Background(), scope.ClusterScopeParams{
@@ -817,3 +901,39 @@ func conditionsMatch(i, j clusterv1.Condition) bool {
 		i.Reason == j.Reason &&
 		i.Severity == j.Severity
 }
+
+func TestControlPlaneVMSizeValidation(t *testing.T) {
+	validation := ControlPlaneVMSizeValidation{MinVCPUs: 4, MinMemoryGB: 16}
+	tests := []struct {
+		name         string
+		capabilities resourceskus.VMCapabilities
+		wantErr      string
+	}{
+		{
+			name:         "too few vCPUs",
+			capabilities: resourceskus.VMCapabilities{VCPUs: 2, MemoryGB: 16},
+			wantErr:      "VM size Standard_D2s_v3 has 2 vCPUs, control plane machines require at least 4",
+		},
+		{
+			name:         "too little memory",
+			capabilities: resourceskus.VMCapabilities{VCPUs: 4, MemoryGB: 7.5},
+			wantErr:      "VM size Standard_D2s_v3 has 7.5GB of memory, control plane machines require at least 16GB",
+		},
+		{
+			name:         "adequate size",
+			capabilities: resourceskus.VMCapabilities{VCPUs: 4, MemoryGB: 16},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validation.validate("Standard_D2s_v3", tc.capabilities)
+			if tc.wantErr != "" {
+				g.Expect(err).To(MatchError(tc.wantErr))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
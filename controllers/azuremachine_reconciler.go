@@ -124,7 +124,7 @@ func (s *azureMachineService) reconcile(ctx context.Context) error {
 	}
 
 	for _, service := range s.services {
-		if err := service.Reconcile(ctx); err != nil {
+		if err := ReconcileService(ctx, service); err != nil {
 			return errors.Wrapf(err, "failed to reconcile AzureMachine service %s", service.Name())
 		}
 	}
@@ -157,7 +157,7 @@ func (s *azureMachineService) delete(ctx context.Context) error {
 
 	// Delete services in reverse order of creation.
 	for i := len(s.services) - 1; i >= 0; i-- {
-		if err := s.services[i].Delete(ctx); err != nil {
+		if err := DeleteService(ctx, s.services[i]); err != nil {
 			return errors.Wrapf(err, "failed to delete AzureMachine service %s", s.services[i].Name())
 		}
 	}
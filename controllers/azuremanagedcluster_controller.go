@@ -111,6 +111,7 @@ func (amcr *AzureManagedClusterReconciler) Reconcile(ctx context.Context, req ct
 		tele.KVP("kind", infrav1.AzureManagedClusterKind),
 	)
 	defer done()
+	defer func() { tele.RecordError(ctx, reterr) }()
 
 	// Fetch the AzureManagedCluster instance
 	aksCluster := &infrav1.AzureManagedCluster{}
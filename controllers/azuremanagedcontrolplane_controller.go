@@ -134,6 +134,7 @@ func (amcpr *AzureManagedControlPlaneReconciler) Reconcile(ctx context.Context,
 		tele.KVP("kind", infrav1.AzureManagedControlPlaneKind),
 	)
 	defer done()
+	defer func() { tele.RecordError(ctx, reterr) }()
 
 	// Fetch the AzureManagedControlPlane instance
 	azureControlPlane := &infrav1.AzureManagedControlPlane{}
@@ -311,7 +312,13 @@ func (amcpr *AzureManagedControlPlaneReconciler) reconcileDelete(ctx context.Con
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, "failed to create azureManagedControlPlane service")
 	}
-	if err := svc.Delete(ctx); err != nil {
+	if ShouldSkipAzureDeletion(scope.ControlPlane) {
+		// Pausing stops ASO from deleting the Azure resources when their ASO resources are garbage collected.
+		log.Info("Skipping AzureManagedControlPlane deletion; Azure resources are left in place.")
+		if err := svc.Pause(ctx); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to pause azureManagedControlPlane service")
+		}
+	} else if err := svc.Delete(ctx); err != nil {
 		// Handle transient errors
 		var reconcileError azure.ReconcileError
 		if errors.As(err, &reconcileError) && reconcileError.IsTransient() {
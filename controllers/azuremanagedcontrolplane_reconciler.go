@@ -24,13 +24,17 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/acrattachments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aksextensions"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/datacollectionrules"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/fleetsmembers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/managedclusters"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/monitorworkspaces"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourcehealth"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/tags"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualnetworks"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	"sigs.k8s.io/cluster-api/util/secret"
@@ -51,6 +55,26 @@ func newAzureManagedControlPlaneReconciler(scope *scope.ManagedControlPlaneScope
 	if err != nil {
 		return nil, err
 	}
+	managedClustersSvc, err := managedclusters.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	tagsSvc, err := tags.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	acrAttachmentsSvc, err := acrattachments.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	monitorWorkspacesSvc, err := monitorworkspaces.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	dataCollectionRulesSvc, err := datacollectionrules.New(scope)
+	if err != nil {
+		return nil, err
+	}
 	return &azureManagedControlPlaneService{
 		kubeclient: scope.Client,
 		scope:      scope,
@@ -58,10 +82,14 @@ func newAzureManagedControlPlaneReconciler(scope *scope.ManagedControlPlaneScope
 			groups.New(scope),
 			virtualnetworks.New(scope),
 			subnets.New(scope),
-			managedclusters.New(scope),
+			monitorWorkspacesSvc,
+			managedClustersSvc,
+			dataCollectionRulesSvc,
 			privateendpoints.New(scope),
 			fleetsmembers.New(scope),
 			aksextensions.New(scope),
+			acrAttachmentsSvc,
+			tagsSvc,
 			resourceHealthSvc,
 		},
 	}, nil
@@ -73,7 +101,7 @@ func (r *azureManagedControlPlaneService) Reconcile(ctx context.Context) error {
 	defer done()
 
 	for _, service := range r.services {
-		if err := service.Reconcile(ctx); err != nil {
+		if err := ReconcileService(ctx, service); err != nil {
 			return errors.Wrapf(err, "failed to reconcile AzureManagedControlPlane service %s", service.Name())
 		}
 	}
@@ -110,7 +138,7 @@ func (r *azureManagedControlPlaneService) Delete(ctx context.Context) error {
 
 	// Delete services in reverse order of creation.
 	for i := len(r.services) - 1; i >= 0; i-- {
-		if err := r.services[i].Delete(ctx); err != nil {
+		if err := DeleteService(ctx, r.services[i]); err != nil {
 			return errors.Wrapf(err, "failed to delete AzureManagedControlPlane service %s", r.services[i].Name())
 		}
 	}
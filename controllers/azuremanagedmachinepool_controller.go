@@ -144,6 +144,7 @@ func (ammpr *AzureManagedMachinePoolReconciler) Reconcile(ctx context.Context, r
 		tele.KVP("kind", "AzureManagedMachinePool"),
 	)
 	defer done()
+	defer func() { tele.RecordError(ctx, reterr) }()
 
 	// Fetch the AzureManagedMachinePool instance
 	infraPool := &infrav1.AzureManagedMachinePool{}
@@ -272,7 +273,11 @@ func (ammpr *AzureManagedMachinePoolReconciler) reconcileNormal(ctx context.Cont
 		// Ensure the ready condition is false, but do not overwrite an existing
 		// error condition which might provide more details.
 		if conditions.IsTrue(scope.InfraMachinePool, infrav1.AgentPoolsReadyCondition) {
-			conditions.MarkFalse(scope.InfraMachinePool, infrav1.AgentPoolsReadyCondition, infrav1.FailedReason, clusterv1.ConditionSeverityError, err.Error())
+			reason, severity := infrav1.FailedReason, clusterv1.ConditionSeverityError
+			if azure.IsTransientError(err) {
+				reason, severity = infrav1.ReconcilingReason, clusterv1.ConditionSeverityInfo
+			}
+			conditions.MarkFalse(scope.InfraMachinePool, infrav1.AgentPoolsReadyCondition, reason, severity, err.Error())
 		}
 
 		// Handle transient and terminal errors
@@ -325,7 +330,18 @@ func (ammpr *AzureManagedMachinePoolReconciler) reconcileDelete(ctx context.Cont
 
 	log.Info("Reconciling AzureManagedMachinePool delete")
 
-	if !scope.Cluster.DeletionTimestamp.IsZero() {
+	if ShouldSkipAzureDeletion(scope.InfraMachinePool, scope.ControlPlane) {
+		// Pausing stops ASO from deleting the agent pool when its ASO resource is garbage collected.
+		log.Info("Skipping AzureManagedMachinePool deletion; Azure resources are left in place.")
+		svc, err := ammpr.createAzureManagedMachinePoolService(scope, ammpr.Timeouts.DefaultedAzureServiceReconcileTimeout())
+		if err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to create an AzureManageMachinePoolService")
+		}
+		if err := svc.Pause(ctx); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to pause AzureManagedMachinePool service")
+		}
+		controllerutil.RemoveFinalizer(scope.InfraMachinePool, infrav1.ClusterFinalizer)
+	} else if !scope.Cluster.DeletionTimestamp.IsZero() {
 		// Cluster was deleted, skip machine pool deletion and let AKS delete the whole cluster.
 		// So, remove the finalizer.
 		controllerutil.RemoveFinalizer(scope.InfraMachinePool, infrav1.ClusterFinalizer)
@@ -22,11 +22,14 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	azprovider "sigs.k8s.io/cloud-provider-azure/pkg/provider"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpools"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
@@ -38,6 +41,7 @@ type (
 		scope         agentpools.AgentPoolScope
 		agentPoolsSvc azure.Reconciler
 		scaleSetsSvc  NodeLister
+		skuCache      *resourceskus.Cache
 	}
 
 	// AgentPoolVMSSNotFoundError represents a reconcile error when the VMSS for an agent pool can't be found.
@@ -83,10 +87,19 @@ func newAzureManagedMachinePoolService(scope *scope.ManagedMachinePoolScope, api
 	if err != nil {
 		return nil, err
 	}
+	skuCache, err := resourceskus.GetCache(scope, scope.Location())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a NewCache")
+	}
+	agentPoolsSvc, err := agentpools.New(scope)
+	if err != nil {
+		return nil, err
+	}
 	return &azureManagedMachinePoolService{
 		scope:         scope,
-		agentPoolsSvc: agentpools.New(scope),
+		agentPoolsSvc: agentPoolsSvc,
 		scaleSetsSvc:  scaleSetsClient,
+		skuCache:      skuCache,
 	}, nil
 }
 
@@ -114,6 +127,10 @@ func (s *azureManagedMachinePoolService) Reconcile(ctx context.Context) error {
 	}
 	agentPoolName := agentPool.AzureName()
 
+	if err := s.validateEphemeralOSDisk(ctx, agentPool); err != nil {
+		return errors.Wrapf(err, "failed to validate machine pool %s", agentPoolName)
+	}
+
 	if err := s.agentPoolsSvc.Reconcile(ctx); err != nil {
 		return errors.Wrapf(err, "failed to reconcile machine pool %s", agentPoolName)
 	}
@@ -165,6 +182,32 @@ func (s *azureManagedMachinePoolService) Reconcile(ctx context.Context) error {
 	return nil
 }
 
+// validateEphemeralOSDisk checks that the VM size of an agent pool with an ephemeral OS disk supports ephemeral
+// OS disks and that the OS disk fits in its cache or resource disk.
+func (s *azureManagedMachinePoolService) validateEphemeralOSDisk(ctx context.Context, agentPool *asocontainerservicev1.ManagedClustersAgentPool) error {
+	if ptr.Deref(agentPool.Spec.OsDiskType, "") != asocontainerservicev1.OSDiskType_Ephemeral {
+		return nil
+	}
+
+	vmSize := ptr.Deref(agentPool.Spec.VmSize, "")
+	capabilities, err := s.skuCache.GetVMCapabilities(ctx, vmSize)
+	if err != nil {
+		return azure.WithTerminalError(errors.Wrap(err, "failed to validate the vm size capabilities"))
+	}
+
+	if !capabilities.EphemeralOSDiskSupported {
+		return azure.WithTerminalError(errors.Errorf("vm size %s does not support ephemeral os. select a different vm size or use a managed os disk", vmSize))
+	}
+
+	// AKS picks an OS disk size which fits the VM size when none is set.
+	osDiskSizeGB := int64(ptr.Deref(agentPool.Spec.OsDiskSizeGB, 0))
+	if osDiskSizeGB > capabilities.MaxEphemeralDiskSizeGB {
+		return azure.WithTerminalError(errors.Errorf("ephemeral os disk of %dGB does not fit the %dGB cache of vm size %s. select a smaller os disk or a different vm size", osDiskSizeGB, capabilities.MaxEphemeralDiskSizeGB, vmSize))
+	}
+
+	return nil
+}
+
 // Pause pauses all components making up the machine pool.
 func (s *azureManagedMachinePoolService) Pause(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "controllers.azureManagedMachinePoolService.Pause")
@@ -20,13 +20,17 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
 )
 
@@ -136,3 +140,86 @@ func TestAzureManagedMachinePoolServicePause(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEphemeralOSDisk(t *testing.T) {
+	skuCache := resourceskus.NewStaticCache([]armcompute.ResourceSKU{
+		{
+			Name:         ptr.To("Standard_D8s_v3"),
+			ResourceType: ptr.To(string(resourceskus.VirtualMachines)),
+			Capabilities: []*armcompute.ResourceSKUCapabilities{
+				{Name: ptr.To(resourceskus.EphemeralOSDisk), Value: ptr.To("True")},
+				{Name: ptr.To(resourceskus.CachedDiskBytes), Value: ptr.To("214748364800")},
+				{Name: ptr.To(resourceskus.MaxResourceVolumeMB), Value: ptr.To("65536")},
+			},
+		},
+		{
+			Name:         ptr.To("Standard_B2s"),
+			ResourceType: ptr.To(string(resourceskus.VirtualMachines)),
+		},
+	}, "westus2")
+
+	cases := []struct {
+		name          string
+		osDiskType    asocontainerservicev1.OSDiskType
+		vmSize        string
+		osDiskSizeGB  int
+		expectedError string
+	}{
+		{
+			name:       "managed os disk",
+			osDiskType: asocontainerservicev1.OSDiskType_Managed,
+			vmSize:     "Standard_B2s",
+		},
+		{
+			name:         "ephemeral os disk which fits the cache",
+			osDiskType:   asocontainerservicev1.OSDiskType_Ephemeral,
+			vmSize:       "Standard_D8s_v3",
+			osDiskSizeGB: 200,
+		},
+		{
+			name:       "ephemeral os disk without a size",
+			osDiskType: asocontainerservicev1.OSDiskType_Ephemeral,
+			vmSize:     "Standard_D8s_v3",
+		},
+		{
+			name:          "ephemeral os disk which doesn't fit the cache",
+			osDiskType:    asocontainerservicev1.OSDiskType_Ephemeral,
+			vmSize:        "Standard_D8s_v3",
+			osDiskSizeGB:  256,
+			expectedError: "reconcile error that cannot be recovered occurred: ephemeral os disk of 256GB does not fit the 200GB cache of vm size Standard_D8s_v3. select a smaller os disk or a different vm size. Object will not be requeued",
+		},
+		{
+			name:          "ephemeral os disk on a vm size without ephemeral os support",
+			osDiskType:    asocontainerservicev1.OSDiskType_Ephemeral,
+			vmSize:        "Standard_B2s",
+			osDiskSizeGB:  30,
+			expectedError: "reconcile error that cannot be recovered occurred: vm size Standard_B2s does not support ephemeral os. select a different vm size or use a managed os disk. Object will not be requeued",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			t.Parallel()
+
+			s := &azureManagedMachinePoolService{
+				skuCache: skuCache,
+			}
+			agentPool := &asocontainerservicev1.ManagedClustersAgentPool{
+				Spec: asocontainerservicev1.ManagedClusters_AgentPool_Spec{
+					VmSize:       ptr.To(tc.vmSize),
+					OsDiskType:   ptr.To(tc.osDiskType),
+					OsDiskSizeGB: ptr.To(asocontainerservicev1.ContainerServiceOSDisk(tc.osDiskSizeGB)),
+				},
+			}
+
+			err := s.validateEphemeralOSDisk(context.TODO(), agentPool)
+			if tc.expectedError != "" {
+				g.Expect(err).To(gomega.MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+		})
+	}
+}
@@ -267,13 +267,15 @@ func userAssignedIdentityCloudProviderConfig(d azure.ClusterScoper, identityID s
 
 func newCloudProviderConfig(d azure.ClusterScoper) (controlPlaneConfig *CloudProviderConfig, workerConfig *CloudProviderConfig) {
 	subnet := getOneNodeSubnet(d)
+	resourceGroup, loadBalancerResourceGroup, routeTableResourceGroup := cloudProviderResourceGroups(d)
 	return (&CloudProviderConfig{
 			Cloud:                        d.CloudEnvironment(),
 			AadClientID:                  d.ClientID(),
 			AadClientSecret:              d.ClientSecret(),
 			TenantID:                     d.TenantID(),
 			SubscriptionID:               d.SubscriptionID(),
-			ResourceGroup:                d.ResourceGroup(),
+			ResourceGroup:                resourceGroup,
+			LoadBalancerResourceGroup:    loadBalancerResourceGroup,
 			SecurityGroupName:            subnet.SecurityGroup.Name,
 			SecurityGroupResourceGroup:   d.Vnet().ResourceGroup,
 			Location:                     d.Location(),
@@ -284,6 +286,7 @@ func newCloudProviderConfig(d azure.ClusterScoper) (controlPlaneConfig *CloudPro
 			VnetResourceGroup:            d.Vnet().ResourceGroup,
 			SubnetName:                   subnet.Name,
 			RouteTableName:               subnet.RouteTable.Name,
+			RouteTableResourceGroup:      routeTableResourceGroup,
 			LoadBalancerSku:              "Standard",
 			LoadBalancerName:             d.OutboundLBName(infrav1.Node),
 			MaximumLoadBalancerRuleCount: 250,
@@ -296,7 +299,8 @@ func newCloudProviderConfig(d azure.ClusterScoper) (controlPlaneConfig *CloudPro
 			AadClientSecret:              d.ClientSecret(),
 			TenantID:                     d.TenantID(),
 			SubscriptionID:               d.SubscriptionID(),
-			ResourceGroup:                d.ResourceGroup(),
+			ResourceGroup:                resourceGroup,
+			LoadBalancerResourceGroup:    loadBalancerResourceGroup,
 			SecurityGroupName:            subnet.SecurityGroup.Name,
 			SecurityGroupResourceGroup:   d.Vnet().ResourceGroup,
 			Location:                     d.Location(),
@@ -307,6 +311,7 @@ func newCloudProviderConfig(d azure.ClusterScoper) (controlPlaneConfig *CloudPro
 			VnetResourceGroup:            d.Vnet().ResourceGroup,
 			SubnetName:                   subnet.Name,
 			RouteTableName:               subnet.RouteTable.Name,
+			RouteTableResourceGroup:      routeTableResourceGroup,
 			LoadBalancerSku:              "Standard",
 			LoadBalancerName:             d.OutboundLBName(infrav1.Node),
 			MaximumLoadBalancerRuleCount: 250,
@@ -325,6 +330,19 @@ func getOneNodeSubnet(d azure.ClusterScoper) infrav1.SubnetSpec {
 	return infrav1.SubnetSpec{}
 }
 
+// cloudProviderResourceGroups returns the resource group of the nodes and, if they are different ones, the resource
+// groups of the load balancers and of the route tables for the cloud provider config.
+func cloudProviderResourceGroups(d azure.ClusterScoper) (resourceGroup, loadBalancerResourceGroup, routeTableResourceGroup string) {
+	// Only AzureClusters can place their nodes in a separate resource group, the node resource group of managed
+	// clusters is managed by AKS.
+	if _, ok := d.(*scope.ClusterScope); !ok || d.NodeResourceGroup() == d.ResourceGroup() {
+		return d.ResourceGroup(), "", ""
+	}
+	// The cloud provider looks for the route tables in the node resource group unless told otherwise, but they are
+	// created in the vnet resource group.
+	return d.NodeResourceGroup(), d.ResourceGroup(), d.Vnet().ResourceGroup
+}
+
 // CloudProviderConfig is an abbreviated version of the same struct in k/k.
 type CloudProviderConfig struct {
 	Cloud                        string `json:"cloud"`
@@ -343,8 +361,10 @@ type CloudProviderConfig struct {
 	VnetResourceGroup            string `json:"vnetResourceGroup"`
 	SubnetName                   string `json:"subnetName"`
 	RouteTableName               string `json:"routeTableName"`
+	RouteTableResourceGroup      string `json:"routeTableResourceGroup,omitempty"`
 	LoadBalancerSku              string `json:"loadBalancerSku"`
 	LoadBalancerName             string `json:"loadBalancerName"`
+	LoadBalancerResourceGroup    string `json:"loadBalancerResourceGroup,omitempty"`
 	MaximumLoadBalancerRuleCount int    `json:"maximumLoadBalancerRuleCount"`
 	UseManagedIdentityExtension  bool   `json:"useManagedIdentityExtension"`
 	UseInstanceMetadata          bool   `json:"useInstanceMetadata"`
@@ -604,6 +624,38 @@ func GetAzureMachinePoolByName(ctx context.Context, c client.Client, namespace,
 	return m, nil
 }
 
+// ReconcileService reconciles the service within a span named after it, e.g. "services.subnets.Reconcile",
+// and records any error on the span.
+func ReconcileService(ctx context.Context, service azure.ServiceReconciler) error {
+	ctx, done := startServiceSpan(ctx, service, "Reconcile")
+	defer done()
+
+	err := service.Reconcile(ctx)
+	tele.RecordError(ctx, err)
+	return err
+}
+
+// DeleteService deletes the service within a span named after it, e.g. "services.subnets.Delete",
+// and records any error on the span.
+func DeleteService(ctx context.Context, service azure.ServiceReconciler) error {
+	ctx, done := startServiceSpan(ctx, service, "Delete")
+	defer done()
+
+	err := service.Delete(ctx)
+	tele.RecordError(ctx, err)
+	return err
+}
+
+// startServiceSpan starts a span for an operation of the service. The span is only named after the service
+// when it is recorded, so that nothing is done for spans while tracing is disabled.
+func startServiceSpan(ctx context.Context, service azure.ServiceReconciler, operation string) (context.Context, func()) {
+	ctx, span := tele.Tracer().Start(ctx, "services."+operation)
+	if span.IsRecording() {
+		span.SetName("services." + service.Name() + "." + operation)
+	}
+	return ctx, func() { span.End() }
+}
+
 // ShouldDeleteIndividualResources returns false if the resource group is managed and the whole cluster is being deleted
 // meaning that we can rely on a single resource group delete operation as opposed to deleting every individual VM resource.
 func ShouldDeleteIndividualResources(ctx context.Context, cluster ClusterScoper) bool {
@@ -620,6 +672,26 @@ func ShouldDeleteIndividualResources(ctx context.Context, cluster ClusterScoper)
 	return err != nil || !managed
 }
 
+// ShouldSkipAzureDeletion returns true if any of the given objects has the SkipDeletionAnnotation set to "true",
+// meaning the Azure resources should be left in place and only the finalizers removed when they are deleted.
+func ShouldSkipAzureDeletion(objs ...metav1.Object) bool {
+	for _, obj := range objs {
+		if obj != nil && obj.GetAnnotations()[infrav1.SkipDeletionAnnotation] == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// InfraClusterObject returns the AzureCluster or AzureManagedControlPlane described by the cluster scope, or nil
+// if it cannot be determined.
+func InfraClusterObject(cluster ClusterScoper) metav1.Object {
+	if owner, ok := cluster.(interface{ ASOOwner() client.Object }); ok {
+		return owner.ASOOwner()
+	}
+	return nil
+}
+
 // GetClusterIdentityFromRef returns the AzureClusterIdentity referenced by the AzureCluster.
 func GetClusterIdentityFromRef(ctx context.Context, c client.Client, azureClusterNamespace string, ref *corev1.ObjectReference) (*infrav1.AzureClusterIdentity, error) {
 	identity := &infrav1.AzureClusterIdentity{}
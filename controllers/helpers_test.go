@@ -26,6 +26,10 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/exp/maps"
 	corev1 "k8s.io/api/core/v1"
@@ -37,8 +41,10 @@ import (
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
 	"sigs.k8s.io/cluster-api-provider-azure/internal/test/mock_log"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
@@ -104,6 +110,12 @@ func TestGetCloudProviderConfig(t *testing.T) {
 	azureCluster.Default()
 	azureClusterCustomVnet := newAzureClusterWithCustomVnet("bar")
 	azureClusterCustomVnet.Default()
+	azureClusterNodeResourceGroup := newAzureClusterWithCustomVnet("bar")
+	azureClusterNodeResourceGroup.Spec.NodeResourceGroup = "bar-nodes"
+	azureClusterNodeResourceGroup.Default()
+	azureClusterNodeResourceGroupDefaultVnet := newAzureCluster("bar")
+	azureClusterNodeResourceGroupDefaultVnet.Spec.NodeResourceGroup = "bar-nodes"
+	azureClusterNodeResourceGroupDefaultVnet.Default()
 
 	cases := map[string]struct {
 		cluster                    *clusterv1.Cluster
@@ -143,6 +155,20 @@ func TestGetCloudProviderConfig(t *testing.T) {
 			expectedControlPlaneConfig: spCustomVnetControlPlaneCloudConfig,
 			expectedWorkerNodeConfig:   spCustomVnetWorkerNodeCloudConfig,
 		},
+		"serviceprincipal with node resource group": {
+			cluster:                    cluster,
+			azureCluster:               azureClusterNodeResourceGroup,
+			identityType:               infrav1.VMIdentityNone,
+			expectedControlPlaneConfig: spNodeResourceGroupCloudConfig,
+			expectedWorkerNodeConfig:   spNodeResourceGroupCloudConfig,
+		},
+		"serviceprincipal with node resource group and the default vnet": {
+			cluster:                    cluster,
+			azureCluster:               azureClusterNodeResourceGroupDefaultVnet,
+			identityType:               infrav1.VMIdentityNone,
+			expectedControlPlaneConfig: spNodeResourceGroupDefaultVnetCloudConfig,
+			expectedWorkerNodeConfig:   spNodeResourceGroupDefaultVnetCloudConfig,
+		},
 		"with rate limits": {
 			cluster:                    cluster,
 			azureCluster:               withRateLimits(*azureCluster),
@@ -677,6 +703,52 @@ const (
     "maximumLoadBalancerRuleCount": 250,
     "useManagedIdentityExtension": false,
     "useInstanceMetadata": true
+}`
+	spNodeResourceGroupCloudConfig = `{
+    "cloud": "AzurePublicCloud",
+    "tenantId": "fooTenant",
+    "subscriptionId": "baz",
+    "aadClientId": "fooClient",
+    "aadClientSecret": "fooSecret",
+    "resourceGroup": "bar-nodes",
+    "securityGroupName": "foo-node-nsg",
+    "securityGroupResourceGroup": "custom-vnet-resource-group",
+    "location": "bar",
+    "vmType": "vmss",
+    "vnetName": "custom-vnet",
+    "vnetResourceGroup": "custom-vnet-resource-group",
+    "subnetName": "foo-node-subnet",
+    "routeTableName": "foo-node-routetable",
+    "routeTableResourceGroup": "custom-vnet-resource-group",
+    "loadBalancerSku": "Standard",
+    "loadBalancerName": "",
+    "loadBalancerResourceGroup": "bar",
+    "maximumLoadBalancerRuleCount": 250,
+    "useManagedIdentityExtension": false,
+    "useInstanceMetadata": true
+}`
+	spNodeResourceGroupDefaultVnetCloudConfig = `{
+    "cloud": "AzurePublicCloud",
+    "tenantId": "fooTenant",
+    "subscriptionId": "baz",
+    "aadClientId": "fooClient",
+    "aadClientSecret": "fooSecret",
+    "resourceGroup": "bar-nodes",
+    "securityGroupName": "foo-node-nsg",
+    "securityGroupResourceGroup": "bar",
+    "location": "bar",
+    "vmType": "vmss",
+    "vnetName": "foo-vnet",
+    "vnetResourceGroup": "bar",
+    "subnetName": "foo-node-subnet",
+    "routeTableName": "foo-node-routetable",
+    "routeTableResourceGroup": "bar",
+    "loadBalancerSku": "Standard",
+    "loadBalancerName": "",
+    "loadBalancerResourceGroup": "bar",
+    "maximumLoadBalancerRuleCount": 250,
+    "useManagedIdentityExtension": false,
+    "useInstanceMetadata": true
 }`
 	rateLimitsControlPlaneCloudConfig = `{
     "cloud": "AzurePublicCloud",
@@ -1647,3 +1719,72 @@ func TestRemoveBlockMoveAnnotation(t *testing.T) {
 		})
 	}
 }
+
+func TestShouldSkipAzureDeletion(t *testing.T) {
+	skip := &metav1.ObjectMeta{Annotations: map[string]string{infrav1.SkipDeletionAnnotation: "true"}}
+	noSkip := &metav1.ObjectMeta{Annotations: map[string]string{infrav1.SkipDeletionAnnotation: "false"}}
+	none := &metav1.ObjectMeta{}
+
+	tests := []struct {
+		name     string
+		objs     []metav1.Object
+		expected bool
+	}{
+		{
+			name:     "no objects",
+			expected: false,
+		},
+		{
+			name:     "annotation not present",
+			objs:     []metav1.Object{none, nil},
+			expected: false,
+		},
+		{
+			name:     "annotation not true",
+			objs:     []metav1.Object{noSkip},
+			expected: false,
+		},
+		{
+			name:     "annotation on the object",
+			objs:     []metav1.Object{skip, none},
+			expected: true,
+		},
+		{
+			name:     "annotation on the cluster",
+			objs:     []metav1.Object{none, nil, skip},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(ShouldSkipAzureDeletion(test.objs...)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestReconcileAndDeleteServiceSpans(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tele.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer tele.SetTracerProvider(nil)
+
+	svc := mock_azure.NewMockServiceReconciler(mockCtrl)
+	svc.EXPECT().Name().Return("subnets").AnyTimes()
+	svc.EXPECT().Reconcile(gomock.Any()).Return(nil)
+	svc.EXPECT().Delete(gomock.Any()).Return(errors.New("boom"))
+
+	g.Expect(ReconcileService(context.Background(), svc)).To(Succeed())
+	g.Expect(DeleteService(context.Background(), svc)).To(MatchError("boom"))
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(2))
+	g.Expect(spans[0].Name).To(Equal("services.subnets.Reconcile"))
+	g.Expect(spans[0].Status.Code).To(Equal(codes.Unset))
+	g.Expect(spans[1].Name).To(Equal("services.subnets.Delete"))
+	g.Expect(spans[1].Status.Code).To(Equal(codes.Error))
+}
@@ -39,6 +39,13 @@ const (
 	NewestDeletePolicyType AzureMachinePoolDeletePolicyType = "Newest"
 	// RandomDeletePolicyType will delete machines in random order.
 	RandomDeletePolicyType AzureMachinePoolDeletePolicyType = "Random"
+
+	// ApplicationHealthProtocolTCP probes the health of an instance by opening a TCP connection.
+	ApplicationHealthProtocolTCP ApplicationHealthProtocol = "tcp"
+	// ApplicationHealthProtocolHTTP probes the health of an instance with an HTTP request.
+	ApplicationHealthProtocolHTTP ApplicationHealthProtocol = "http"
+	// ApplicationHealthProtocolHTTPS probes the health of an instance with an HTTPS request.
+	ApplicationHealthProtocolHTTPS ApplicationHealthProtocol = "https"
 )
 
 type (
@@ -103,6 +110,36 @@ type (
 		// The primary interface will be the first networkInterface specified (index 0) in the list.
 		// +optional
 		NetworkInterfaces []infrav1.NetworkInterface `json:"networkInterfaces,omitempty"`
+
+		// ApplicationHealth configures the application health extension of the scale set, which reports the
+		// health of each instance for automatic instance repairs and rolling upgrades.
+		// +optional
+		ApplicationHealth *ApplicationHealth `json:"applicationHealth,omitempty"`
+
+		// RegistryMirrors configures containerd to pull the images of the given registries from mirrors. The mirror
+		// configuration is merged into the cloud-init bootstrap data, so changing it rolls the instances of the
+		// scale set like any other change of the bootstrap data. Linux only.
+		// +listType=map
+		// +listMapKey=registry
+		// +optional
+		RegistryMirrors []infrav1.RegistryMirror `json:"registryMirrors,omitempty"`
+	}
+
+	// ApplicationHealth configures how the application health extension probes the health of an instance.
+	ApplicationHealth struct {
+		// Protocol is the protocol used to probe the health of the instance.
+		// +kubebuilder:validation:Enum=tcp;http;https
+		Protocol ApplicationHealthProtocol `json:"protocol"`
+
+		// Port is the port used to probe the health of the instance.
+		// +kubebuilder:validation:Minimum=1
+		// +kubebuilder:validation:Maximum=65535
+		Port int32 `json:"port"`
+
+		// RequestPath is the path of the health probe request. It is required for the http and https protocols
+		// and must not be set for the tcp protocol.
+		// +optional
+		RequestPath string `json:"requestPath,omitempty"`
 	}
 
 	// AzureMachinePoolSpec defines the desired state of AzureMachinePool.
@@ -160,6 +197,38 @@ type (
 		// OrchestrationMode specifies the orchestration mode for the Virtual Machine Scale Set
 		// +kubebuilder:default=Uniform
 		OrchestrationMode infrav1.OrchestrationModeType `json:"orchestrationMode,omitempty"`
+
+		// ForceDeleteInstances specifies whether instances of the Virtual Machine Scale Set are force deleted
+		// when their AzureMachinePoolMachines are deleted, which frees instances stuck in a failed state faster
+		// at the risk of losing ephemeral data. Defaults to false, which deletes instances gracefully. Instances
+		// of flexible orchestration mode scale sets are always force deleted.
+		// +optional
+		ForceDeleteInstances bool `json:"forceDeleteInstances,omitempty"`
+
+		// NodeStartupTimeout is the amount of time within which the node of an AzureMachinePoolMachine is expected to
+		// join the workload cluster. Once it is exceeded, the AzureMachinePoolMachine's NodeHealthy condition reports
+		// that the node hasn't joined. The default value is 0, meaning that the node may take any time to join.
+		// +optional
+		NodeStartupTimeout *metav1.Duration `json:"nodeStartupTimeout,omitempty"`
+
+		// PlatformFaultDomainCount is the number of fault domains the Virtual Machine Scale Set spreads its instances
+		// across. It must not exceed the maximum fault domain count of the region, and can't be changed once the
+		// Virtual Machine Scale Set exists. Defaults to the Azure default of the orchestration mode.
+		// +kubebuilder:validation:Minimum=1
+		// +optional
+		PlatformFaultDomainCount *int32 `json:"platformFaultDomainCount,omitempty"`
+
+		// HostGroupID is the resource ID of the dedicated host group the Virtual Machine Scale Set is placed in. The
+		// host group must support automatic placement, be in the location of the Virtual Machine Scale Set and, if it
+		// is zonal, in the failure domains of the MachinePool. Immutable.
+		// +optional
+		HostGroupID *string `json:"hostGroupID,omitempty"`
+
+		// InboundNATPools are the names of the inbound NAT pools of the node outbound load balancer of the cluster the
+		// instances of the Virtual Machine Scale Set are associated with. Only supported with the Uniform orchestration
+		// mode. Immutable.
+		// +optional
+		InboundNATPools []string `json:"inboundNATPools,omitempty"`
 	}
 
 	// AzureMachinePoolDeploymentStrategyType is the type of deployment strategy employed to rollout a new version of
@@ -185,6 +254,9 @@ type (
 	// upgrade.
 	AzureMachinePoolDeletePolicyType string
 
+	// ApplicationHealthProtocol is the protocol the application health extension uses to probe the health of an instance.
+	ApplicationHealthProtocol string
+
 	// MachineRollingUpdateDeployment is used to control the desired behavior of rolling update.
 	MachineRollingUpdateDeployment struct {
 		// The maximum number of machines that can be unavailable during the update.
@@ -32,6 +32,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+	webhookutils "sigs.k8s.io/cluster-api-provider-azure/util/webhook"
 	capifeature "sigs.k8s.io/cluster-api/feature"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -109,7 +110,13 @@ func (amp *AzureMachinePool) Validate(old runtime.Object, client client.Client)
 		amp.ValidateStrategy(),
 		amp.ValidateSystemAssignedIdentity(old),
 		amp.ValidateSystemAssignedIdentityRole,
+		amp.ValidatePlatformFaultDomainCount(old),
+		amp.ValidateHostGroupID(old),
+		amp.ValidateInboundNATPools(old),
 		amp.ValidateNetwork,
+		amp.ValidateAdditionalTags,
+		amp.ValidateApplicationHealth,
+		amp.ValidateRegistryMirrors,
 	}
 
 	var errs []error
@@ -172,6 +179,45 @@ func (amp *AzureMachinePool) ValidateSSHKey() error {
 	return nil
 }
 
+// ValidateAdditionalTags validates the additional tags.
+func (amp *AzureMachinePool) ValidateAdditionalTags() error {
+	if errs := infrav1.ValidateTags(amp.Spec.AdditionalTags, field.NewPath("additionalTags")); len(errs) > 0 {
+		return kerrors.NewAggregate(errs.ToAggregate().Errors())
+	}
+
+	return nil
+}
+
+// ValidateRegistryMirrors validates the registry mirrors of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateRegistryMirrors() error {
+	if errs := infrav1.ValidateRegistryMirrors(amp.Spec.Template.RegistryMirrors, amp.Spec.Template.OSDisk.OSType, field.NewPath("template", "registryMirrors")); len(errs) > 0 {
+		return kerrors.NewAggregate(errs.ToAggregate().Errors())
+	}
+
+	return nil
+}
+
+// ValidateApplicationHealth validates the application health extension configuration.
+func (amp *AzureMachinePool) ValidateApplicationHealth() error {
+	health := amp.Spec.Template.ApplicationHealth
+	if health == nil {
+		return nil
+	}
+
+	switch health.Protocol {
+	case ApplicationHealthProtocolHTTP, ApplicationHealthProtocolHTTPS:
+		if health.RequestPath == "" {
+			return errors.Errorf("applicationHealth.requestPath is required for the %s protocol", health.Protocol)
+		}
+	case ApplicationHealthProtocolTCP:
+		if health.RequestPath != "" {
+			return errors.Errorf("applicationHealth.requestPath cannot be set for the %s protocol", health.Protocol)
+		}
+	}
+
+	return nil
+}
+
 // ValidateUserAssignedIdentity validates the user-assigned identities list.
 func (amp *AzureMachinePool) ValidateUserAssignedIdentity() error {
 	fldPath := field.NewPath("UserAssignedIdentities")
@@ -187,10 +233,16 @@ func (amp *AzureMachinePool) ValidateStrategy() func() error {
 	return func() error {
 		if amp.Spec.Strategy.Type == RollingUpdateAzureMachinePoolDeploymentStrategyType && amp.Spec.Strategy.RollingUpdate != nil {
 			rollingUpdateStrategy := amp.Spec.Strategy.RollingUpdate
-			maxSurge := rollingUpdateStrategy.MaxSurge
-			maxUnavailable := rollingUpdateStrategy.MaxUnavailable
-			if maxSurge.Type == intstr.Int && maxSurge.IntVal == 0 &&
-				maxUnavailable.Type == intstr.Int && maxUnavailable.IntVal == 0 {
+			maxSurge, err := validateIntOrPercent(rollingUpdateStrategy.MaxSurge, "MaxSurge")
+			if err != nil {
+				return err
+			}
+			maxUnavailable, err := validateIntOrPercent(rollingUpdateStrategy.MaxUnavailable, "MaxUnavailable")
+			if err != nil {
+				return err
+			}
+			if rollingUpdateStrategy.MaxSurge != nil && maxSurge == 0 &&
+				rollingUpdateStrategy.MaxUnavailable != nil && maxUnavailable == 0 {
 				return errors.New("rolling update strategy MaxUnavailable must not be 0 if MaxSurge is 0")
 			}
 		}
@@ -199,6 +251,22 @@ func (amp *AzureMachinePool) ValidateStrategy() func() error {
 	}
 }
 
+// validateIntOrPercent validates that a rolling update value is a non-negative number or percentage and returns it
+// scaled against 100 machines, so that both 0 and "0%" are returned as 0. A nil value is returned as 0.
+func validateIntOrPercent(value *intstr.IntOrString, name string) (int, error) {
+	if value == nil {
+		return 0, nil
+	}
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	if err != nil {
+		return 0, errors.Wrapf(err, "rolling update strategy %s must be a number or a percentage", name)
+	}
+	if scaled < 0 {
+		return 0, errors.Errorf("rolling update strategy %s must not be negative, got %s", name, value.String())
+	}
+	return scaled, nil
+}
+
 // ValidateSystemAssignedIdentity validates system-assigned identity role.
 func (amp *AzureMachinePool) ValidateSystemAssignedIdentity(old runtime.Object) func() error {
 	return func() error {
@@ -228,6 +296,75 @@ func (amp *AzureMachinePool) ValidateSystemAssignedIdentity(old runtime.Object)
 	}
 }
 
+// ValidatePlatformFaultDomainCount validates that the platform fault domain count isn't changed, which Azure doesn't
+// support for an existing Virtual Machine Scale Set.
+func (amp *AzureMachinePool) ValidatePlatformFaultDomainCount(old runtime.Object) func() error {
+	return func() error {
+		if old == nil {
+			return nil
+		}
+		oldMachinePool, ok := old.(*AzureMachinePool)
+		if !ok {
+			return fmt.Errorf("unexpected type for old azure machine pool object. Expected: %q, Got: %q",
+				"AzureMachinePool", reflect.TypeOf(old))
+		}
+		if err := webhookutils.ValidateImmutable(
+			field.NewPath("spec", "platformFaultDomainCount"),
+			oldMachinePool.Spec.PlatformFaultDomainCount,
+			amp.Spec.PlatformFaultDomainCount); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// ValidateHostGroupID validates the reference to the dedicated host group of the Virtual Machine Scale Set, which
+// Azure doesn't support changing for an existing Virtual Machine Scale Set.
+func (amp *AzureMachinePool) ValidateHostGroupID(old runtime.Object) func() error {
+	return func() error {
+		fldPath := field.NewPath("spec", "hostGroupID")
+		if errs := infrav1.ValidateHostGroupID(amp.Spec.HostGroupID, fldPath); len(errs) > 0 {
+			return errs.ToAggregate()
+		}
+		if old == nil {
+			return nil
+		}
+		oldMachinePool, ok := old.(*AzureMachinePool)
+		if !ok {
+			return fmt.Errorf("unexpected type for old azure machine pool object. Expected: %q, Got: %q",
+				"AzureMachinePool", reflect.TypeOf(old))
+		}
+		if err := webhookutils.ValidateImmutable(fldPath, oldMachinePool.Spec.HostGroupID, amp.Spec.HostGroupID); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// ValidateInboundNATPools validates the inbound NAT pools the Virtual Machine Scale Set is associated with. Inbound NAT
+// pools are only supported by Uniform Virtual Machine Scale Sets, and CAPZ doesn't update the network profile of an
+// existing Virtual Machine Scale Set.
+func (amp *AzureMachinePool) ValidateInboundNATPools(old runtime.Object) func() error {
+	return func() error {
+		fldPath := field.NewPath("spec", "inboundNATPools")
+		if len(amp.Spec.InboundNATPools) > 0 && amp.Spec.OrchestrationMode == infrav1.FlexibleOrchestrationMode {
+			return field.Forbidden(fldPath, "inbound NAT pools are not supported with the Flexible orchestration mode")
+		}
+		if old == nil {
+			return nil
+		}
+		oldMachinePool, ok := old.(*AzureMachinePool)
+		if !ok {
+			return fmt.Errorf("unexpected type for old azure machine pool object. Expected: %q, Got: %q",
+				"AzureMachinePool", reflect.TypeOf(old))
+		}
+		if err := webhookutils.ValidateImmutable(fldPath, oldMachinePool.Spec.InboundNATPools, amp.Spec.InboundNATPools); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
 // ValidateSystemAssignedIdentityRole validates the scope and roleDefinitionID for the system-assigned identity.
 func (amp *AzureMachinePool) ValidateSystemAssignedIdentityRole() error {
 	var allErrs field.ErrorList
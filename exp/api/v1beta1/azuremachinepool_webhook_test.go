@@ -49,6 +49,8 @@ var (
 	one               = intstr.FromInt(1)
 )
 
+const fakeHostGroupID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-host-group"
+
 type mockClient struct {
 	client.Client
 	Version     string
@@ -203,6 +205,60 @@ func TestAzureMachinePool_ValidateCreate(t *testing.T) {
 			}),
 			wantErr: false,
 		},
+		{
+			name: "azuremachinepool with percentage MaxSurge rolling upgrade configuration",
+			amp: createMachinePoolWithStrategy(AzureMachinePoolDeploymentStrategy{
+				Type: RollingUpdateAzureMachinePoolDeploymentStrategyType,
+				RollingUpdate: &MachineRollingUpdateDeployment{
+					MaxSurge:       ptr.To(intstr.FromString("25%")),
+					MaxUnavailable: &zero,
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "azuremachinepool with 0% MaxSurge and MaxUnavailable rolling upgrade configuration",
+			amp: createMachinePoolWithStrategy(AzureMachinePoolDeploymentStrategy{
+				Type: RollingUpdateAzureMachinePoolDeploymentStrategyType,
+				RollingUpdate: &MachineRollingUpdateDeployment{
+					MaxSurge:       ptr.To(intstr.FromString("0%")),
+					MaxUnavailable: &zero,
+				},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "azuremachinepool with negative MaxSurge rolling upgrade configuration",
+			amp: createMachinePoolWithStrategy(AzureMachinePoolDeploymentStrategy{
+				Type: RollingUpdateAzureMachinePoolDeploymentStrategyType,
+				RollingUpdate: &MachineRollingUpdateDeployment{
+					MaxSurge:       ptr.To(intstr.FromInt(-1)),
+					MaxUnavailable: &one,
+				},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "azuremachinepool with malformed MaxSurge rolling upgrade configuration",
+			amp: createMachinePoolWithStrategy(AzureMachinePoolDeploymentStrategy{
+				Type: RollingUpdateAzureMachinePoolDeploymentStrategyType,
+				RollingUpdate: &MachineRollingUpdateDeployment{
+					MaxSurge:       ptr.To(intstr.FromString("lots")),
+					MaxUnavailable: &one,
+				},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "azuremachinepool with only MaxSurge set in rolling upgrade configuration",
+			amp: createMachinePoolWithStrategy(AzureMachinePoolDeploymentStrategy{
+				Type: RollingUpdateAzureMachinePoolDeploymentStrategyType,
+				RollingUpdate: &MachineRollingUpdateDeployment{
+					MaxSurge: ptr.To(intstr.FromInt(2)),
+				},
+			}),
+			wantErr: false,
+		},
 		{
 			name:    "azuremachinepool with valid legacy network configuration",
 			amp:     createMachinePoolWithNetworkConfig("testSubnet", []infrav1.NetworkInterface{}),
@@ -237,6 +293,46 @@ func TestAzureMachinePool_ValidateCreate(t *testing.T) {
 			ownerNotFound: true,
 			wantErr:       true,
 		},
+		{
+			name:    "azuremachinepool with tcp application health",
+			amp:     createMachinePoolWithApplicationHealth(&ApplicationHealth{Protocol: ApplicationHealthProtocolTCP, Port: 22}),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with http application health",
+			amp:     createMachinePoolWithApplicationHealth(&ApplicationHealth{Protocol: ApplicationHealthProtocolHTTP, Port: 10256, RequestPath: "/healthz"}),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with https application health without a request path",
+			amp:     createMachinePoolWithApplicationHealth(&ApplicationHealth{Protocol: ApplicationHealthProtocolHTTPS, Port: 443}),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with tcp application health with a request path",
+			amp:     createMachinePoolWithApplicationHealth(&ApplicationHealth{Protocol: ApplicationHealthProtocolTCP, Port: 22, RequestPath: "/healthz"}),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with a dedicated host group",
+			amp:     createMachinePoolWithHostGroupID(ptr.To(fakeHostGroupID)),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with an invalid dedicated host group ID",
+			amp:     createMachinePoolWithHostGroupID(ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/availabilitySets/my-availability-set")),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with inbound NAT pools",
+			amp:     createMachinePoolWithInboundNATPools(infrav1.UniformOrchestrationMode, []string{"ssh"}),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with inbound NAT pools in Flexible orchestration mode",
+			amp:     createMachinePoolWithInboundNATPools(infrav1.FlexibleOrchestrationMode, []string{"ssh"}),
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -381,6 +477,48 @@ func TestAzureMachinePool_ValidateUpdate(t *testing.T) {
 			amp:     createMachinePoolWithNetworkConfig("subnet", []infrav1.NetworkInterface{{SubnetName: "testSubnet2"}}),
 			wantErr: true,
 		},
+		{
+			name:    "azuremachinepool with platform fault domain count unchanged",
+			oldAMP:  createMachinePoolWithPlatformFaultDomainCount(ptr.To[int32](2)),
+			amp:     createMachinePoolWithPlatformFaultDomainCount(ptr.To[int32](2)),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with platform fault domain count changed",
+			oldAMP:  createMachinePoolWithPlatformFaultDomainCount(ptr.To[int32](2)),
+			amp:     createMachinePoolWithPlatformFaultDomainCount(ptr.To[int32](3)),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with platform fault domain count added",
+			oldAMP:  createMachinePoolWithPlatformFaultDomainCount(nil),
+			amp:     createMachinePoolWithPlatformFaultDomainCount(ptr.To[int32](2)),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with host group unchanged",
+			oldAMP:  createMachinePoolWithHostGroupID(ptr.To(fakeHostGroupID)),
+			amp:     createMachinePoolWithHostGroupID(ptr.To(fakeHostGroupID)),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with host group removed",
+			oldAMP:  createMachinePoolWithHostGroupID(ptr.To(fakeHostGroupID)),
+			amp:     createMachinePoolWithHostGroupID(nil),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with inbound NAT pools unchanged",
+			oldAMP:  createMachinePoolWithInboundNATPools(infrav1.UniformOrchestrationMode, []string{"ssh"}),
+			amp:     createMachinePoolWithInboundNATPools(infrav1.UniformOrchestrationMode, []string{"ssh"}),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with inbound NAT pools changed",
+			oldAMP:  createMachinePoolWithInboundNATPools(infrav1.UniformOrchestrationMode, []string{"ssh"}),
+			amp:     createMachinePoolWithInboundNATPools(infrav1.UniformOrchestrationMode, []string{"ssh", "rdp"}),
+			wantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -542,6 +680,16 @@ func createMachinePoolWithNetworkConfig(subnetName string, interfaces []infrav1.
 	}
 }
 
+func createMachinePoolWithApplicationHealth(health *ApplicationHealth) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			Template: AzureMachinePoolMachineTemplate{
+				ApplicationHealth: health,
+			},
+		},
+	}
+}
+
 func createMachinePoolWithImageByID(imageID string, terminateNotificationTimeout *int) *AzureMachinePool {
 	image := infrav1.Image{
 		ID: &imageID,
@@ -629,6 +777,31 @@ func createMachinePoolWithStrategy(strategy AzureMachinePoolDeploymentStrategy)
 	}
 }
 
+func createMachinePoolWithPlatformFaultDomainCount(count *int32) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			PlatformFaultDomainCount: count,
+		},
+	}
+}
+
+func createMachinePoolWithHostGroupID(hostGroupID *string) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			HostGroupID: hostGroupID,
+		},
+	}
+}
+
+func createMachinePoolWithInboundNATPools(mode infrav1.OrchestrationModeType, inboundNATPools []string) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			OrchestrationMode: mode,
+			InboundNATPools:   inboundNATPools,
+		},
+	}
+}
+
 func createMachinePoolWithOrchestrationMode(mode armcompute.OrchestrationMode) *AzureMachinePool {
 	return &AzureMachinePool{
 		Spec: AzureMachinePoolSpec{
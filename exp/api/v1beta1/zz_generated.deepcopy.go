@@ -21,7 +21,8 @@ limitations under the License.
 package v1beta1
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	apiv1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -29,6 +30,21 @@ import (
 	"sigs.k8s.io/cluster-api/errors"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationHealth) DeepCopyInto(out *ApplicationHealth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationHealth.
+func (in *ApplicationHealth) DeepCopy() *ApplicationHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureMachinePool) DeepCopyInto(out *AzureMachinePool) {
 	*out = *in
@@ -207,7 +223,7 @@ func (in *AzureMachinePoolMachineStatus) DeepCopyInto(out *AzureMachinePoolMachi
 	*out = *in
 	if in.NodeRef != nil {
 		in, out := &in.NodeRef, &out.NodeRef
-		*out = new(v1.ObjectReference)
+		*out = new(corev1.ObjectReference)
 		**out = **in
 	}
 	if in.ProvisioningState != nil {
@@ -304,6 +320,18 @@ func (in *AzureMachinePoolMachineTemplate) DeepCopyInto(out *AzureMachinePoolMac
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ApplicationHealth != nil {
+		in, out := &in.ApplicationHealth, &out.ApplicationHealth
+		*out = new(ApplicationHealth)
+		**out = **in
+	}
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]apiv1beta1.RegistryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachinePoolMachineTemplate.
@@ -343,6 +371,26 @@ func (in *AzureMachinePoolSpec) DeepCopyInto(out *AzureMachinePoolSpec) {
 		copy(*out, *in)
 	}
 	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.NodeStartupTimeout != nil {
+		in, out := &in.NodeStartupTimeout, &out.NodeStartupTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PlatformFaultDomainCount != nil {
+		in, out := &in.PlatformFaultDomainCount, &out.PlatformFaultDomainCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HostGroupID != nil {
+		in, out := &in.HostGroupID, &out.HostGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InboundNATPools != nil {
+		in, out := &in.InboundNATPools, &out.InboundNATPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachinePoolSpec.
@@ -185,6 +185,7 @@ func (ampr *AzureMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.
 		tele.KVP("kind", infrav1.AzureMachinePoolKind),
 	)
 	defer done()
+	defer func() { tele.RecordError(ctx, reterr) }()
 	ctx, cancel := context.WithTimeout(ctx, ampr.Timeouts.DefaultedLoopTimeout())
 	defer cancel()
 
@@ -379,7 +380,16 @@ func (ampr *AzureMachinePoolReconciler) reconcileDelete(ctx context.Context, mac
 
 	log.V(2).Info("handling deleted AzureMachinePool")
 
-	if infracontroller.ShouldDeleteIndividualResources(ctx, clusterScope) {
+	if infracontroller.ShouldSkipAzureDeletion(machinePoolScope.AzureMachinePool, infracontroller.InfraClusterObject(clusterScope)) {
+		log.Info("Skipping AzureMachinePool deletion; Azure resources are left in place.")
+		amps, err := ampr.createAzureMachinePoolService(machinePoolScope)
+		if err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed creating a new AzureMachinePoolService")
+		}
+		if err := amps.Pause(ctx); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "failed to pause AzureMachinePool services")
+		}
+	} else if infracontroller.ShouldDeleteIndividualResources(ctx, clusterScope) {
 		amps, err := ampr.createAzureMachinePoolService(machinePoolScope)
 		if err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "failed creating a new AzureMachinePoolService")
@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
+	infracontroller "sigs.k8s.io/cluster-api-provider-azure/controllers"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
@@ -72,8 +73,12 @@ func (s *azureMachinePoolService) Reconcile(ctx context.Context) error {
 		return errors.Wrap(err, "failed defaulting subnet name")
 	}
 
+	if err := s.scope.ValidateSubnetNames(); err != nil {
+		return errors.Wrap(err, "invalid subnet name")
+	}
+
 	for _, service := range s.services {
-		if err := service.Reconcile(ctx); err != nil {
+		if err := infracontroller.ReconcileService(ctx, service); err != nil {
 			return errors.Wrapf(err, "failed to reconcile AzureMachinePool service %s", service.Name())
 		}
 	}
@@ -106,7 +111,7 @@ func (s *azureMachinePoolService) Delete(ctx context.Context) error {
 
 	// Delete services in reverse order of creation.
 	for i := len(s.services) - 1; i >= 0; i-- {
-		if err := s.services[i].Delete(ctx); err != nil {
+		if err := infracontroller.DeleteService(ctx, s.services[i]); err != nil {
 			return errors.Wrapf(err, "failed to delete AzureMachinePool service %s", s.services[i].Name())
 		}
 	}
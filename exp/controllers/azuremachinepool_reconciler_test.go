@@ -37,6 +37,7 @@ import (
 
 func TestAzureMachinePoolServiceReconcile(t *testing.T) {
 	cases := map[string]struct {
+		subnetName    string
 		expectedError string
 		expect        func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder)
 	}{
@@ -58,6 +59,12 @@ func TestAzureMachinePoolServiceReconcile(t *testing.T) {
 					two.Name().Return("foo"))
 			},
 		},
+		"subnet is missing from the cluster's virtual network": {
+			subnetName:    "missing-subnet",
+			expectedError: `invalid subnet name: subnet "missing-subnet" of network interface 0 is not a subnet of virtual network test-vnet`,
+			expect: func(one *mock_azure.MockServiceReconcilerMockRecorder, two *mock_azure.MockServiceReconcilerMockRecorder, three *mock_azure.MockServiceReconcilerMockRecorder) {
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -74,17 +81,32 @@ func TestAzureMachinePoolServiceReconcile(t *testing.T) {
 
 			tc.expect(svcOneMock.EXPECT(), svcTwoMock.EXPECT(), svcThreeMock.EXPECT())
 
+			subnetName := "test-subnet"
+			if tc.subnetName != "" {
+				subnetName = tc.subnetName
+			}
 			s := &azureMachinePoolService{
 				scope: &scope.MachinePoolScope{
 					ClusterScoper: &scope.ClusterScope{
-						AzureCluster: &infrav1.AzureCluster{},
-						Cluster:      &clusterv1.Cluster{},
+						AzureCluster: &infrav1.AzureCluster{
+							Spec: infrav1.AzureClusterSpec{
+								NetworkSpec: infrav1.NetworkSpec{
+									Vnet: infrav1.VnetSpec{
+										Name: "test-vnet",
+									},
+									Subnets: infrav1.Subnets{
+										{SubnetClassSpec: infrav1.SubnetClassSpec{Name: "test-subnet", Role: infrav1.SubnetNode}},
+									},
+								},
+							},
+						},
+						Cluster: &clusterv1.Cluster{},
 					},
 					MachinePool: &expv1.MachinePool{},
 					AzureMachinePool: &infrav1exp.AzureMachinePool{
 						Spec: infrav1exp.AzureMachinePoolSpec{
 							Template: infrav1exp.AzureMachinePoolMachineTemplate{
-								SubnetName: "test-subnet",
+								SubnetName: subnetName,
 							},
 						},
 					},
@@ -29,6 +29,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesetvms"
 	infracontroller "sigs.k8s.io/cluster-api-provider-azure/controllers"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
@@ -65,6 +66,12 @@ type (
 	azureMachinePoolMachineReconciler struct {
 		Scope              *scope.MachinePoolMachineScope
 		scalesetVMsService *scalesetvms.Service
+		scaleSetsService   scaleSetInstancesDeleter
+	}
+
+	// scaleSetInstancesDeleter deletes instances of a Virtual Machine Scale Set by provider ID.
+	scaleSetInstancesDeleter interface {
+		DeleteInstances(ctx context.Context, providerIDs []string) error
 	}
 )
 
@@ -143,6 +150,7 @@ func (ampmr *AzureMachinePoolMachineController) Reconcile(ctx context.Context, r
 		tele.KVP("kind", "AzureMachinePoolMachine"),
 	)
 	defer done()
+	defer func() { tele.RecordError(ctx, reterr) }()
 
 	logger = logger.WithValues("namespace", req.Namespace, "azureMachinePoolMachine", req.Name)
 
@@ -290,6 +298,11 @@ func (ampmr *AzureMachinePoolMachineController) reconcileNormal(ctx context.Cont
 		return reconcile.Result{}, err
 	}
 
+	// Scale sets in Flexible orchestration mode don't support protectFromScaleIn on their instances.
+	if machineScope.OrchestrationMode() == infrav1.FlexibleOrchestrationMode && machineScope.AzureMachinePoolMachine.Annotations[infrav1.ProtectFromScaleInAnnotation] == "true" {
+		ampmr.Recorder.Eventf(machineScope.AzureMachinePoolMachine, corev1.EventTypeWarning, "ProtectFromScaleInUnsupported", "Flexible scale sets don't support scale-in protection of instances, the machine is only excluded from scale-in by CAPZ")
+	}
+
 	state := machineScope.ProvisioningState()
 	switch state {
 	case infrav1.Failed:
@@ -324,6 +337,13 @@ func (ampmr *AzureMachinePoolMachineController) reconcileDelete(ctx context.Cont
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "controllers.AzureMachinePoolMachineController.reconcileDelete")
 	defer done()
 
+	if infracontroller.ShouldSkipAzureDeletion(machineScope.AzureMachinePoolMachine, machineScope.AzureMachinePool, infracontroller.InfraClusterObject(clusterScope)) {
+		log.Info("Skipping VMSS VM deletion; Azure resources are left in place")
+
+		controllerutil.RemoveFinalizer(machineScope.AzureMachinePoolMachine, infrav1exp.AzureMachinePoolMachineFinalizer)
+		return reconcile.Result{}, nil
+	}
+
 	if !infracontroller.ShouldDeleteIndividualResources(ctx, clusterScope) {
 		log.Info("Skipping VMSS VM deletion as the whole resource group is being deleted")
 
@@ -376,9 +396,14 @@ func newAzureMachinePoolMachineReconciler(scope *scope.MachinePoolMachineScope)
 	if err != nil {
 		return nil, err
 	}
+	scaleSetsSvc, err := scalesets.New(scope.MachinePoolScope, nil)
+	if err != nil {
+		return nil, err
+	}
 	return &azureMachinePoolMachineReconciler{
 		Scope:              scope,
 		scalesetVMsService: scaleSetVMsSvc,
+		scaleSetsService:   scaleSetsSvc,
 	}, nil
 }
 
@@ -417,8 +442,12 @@ func (r *azureMachinePoolMachineReconciler) Delete(ctx context.Context) error {
 		}
 	}()
 
-	if err := r.scalesetVMsService.Delete(ctx); err != nil {
-		return errors.Wrap(err, "failed to reconcile scalesetVMs")
+	if r.Scope.OrchestrationMode() == infrav1.FlexibleOrchestrationMode {
+		if err := r.scalesetVMsService.Delete(ctx); err != nil {
+			return errors.Wrap(err, "failed to reconcile scalesetVMs")
+		}
+	} else if err := r.deleteScaleSetInstance(ctx); err != nil {
+		return err
 	}
 
 	// no long running operation, so we are finished deleting the resource. Remove the finalizer.
@@ -426,3 +455,16 @@ func (r *azureMachinePoolMachineReconciler) Delete(ctx context.Context) error {
 
 	return nil
 }
+
+// deleteScaleSetInstance deletes the instance of a Uniform scale set through the scale set, looking it up by its provider
+// ID. An instance which no longer exists is already deleted.
+func (r *azureMachinePoolMachineReconciler) deleteScaleSetInstance(ctx context.Context) error {
+	err := r.scaleSetsService.DeleteInstances(ctx, []string{r.Scope.ProviderID()})
+	if err != nil && !errors.Is(err, scalesets.ErrInstancesNotFound) {
+		r.Scope.SetVMSSVMState(infrav1.Deleting)
+		return errors.Wrap(err, "failed to delete scale set instance")
+	}
+
+	r.Scope.SetVMSSVMState(infrav1.Deleted)
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,6 +32,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 	gomock2 "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
 	reconcilerutils "sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
@@ -39,6 +41,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 func TestAzureMachinePoolMachineReconciler_Reconcile(t *testing.T) {
@@ -78,21 +81,7 @@ func TestAzureMachinePoolMachineReconciler_Reconcile(t *testing.T) {
 				g          = NewWithT(t)
 				mockCtrl   = gomock.NewController(t)
 				reconciler = mock_azure.NewMockReconciler(mockCtrl)
-				scheme     = func() *runtime.Scheme {
-					s := runtime.NewScheme()
-					for _, addTo := range []func(s *runtime.Scheme) error{
-						clusterv1.AddToScheme,
-						expv1.AddToScheme,
-						infrav1.AddToScheme,
-						infrav1exp.AddToScheme,
-						corev1.AddToScheme,
-					} {
-						g.Expect(addTo(s)).To(Succeed())
-					}
-
-					return s
-				}()
-				cb = fake.NewClientBuilder().WithScheme(scheme)
+				cb         = fake.NewClientBuilder().WithScheme(newMachinePoolMachineScheme(g))
 			)
 			defer mockCtrl.Finish()
 
@@ -112,7 +101,102 @@ func TestAzureMachinePoolMachineReconciler_Reconcile(t *testing.T) {
 	}
 }
 
-func getReadyMachinePoolMachineClusterObjects(ampmIsDeleting bool) []client.Object {
+func TestAzureMachinePoolMachineReconciler_DeleteUniformInstance(t *testing.T) {
+	providerID := "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/amp1/virtualMachines/0"
+
+	cases := []struct {
+		Name              string
+		DeleteErr         error
+		ExpectErr         string
+		ExpectFinalizer   bool
+		ExpectProviderIDs []string
+	}{
+		{
+			Name:              "should delete the instance by provider ID",
+			ExpectProviderIDs: []string{providerID},
+		},
+		{
+			Name:              "should finish deleting if the instance no longer exists",
+			DeleteErr:         errors.Wrap(scalesets.ErrInstancesNotFound, "failed to delete instances of scale set my-rg/amp1"),
+			ExpectProviderIDs: []string{providerID},
+		},
+		{
+			Name:              "should keep the finalizer if the instance fails to delete",
+			DeleteErr:         errors.New("internal server error"),
+			ExpectErr:         "failed to delete scale set instance",
+			ExpectFinalizer:   true,
+			ExpectProviderIDs: []string{providerID},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			objects := getReadyMachinePoolMachineClusterObjects(true, func(ampm *infrav1exp.AzureMachinePoolMachine) {
+				ampm.Spec.ProviderID = providerID
+				ampm.Finalizers = append(ampm.Finalizers, infrav1exp.AzureMachinePoolMachineFinalizer)
+			})
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(newMachinePoolMachineScheme(g)).
+				WithStatusSubresource(&infrav1exp.AzureMachinePoolMachine{}).
+				WithObjects(objects...).
+				Build()
+			deleter := &fakeScaleSetInstancesDeleter{err: c.DeleteErr}
+
+			controller := NewAzureMachinePoolMachineController(fakeClient, nil, reconcilerutils.Timeouts{}, "foo")
+			controller.reconcilerFactory = func(s *scope.MachinePoolMachineScope) (azure.Reconciler, error) {
+				return &azureMachinePoolMachineReconciler{
+					Scope:            s,
+					scaleSetsService: deleter,
+				}, nil
+			}
+			_, err := controller.Reconcile(context.TODO(), ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      "ampm1",
+					Namespace: "default",
+				},
+			})
+			if c.ExpectErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(c.ExpectErr)))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(deleter.providerIDs).To(Equal(c.ExpectProviderIDs))
+
+			ampm := &infrav1exp.AzureMachinePoolMachine{}
+			g.Expect(fakeClient.Get(context.TODO(), types.NamespacedName{Name: "ampm1", Namespace: "default"}, ampm)).To(Succeed())
+			g.Expect(controllerutil.ContainsFinalizer(ampm, infrav1exp.AzureMachinePoolMachineFinalizer)).To(Equal(c.ExpectFinalizer))
+		})
+	}
+}
+
+type fakeScaleSetInstancesDeleter struct {
+	providerIDs []string
+	err         error
+}
+
+func (f *fakeScaleSetInstancesDeleter) DeleteInstances(_ context.Context, providerIDs []string) error {
+	f.providerIDs = append(f.providerIDs, providerIDs...)
+	return f.err
+}
+
+func newMachinePoolMachineScheme(g *WithT) *runtime.Scheme {
+	s := runtime.NewScheme()
+	for _, addTo := range []func(s *runtime.Scheme) error{
+		clusterv1.AddToScheme,
+		expv1.AddToScheme,
+		infrav1.AddToScheme,
+		infrav1exp.AddToScheme,
+		corev1.AddToScheme,
+	} {
+		g.Expect(addTo(s)).To(Succeed())
+	}
+
+	return s
+}
+
+func getReadyMachinePoolMachineClusterObjects(ampmIsDeleting bool, ampmMutators ...func(*infrav1exp.AzureMachinePoolMachine)) []client.Object {
 	azCluster := &infrav1.AzureCluster{
 		TypeMeta: metav1.TypeMeta{
 			Kind: "AzureCluster",
@@ -254,5 +338,9 @@ func getReadyMachinePoolMachineClusterObjects(ampmIsDeleting bool) []client.Obje
 		}
 	}
 
+	for _, mutate := range ampmMutators {
+		mutate(ampm)
+	}
+
 	return []client.Object{cluster, azCluster, mp, amp, ma, ampm, fakeIdentity, fakeSecret}
 }
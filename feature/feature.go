@@ -47,6 +47,12 @@ const (
 	// owner: @upxinxin
 	// alpha: v1.8
 	EdgeZone featuregate.Feature = "EdgeZone"
+
+	// OrphanedResourceDeletion is the feature gate for deleting Azure resources which are tagged as owned by an
+	// AzureCluster but are no longer part of its spec when the AzureCluster is deleted.
+	// owner: @alexander-demicev
+	// alpha: v1.14
+	OrphanedResourceDeletion featuregate.Feature = "OrphanedResourceDeletion"
 )
 
 func init() {
@@ -57,7 +63,8 @@ func init() {
 // To add a new feature, define a key for it above and add it here.
 var defaultCAPZFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	// Every feature should be initiated here:
-	AKS:               {Default: true, PreRelease: featuregate.GA, LockToDefault: true}, // Remove in 1.12
-	AKSResourceHealth: {Default: false, PreRelease: featuregate.Alpha},
-	EdgeZone:          {Default: false, PreRelease: featuregate.Alpha},
+	AKS:                      {Default: true, PreRelease: featuregate.GA, LockToDefault: true}, // Remove in 1.12
+	AKSResourceHealth:        {Default: false, PreRelease: featuregate.Alpha},
+	EdgeZone:                 {Default: false, PreRelease: featuregate.Alpha},
+	OrphanedResourceDeletion: {Default: false, PreRelease: featuregate.Alpha},
 }
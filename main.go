@@ -26,9 +26,11 @@ import (
 	"time"
 
 	// +kubebuilder:scaffold:imports
+	asocomputev1 "github.com/Azure/azure-service-operator/v2/api/compute/v1api20220702"
 	asocontainerservicev1preview "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20230315preview"
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	asokubernetesconfigurationv1 "github.com/Azure/azure-service-operator/v2/api/kubernetesconfiguration/v1api20230501"
+	asomanagedidentityv1 "github.com/Azure/azure-service-operator/v2/api/managedidentity/v1api20230131"
 	asonetworkv1api20201101 "github.com/Azure/azure-service-operator/v2/api/network/v1api20201101"
 	asonetworkv1api20220701 "github.com/Azure/azure-service-operator/v2/api/network/v1api20220701"
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
@@ -42,6 +44,8 @@ import (
 	"k8s.io/component-base/logs"
 	"k8s.io/klog/v2"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/controllers"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 	infrav1controllersexp "sigs.k8s.io/cluster-api-provider-azure/exp/controllers"
@@ -83,6 +87,8 @@ func init() {
 	_ = asonetworkv1api20201101.AddToScheme(scheme)
 	_ = asocontainerservicev1preview.AddToScheme(scheme)
 	_ = asokubernetesconfigurationv1.AddToScheme(scheme)
+	_ = asomanagedidentityv1.AddToScheme(scheme)
+	_ = asocomputev1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -106,6 +112,11 @@ var (
 	webhookCertDir                     string
 	diagnosticsOptions                 = DiagnosticsOptions{}
 	timeouts                           reconciler.Timeouts
+	requeueBackoff                     reconciler.BackoffConfig
+	requeueBackoffOverrides            map[string]string
+	serviceReconcileTimeoutOverrides   map[string]string
+	errorCodeOverrides                 map[string]string
+	controlPlaneVMSizeValidation       controllers.ControlPlaneVMSizeValidation
 	enableTracing                      bool
 )
 
@@ -228,6 +239,12 @@ func InitFlags(fs *pflag.FlagSet) {
 		"The maximum duration each Azure service reconcile can run (e.g. 90m)",
 	)
 
+	fs.StringToStringVar(&serviceReconcileTimeoutOverrides,
+		"service-reconcile-timeout-overrides",
+		nil,
+		"Per-service overrides of the maximum duration each Azure service reconcile can run, at most the reconcile timeout (e.g. scalesets=30m,managedcluster=45m)",
+	)
+
 	fs.DurationVar(&timeouts.AzureCall,
 		"api-call-timeout",
 		reconciler.DefaultAzureCallTimeout,
@@ -240,6 +257,54 @@ func InitFlags(fs *pflag.FlagSet) {
 		"The duration to wait before retrying after a transient reconcile error occurs (e.g. 15s)",
 	)
 
+	fs.Float64Var(&requeueBackoff.Factor,
+		"reconciler-requeue-backoff-factor",
+		1,
+		"The multiplier applied to the reconciler requeue for each consecutive transient error of an Azure resource (e.g. 2). Values less than or equal to 1 keep the reconciler requeue fixed",
+	)
+
+	fs.Float64Var(&requeueBackoff.Jitter,
+		"reconciler-requeue-backoff-jitter",
+		0,
+		"The maximum fraction of the reconciler requeue added at random to spread out retries (e.g. 0.1)",
+	)
+
+	fs.DurationVar(&requeueBackoff.Max,
+		"reconciler-requeue-backoff-max",
+		reconciler.DefaultRequeueBackoffMax,
+		"The maximum duration to wait before retrying after consecutive transient reconcile errors (e.g. 5m)",
+	)
+
+	fs.StringToStringVar(&requeueBackoffOverrides,
+		"reconciler-requeue-backoff-overrides",
+		nil,
+		"Per-service overrides of the reconciler requeue backoff in the form factor:jitter:max (e.g. virtualmachine=2:0.1:10m,scalesets=3:0.2:15m)",
+	)
+
+	fs.StringToStringVar(&errorCodeOverrides,
+		"azure-error-code-overrides",
+		nil,
+		"Overrides of whether Azure errors are transient or terminal, keyed by patterns of Azure error codes (e.g. InternalServerError=Transient,Quota*=Terminal). When several patterns match an error code, the longest one is used",
+	)
+
+	fs.Int64Var(&controlPlaneVMSizeValidation.MinVCPUs,
+		"control-plane-min-vcpus",
+		resourceskus.MinimumVCPUS,
+		"The minimum number of vCPUs of the VM size of control plane AzureMachines, checked before their VMs are created",
+	)
+
+	fs.Float64Var(&controlPlaneVMSizeValidation.MinMemoryGB,
+		"control-plane-min-memory-gb",
+		resourceskus.MinimumMemory,
+		"The minimum amount of memory in GB of the VM size of control plane AzureMachines, checked before their VMs are created",
+	)
+
+	fs.BoolVar(&controlPlaneVMSizeValidation.WarnOnly,
+		"control-plane-vm-size-warn-only",
+		false,
+		"Emit a warning event instead of rejecting control plane AzureMachines whose VM size is below the minimum vCPUs or memory",
+	)
+
 	fs.BoolVar(
 		&enableTracing,
 		"enable-tracing",
@@ -265,6 +330,37 @@ func main() {
 	// klog.Background will automatically use the right logger.
 	ctrl.SetLogger(klog.Background())
 
+	if err := requeueBackoff.Validate(); err != nil {
+		setupLog.Error(err, "invalid reconciler requeue backoff")
+		os.Exit(1)
+	}
+	backoffOverrides, err := reconciler.ParseRequeueBackoffOverrides(requeueBackoffOverrides)
+	if err != nil {
+		setupLog.Error(err, "invalid reconciler requeue backoff overrides")
+		os.Exit(1)
+	}
+	timeouts.RequeueBackoff = &reconciler.RequeueBackoff{
+		Default:          requeueBackoff,
+		ServiceOverrides: backoffOverrides,
+	}
+
+	timeouts.AzureServiceReconcileOverrides, err = reconciler.ParseAzureServiceReconcileOverrides(serviceReconcileTimeoutOverrides, timeouts.DefaultedLoopTimeout())
+	if err != nil {
+		setupLog.Error(err, "invalid service reconcile timeout overrides")
+		os.Exit(1)
+	}
+	if timeouts.DefaultedAzureServiceReconcileTimeout() > timeouts.DefaultedLoopTimeout() {
+		setupLog.Info("the service reconcile timeout exceeds the reconcile loop timeout, service reconciles will be cut short by the reconcile loop timeout",
+			"serviceReconcileTimeout", timeouts.DefaultedAzureServiceReconcileTimeout(), "loopTimeout", timeouts.DefaultedLoopTimeout())
+	}
+
+	overrides, err := azure.ParseErrorCodeOverrides(errorCodeOverrides)
+	if err != nil {
+		setupLog.Error(err, "invalid Azure error code overrides")
+		os.Exit(1)
+	}
+	azure.SetErrorCodeOverrides(overrides)
+
 	// Machine and cluster operations can create enough events to trigger the event recorder spam filter
 	// Setting the burst size higher ensures all events will be recorded and submitted to the API
 	broadcaster := cgrecord.NewBroadcasterWithCorrelatorOptions(cgrecord.CorrelatorOptions{
@@ -354,11 +450,13 @@ func registerControllers(ctx context.Context, mgr manager.Manager) {
 	if err != nil {
 		setupLog.Error(err, "failed to build machineCache ReconcileCache")
 	}
-	if err := controllers.NewAzureMachineReconciler(mgr.GetClient(),
+	azureMachineReconciler := controllers.NewAzureMachineReconciler(mgr.GetClient(),
 		mgr.GetEventRecorderFor("azuremachine-reconciler"),
 		timeouts,
 		watchFilterValue,
-	).SetupWithManager(ctx, mgr, controllers.Options{Options: controller.Options{MaxConcurrentReconciles: azureMachineConcurrency}, Cache: machineCache}); err != nil {
+	)
+	azureMachineReconciler.ControlPlaneVMSizeValidation = controlPlaneVMSizeValidation
+	if err := azureMachineReconciler.SetupWithManager(ctx, mgr, controllers.Options{Options: controller.Options{MaxConcurrentReconciles: azureMachineConcurrency}, Cache: machineCache}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AzureMachine")
 		os.Exit(1)
 	}
@@ -126,7 +126,7 @@ func AzurePrivateClusterSpec(ctx context.Context, inputGetter func() AzurePrivat
 	Expect(os.Setenv(AzureInternalLBIP, "10.255.0.100")).To(Succeed())
 	Expect(os.Setenv(AzureCPSubnetCidr, "10.255.0.0/24")).To(Succeed())
 	Expect(os.Setenv(AzureNodeSubnetCidr, "10.255.1.0/24")).To(Succeed())
-	Expect(os.Setenv(AzureBastionSubnetCidr, "10.255.255.224/27")).To(Succeed())
+	Expect(os.Setenv(AzureBastionSubnetCidr, "10.255.255.192/26")).To(Succeed())
 	result := &clusterctl.ApplyClusterTemplateAndWaitResult{}
 
 	clusterctl.ApplyClusterTemplateAndWait(ctx, createApplyClusterTemplateInput(
@@ -30,3 +30,23 @@ func Merge[K comparable, V any](base map[K]V, overrides map[K]V) map[K]V {
 
 	return m
 }
+
+// MergeWithResolver merges the two maps and returns the result. For overlapping keys,
+// resolve is called with the key, the value from base and the value from overrides,
+// and the value it returns is used.
+func MergeWithResolver[K comparable, V any](base map[K]V, overrides map[K]V, resolve func(key K, baseVal, overrideVal V) V) map[K]V {
+	m := make(map[K]V, len(base)+len(overrides))
+
+	for k, v := range base {
+		m[k] = v
+	}
+	for k, v := range overrides {
+		if baseVal, ok := m[k]; ok {
+			m[k] = resolve(k, baseVal, v)
+			continue
+		}
+		m[k] = v
+	}
+
+	return m
+}
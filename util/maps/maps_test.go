@@ -62,3 +62,79 @@ func TestMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeWithResolver(t *testing.T) {
+	preferNonEmpty := func(_ string, baseVal, overrideVal string) string {
+		if overrideVal == "" {
+			return baseVal
+		}
+		return overrideVal
+	}
+	concatenate := func(_ string, baseVal, overrideVal string) string {
+		return baseVal + "," + overrideVal
+	}
+
+	tests := []struct {
+		name      string
+		base      map[string]string
+		overrides map[string]string
+		resolve   func(key, baseVal, overrideVal string) string
+		expected  map[string]string
+	}{
+		{
+			name:      "nil base",
+			base:      nil,
+			overrides: map[string]string{"key": "value"},
+			resolve:   concatenate,
+			expected:  map[string]string{"key": "value"},
+		},
+		{
+			name:      "nil overrides",
+			base:      map[string]string{"key": "value"},
+			overrides: nil,
+			resolve:   concatenate,
+			expected:  map[string]string{"key": "value"},
+		},
+		{
+			name:      "resolver prefers non-empty override",
+			base:      map[string]string{"key": "base"},
+			overrides: map[string]string{"key": "overrides"},
+			resolve:   preferNonEmpty,
+			expected:  map[string]string{"key": "overrides"},
+		},
+		{
+			name:      "resolver prefers non-empty base",
+			base:      map[string]string{"key": "base", "other": ""},
+			overrides: map[string]string{"key": "", "other": ""},
+			resolve:   preferNonEmpty,
+			expected:  map[string]string{"key": "base", "other": ""},
+		},
+		{
+			name:      "resolver concatenates",
+			base:      map[string]string{"key": "base", "base": "value"},
+			overrides: map[string]string{"key": "overrides", "overrides": "value"},
+			resolve:   concatenate,
+			expected:  map[string]string{"key": "base,overrides", "base": "value", "overrides": "value"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			g.Expect(MergeWithResolver(test.base, test.overrides, test.resolve)).To(gomega.Equal(test.expected))
+		})
+	}
+}
+
+func TestMergeWithResolverOnlyResolvesConflicts(t *testing.T) {
+	g := gomega.NewWithT(t)
+	var conflicts []string
+	resolve := func(key string, _, overrideVal int) int {
+		conflicts = append(conflicts, key)
+		return overrideVal
+	}
+
+	merged := MergeWithResolver(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3, "c": 4}, resolve)
+	g.Expect(merged).To(gomega.Equal(map[string]int{"a": 1, "b": 3, "c": 4}))
+	g.Expect(conflicts).To(gomega.ConsistOf("b"))
+}
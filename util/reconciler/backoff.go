@@ -0,0 +1,168 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultRequeueBackoffMax is the default upper bound of a requeue interval computed with backoff.
+const DefaultRequeueBackoffMax = 5 * time.Minute
+
+// BackoffConfig configures how the requeue interval grows for a resource which keeps returning transient errors.
+type BackoffConfig struct {
+	// Factor is the multiplier applied to the requeue interval for each consecutive transient error.
+	// Values less than or equal to 1 keep the requeue interval fixed.
+	Factor float64
+	// Jitter is the maximum fraction of the requeue interval which is added at random, e.g. 0.1 for up to 10%.
+	Jitter float64
+	// Max caps the requeue interval before jitter is added. Defaults to DefaultRequeueBackoffMax.
+	Max time.Duration
+}
+
+// ParseBackoffConfig parses a BackoffConfig in the form "factor:jitter:max", e.g. "2:0.1:5m".
+func ParseBackoffConfig(s string) (BackoffConfig, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return BackoffConfig{}, errors.Errorf("invalid backoff %q, expected the form factor:jitter:max", s)
+	}
+	factor, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return BackoffConfig{}, errors.Wrapf(err, "invalid backoff factor %q", parts[0])
+	}
+	jitter, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return BackoffConfig{}, errors.Wrapf(err, "invalid backoff jitter %q", parts[1])
+	}
+	maxInterval, err := time.ParseDuration(parts[2])
+	if err != nil {
+		return BackoffConfig{}, errors.Wrapf(err, "invalid backoff max %q", parts[2])
+	}
+	config := BackoffConfig{Factor: factor, Jitter: jitter, Max: maxInterval}
+	if err := config.Validate(); err != nil {
+		return BackoffConfig{}, err
+	}
+	return config, nil
+}
+
+// ParseRequeueBackoffOverrides parses per-service overrides of the requeue backoff, keyed by service name,
+// with values in the form accepted by ParseBackoffConfig.
+func ParseRequeueBackoffOverrides(overrides map[string]string) (map[string]BackoffConfig, error) {
+	parsed := make(map[string]BackoffConfig, len(overrides))
+	for serviceName, override := range overrides {
+		if err := validateServiceName(serviceName); err != nil {
+			return nil, errors.Wrap(err, "invalid backoff override")
+		}
+		config, err := ParseBackoffConfig(override)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid backoff override of service %q", serviceName)
+		}
+		parsed[serviceName] = config
+	}
+	return parsed, nil
+}
+
+// Validate returns an error if the factor, jitter or max of the BackoffConfig is negative.
+func (c BackoffConfig) Validate() error {
+	if c.Factor < 0 {
+		return errors.Errorf("invalid backoff factor %v, must not be negative", c.Factor)
+	}
+	if c.Jitter < 0 {
+		return errors.Errorf("invalid backoff jitter %v, must not be negative", c.Jitter)
+	}
+	if c.Max < 0 {
+		return errors.Errorf("invalid backoff max %s, must not be negative", c.Max)
+	}
+	return nil
+}
+
+// interval returns the requeue interval after the given number of previous consecutive transient errors.
+func (c BackoffConfig) interval(base time.Duration, failures int) time.Duration {
+	maxInterval := c.Max
+	if maxInterval <= 0 {
+		maxInterval = DefaultRequeueBackoffMax
+	}
+	if maxInterval < base {
+		maxInterval = base
+	}
+
+	d := base
+	if c.Factor > 1 {
+		if f := float64(base) * math.Pow(c.Factor, float64(failures)); f < float64(maxInterval) {
+			d = time.Duration(f)
+		} else {
+			d = maxInterval
+		}
+	}
+	if c.Jitter > 0 {
+		d += time.Duration(rand.Float64() * c.Jitter * float64(d)) //nolint:gosec // Jitter doesn't need a secure random number.
+	}
+	return d
+}
+
+// RequeueBackoff computes exponentially increasing, jittered requeue intervals for resources which keep
+// returning transient errors, so that retries against a throttled API are spread out instead of happening
+// in lockstep. The number of consecutive transient errors of each resource is tracked in memory. It is safe
+// for concurrent use.
+type RequeueBackoff struct {
+	// Default configures the backoff of services without an entry in ServiceOverrides.
+	Default BackoffConfig
+	// ServiceOverrides configures the backoff of individual services, keyed by service name.
+	ServiceOverrides map[string]BackoffConfig
+
+	mu       sync.Mutex
+	failures map[string]int
+}
+
+// Next returns how long to wait before retrying the resource identified by key and reconciled by the named
+// service, and records another consecutive transient error for it.
+func (b *RequeueBackoff) Next(serviceName, key string, base time.Duration) time.Duration {
+	config, ok := b.ServiceOverrides[serviceName]
+	if !ok {
+		config = b.Default
+	}
+
+	b.mu.Lock()
+	if b.failures == nil {
+		b.failures = make(map[string]int)
+	}
+	id := backoffID(serviceName, key)
+	failures := b.failures[id]
+	b.failures[id] = failures + 1
+	b.mu.Unlock()
+
+	return config.interval(base, failures)
+}
+
+// Reset forgets the consecutive transient errors of the resource identified by key and reconciled by the named service.
+// It must be called once the resource no longer fails transiently so that its entry is removed.
+func (b *RequeueBackoff) Reset(serviceName, key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.failures, backoffID(serviceName, key))
+}
+
+func backoffID(serviceName, key string) string {
+	return serviceName + "/" + key
+}
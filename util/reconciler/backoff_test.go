@@ -0,0 +1,239 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+func TestRequeueBackoffProgression(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Config   reconciler.BackoffConfig
+		Expected []time.Duration
+	}{
+		{
+			Name:     "WithZeroValueKeepsRequeueFixed",
+			Config:   reconciler.BackoffConfig{},
+			Expected: []time.Duration{10 * time.Second, 10 * time.Second, 10 * time.Second},
+		},
+		{
+			Name:     "WithFactor",
+			Config:   reconciler.BackoffConfig{Factor: 2, Max: time.Hour},
+			Expected: []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second, 80 * time.Second},
+		},
+		{
+			Name:     "WithMax",
+			Config:   reconciler.BackoffConfig{Factor: 3, Max: time.Minute},
+			Expected: []time.Duration{10 * time.Second, 30 * time.Second, time.Minute, time.Minute},
+		},
+		{
+			Name:     "WithDefaultMax",
+			Config:   reconciler.BackoffConfig{Factor: 10},
+			Expected: []time.Duration{10 * time.Second, 100 * time.Second, reconciler.DefaultRequeueBackoffMax, reconciler.DefaultRequeueBackoffMax},
+		},
+		{
+			Name:     "WithMaxBelowBase",
+			Config:   reconciler.BackoffConfig{Factor: 2, Max: time.Second},
+			Expected: []time.Duration{10 * time.Second, 10 * time.Second},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			backoff := &reconciler.RequeueBackoff{Default: c.Config}
+			for _, expected := range c.Expected {
+				g.Expect(backoff.Next("service", "key", 10*time.Second)).To(gomega.Equal(expected))
+			}
+		})
+	}
+}
+
+func TestRequeueBackoffJitter(t *testing.T) {
+	g := gomega.NewWithT(t)
+	backoff := &reconciler.RequeueBackoff{Default: reconciler.BackoffConfig{Factor: 2, Jitter: 0.5, Max: time.Hour}}
+	for _, base := range []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second} {
+		g.Expect(backoff.Next("service", "key", 10*time.Second)).To(gomega.And(
+			gomega.BeNumerically(">=", base),
+			gomega.BeNumerically("<=", base+base/2),
+		))
+	}
+}
+
+func TestRequeueBackoffReset(t *testing.T) {
+	g := gomega.NewWithT(t)
+	backoff := &reconciler.RequeueBackoff{Default: reconciler.BackoffConfig{Factor: 2, Max: time.Hour}}
+
+	g.Expect(backoff.Next("service", "key", time.Second)).To(gomega.Equal(time.Second))
+	g.Expect(backoff.Next("service", "key", time.Second)).To(gomega.Equal(2 * time.Second))
+	g.Expect(backoff.Next("service", "other", time.Second)).To(gomega.Equal(time.Second))
+	g.Expect(backoff.Next("other", "key", time.Second)).To(gomega.Equal(time.Second))
+
+	backoff.Reset("service", "key")
+	g.Expect(backoff.Next("service", "key", time.Second)).To(gomega.Equal(time.Second))
+	g.Expect(backoff.Next("service", "other", time.Second)).To(gomega.Equal(2 * time.Second))
+}
+
+func TestRequeueBackoffServiceOverrides(t *testing.T) {
+	g := gomega.NewWithT(t)
+	backoff := &reconciler.RequeueBackoff{
+		Default: reconciler.BackoffConfig{Factor: 2, Max: time.Hour},
+		ServiceOverrides: map[string]reconciler.BackoffConfig{
+			"override": {Factor: 4, Max: time.Hour},
+		},
+	}
+
+	g.Expect(backoff.Next("service", "key", time.Second)).To(gomega.Equal(time.Second))
+	g.Expect(backoff.Next("service", "key", time.Second)).To(gomega.Equal(2 * time.Second))
+	g.Expect(backoff.Next("override", "key", time.Second)).To(gomega.Equal(time.Second))
+	g.Expect(backoff.Next("override", "key", time.Second)).To(gomega.Equal(4 * time.Second))
+}
+
+func TestBackoffReconcilerRequeue(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	timeouts := reconciler.Timeouts{Requeue: time.Second}
+	g.Expect(timeouts.BackoffReconcilerRequeue("service", "key")).To(gomega.Equal(time.Second))
+	g.Expect(timeouts.BackoffReconcilerRequeue("service", "key")).To(gomega.Equal(time.Second))
+	timeouts.ResetReconcilerRequeueBackoff("service", "key")
+
+	timeouts.RequeueBackoff = &reconciler.RequeueBackoff{Default: reconciler.BackoffConfig{Factor: 2, Max: time.Hour}}
+	g.Expect(timeouts.BackoffReconcilerRequeue("service", "key")).To(gomega.Equal(time.Second))
+	g.Expect(timeouts.BackoffReconcilerRequeue("service", "key")).To(gomega.Equal(2 * time.Second))
+	timeouts.ResetReconcilerRequeueBackoff("service", "key")
+	g.Expect(timeouts.BackoffReconcilerRequeue("service", "key")).To(gomega.Equal(time.Second))
+}
+
+func TestParseBackoffConfig(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Subject       string
+		Expected      reconciler.BackoffConfig
+		ExpectedError bool
+	}{
+		{
+			Name:     "Valid",
+			Subject:  "2:0.1:5m",
+			Expected: reconciler.BackoffConfig{Factor: 2, Jitter: 0.1, Max: 5 * time.Minute},
+		},
+		{
+			Name:          "MissingParts",
+			Subject:       "2:0.1",
+			ExpectedError: true,
+		},
+		{
+			Name:          "InvalidFactor",
+			Subject:       "two:0.1:5m",
+			ExpectedError: true,
+		},
+		{
+			Name:          "InvalidJitter",
+			Subject:       "2:some:5m",
+			ExpectedError: true,
+		},
+		{
+			Name:          "InvalidMax",
+			Subject:       "2:0.1:5",
+			ExpectedError: true,
+		},
+		{
+			Name:          "NegativeFactor",
+			Subject:       "-2:0.1:5m",
+			ExpectedError: true,
+		},
+		{
+			Name:          "NegativeJitter",
+			Subject:       "2:-0.1:5m",
+			ExpectedError: true,
+		},
+		{
+			Name:          "NegativeMax",
+			Subject:       "2:0.1:-5m",
+			ExpectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			config, err := reconciler.ParseBackoffConfig(c.Subject)
+			if c.ExpectedError {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(config).To(gomega.Equal(c.Expected))
+		})
+	}
+}
+
+func TestParseRequeueBackoffOverrides(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Subject       map[string]string
+		Expected      map[string]reconciler.BackoffConfig
+		ExpectedError bool
+	}{
+		{
+			Name:     "Empty",
+			Subject:  nil,
+			Expected: map[string]reconciler.BackoffConfig{},
+		},
+		{
+			Name:    "Valid",
+			Subject: map[string]string{"virtualmachine": "2:0.1:10m", "scalesets": "3:0.2:15m"},
+			Expected: map[string]reconciler.BackoffConfig{
+				"virtualmachine": {Factor: 2, Jitter: 0.1, Max: 10 * time.Minute},
+				"scalesets":      {Factor: 3, Jitter: 0.2, Max: 15 * time.Minute},
+			},
+		},
+		{
+			Name:          "UnknownService",
+			Subject:       map[string]string{"virtualmachines": "2:0.1:10m"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "InvalidConfig",
+			Subject:       map[string]string{"virtualmachine": "2:0.1:-10m"},
+			ExpectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			overrides, err := reconciler.ParseRequeueBackoffOverrides(c.Subject)
+			if c.ExpectedError {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(overrides).To(gomega.Equal(c.Expected))
+		})
+	}
+}
@@ -18,6 +18,8 @@ package reconciler
 
 import (
 	"time"
+
+	"github.com/pkg/errors"
 )
 
 const (
@@ -41,10 +43,16 @@ type Timeouts struct {
 	Loop time.Duration
 	// AzureServiceReconcile is the timeout for an Azure service reconcile.
 	AzureServiceReconcile time.Duration
+	// AzureServiceReconcileOverrides are the timeouts of individual Azure service reconciles, keyed by service
+	// name, which take precedence over AzureServiceReconcile.
+	AzureServiceReconcileOverrides map[string]time.Duration
 	// AzureCall is the timeout for an Azure request after which an Azure operation is considered long-running.
 	AzureCall time.Duration
 	// Requeue is the value for the reconcile retry.
 	Requeue time.Duration
+	// RequeueBackoff, when set, increases the reconcile retry interval for resources that keep returning
+	// transient errors.
+	RequeueBackoff *RequeueBackoff
 }
 
 // DefaultedAzureCallTimeout will default the timeout if it is zero-valued.
@@ -65,6 +73,37 @@ func (t Timeouts) DefaultedAzureServiceReconcileTimeout() time.Duration {
 	return t.AzureServiceReconcile
 }
 
+// AzureServiceReconcileTimeout returns the timeout for a reconcile of the named Azure service, falling back
+// to the defaulted Azure service reconcile timeout if the service has no override.
+func (t Timeouts) AzureServiceReconcileTimeout(serviceName string) time.Duration {
+	if timeout := t.AzureServiceReconcileOverrides[serviceName]; timeout > 0 {
+		return timeout
+	}
+
+	return t.DefaultedAzureServiceReconcileTimeout()
+}
+
+// ParseAzureServiceReconcileOverrides parses per-service overrides of the Azure service reconcile timeout, keyed
+// by service name. Each override must be positive and must not exceed loop, the reconcile loop timeout, which
+// bounds the reconcile of every service.
+func ParseAzureServiceReconcileOverrides(overrides map[string]string, loop time.Duration) (map[string]time.Duration, error) {
+	parsed := make(map[string]time.Duration, len(overrides))
+	for serviceName, override := range overrides {
+		if err := validateServiceName(serviceName); err != nil {
+			return nil, errors.Wrap(err, "invalid service reconcile timeout override")
+		}
+		timeout, err := time.ParseDuration(override)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid service reconcile timeout override of service %q", serviceName)
+		}
+		if timeout <= 0 || timeout > loop {
+			return nil, errors.Errorf("invalid service reconcile timeout override %s of service %q, must be positive and at most the reconcile loop timeout %s", timeout, serviceName, loop)
+		}
+		parsed[serviceName] = timeout
+	}
+	return parsed, nil
+}
+
 // DefaultedReconcilerRequeue will default the timeout if it is zero-valued.
 func (t Timeouts) DefaultedReconcilerRequeue() time.Duration {
 	if t.Requeue <= 0 {
@@ -74,6 +113,24 @@ func (t Timeouts) DefaultedReconcilerRequeue() time.Duration {
 	return t.Requeue
 }
 
+// BackoffReconcilerRequeue returns the reconcile retry interval after a transient error for the resource
+// identified by key and reconciled by the named service. Without a RequeueBackoff, it is the defaulted
+// reconciler requeue.
+func (t Timeouts) BackoffReconcilerRequeue(serviceName, key string) time.Duration {
+	if t.RequeueBackoff == nil {
+		return t.DefaultedReconcilerRequeue()
+	}
+
+	return t.RequeueBackoff.Next(serviceName, key, t.DefaultedReconcilerRequeue())
+}
+
+// ResetReconcilerRequeueBackoff resets the reconcile retry interval of a resource after it was reconciled successfully.
+func (t Timeouts) ResetReconcilerRequeueBackoff(serviceName, key string) {
+	if t.RequeueBackoff != nil {
+		t.RequeueBackoff.Reset(serviceName, key)
+	}
+}
+
 // DefaultedLoopTimeout will default the timeout if it is zero-valued.
 func (t Timeouts) DefaultedLoopTimeout() time.Duration {
 	if t.Loop <= 0 {
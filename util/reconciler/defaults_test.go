@@ -167,3 +167,108 @@ func TestDefaultedAzureServiceReconcileTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureServiceReconcileTimeout(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Default   time.Duration
+		Overrides map[string]time.Duration
+		Expected  time.Duration
+	}{
+		{
+			Name:     "WithoutOverridesDefaults",
+			Expected: reconciler.DefaultAzureServiceReconcileTimeout,
+		},
+		{
+			Name:     "WithoutOverridesUsesServiceReconcileTimeout",
+			Default:  time.Minute,
+			Expected: time.Minute,
+		},
+		{
+			Name:      "WithOverride",
+			Default:   time.Minute,
+			Overrides: map[string]time.Duration{"service": time.Hour},
+			Expected:  time.Hour,
+		},
+		{
+			Name:      "WithOverrideOfOtherService",
+			Default:   time.Minute,
+			Overrides: map[string]time.Duration{"other": time.Hour},
+			Expected:  time.Minute,
+		},
+		{
+			Name:      "WithNegativeOverride",
+			Default:   time.Minute,
+			Overrides: map[string]time.Duration{"service": time.Duration(-2)},
+			Expected:  time.Minute,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			timeouts := reconciler.Timeouts{
+				AzureServiceReconcile:          c.Default,
+				AzureServiceReconcileOverrides: c.Overrides,
+			}
+			g.Expect(timeouts.AzureServiceReconcileTimeout("service")).To(gomega.Equal(c.Expected))
+		})
+	}
+}
+
+func TestParseAzureServiceReconcileOverrides(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Subject       map[string]string
+		Expected      map[string]time.Duration
+		ExpectedError bool
+	}{
+		{
+			Name:     "Empty",
+			Subject:  nil,
+			Expected: map[string]time.Duration{},
+		},
+		{
+			Name:     "Valid",
+			Subject:  map[string]string{"scalesets": "30m", "managedcluster": "45m"},
+			Expected: map[string]time.Duration{"scalesets": 30 * time.Minute, "managedcluster": 45 * time.Minute},
+		},
+		{
+			Name:          "UnknownService",
+			Subject:       map[string]string{"scaleset": "30m"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "InvalidDuration",
+			Subject:       map[string]string{"scalesets": "30"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "NotPositive",
+			Subject:       map[string]string{"scalesets": "0s"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "ExceedsLoopTimeout",
+			Subject:       map[string]string{"scalesets": "2h"},
+			ExpectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			overrides, err := reconciler.ParseAzureServiceReconcileOverrides(c.Subject, time.Hour)
+			if c.ExpectedError {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(overrides).To(gomega.Equal(c.Expected))
+		})
+	}
+}
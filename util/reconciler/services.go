@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ServiceNames are the names of the Azure services reconciled by CAPZ. Per-service overrides of the
+// reconciler configuration must be keyed by one of them.
+var ServiceNames = sets.New(
+	"acrattachments",
+	"agentpools",
+	"availabilitysets",
+	"azurefirewalls",
+	"bastionhosts",
+	"datacollectionrules",
+	"diagnosticsettings",
+	"diskencryptionsets",
+	"disks",
+	"extension",
+	"fleetsmember",
+	"group",
+	"inboundnatrules",
+	"interfaces",
+	"loadbalancers",
+	"managedcluster",
+	"monitorworkspaces",
+	"natgateways",
+	"netappvolumes",
+	"orphanedresources",
+	"policyassignments",
+	"privatedns",
+	"privateendpoints",
+	"publicips",
+	"resourcehealth",
+	"roleassignments",
+	"routetables",
+	"scalesets",
+	"scalesetvms",
+	"securitygroups",
+	"subnets",
+	"tags",
+	"userassignedidentities",
+	"virtualmachine",
+	"virtualnetworks",
+	"vmextensions",
+	"vnetpeerings",
+)
+
+// validateServiceName returns an error if name isn't the name of an Azure service reconciled by CAPZ.
+func validateServiceName(name string) error {
+	if !ServiceNames.Has(name) {
+		return errors.Errorf("unknown service %q, must be one of %v", name, sets.List(ServiceNames))
+	}
+	return nil
+}
@@ -18,12 +18,37 @@ package tele
 
 import (
 	"context"
+	"sync"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
 
+var (
+	tracerProviderMu sync.RWMutex
+	tracerProvider   trace.TracerProvider
+)
+
+// SetTracerProvider sets the TracerProvider used by Tracer to create spans.
+// By default, Tracer uses the globally-registered TracerProvider, which is
+// a no-op unless tracing is enabled. Passing nil restores the default.
+func SetTracerProvider(tp trace.TracerProvider) {
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+	tracerProvider = tp
+}
+
+func getTracerProvider() trace.TracerProvider {
+	tracerProviderMu.RLock()
+	defer tracerProviderMu.RUnlock()
+	if tracerProvider != nil {
+		return tracerProvider
+	}
+	return otel.GetTracerProvider()
+}
+
 type tracer struct {
 	trace.Tracer
 }
@@ -49,8 +74,8 @@ func (t tracer) Start(
 }
 
 // Tracer returns an OpenTelemetry Tracer implementation to be used
-// to create spans. If you need access to the raw globally-registered
-// tracer, use this function.
+// to create spans. If you need access to the raw tracer from the
+// TracerProvider set with SetTracerProvider, use this function.
 //
 // Most people should not use this function directly, however.
 // Instead, consider using StartSpanWithLogger, which uses
@@ -64,6 +89,26 @@ func (t tracer) Start(
 //	// use the span and context here
 func Tracer() trace.Tracer {
 	return tracer{
-		Tracer: otel.Tracer("capz"),
+		Tracer: getTracerProvider().Tracer("capz"),
+	}
+}
+
+// RecordError records err on the span in ctx and marks the span as failed.
+// It does nothing if err is nil.
+//
+// Example usage:
+//
+//	ctx, _, done := tele.StartSpanWithLogger(ctx, "myFunction")
+//	defer done()
+//	if err := doSomething(ctx); err != nil {
+//		tele.RecordError(ctx, err)
+//		return err
+//	}
+func RecordError(ctx context.Context, err error) {
+	if err == nil {
+		return
 	}
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
 }
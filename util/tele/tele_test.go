@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tele
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStartSpanWithLogger(t *testing.T) {
+	g := NewWithT(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer SetTracerProvider(nil)
+
+	ctx, _, done := StartSpanWithLogger(context.Background(), "parent")
+	_, _, childDone := StartSpanWithLogger(ctx, "child")
+	childDone()
+	RecordError(ctx, nil)
+	RecordError(ctx, errors.New("boom"))
+	done()
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(2))
+	g.Expect(spans[0].Name).To(Equal("child"))
+	g.Expect(spans[0].Parent.SpanID()).To(Equal(spans[1].SpanContext.SpanID()))
+	g.Expect(spans[0].Status.Code).To(Equal(codes.Unset))
+	g.Expect(spans[1].Name).To(Equal("parent"))
+	g.Expect(spans[1].Status.Code).To(Equal(codes.Error))
+	g.Expect(spans[1].Status.Description).To(Equal("boom"))
+	g.Expect(spans[1].Events).To(HaveLen(1))
+}
+
+func TestTracerNoopByDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	_, span := Tracer().Start(context.Background(), "noop")
+	defer span.End()
+	g.Expect(span.SpanContext().IsValid()).To(BeFalse())
+	g.Expect(span.IsRecording()).To(BeFalse())
+}